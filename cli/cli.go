@@ -52,6 +52,8 @@ func NewDefraCommand(cfg *config.Config) *cobra.Command {
 		MakeSchemaMigrationReloadCommand(),
 		MakeSchemaMigrationUpCommand(),
 		MakeSchemaMigrationDownCommand(),
+		MakeSchemaMigrationApplyCommand(),
+		MakeSchemaMigrationStatusCommand(),
 	)
 
 	schema := MakeSchemaCommand()
@@ -96,6 +98,11 @@ func NewDefraCommand(cfg *config.Config) *cobra.Command {
 		MakeCollectionUpdateCommand(),
 		MakeCollectionCreateCommand(),
 		MakeCollectionDescribeCommand(),
+		MakeCollectionDiffCommand(),
+		MakeCollectionRevertCommand(),
+		MakeCollectionCompactCommand(),
+		MakeCollectionPurgeCommand(),
+		MakeCollectionChangesCommand(),
 	)
 
 	client := MakeClientCommand(cfg)
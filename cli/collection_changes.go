@@ -0,0 +1,51 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func MakeCollectionChangesCommand() *cobra.Command {
+	var since string
+	var limit int
+	var cmd = &cobra.Command{
+		Use:   "changes [--since <cursor>] [--limit <limit>]",
+		Short: "View the changes made to the documents of a collection.",
+		Long: `View the changes made to the documents of a collection.
+
+Changes are listed in the order they were applied by this node. Pass the cursor
+of the last processed change with --since to list the changes following it.
+
+Example: view all changes
+  defradb client collection changes --name User
+
+Example: view the next 100 changes after a cursor
+  defradb client collection changes --name User --since 00000000000000000042 --limit 100
+		`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			col, ok := tryGetCollectionContext(cmd)
+			if !ok {
+				return cmd.Usage()
+			}
+
+			changes, err := col.Changes(cmd.Context(), since, limit)
+			if err != nil {
+				return err
+			}
+			return writeJSON(cmd, changes)
+		},
+	}
+	cmd.Flags().StringVar(&since, "since", "", "Cursor of the change to list the following changes of")
+	cmd.Flags().IntVar(&limit, "limit", 0, "Maximum number of changes to list")
+	return cmd
+}
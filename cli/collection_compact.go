@@ -0,0 +1,40 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func MakeCollectionCompactCommand() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "compact",
+		Short: "Compact the history of the documents of a collection.",
+		Long: `Compact the history of the documents of a collection.
+
+The history older than the retention policy of the collection, set with the
+@retention directive, is pruned. The state of each document at the oldest
+retained version is kept so that the retained versions can still be queried.
+
+Example:
+  defradb client collection compact --name User
+		`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			col, ok := tryGetCollectionContext(cmd)
+			if !ok {
+				return cmd.Usage()
+			}
+			return col.CompactHistory(cmd.Context())
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/ipfs/go-cid"
+	"github.com/spf13/cobra"
+)
+
+func MakeCollectionDiffCommand() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "diff <from> <to>",
+		Short: "View the difference between two versions of a document.",
+		Long: `View the field-level difference between two versions of a document.
+
+The versions are identified by the CIDs of their document (composite) commits.
+
+Example:
+  defradb client collection diff --name User bafybeia... bafybeib...
+		`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			col, ok := tryGetCollectionContext(cmd)
+			if !ok {
+				return cmd.Usage()
+			}
+
+			from, err := cid.Decode(args[0])
+			if err != nil {
+				return err
+			}
+			to, err := cid.Decode(args[1])
+			if err != nil {
+				return err
+			}
+			diff, err := col.Diff(cmd.Context(), from, to)
+			if err != nil {
+				return err
+			}
+			return writeJSON(cmd, diff)
+		},
+	}
+	return cmd
+}
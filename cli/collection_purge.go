@@ -0,0 +1,46 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func MakeCollectionPurgeCommand() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "purge <docID>",
+		Short: "Permanently remove a deleted document and its history.",
+		Long: `Permanently remove a deleted document and its history.
+
+The document is removed from this node only, and is remembered as purged so
+that it is not synced again from peers.
+
+Example:
+  defradb client collection purge --name User bae-123
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			col, ok := tryGetCollectionContext(cmd)
+			if !ok {
+				return cmd.Usage()
+			}
+
+			docID, err := client.NewDocIDFromString(args[0])
+			if err != nil {
+				return err
+			}
+			return col.Purge(cmd.Context(), docID)
+		},
+	}
+	return cmd
+}
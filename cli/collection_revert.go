@@ -0,0 +1,51 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/ipfs/go-cid"
+	"github.com/spf13/cobra"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func MakeCollectionRevertCommand() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "revert <docID> <cid>",
+		Short: "Revert a document to a previous version.",
+		Long: `Revert a document to a previous version.
+
+The field values of the given version are written as new commits, leaving
+the history of the document untouched.
+
+Example:
+  defradb client collection revert --name User bae-123 bafybeia...
+		`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			col, ok := tryGetCollectionContext(cmd)
+			if !ok {
+				return cmd.Usage()
+			}
+
+			docID, err := client.NewDocIDFromString(args[0])
+			if err != nil {
+				return err
+			}
+			version, err := cid.Decode(args[1])
+			if err != nil {
+				return err
+			}
+			return col.Revert(cmd.Context(), docID, version)
+		},
+	}
+	return cmd
+}
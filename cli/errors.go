@@ -17,6 +17,7 @@ import (
 const (
 	errInvalidLensConfig        string = "invalid lens configuration"
 	errSchemaVersionNotOfSchema string = "the given schema version is from a different schema"
+	errInvalidRequestVariables  string = "invalid request variables"
 )
 
 var (
@@ -28,12 +29,17 @@ var (
 	ErrInvalidLensConfig        = errors.New("invalid lens configuration")
 	ErrSchemaVersionNotOfSchema = errors.New(errSchemaVersionNotOfSchema)
 	ErrViewAddMissingArgs       = errors.New("please provide a base query and output SDL for this view")
+	ErrNoCommitSigningKey       = errors.New("commit signing requires P2P to be enabled, or a signing key")
 )
 
 func NewErrInvalidLensConfig(inner error) error {
 	return errors.Wrap(errInvalidLensConfig, inner)
 }
 
+func NewErrInvalidRequestVariables(inner error) error {
+	return errors.Wrap(errInvalidRequestVariables, inner)
+}
+
 func NewErrSchemaVersionNotOfSchema(schemaRoot string, schemaVersionID string) error {
 	return errors.New(
 		errSchemaVersionNotOfSchema,
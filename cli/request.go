@@ -11,11 +11,14 @@
 package cli
 
 import (
+	"encoding/json"
 	"io"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/errors"
 )
 
@@ -26,6 +29,8 @@ const (
 
 func MakeRequestCommand() *cobra.Command {
 	var filePath string
+	var operationName string
+	var variables string
 	var cmd = &cobra.Command{
 		Use:   "query [query request]",
 		Short: "Send a DefraDB GraphQL query request",
@@ -40,6 +45,9 @@ Do a query request from a file by using the '-f' flag. Example command:
 Or it can be sent via stdin by using the '-' special syntax. Example command:
   cat request.graphql | defradb client query -
 
+Variable values can be provided as a JSON object, and the operation to execute selected by name. Example command:
+  defradb client query --operation GetUser --variables '{"name": "Bob"}' 'query GetUser($name: String) { ... }'
+
 A GraphQL client such as GraphiQL (https://github.com/graphql/graphiql) can be used to interact
 with the database more conveniently.
 
@@ -68,7 +76,21 @@ To learn more about the DefraDB GraphQL Query Language, refer to https://docs.so
 			if request == "" {
 				return errors.New("request cannot be empty")
 			}
-			result := store.ExecRequest(cmd.Context(), request)
+			var options []client.RequestOption
+			if operationName != "" {
+				options = append(options, client.WithOperationName(operationName))
+			}
+			if variables != "" {
+				// decode numbers to json.Number so that variables keep their precision
+				dec := json.NewDecoder(strings.NewReader(variables))
+				dec.UseNumber()
+				var values map[string]any
+				if err := dec.Decode(&values); err != nil {
+					return NewErrInvalidRequestVariables(err)
+				}
+				options = append(options, client.WithVariables(values))
+			}
+			result := store.ExecRequest(cmd.Context(), request, options...)
 
 			var errors []string
 			for _, err := range result.GQL.Errors {
@@ -76,7 +98,11 @@ To learn more about the DefraDB GraphQL Query Language, refer to https://docs.so
 			}
 			if result.Pub == nil {
 				cmd.Print(REQ_RESULTS_HEADER)
-				return writeJSON(cmd, map[string]any{"data": result.GQL.Data, "errors": errors})
+				out := map[string]any{"data": result.GQL.Data, "errors": errors}
+				if len(result.GQL.Extensions) > 0 {
+					out["extensions"] = result.GQL.Extensions
+				}
+				return writeJSON(cmd, out)
 			}
 			cmd.Print(SUB_RESULTS_HEADER)
 			for item := range result.Pub.Stream() {
@@ -87,5 +113,7 @@ To learn more about the DefraDB GraphQL Query Language, refer to https://docs.so
 	}
 
 	cmd.Flags().StringVarP(&filePath, "file", "f", "", "File containing the query request")
+	cmd.Flags().StringVarP(&operationName, "operation", "o", "", "Name of the operation to execute, required if the request has several operations")
+	cmd.Flags().StringVar(&variables, "variables", "", "JSON object of the values of the request variables")
 	return cmd
 }
@@ -0,0 +1,50 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func MakeSchemaMigrationApplyCommand() *cobra.Command {
+	var opts client.MigrateDocumentsOptions
+	var cmd = &cobra.Command{
+		Use:   "apply [collection]",
+		Short: "Migrate the documents of a collection to its default schema version",
+		Long: `Eagerly migrate the stored documents of a collection to its default schema version
+through the registered schema migrations, updating any indexes.
+
+Documents are migrated in batches, each committed within its own transaction. Progress is
+persisted after each batch so that an interrupted or limited migration may be resumed by
+running the command again. The migration status of the collection is printed on completion.
+
+Example: migrate all documents:
+  defradb client schema migration apply User
+
+Example: migrate up to 10 batches of 500 documents:
+  defradb client schema migration apply User --batch-size 500 --batch-limit 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store := mustGetStoreContext(cmd)
+
+			status, err := store.MigrateDocuments(cmd.Context(), args[0], opts)
+			if err != nil {
+				return err
+			}
+			return writeJSON(cmd, status)
+		},
+	}
+	cmd.Flags().IntVar(&opts.BatchSize, "batch-size", 0, "Maximum number of documents migrated per batch")
+	cmd.Flags().IntVar(&opts.BatchLimit, "batch-limit", 0, "Maximum number of batches to migrate, zero for all")
+	return cmd
+}
@@ -0,0 +1,38 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func MakeSchemaMigrationStatusCommand() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "status [collection]",
+		Short: "Show the progress of the migration of the documents of a collection",
+		Long: `Show how many documents of a collection are at, and are yet to be migrated to,
+the default schema version of the collection.
+
+Example:
+  defradb client schema migration status User`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store := mustGetStoreContext(cmd)
+
+			status, err := store.GetDocumentMigrationStatus(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			return writeJSON(cmd, status)
+		},
+	}
+	return cmd
+}
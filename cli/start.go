@@ -20,11 +20,14 @@ import (
 	"strings"
 	"syscall"
 
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
 	badger "github.com/sourcenetwork/badger/v4"
 	"github.com/spf13/cobra"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/config"
+	"github.com/sourcenetwork/defradb/core"
 	ds "github.com/sourcenetwork/defradb/datastore"
 	badgerds "github.com/sourcenetwork/defradb/datastore/badger/v4"
 	"github.com/sourcenetwork/defradb/db"
@@ -80,6 +83,51 @@ func MakeStartCommand(cfg *config.Config) *cobra.Command {
 		log.FeedbackFatalE(context.Background(), "Could not bind datastore.maxtxnretries", err)
 	}
 
+	cmd.Flags().Bool(
+		"commit-metadata", cfg.Datastore.CommitMetadata,
+		"Record the creation time, and the peer ID of the node if P2P is enabled, in new commits",
+	)
+	err = cfg.BindFlag("datastore.commitmetadata", cmd.Flags().Lookup("commit-metadata"))
+	if err != nil {
+		log.FeedbackFatalE(context.Background(), "Could not bind datastore.commitmetadata", err)
+	}
+
+	cmd.Flags().Bool(
+		"sign-commits", cfg.Datastore.SignCommits,
+		"Sign new commits with the libp2p identity key of the node, or with the key given by --signing-key",
+	)
+	err = cfg.BindFlag("datastore.signcommits", cmd.Flags().Lookup("sign-commits"))
+	if err != nil {
+		log.FeedbackFatalE(context.Background(), "Could not bind datastore.signcommits", err)
+	}
+
+	cmd.Flags().String(
+		"signing-key", cfg.Datastore.SigningKeyPath,
+		"Path to the private key used to sign new commits",
+	)
+	err = cfg.BindFlag("datastore.signingkeypath", cmd.Flags().Lookup("signing-key"))
+	if err != nil {
+		log.FeedbackFatalE(context.Background(), "Could not bind datastore.signingkeypath", err)
+	}
+
+	cmd.Flags().Bool(
+		"require-signatures", cfg.Datastore.RequireSignatures,
+		"Reject unsigned commits received from peers or read from the document history",
+	)
+	err = cfg.BindFlag("datastore.requiresignatures", cmd.Flags().Lookup("require-signatures"))
+	if err != nil {
+		log.FeedbackFatalE(context.Background(), "Could not bind datastore.requiresignatures", err)
+	}
+
+	cmd.Flags().StringArray(
+		"trusted-signers", cfg.Datastore.TrustedSigners,
+		"List of peer IDs whose signed commits are accepted, all other commits are rejected if set",
+	)
+	err = cfg.BindFlag("datastore.trustedsigners", cmd.Flags().Lookup("trusted-signers"))
+	if err != nil {
+		log.FeedbackFatalE(context.Background(), "Could not bind datastore.trustedsigners", err)
+	}
+
 	cmd.Flags().String(
 		"store", cfg.Datastore.Store,
 		"Specify the datastore to use (supported: badger, memory)",
@@ -211,6 +259,58 @@ func start(ctx context.Context, cfg *config.Config) (*defraInstance, error) {
 		db.WithMaxRetries(cfg.Datastore.MaxTxnRetries),
 	}
 
+	var peerKey crypto.PrivKey
+	if !cfg.Net.P2PDisabled {
+		if cfg.Datastore.Store == badgerDatastoreName {
+			// It would be ideal to not have the key path tied to the datastore.
+			// Running with memory store mode will always generate a random key.
+			// Adding support for an ephemeral mode and moving the key to the
+			// config would solve both of these issues.
+			peerKey, err = loadOrGeneratePrivateKey(filepath.Join(cfg.Rootdir, "data", "key"))
+			if err != nil {
+				return nil, err
+			}
+		} else if cfg.Datastore.CommitMetadata || cfg.Datastore.SignCommits {
+			// the ephemeral key is generated here, instead of by the node, so that
+			// its peer ID may be recorded in, or used to sign, the commits.
+			peerKey, _, err = crypto.GenerateKeyPair(crypto.Ed25519, 0)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if cfg.Datastore.CommitMetadata {
+		options = append(options, db.WithCommitTimestamps())
+		if peerKey != nil {
+			peerID, err := peer.IDFromPrivateKey(peerKey)
+			if err != nil {
+				return nil, err
+			}
+			options = append(options, db.WithCommitAuthor(peerID.String()))
+		}
+	}
+
+	if cfg.Datastore.SignCommits {
+		signingKey := peerKey
+		if cfg.Datastore.SigningKeyPath != "" {
+			signingKey, err = loadPrivateKey(cfg.Datastore.SigningKeyPath)
+			if err != nil {
+				return nil, errors.Wrap("failed to load commit signing key", err)
+			}
+		}
+		if signingKey == nil {
+			return nil, ErrNoCommitSigningKey
+		}
+		options = append(options, db.WithCommitSigningKey(signingKey))
+	}
+
+	signaturePolicy := core.SignaturePolicy{
+		RequireSignature: cfg.Datastore.RequireSignatures,
+		TrustedSigners:   cfg.Datastore.TrustedSigners,
+	}
+	options = append(options, db.WithSignaturePolicy(signaturePolicy))
+
 	db, err := db.NewDB(ctx, rootstore, options...)
 	if err != nil {
 		return nil, errors.Wrap("failed to create database", err)
@@ -221,17 +321,10 @@ func start(ctx context.Context, cfg *config.Config) (*defraInstance, error) {
 	if !cfg.Net.P2PDisabled {
 		nodeOpts := []net.NodeOpt{
 			net.WithConfig(cfg),
+			net.WithSignaturePolicy(signaturePolicy),
 		}
-		if cfg.Datastore.Store == badgerDatastoreName {
-			// It would be ideal to not have the key path tied to the datastore.
-			// Running with memory store mode will always generate a random key.
-			// Adding support for an ephemeral mode and moving the key to the
-			// config would solve both of these issues.
-			key, err := loadOrGeneratePrivateKey(filepath.Join(cfg.Rootdir, "data", "key"))
-			if err != nil {
-				return nil, err
-			}
-			nodeOpts = append(nodeOpts, net.WithPrivateKey(key))
+		if peerKey != nil {
+			nodeOpts = append(nodeOpts, net.WithPrivateKey(peerKey))
 		}
 		log.FeedbackInfo(ctx, "Starting P2P node", logging.NewKV("P2P address", cfg.Net.P2PAddress))
 		node, err = net.NewNode(ctx, db, nodeOpts...)
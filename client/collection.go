@@ -12,6 +12,9 @@ package client
 
 import (
 	"context"
+	"time"
+
+	"github.com/ipfs/go-cid"
 
 	"github.com/sourcenetwork/defradb/datastore"
 )
@@ -156,6 +159,51 @@ type Collection interface {
 	// Returns an ErrDocumentNotFound if a document matching the given DocID is not found.
 	Get(ctx context.Context, docID DocID, showDeleted bool) (*Document, error)
 
+	// Diff returns the field-level difference between two versions of a document.
+	//
+	// The versions are identified by the CIDs of their document (composite) commits, and
+	// do not need to be ancestors of one another, but must belong to the same document.
+	Diff(ctx context.Context, from cid.Cid, to cid.Cid) (*DocumentDiff, error)
+
+	// Revert restores the field values of the document with the given DocID to those of the
+	// given version.
+	//
+	// The history of the document is not rewritten, the restored values are written as new
+	// commits so that peers converge on the reverted state.
+	//
+	// Returns an ErrDocumentNotFound if a document matching the given DocID is not found.
+	Revert(ctx context.Context, docID DocID, version cid.Cid) error
+
+	// CompactHistory applies the retention policy of the collection to the history of its
+	// documents.
+	//
+	// The state of each document at the oldest retained version is snapshotted, and the older
+	// commits are pruned from the blockstore. Time-travel queries on retained versions keep
+	// working, but pruned versions can no longer be queried, nor synced to peers. Peers lacking
+	// the history of a document are sent its snapshot instead.
+	//
+	// Does nothing if the collection has no retention policy.
+	CompactHistory(ctx context.Context) error
+
+	// Purge permanently removes the deleted document with the given DocID, including its
+	// entire history, from this node.
+	//
+	// The document is remembered as purged so that its commits are not synced again from
+	// peers. The purge is advertised to peers, which purge the document as well if they have
+	// deleted it.
+	//
+	// Returns an ErrDocumentNotFound if a document matching the given DocID is not found.
+	Purge(ctx context.Context, docID DocID) error
+
+	// Changes returns the changes made to the documents of the collection after the change of
+	// the given cursor, in the order this node applied them, up to the given limit.
+	//
+	// Creates, updates and deletes are recorded whether they were made locally or received
+	// from peers. The change log is persisted, so consumers can resume from the cursor of the
+	// last change they processed. An empty cursor returns the changes from the start of the
+	// log, and a limit of zero returns all of them.
+	Changes(ctx context.Context, since string, limit int) ([]Change, error)
+
 	// WithTxn returns a new instance of the collection, with a transaction
 	// handle instead of a raw DB handle.
 	WithTxn(datastore.Txn) Collection
@@ -201,6 +249,63 @@ type DeleteResult struct {
 	DocIDs []string
 }
 
+// DocumentDiff is the field-level difference between two versions of a document.
+type DocumentDiff struct {
+	// DocID is the ID of the document the versions belong to.
+	DocID string `json:"docID"`
+	// From is the CID of the version the difference is from.
+	From string `json:"from"`
+	// To is the CID of the version the difference is to.
+	To string `json:"to"`
+	// Commits contains the CIDs of the document commits that are part of the `To` version,
+	// but not of the `From` version, in the order they were made.
+	Commits []string `json:"commits"`
+	// Fields contains the fields whose value differs between the two versions.
+	Fields []FieldDiff `json:"fields"`
+}
+
+// FieldDiff is the difference between the values of a field in two versions of a document.
+type FieldDiff struct {
+	// Name is the name of the field.
+	//
+	// A change of the deleted status of the document is reported as a change of the
+	// `_deleted` field.
+	Name string `json:"name"`
+	// Relation is the name of the relation field that this field holds the related document
+	// ID of, if any.
+	Relation string `json:"relation,omitempty"`
+	// Before is the value of the field in the `From` version.
+	Before any `json:"before"`
+	// After is the value of the field in the `To` version.
+	After any `json:"after"`
+}
+
+// ChangeType is the kind of change made to a document by a commit.
+type ChangeType string
+
+const (
+	// ChangeTypeCreate is the change made by the first commit of a document.
+	ChangeTypeCreate ChangeType = "create"
+	// ChangeTypeUpdate is the change made by a commit updating the fields of a document.
+	ChangeTypeUpdate ChangeType = "update"
+	// ChangeTypeDelete is the change made by a commit deleting a document.
+	ChangeTypeDelete ChangeType = "delete"
+)
+
+// Change is an entry of the change log of a collection.
+type Change struct {
+	// Cursor identifies the position of the change in the change log.
+	Cursor string `json:"cursor"`
+	// DocID is the ID of the changed document.
+	DocID string `json:"docID"`
+	// Cid is the CID of the document (composite) commit that made the change.
+	Cid string `json:"cid"`
+	// Type is the kind of change.
+	Type ChangeType `json:"type"`
+	// Time is the time at which this node applied the change.
+	Time time.Time `json:"time"`
+}
+
 // P2PCollection is the gRPC response representation of a P2P collection topic
 type P2PCollection struct {
 	// The collection ID
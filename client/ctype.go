@@ -39,10 +39,12 @@ func (t CType) IsSupportedFieldCType() bool {
 func (t CType) IsCompatibleWith(kind FieldKind) bool {
 	switch t {
 	case PN_COUNTER:
-		if kind == FieldKind_INT || kind == FieldKind_FLOAT {
+		switch kind {
+		case FieldKind_INT, FieldKind_FLOAT, FieldKind_BIGINT, FieldKind_DECIMAL:
 			return true
+		default:
+			return false
 		}
-		return false
 	default:
 		return true
 	}
@@ -112,6 +112,11 @@ type Store interface {
 	//
 	// Field [FieldKind] values may be provided in either their raw integer form, or as string as per
 	// [FieldKindStringToEnumMapping].
+	//
+	// Non-relational fields may be removed, renamed, or have their kind changed between scalar kinds.
+	// A migration built from the built-in lens modules will be registered from the previous schema
+	// version to the new one, and any indexes on the affected fields will be dropped, renamed or rebuilt
+	// when the new version is made default.
 	PatchSchema(context.Context, string, bool) error
 
 	// SetDefaultSchemaVersion sets the default schema version to the ID provided.  It will be applied to all
@@ -165,6 +170,20 @@ type Store interface {
 	// schema version.
 	SetMigration(context.Context, LensConfig) error
 
+	// MigrateDocuments eagerly migrates the documents of the given collection to the default schema
+	// version of the collection through the registered migrations, persisting the migrated values
+	// and updating any indexes.
+	//
+	// Documents are migrated in batches, each within its own transaction unless called within an
+	// explicit transaction.  Progress is persisted after each batch, allowing an interrupted or
+	// limited migration to be resumed by calling this again.  The status of the collection's
+	// documents after the call is returned.
+	MigrateDocuments(context.Context, CollectionName, MigrateDocumentsOptions) (DocumentMigrationStatus, error)
+
+	// GetDocumentMigrationStatus returns how many documents of the given collection are at, and
+	// are yet to be migrated to, the default schema version of the collection.
+	GetDocumentMigrationStatus(context.Context, CollectionName) (DocumentMigrationStatus, error)
+
 	// LensRegistry returns the LensRegistry in use by this database instance.
 	//
 	// It exposes several useful thread-safe migration related functions.
@@ -187,6 +206,8 @@ type Store interface {
 
 	// GetAllCollections returns all the collections and their descriptions that currently exist within
 	// this [Store].
+	//
+	// Internal collections, such as the link collections of many-to-many relations, are excluded.
 	GetAllCollections(context.Context) ([]Collection, error)
 
 	// GetSchemasByName returns the all schema versions with the given name.
@@ -209,7 +230,49 @@ type Store interface {
 	GetAllIndexes(context.Context) (map[CollectionName][]IndexDescription, error)
 
 	// ExecRequest executes the given GQL request against the [Store].
-	ExecRequest(context.Context, string) *RequestResult
+	//
+	// Variables referenced by the request and the name of the operation to execute may be
+	// provided via the [RequestOption]s [WithVariables] and [WithOperationName].
+	ExecRequest(context.Context, string, ...RequestOption) *RequestResult
+}
+
+// GQLOptions contains the optional parameters of a GQL request.
+type GQLOptions struct {
+	// OperationName is the name of the operation within the request to execute.
+	//
+	// May only be empty if the request contains a single operation, requests containing several
+	// operations return an error without one.
+	OperationName string
+
+	// Variables contains the values of the variables referenced by the request, keyed
+	// by variable name.
+	Variables map[string]any
+}
+
+// RequestOption sets an optional parameter of a GQL request.
+type RequestOption func(*GQLOptions)
+
+// WithOperationName sets the name of the operation within the request to execute.
+func WithOperationName(operationName string) RequestOption {
+	return func(o *GQLOptions) {
+		o.OperationName = operationName
+	}
+}
+
+// WithVariables sets the values of the variables referenced by the request.
+func WithVariables(variables map[string]any) RequestOption {
+	return func(o *GQLOptions) {
+		o.Variables = variables
+	}
+}
+
+// NewGQLOptions returns the [GQLOptions] set by the given [RequestOption]s.
+func NewGQLOptions(opts ...RequestOption) GQLOptions {
+	var options GQLOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return options
 }
 
 // GQLResult represents the immediate results of a GQL request.
@@ -226,6 +289,10 @@ type GQLResult struct {
 	//
 	// It will be nil if any errors were raised during execution.
 	Data any `json:"data"`
+
+	// Extensions contains any information about the result that is not part of the
+	// resultant data, such as the page info of the documents yielded by a paginated request.
+	Extensions map[string]any `json:"extensions,omitempty"`
 }
 
 // RequestResult represents the results of a GQL request.
@@ -12,6 +12,10 @@ package client
 
 import (
 	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/sourcenetwork/immutable"
 
 	"github.com/sourcenetwork/defradb/client/request"
 )
@@ -41,6 +45,51 @@ type CollectionDescription struct {
 
 	// Indexes contains the secondary indexes that this Collection has.
 	Indexes []IndexDescription
+
+	// RetentionPolicy defines how much of the history of the documents of this collection is
+	// kept when it is compacted.
+	//
+	// The full history is kept if nil.
+	RetentionPolicy *RetentionPolicy
+
+	// TTL defines when the documents of this collection expire, expired documents are
+	// automatically deleted by the node that created them.
+	//
+	// Documents never expire if nil.
+	TTL *TTLDescription
+
+	// Internal is true if this collection is managed by the database itself, such as the
+	// collection holding the links of a many-to-many relation.
+	//
+	// Internal collections are not returned by [Store.GetAllCollections] and are not exposed
+	// through GraphQL, they are synced by the P2P system along with the collections they
+	// belong to.
+	Internal bool
+}
+
+// RetentionPolicy defines how much of the history of a document is kept when it is compacted.
+//
+// If both limits are set, the versions kept by either of them are kept. The current version of
+// a document is always kept.
+type RetentionPolicy struct {
+	// MaxVersions is the number of most recent versions to keep.
+	MaxVersions immutable.Option[int]
+
+	// MaxAge is the duration, since they were applied to this node, for which versions are kept.
+	MaxAge immutable.Option[time.Duration]
+}
+
+// TTLDescription defines when the documents of a collection expire.
+//
+// Expired documents are deleted by the node that created them, the deletions being replicated
+// to its peers. Should that node be unavailable, the other nodes delete the documents once they
+// have been expired for longer than their grace period.
+type TTLDescription struct {
+	// Field is the name of the DateTime field holding the time at which a document expires.
+	//
+	// Documents without a value for this field never expire. As date times are stored in whole
+	// seconds, documents are deleted once the whole second of their expiry time has passed.
+	Field string
 }
 
 // IDString returns the collection ID as a string.
@@ -110,6 +159,12 @@ type SchemaDescription struct {
 	//
 	// Currently new fields may be added after initial declaration, but they cannot be removed.
 	Fields []FieldDescription
+
+	// Enums contains the enum types referenced by the fields within this Schema.
+	//
+	// New enums, and new values within existing enums, may be added after initial declaration,
+	// but existing values cannot be removed or reordered.
+	Enums []EnumDescription `json:",omitempty"`
 }
 
 // GetField returns the field of the given name.
@@ -122,6 +177,37 @@ func (sd SchemaDescription) GetField(name string) (FieldDescription, bool) {
 	return FieldDescription{}, false
 }
 
+// GetEnum returns the enum of the given name.
+func (sd SchemaDescription) GetEnum(name string) (EnumDescription, bool) {
+	for _, enum := range sd.Enums {
+		if enum.Name == name {
+			return enum, true
+		}
+	}
+	return EnumDescription{}, false
+}
+
+// EnumDescription describes an enum type and the values that it permits.
+type EnumDescription struct {
+	// Name is the name of this enum.
+	//
+	// It is immutable.
+	Name string
+
+	// Values contains the set of values that fields of this enum type may hold.
+	Values []string
+}
+
+// HasValue returns true if the given value is permitted by this enum.
+func (e EnumDescription) HasValue(value string) bool {
+	for _, v := range e.Values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
 // FieldKind describes the type of a field.
 type FieldKind uint8
 
@@ -157,6 +243,12 @@ func (f FieldKind) String() string {
 		return "[String!]"
 	case FieldKind_BLOB:
 		return "Blob"
+	case FieldKind_ENUM:
+		return "Enum"
+	case FieldKind_BIGINT:
+		return "BigInt"
+	case FieldKind_DECIMAL:
+		return "Decimal"
 	default:
 		return fmt.Sprint(uint8(f))
 	}
@@ -191,6 +283,16 @@ const (
 	FieldKind_NILLABLE_INT_ARRAY    FieldKind = 19
 	FieldKind_NILLABLE_FLOAT_ARRAY  FieldKind = 20
 	FieldKind_NILLABLE_STRING_ARRAY FieldKind = 21
+
+	// Enum value, the permitted values are defined by the [EnumDescription] on the
+	// host schema that is named by the field's Schema property.
+	FieldKind_ENUM FieldKind = 22
+
+	// Arbitrary precision integer, held as a [*big.Int].
+	FieldKind_BIGINT FieldKind = 23
+
+	// Arbitrary precision decimal, held as a [decimal.Decimal].
+	FieldKind_DECIMAL FieldKind = 24
 )
 
 // FieldKindStringToEnumMapping maps string representations of [FieldKind] values to
@@ -216,6 +318,8 @@ var FieldKindStringToEnumMapping = map[string]FieldKind{
 	"[String]":   FieldKind_NILLABLE_STRING_ARRAY,
 	"[String!]":  FieldKind_STRING_ARRAY,
 	"Blob":       FieldKind_BLOB,
+	"BigInt":     FieldKind_BIGINT,
+	"Decimal":    FieldKind_DECIMAL,
 }
 
 // RelationType describes the type of relation between two types.
@@ -261,7 +365,8 @@ type FieldDescription struct {
 	Kind FieldKind
 
 	// Schema contains the schema name of the type this field contains if this field is
-	// a relation field.  Otherwise this will be empty.
+	// a relation field, or the name of the enum if this field is an enum field.  Otherwise
+	// this will be empty.
 	Schema string
 
 	// RelationName the name of the relationship that this field represents if this field is
@@ -276,6 +381,120 @@ type FieldDescription struct {
 	// RelationType contains the relationship type if this field is a relation field. Otherwise this
 	// will be empty.
 	RelationType RelationType
+
+	// IsNonNull is true if documents must hold a value for this field, it may not be omitted
+	// on create nor cleared on update.
+	//
+	// It is currently immutable.
+	IsNonNull bool `json:",omitempty"`
+
+	// DefaultValue contains the JSON encoded value that will be given to this field if no value
+	// has been provided for it when creating a new document.  If empty, the field has no default.
+	//
+	// It is currently immutable.
+	DefaultValue string `json:",omitempty"`
+
+	// Constraint contains the constraints that any value of this field must satisfy.  If nil,
+	// the field has no constraints.
+	//
+	// It is currently immutable.
+	Constraint *FieldConstraint `json:",omitempty"`
+
+	// Computed contains the expression from which the value of this field is computed at
+	// query time.  If empty, the field holds stored values.  Computed fields are read-only.
+	//
+	// It is currently immutable.
+	Computed string `json:",omitempty"`
+
+	// OnDelete describes what happens to documents that reference a related document through
+	// this field when that document is deleted.  If empty, the reference is left as is.
+	//
+	// It may only be set on the primary side of a relation. It is currently immutable.
+	OnDelete RelationOnDelete `json:",omitempty"`
+
+	// CheckExists is true if the related document must exist when a document references it
+	// through this field.
+	//
+	// It may only be set on the primary side of a relation. It is currently immutable.
+	CheckExists bool `json:",omitempty"`
+}
+
+// RelationOnDelete describes what happens to the documents referencing a document through
+// a relation when that document is deleted.
+//
+// The options are only applied by the node on which the document is deleted.  Documents
+// deleted or updated as a result are shared with peers as any other change, whilst deletions
+// restricted by a relation are only prevented locally, and are not enforced on the deletions
+// received from peers.
+type RelationOnDelete string
+
+const (
+	// RelationOnDeleteCascade deletes the referencing documents.
+	RelationOnDeleteCascade RelationOnDelete = "CASCADE"
+	// RelationOnDeleteSetNull clears the reference held by the referencing documents.
+	RelationOnDeleteSetNull RelationOnDelete = "SET_NULL"
+	// RelationOnDeleteRestrict prevents the deletion of referenced documents.
+	RelationOnDeleteRestrict RelationOnDelete = "RESTRICT"
+)
+
+// FieldConstraint describes the constraints that the values of a field must satisfy.
+type FieldConstraint struct {
+	// Min is the inclusive minimum value permitted for a numeric field.
+	//
+	// It is held as a decimal so that bounds of BigInt and Decimal fields are not rounded.
+	Min immutable.Option[decimal.Decimal]
+
+	// Max is the inclusive maximum value permitted for a numeric field.
+	//
+	// It is held as a decimal so that bounds of BigInt and Decimal fields are not rounded.
+	Max immutable.Option[decimal.Decimal]
+
+	// Pattern is a regular expression that values of a string field must match.
+	Pattern string
+
+	// MaxLength is the maximum permitted length of a string field, or the maximum permitted
+	// number of items in an array field.
+	MaxLength immutable.Option[int]
+}
+
+// Equal returns true if the given constraint is equal to this constraint.
+//
+// Nil constraints are equal only to other nil constraints.
+func (c *FieldConstraint) Equal(other *FieldConstraint) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+	return equalDecimalOption(c.Min, other.Min) &&
+		equalDecimalOption(c.Max, other.Max) &&
+		c.Pattern == other.Pattern &&
+		c.MaxLength == other.MaxLength
+}
+
+func equalDecimalOption(a immutable.Option[decimal.Decimal], b immutable.Option[decimal.Decimal]) bool {
+	if !a.HasValue() || !b.HasValue() {
+		return a.HasValue() == b.HasValue()
+	}
+	return a.Value().Equal(b.Value())
+}
+
+// Equal returns true if the given field description is equal to this description.
+func (f FieldDescription) Equal(other FieldDescription) bool {
+	if !f.Constraint.Equal(other.Constraint) {
+		return false
+	}
+	f.Constraint = nil
+	other.Constraint = nil
+	return f == other
+}
+
+// HasDefaultValue returns true if this field has a default value.
+func (f FieldDescription) HasDefaultValue() bool {
+	return f.DefaultValue != ""
+}
+
+// IsComputed returns true if the value of this field is computed at query time.
+func (f FieldDescription) IsComputed() bool {
+	return f.Computed != ""
 }
 
 // IsInternal returns true if this field is internally generated.
@@ -299,6 +518,16 @@ func (f FieldDescription) IsPrimaryRelation() bool {
 	return f.RelationType > 0 && f.RelationType&Relation_Type_Primary != 0
 }
 
+// IsManyToMany returns true if this field is a side of a many-to-many relation.
+func (f FieldDescription) IsManyToMany() bool {
+	return f.RelationType.IsSet(Relation_Type_MANYMANY)
+}
+
+// IsEnum returns true if this field is an enum type.
+func (f FieldDescription) IsEnum() bool {
+	return f.Kind == FieldKind_ENUM
+}
+
 // IsRelation returns true if this field is a relation.
 func (f FieldDescription) IsRelation() bool {
 	return f.RelationType > 0
@@ -318,6 +547,22 @@ func (f FieldDescription) IsArray() bool {
 		f.Kind == FieldKind_NILLABLE_STRING_ARRAY
 }
 
+// LinkedFieldName is the name of the field of a link collection that holds whether the
+// documents it references are currently related.
+const LinkedFieldName = "linked"
+
+// LinkCollectionName returns the name of the hidden collection holding the links between the
+// documents of the many-to-many relation with the given name.
+func LinkCollectionName(relationName string) string {
+	return "_" + relationName
+}
+
+// LinkFieldName returns the name of the field of a link collection that holds the IDs of the
+// documents related through the given many-to-many relation field.
+func LinkFieldName(field FieldDescription) string {
+	return field.Name + request.RelatedObjectID
+}
+
 // IsSet returns true if the target relation type is set.
 func (m RelationType) IsSet(target RelationType) bool {
 	return m&target > 0
@@ -12,18 +12,23 @@ package client
 
 import (
 	"encoding/json"
+	"math/big"
+	"reflect"
 	"regexp"
 	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/fxamacker/cbor/v2"
 	"github.com/ipfs/go-cid"
+	"github.com/shopspring/decimal"
 	"github.com/sourcenetwork/immutable"
 	"github.com/valyala/fastjson"
 
 	"github.com/sourcenetwork/defradb/client/request"
 	ccid "github.com/sourcenetwork/defradb/core/cid"
+	"github.com/sourcenetwork/defradb/errors"
 )
 
 // This is the main implementation starting point for accessing the internal Document API
@@ -84,7 +89,22 @@ func NewDocWithID(docID DocID, sd SchemaDescription) *Document {
 }
 
 // NewDocFromMap creates a new Document from a data map.
+//
+// Default values are not set, use NewDocFromMapWithDefaults to create a new document.
 func NewDocFromMap(data map[string]any, sd SchemaDescription) (*Document, error) {
+	return newDocFromMap(data, sd, false)
+}
+
+// NewDocFromMapWithDefaults creates a new Document from a data map, and sets the default value
+// of every field that has one and is not in the map.
+//
+// It must only be used to create new documents, as default values are not given to existing
+// documents.
+func NewDocFromMapWithDefaults(data map[string]any, sd SchemaDescription) (*Document, error) {
+	return newDocFromMap(data, sd, true)
+}
+
+func newDocFromMap(data map[string]any, sd SchemaDescription, withDefaults bool) (*Document, error) {
 	var err error
 	doc := newEmptyDoc(sd)
 
@@ -106,6 +126,13 @@ func NewDocFromMap(data map[string]any, sd SchemaDescription) (*Document, error)
 		return nil, err
 	}
 
+	if withDefaults {
+		err = doc.setDefaultValues()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// if no DocID was specified, then we assume it doesn't exist and we generate, and set it.
 	if !hasDocID {
 		err = doc.generateAndSetDocID()
@@ -125,12 +152,18 @@ func IsJSONArray(obj []byte) bool {
 }
 
 // NewFromJSON creates a new instance of a Document from a raw JSON object byte array.
+//
+// The default value of every field that has one and is not in the object is set.
 func NewDocFromJSON(obj []byte, sd SchemaDescription) (*Document, error) {
 	doc := newEmptyDoc(sd)
 	err := doc.SetWithJSON(obj)
 	if err != nil {
 		return nil, err
 	}
+	err = doc.setDefaultValues()
+	if err != nil {
+		return nil, err
+	}
 	err = doc.generateAndSetDocID()
 	if err != nil {
 		return nil, err
@@ -140,6 +173,8 @@ func NewDocFromJSON(obj []byte, sd SchemaDescription) (*Document, error) {
 
 // ManyFromJSON creates a new slice of Documents from a raw JSON array byte array.
 // It will return an error if the given byte array is not a valid JSON array.
+//
+// The default value of every field that has one and is not in an object is set.
 func NewDocsFromJSON(obj []byte, sd SchemaDescription) ([]*Document, error) {
 	v, err := fastjson.ParseBytes(obj)
 	if err != nil {
@@ -161,6 +196,10 @@ func NewDocsFromJSON(obj []byte, sd SchemaDescription) ([]*Document, error) {
 		if err != nil {
 			return nil, err
 		}
+		err = doc.setDefaultValues()
+		if err != nil {
+			return nil, err
+		}
 		err = doc.generateAndSetDocID()
 		if err != nil {
 			return nil, err
@@ -177,7 +216,7 @@ func NewDocsFromJSON(obj []byte, sd SchemaDescription) ([]*Document, error) {
 // the typed value again as an interface.
 func validateFieldSchema(val any, field FieldDescription) (any, error) {
 	switch field.Kind {
-	case FieldKind_DocID, FieldKind_STRING, FieldKind_BLOB:
+	case FieldKind_DocID, FieldKind_STRING, FieldKind_BLOB, FieldKind_ENUM:
 		return getString(val)
 
 	case FieldKind_STRING_ARRAY:
@@ -216,6 +255,12 @@ func validateFieldSchema(val any, field FieldDescription) (any, error) {
 	case FieldKind_NILLABLE_INT_ARRAY:
 		return getNillableArray(val, getInt64)
 
+	case FieldKind_BIGINT:
+		return getBigInt(val)
+
+	case FieldKind_DECIMAL:
+		return getDecimal(val)
+
 	case FieldKind_FOREIGN_OBJECT:
 		return getString(val)
 
@@ -249,6 +294,8 @@ func getFloat64(v any) (float64, error) {
 	switch val := v.(type) {
 	case *fastjson.Value:
 		return val.Float64()
+	case json.Number:
+		return val.Float64()
 	case int:
 		return float64(val), nil
 	case int32:
@@ -266,6 +313,8 @@ func getInt64(v any) (int64, error) {
 	switch val := v.(type) {
 	case *fastjson.Value:
 		return val.Int64()
+	case json.Number:
+		return val.Int64()
 	case int:
 		return int64(val), nil
 	case int32:
@@ -279,6 +328,143 @@ func getInt64(v any) (int64, error) {
 	}
 }
 
+// MaxBigNumberDigits is the maximum number of digits of BigInt and Decimal values, counting the
+// zeros implied by their exponent.
+//
+// These values are held in full once parsed, so without a limit a number written in a short
+// exponent form, such as `1e1000000000`, would expand into a value far too large to handle.
+const MaxBigNumberDigits = 10_000
+
+// ParseBigNumber parses the given base 10 number, which may be written in exponent form.
+//
+// An error is returned if the number has more than MaxBigNumberDigits digits.
+func ParseBigNumber(s string) (decimal.Decimal, error) {
+	d, err := decimal.NewFromString(s)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	return d, CheckBigNumberDigits(d)
+}
+
+// CheckBigNumberDigits returns an error if the given number has more than MaxBigNumberDigits
+// digits.
+func CheckBigNumberDigits(d decimal.Decimal) error {
+	exponent := int64(d.Exponent())
+	if exponent < 0 {
+		exponent = -exponent
+	}
+	if exponent > MaxBigNumberDigits {
+		return NewErrNumberTooLarge(exponent)
+	}
+	coefficient := d.Coefficient()
+	digits := int64(len(coefficient.Abs(coefficient).String())) + exponent
+	if digits > MaxBigNumberDigits {
+		return NewErrNumberTooLarge(digits)
+	}
+	return nil
+}
+
+func getBigInt(v any) (*big.Int, error) {
+	switch val := v.(type) {
+	case *fastjson.Value:
+		switch val.Type() {
+		case fastjson.TypeNumber:
+			// The raw JSON text is used so that values outside of the float64
+			// range do not lose precision.
+			return getBigInt(val.String())
+		default:
+			b, err := val.StringBytes()
+			if err != nil {
+				return nil, err
+			}
+			return getBigInt(string(b))
+		}
+	case *big.Int:
+		return val, nil
+	case json.Number:
+		return getBigInt(val.String())
+	case int:
+		return big.NewInt(int64(val)), nil
+	case int32:
+		return big.NewInt(int64(val)), nil
+	case int64:
+		return big.NewInt(val), nil
+	case uint64:
+		return new(big.Int).SetUint64(val), nil
+	case float64:
+		d := decimal.NewFromFloat(val)
+		if !d.IsInteger() {
+			return nil, NewErrUnexpectedType[*big.Int]("field", v)
+		}
+		return d.BigInt(), nil
+	case decimal.Decimal:
+		if !val.IsInteger() {
+			return nil, NewErrUnexpectedType[*big.Int]("field", v)
+		}
+		if err := CheckBigNumberDigits(val); err != nil {
+			return nil, err
+		}
+		return val.BigInt(), nil
+	case string:
+		// Integers written in exponent form, e.g. `1e21`, are still valid.
+		d, err := ParseBigNumber(val)
+		if errors.Is(err, ErrNumberTooLarge) {
+			return nil, err
+		}
+		if err != nil || !d.IsInteger() {
+			return nil, NewErrUnexpectedType[*big.Int]("field", v)
+		}
+		return d.BigInt(), nil
+	default:
+		return nil, NewErrUnexpectedType[*big.Int]("field", v)
+	}
+}
+
+func getDecimal(v any) (decimal.Decimal, error) {
+	switch val := v.(type) {
+	case *fastjson.Value:
+		switch val.Type() {
+		case fastjson.TypeNumber:
+			// The raw JSON text is used so that the value does not lose precision
+			// by being parsed as a float64.
+			return getDecimal(val.String())
+		default:
+			b, err := val.StringBytes()
+			if err != nil {
+				return decimal.Decimal{}, err
+			}
+			return getDecimal(string(b))
+		}
+	case decimal.Decimal:
+		return val, CheckBigNumberDigits(val)
+	case json.Number:
+		return getDecimal(val.String())
+	case *big.Int:
+		return decimal.NewFromBigInt(val, 0), nil
+	case int:
+		return decimal.NewFromInt(int64(val)), nil
+	case int32:
+		return decimal.NewFromInt32(val), nil
+	case int64:
+		return decimal.NewFromInt(val), nil
+	case uint64:
+		return decimal.NewFromBigInt(new(big.Int).SetUint64(val), 0), nil
+	case float64:
+		return decimal.NewFromFloat(val), nil
+	case string:
+		d, err := ParseBigNumber(val)
+		if errors.Is(err, ErrNumberTooLarge) {
+			return decimal.Decimal{}, err
+		}
+		if err != nil {
+			return decimal.Decimal{}, NewErrUnexpectedType[decimal.Decimal]("field", v)
+		}
+		return d, nil
+	default:
+		return decimal.Decimal{}, NewErrUnexpectedType[decimal.Decimal]("field", v)
+	}
+}
+
 func getDateTime(v any) (time.Time, error) {
 	var s string
 	switch val := v.(type) {
@@ -504,13 +690,199 @@ func (doc *Document) Set(field string, value any) error {
 			return NewErrFieldNotExist(field)
 		}
 	}
+	if fd.IsComputed() {
+		return NewErrComputedFieldReadOnly(field)
+	}
+	if isNullValue(value) {
+		err := ValidateFieldValue(nil, fd, doc.schemaDescription)
+		if err != nil {
+			return err
+		}
+		return doc.setCBOR(fd.Typ, field, nil)
+	}
 	val, err := validateFieldSchema(value, fd)
 	if err != nil {
 		return err
 	}
+	err = ValidateFieldValue(val, fd, doc.schemaDescription)
+	if err != nil {
+		return err
+	}
+	return doc.setCBOR(fd.Typ, field, val)
+}
+
+// ValidateFieldValue returns an error if the given value may not be held by the given field of
+// the given schema, because the field is non-null, because it is not one of the values of the
+// enum of the field, or because it does not satisfy the constraint of the field.
+//
+// The value is expected to have already been converted to the standardized Defra Go type
+// of the field.
+func ValidateFieldValue(val any, field FieldDescription, schema SchemaDescription) error {
+	if val == nil {
+		if field.IsNonNull {
+			return NewErrNonNullFieldNotSet(field.Name)
+		}
+		return nil
+	}
+	if field.IsEnum() {
+		err := validateEnumValue(val, field, schema)
+		if err != nil {
+			return err
+		}
+	}
+	return ValidateFieldConstraint(val, field)
+}
+
+// SetCounterDelta sets the value to be added to the current value of the given counter field.
+//
+// Unlike Set, the constraint of the field is not checked as it applies to the value of the
+// counter, not to the values added to it.
+func (doc *Document) SetCounterDelta(field string, delta any) error {
+	fd, exists := doc.schemaDescription.GetField(field)
+	if !exists {
+		return NewErrFieldNotExist(field)
+	}
+	if fd.Typ != PN_COUNTER {
+		return NewErrFieldNotCounter(field)
+	}
+	val, err := validateFieldSchema(delta, fd)
+	if err != nil {
+		return err
+	}
 	return doc.setCBOR(fd.Typ, field, val)
 }
 
+// isNullValue returns true if the given value represents null.
+func isNullValue(value any) bool {
+	if value == nil {
+		return true
+	}
+	if jsonValue, isJSON := value.(*fastjson.Value); isJSON {
+		return jsonValue.Type() == fastjson.TypeNull
+	}
+	return false
+}
+
+// setDefaultValues sets the default value of every field that has one, and that has not yet
+// been given a value.
+func (doc *Document) setDefaultValues() error {
+	for _, fd := range doc.schemaDescription.Fields {
+		if !fd.HasDefaultValue() {
+			continue
+		}
+		if _, isSet := doc.fields[fd.Name]; isSet {
+			continue
+		}
+		val, err := fastjson.Parse(fd.DefaultValue)
+		if err != nil {
+			return err
+		}
+		if val.Type() == fastjson.TypeNull {
+			continue
+		}
+		err = doc.Set(fd.Name, val)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateEnumValue returns an error if the given value is not permitted by the enum
+// of the given field.
+func validateEnumValue(val any, field FieldDescription, schema SchemaDescription) error {
+	enum, ok := schema.GetEnum(field.Schema)
+	if !ok {
+		return NewErrEnumNotFound(field.Name, field.Schema)
+	}
+	str, ok := val.(string)
+	if !ok || !enum.HasValue(str) {
+		return NewErrInvalidEnumValue(field.Name, enum.Name, val)
+	}
+	return nil
+}
+
+// patternCache caches compiled field constraint patterns by their source.
+var patternCache sync.Map
+
+func compileFieldPattern(field FieldDescription) (*regexp.Regexp, error) {
+	pattern := field.Constraint.Pattern
+	if re, ok := patternCache.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, NewErrInvalidFieldPattern(field.Name, pattern, err)
+	}
+	patternCache.Store(pattern, re)
+	return re, nil
+}
+
+// ValidateFieldConstraint returns an error if the given value does not satisfy the constraint
+// of the given field.
+//
+// The value is expected to have already been converted to the standardized Defra Go type
+// of the field.  Nil values are not checked.
+func ValidateFieldConstraint(val any, field FieldDescription) error {
+	constraint := field.Constraint
+	if constraint == nil || val == nil {
+		return nil
+	}
+
+	if constraint.Min.HasValue() || constraint.Max.HasValue() {
+		var number decimal.Decimal
+		var isNumber bool
+		switch v := val.(type) {
+		case int64:
+			number, isNumber = decimal.NewFromInt(v), true
+		case float64:
+			number, isNumber = decimal.NewFromFloat(v), true
+		case *big.Int:
+			number, isNumber = decimal.NewFromBigInt(v, 0), true
+		case decimal.Decimal:
+			number, isNumber = v, true
+		}
+		if isNumber {
+			if constraint.Min.HasValue() && number.LessThan(constraint.Min.Value()) {
+				return NewErrValueBelowMinimum(field.Name, constraint.Min.Value(), val)
+			}
+			if constraint.Max.HasValue() && number.GreaterThan(constraint.Max.Value()) {
+				return NewErrValueAboveMaximum(field.Name, constraint.Max.Value(), val)
+			}
+		}
+	}
+
+	if constraint.Pattern != "" {
+		if str, isString := val.(string); isString {
+			re, err := compileFieldPattern(field)
+			if err != nil {
+				return err
+			}
+			if !re.MatchString(str) {
+				return NewErrValueDoesNotMatchPattern(field.Name, constraint.Pattern, val)
+			}
+		}
+	}
+
+	if constraint.MaxLength.HasValue() {
+		var length int
+		switch v := val.(type) {
+		case string:
+			length = utf8.RuneCountInString(v)
+		default:
+			rv := reflect.ValueOf(val)
+			if rv.Kind() == reflect.Slice {
+				length = rv.Len()
+			}
+		}
+		if length > constraint.MaxLength.Value() {
+			return NewErrValueExceedsMaxLength(field.Name, constraint.MaxLength.Value(), length)
+		}
+	}
+
+	return nil
+}
+
 // Delete removes a field, and marks it to be deleted on the following db.Update() call.
 func (doc *Document) Delete(fields ...string) error {
 	doc.mu.Lock()
@@ -520,6 +892,9 @@ func (doc *Document) Delete(fields ...string) error {
 		if !exists {
 			return NewErrFieldNotExist(f)
 		}
+		if fd, ok := doc.schemaDescription.GetField(f); ok && fd.IsNonNull {
+			return NewErrNonNullFieldNotSet(f)
+		}
 		doc.values[field].Delete()
 	}
 	return nil
@@ -131,6 +131,18 @@ func TestSetWithJSON(t *testing.T) {
 	assert.Equal(t, doc.values[doc.fields["Age"]].IsDocument(), false)
 }
 
+func TestSetWithJSON_WithNullValue_ClearsField(t *testing.T) {
+	doc, err := NewDocFromJSON(testJSONObj, schemaDescriptions[0])
+	require.NoError(t, err)
+
+	err = doc.SetWithJSON([]byte(`{"Name": null}`))
+	require.NoError(t, err)
+
+	assert.Nil(t, doc.values[doc.fields["Name"]].Value())
+	assert.True(t, doc.values[doc.fields["Name"]].IsDirty())
+	assert.Equal(t, doc.values[doc.fields["Age"]].Value(), int64(26))
+}
+
 func TestNewDocsFromJSON_WithObjectInsteadOfArray_Error(t *testing.T) {
 	_, err := NewDocsFromJSON(testJSONObj, schemaDescriptions[0])
 	require.ErrorContains(t, err, "value doesn't contain array; it contains object")
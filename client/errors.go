@@ -13,6 +13,8 @@ package client
 import (
 	"fmt"
 
+	"github.com/shopspring/decimal"
+
 	"github.com/sourcenetwork/defradb/errors"
 )
 
@@ -28,6 +30,17 @@ const (
 	errUnknownCRDT                 string = "unknown crdt"
 	errCRDTKindMismatch            string = "CRDT type %s can't be assigned to field kind %s"
 	errInvalidCRDTType             string = "CRDT type not supported"
+	errInvalidEnumValue            string = "value is not a member of the enum"
+	errEnumNotFound                string = "enum not found"
+	errValueBelowMinimum           string = "value is less than the minimum permitted by the field"
+	errValueAboveMaximum           string = "value is greater than the maximum permitted by the field"
+	errValueDoesNotMatchPattern    string = "value does not match the pattern required by the field"
+	errValueExceedsMaxLength       string = "value exceeds the maximum length permitted by the field"
+	errNonNullFieldNotSet          string = "non-null field must be given a value"
+	errInvalidFieldPattern         string = "invalid field constraint pattern"
+	errComputedFieldReadOnly       string = "computed fields are read-only"
+	errFieldNotCounter             string = "field is not a counter"
+	errNumberTooLarge              string = "number has more digits than permitted"
 )
 
 // Errors returnable from this package.
@@ -45,6 +58,7 @@ var (
 	ErrInvalidDeleteTarget = errors.New("the target document to delete is of invalid type")
 	ErrMalformedDocID      = errors.New("malformed document ID, missing either version or cid")
 	ErrInvalidDocIDVersion = errors.New("invalid document ID version")
+	ErrNumberTooLarge      = errors.New(errNumberTooLarge)
 )
 
 // NewErrFieldNotExist returns an error indicating that the given field does not exist.
@@ -145,3 +159,107 @@ func NewErrInvalidCRDTType(name, crdtType string) error {
 func NewErrCRDTKindMismatch(cType, kind string) error {
 	return errors.New(fmt.Sprintf(errCRDTKindMismatch, cType, kind))
 }
+
+// NewErrInvalidEnumValue returns an error indicating that the given value is not permitted
+// by the enum of the given field.
+func NewErrInvalidEnumValue(fieldName string, enumName string, value any) error {
+	return errors.New(
+		errInvalidEnumValue,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Enum", enumName),
+		errors.NewKV("Value", value),
+	)
+}
+
+// NewErrEnumNotFound returns an error indicating that the enum referenced by the given
+// field could not be found.
+func NewErrEnumNotFound(fieldName string, enumName string) error {
+	return errors.New(
+		errEnumNotFound,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Enum", enumName),
+	)
+}
+
+// NewErrValueBelowMinimum returns an error indicating that the given value is less than the
+// minimum permitted by the constraint of the given field.
+func NewErrValueBelowMinimum(fieldName string, min decimal.Decimal, value any) error {
+	return errors.New(
+		errValueBelowMinimum,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Min", min),
+		errors.NewKV("Value", value),
+	)
+}
+
+// NewErrValueAboveMaximum returns an error indicating that the given value is greater than the
+// maximum permitted by the constraint of the given field.
+func NewErrValueAboveMaximum(fieldName string, max decimal.Decimal, value any) error {
+	return errors.New(
+		errValueAboveMaximum,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Max", max),
+		errors.NewKV("Value", value),
+	)
+}
+
+// NewErrValueDoesNotMatchPattern returns an error indicating that the given value does not
+// match the pattern required by the constraint of the given field.
+func NewErrValueDoesNotMatchPattern(fieldName string, pattern string, value any) error {
+	return errors.New(
+		errValueDoesNotMatchPattern,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Pattern", pattern),
+		errors.NewKV("Value", value),
+	)
+}
+
+// NewErrValueExceedsMaxLength returns an error indicating that the given value is longer than
+// the maximum length permitted by the constraint of the given field.
+func NewErrValueExceedsMaxLength(fieldName string, maxLength int, length int) error {
+	return errors.New(
+		errValueExceedsMaxLength,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("MaxLength", maxLength),
+		errors.NewKV("Length", length),
+	)
+}
+
+// NewErrNonNullFieldNotSet returns an error indicating that the given non-null field has not been
+// given a value, or that its value is being cleared.
+func NewErrNonNullFieldNotSet(fieldName string) error {
+	return errors.New(errNonNullFieldNotSet, errors.NewKV("Field", fieldName))
+}
+
+// NewErrComputedFieldReadOnly returns an error indicating that a value has been given to the given
+// computed field.
+func NewErrComputedFieldReadOnly(fieldName string) error {
+	return errors.New(errComputedFieldReadOnly, errors.NewKV("Field", fieldName))
+}
+
+// NewErrFieldNotCounter returns an error indicating that a counter delta has been given to the
+// given field, which is not a counter.
+func NewErrFieldNotCounter(fieldName string) error {
+	return errors.New(errFieldNotCounter, errors.NewKV("Field", fieldName))
+}
+
+// NewErrNumberTooLarge returns an error indicating that a number has the given number of digits,
+// which is more than MaxBigNumberDigits.
+func NewErrNumberTooLarge(digits int64) error {
+	return errors.WithStack(
+		ErrNumberTooLarge,
+		errors.NewKV("Digits", digits),
+		errors.NewKV("MaxDigits", MaxBigNumberDigits),
+	)
+}
+
+// NewErrInvalidFieldPattern returns an error indicating that the pattern constraint of the given
+// field is not a valid regular expression.
+func NewErrInvalidFieldPattern(fieldName string, pattern string, inner error) error {
+	return errors.Wrap(
+		errInvalidFieldPattern,
+		inner,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Pattern", pattern),
+	)
+}
@@ -40,6 +40,39 @@ type LensConfig struct {
 	model.Lens
 }
 
+// DefaultMigrationBatchSize is the number of documents migrated within a single transaction
+// by [Store.MigrateDocuments] if no batch size is specified.
+const DefaultMigrationBatchSize = 100
+
+// MigrateDocumentsOptions represents the options of an eager document migration.
+type MigrateDocumentsOptions struct {
+	// BatchSize is the maximum number of documents to migrate within a single transaction.
+	//
+	// If zero, [DefaultMigrationBatchSize] will be used.
+	BatchSize int
+
+	// BatchLimit is the maximum number of batches to migrate before returning.
+	//
+	// If zero, batches will be migrated until all documents have been migrated.
+	BatchLimit int
+}
+
+// DocumentMigrationStatus represents the progress of the eager migration of the documents
+// of a collection to its default schema version.
+type DocumentMigrationStatus struct {
+	// CollectionName is the name of the collection whose documents are migrated.
+	CollectionName string
+
+	// SchemaVersionID is the ID of the schema version the documents are migrated to.
+	SchemaVersionID string
+
+	// Migrated is the number of documents already at the target schema version.
+	Migrated int
+
+	// Pending is the number of documents yet to be migrated to the target schema version.
+	Pending int
+}
+
 // LensRegistry exposes several useful thread-safe migration related functions which may
 // be used to manage migrations.
 type LensRegistry interface {
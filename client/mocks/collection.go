@@ -5,6 +5,8 @@ package mocks
 import (
 	context "context"
 
+	cid "github.com/ipfs/go-cid"
+
 	client "github.com/sourcenetwork/defradb/client"
 
 	datastore "github.com/sourcenetwork/defradb/datastore"
@@ -25,6 +27,104 @@ func (_m *Collection) EXPECT() *Collection_Expecter {
 	return &Collection_Expecter{mock: &_m.Mock}
 }
 
+// Changes provides a mock function with given fields: ctx, since, limit
+func (_m *Collection) Changes(ctx context.Context, since string, limit int) ([]client.Change, error) {
+	ret := _m.Called(ctx, since, limit)
+
+	var r0 []client.Change
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]client.Change, error)); ok {
+		return rf(ctx, since, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []client.Change); ok {
+		r0 = rf(ctx, since, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Change)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, since, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Collection_Changes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Changes'
+type Collection_Changes_Call struct {
+	*mock.Call
+}
+
+// Changes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - since string
+//   - limit int
+func (_e *Collection_Expecter) Changes(ctx interface{}, since interface{}, limit interface{}) *Collection_Changes_Call {
+	return &Collection_Changes_Call{Call: _e.mock.On("Changes", ctx, since, limit)}
+}
+
+func (_c *Collection_Changes_Call) Run(run func(ctx context.Context, since string, limit int)) *Collection_Changes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *Collection_Changes_Call) Return(_a0 []client.Change, _a1 error) *Collection_Changes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Collection_Changes_Call) RunAndReturn(run func(context.Context, string, int) ([]client.Change, error)) *Collection_Changes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompactHistory provides a mock function with given fields: ctx
+func (_m *Collection) CompactHistory(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Collection_CompactHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompactHistory'
+type Collection_CompactHistory_Call struct {
+	*mock.Call
+}
+
+// CompactHistory is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Collection_Expecter) CompactHistory(ctx interface{}) *Collection_CompactHistory_Call {
+	return &Collection_CompactHistory_Call{Call: _e.mock.On("CompactHistory", ctx)}
+}
+
+func (_c *Collection_CompactHistory_Call) Run(run func(ctx context.Context)) *Collection_CompactHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Collection_CompactHistory_Call) Return(_a0 error) *Collection_CompactHistory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Collection_CompactHistory_Call) RunAndReturn(run func(context.Context) error) *Collection_CompactHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: _a0, _a1
 func (_m *Collection) Create(_a0 context.Context, _a1 *client.Document) error {
 	ret := _m.Called(_a0, _a1)
@@ -519,6 +619,62 @@ func (_c *Collection_Description_Call) RunAndReturn(run func() client.Collection
 	return _c
 }
 
+// Diff provides a mock function with given fields: ctx, from, to
+func (_m *Collection) Diff(ctx context.Context, from cid.Cid, to cid.Cid) (*client.DocumentDiff, error) {
+	ret := _m.Called(ctx, from, to)
+
+	var r0 *client.DocumentDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, cid.Cid, cid.Cid) (*client.DocumentDiff, error)); ok {
+		return rf(ctx, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, cid.Cid, cid.Cid) *client.DocumentDiff); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.DocumentDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, cid.Cid, cid.Cid) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Collection_Diff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Diff'
+type Collection_Diff_Call struct {
+	*mock.Call
+}
+
+// Diff is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from cid.Cid
+//   - to cid.Cid
+func (_e *Collection_Expecter) Diff(ctx interface{}, from interface{}, to interface{}) *Collection_Diff_Call {
+	return &Collection_Diff_Call{Call: _e.mock.On("Diff", ctx, from, to)}
+}
+
+func (_c *Collection_Diff_Call) Run(run func(ctx context.Context, from cid.Cid, to cid.Cid)) *Collection_Diff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(cid.Cid), args[2].(cid.Cid))
+	})
+	return _c
+}
+
+func (_c *Collection_Diff_Call) Return(_a0 *client.DocumentDiff, _a1 error) *Collection_Diff_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Collection_Diff_Call) RunAndReturn(run func(context.Context, cid.Cid, cid.Cid) (*client.DocumentDiff, error)) *Collection_Diff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropIndex provides a mock function with given fields: ctx, indexName
 func (_m *Collection) DropIndex(ctx context.Context, indexName string) error {
 	ret := _m.Called(ctx, indexName)
@@ -861,6 +1017,93 @@ func (_c *Collection_Name_Call) RunAndReturn(run func() string) *Collection_Name
 	return _c
 }
 
+// Purge provides a mock function with given fields: ctx, docID
+func (_m *Collection) Purge(ctx context.Context, docID client.DocID) error {
+	ret := _m.Called(ctx, docID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, client.DocID) error); ok {
+		r0 = rf(ctx, docID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Collection_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type Collection_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - docID client.DocID
+func (_e *Collection_Expecter) Purge(ctx interface{}, docID interface{}) *Collection_Purge_Call {
+	return &Collection_Purge_Call{Call: _e.mock.On("Purge", ctx, docID)}
+}
+
+func (_c *Collection_Purge_Call) Run(run func(ctx context.Context, docID client.DocID)) *Collection_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(client.DocID))
+	})
+	return _c
+}
+
+func (_c *Collection_Purge_Call) Return(_a0 error) *Collection_Purge_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Collection_Purge_Call) RunAndReturn(run func(context.Context, client.DocID) error) *Collection_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revert provides a mock function with given fields: ctx, docID, version
+func (_m *Collection) Revert(ctx context.Context, docID client.DocID, version cid.Cid) error {
+	ret := _m.Called(ctx, docID, version)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, client.DocID, cid.Cid) error); ok {
+		r0 = rf(ctx, docID, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Collection_Revert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revert'
+type Collection_Revert_Call struct {
+	*mock.Call
+}
+
+// Revert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - docID client.DocID
+//   - version cid.Cid
+func (_e *Collection_Expecter) Revert(ctx interface{}, docID interface{}, version interface{}) *Collection_Revert_Call {
+	return &Collection_Revert_Call{Call: _e.mock.On("Revert", ctx, docID, version)}
+}
+
+func (_c *Collection_Revert_Call) Run(run func(ctx context.Context, docID client.DocID, version cid.Cid)) *Collection_Revert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(client.DocID), args[2].(cid.Cid))
+	})
+	return _c
+}
+
+func (_c *Collection_Revert_Call) Return(_a0 error) *Collection_Revert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Collection_Revert_Call) RunAndReturn(run func(context.Context, client.DocID, cid.Cid) error) *Collection_Revert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Save provides a mock function with given fields: _a0, _a1
 func (_m *Collection) Save(_a0 context.Context, _a1 *client.Document) error {
 	ret := _m.Called(_a0, _a1)
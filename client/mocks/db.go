@@ -341,13 +341,20 @@ func (_c *DB_Events_Call) RunAndReturn(run func() events.Events) *DB_Events_Call
 	return _c
 }
 
-// ExecRequest provides a mock function with given fields: _a0, _a1
-func (_m *DB) ExecRequest(_a0 context.Context, _a1 string) *client.RequestResult {
-	ret := _m.Called(_a0, _a1)
+// ExecRequest provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DB) ExecRequest(_a0 context.Context, _a1 string, _a2 ...client.RequestOption) *client.RequestResult {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	var r0 *client.RequestResult
-	if rf, ok := ret.Get(0).(func(context.Context, string) *client.RequestResult); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...client.RequestOption) *client.RequestResult); ok {
+		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*client.RequestResult)
@@ -365,13 +372,21 @@ type DB_ExecRequest_Call struct {
 // ExecRequest is a helper method to define mock.On call
 //   - _a0 context.Context
 //   - _a1 string
-func (_e *DB_Expecter) ExecRequest(_a0 interface{}, _a1 interface{}) *DB_ExecRequest_Call {
-	return &DB_ExecRequest_Call{Call: _e.mock.On("ExecRequest", _a0, _a1)}
+//   - _a2 ...client.RequestOption
+func (_e *DB_Expecter) ExecRequest(_a0 interface{}, _a1 interface{}, _a2 ...interface{}) *DB_ExecRequest_Call {
+	return &DB_ExecRequest_Call{Call: _e.mock.On("ExecRequest",
+		append([]interface{}{_a0, _a1}, _a2...)...)}
 }
 
-func (_c *DB_ExecRequest_Call) Run(run func(_a0 context.Context, _a1 string)) *DB_ExecRequest_Call {
+func (_c *DB_ExecRequest_Call) Run(run func(_a0 context.Context, _a1 string, _a2 ...client.RequestOption)) *DB_ExecRequest_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		variadicArgs := make([]client.RequestOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(client.RequestOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
 	})
 	return _c
 }
@@ -381,7 +396,7 @@ func (_c *DB_ExecRequest_Call) Return(_a0 *client.RequestResult) *DB_ExecRequest
 	return _c
 }
 
-func (_c *DB_ExecRequest_Call) RunAndReturn(run func(context.Context, string) *client.RequestResult) *DB_ExecRequest_Call {
+func (_c *DB_ExecRequest_Call) RunAndReturn(run func(context.Context, string, ...client.RequestOption) *client.RequestResult) *DB_ExecRequest_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -713,6 +728,59 @@ func (_c *DB_GetCollectionsByVersionID_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// GetDocumentMigrationStatus provides a mock function with given fields: _a0, _a1
+func (_m *DB) GetDocumentMigrationStatus(_a0 context.Context, _a1 string) (client.DocumentMigrationStatus, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 client.DocumentMigrationStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (client.DocumentMigrationStatus, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) client.DocumentMigrationStatus); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(client.DocumentMigrationStatus)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DB_GetDocumentMigrationStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDocumentMigrationStatus'
+type DB_GetDocumentMigrationStatus_Call struct {
+	*mock.Call
+}
+
+// GetDocumentMigrationStatus is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 string
+func (_e *DB_Expecter) GetDocumentMigrationStatus(_a0 interface{}, _a1 interface{}) *DB_GetDocumentMigrationStatus_Call {
+	return &DB_GetDocumentMigrationStatus_Call{Call: _e.mock.On("GetDocumentMigrationStatus", _a0, _a1)}
+}
+
+func (_c *DB_GetDocumentMigrationStatus_Call) Run(run func(_a0 context.Context, _a1 string)) *DB_GetDocumentMigrationStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DB_GetDocumentMigrationStatus_Call) Return(_a0 client.DocumentMigrationStatus, _a1 error) *DB_GetDocumentMigrationStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DB_GetDocumentMigrationStatus_Call) RunAndReturn(run func(context.Context, string) (client.DocumentMigrationStatus, error)) *DB_GetDocumentMigrationStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSchemaByVersionID provides a mock function with given fields: _a0, _a1
 func (_m *DB) GetSchemaByVersionID(_a0 context.Context, _a1 string) (client.SchemaDescription, error) {
 	ret := _m.Called(_a0, _a1)
@@ -960,6 +1028,60 @@ func (_c *DB_MaxTxnRetries_Call) RunAndReturn(run func() int) *DB_MaxTxnRetries_
 	return _c
 }
 
+// MigrateDocuments provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DB) MigrateDocuments(_a0 context.Context, _a1 string, _a2 client.MigrateDocumentsOptions) (client.DocumentMigrationStatus, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 client.DocumentMigrationStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, client.MigrateDocumentsOptions) (client.DocumentMigrationStatus, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, client.MigrateDocumentsOptions) client.DocumentMigrationStatus); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(client.DocumentMigrationStatus)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, client.MigrateDocumentsOptions) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DB_MigrateDocuments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MigrateDocuments'
+type DB_MigrateDocuments_Call struct {
+	*mock.Call
+}
+
+// MigrateDocuments is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 string
+//   - _a2 client.MigrateDocumentsOptions
+func (_e *DB_Expecter) MigrateDocuments(_a0 interface{}, _a1 interface{}, _a2 interface{}) *DB_MigrateDocuments_Call {
+	return &DB_MigrateDocuments_Call{Call: _e.mock.On("MigrateDocuments", _a0, _a1, _a2)}
+}
+
+func (_c *DB_MigrateDocuments_Call) Run(run func(_a0 context.Context, _a1 string, _a2 client.MigrateDocumentsOptions)) *DB_MigrateDocuments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(client.MigrateDocumentsOptions))
+	})
+	return _c
+}
+
+func (_c *DB_MigrateDocuments_Call) Return(_a0 client.DocumentMigrationStatus, _a1 error) *DB_MigrateDocuments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DB_MigrateDocuments_Call) RunAndReturn(run func(context.Context, string, client.MigrateDocumentsOptions) (client.DocumentMigrationStatus, error)) *DB_MigrateDocuments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewConcurrentTxn provides a mock function with given fields: _a0, _a1
 func (_m *DB) NewConcurrentTxn(_a0 context.Context, _a1 bool) (datastore.Txn, error) {
 	ret := _m.Called(_a0, _a1)
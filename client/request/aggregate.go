@@ -26,4 +26,8 @@ type AggregateTarget struct {
 	Offset  immutables.Option[uint64]
 	OrderBy immutables.Option[OrderBy]
 	Filter  immutables.Option[Filter]
+
+	// Distinct is the optional name of the field by which the targeted items should
+	// be de-duplicated before being aggregated.
+	Distinct immutables.Option[string]
 }
@@ -24,6 +24,7 @@ type CommitSelect struct {
 	Cid     immutable.Option[string]
 	Depth   immutable.Option[uint64]
 
+	Filter  immutable.Option[Filter]
 	Limit   immutable.Option[uint64]
 	Offset  immutable.Option[uint64]
 	OrderBy immutable.Option[OrderBy]
@@ -38,6 +39,7 @@ func (c CommitSelect) ToSelect() *Select {
 			Name:  c.Name,
 			Alias: c.Alias,
 		},
+		Filter:  c.Filter,
 		Limit:   c.Limit,
 		Offset:  c.Offset,
 		OrderBy: c.OrderBy,
@@ -19,29 +19,60 @@ const (
 	// that corresponds to the related object's join relation id, i.e. `Author_id`.
 	RelatedObjectID = "_id"
 
-	Cid         = "cid"
-	Input       = "input"
-	FieldName   = "field"
-	FieldIDName = "fieldId"
-	ShowDeleted = "showDeleted"
-
-	FilterClause  = "filter"
-	GroupByClause = "groupBy"
-	LimitClause   = "limit"
-	OffsetClause  = "offset"
-	OrderClause   = "order"
-	DepthClause   = "depth"
+	Cid              = "cid"
+	Input            = "input"
+	FieldName        = "field"
+	FieldIDName      = "fieldId"
+	ShowDeleted      = "showDeleted"
+	AsOf             = "asOf"
+	AsOfClockArgName = "asOfClock"
+
+	RelationCreate     = "create"
+	RelationConnect    = "connect"
+	RelationDisconnect = "disconnect"
+
+	FilterClause      = "filter"
+	GroupByClause     = "groupBy"
+	GroupByKeysClause = "groupByKeys"
+
+	GroupByKeyField    = "field"
+	GroupByKeyRelation = "relation"
+	GroupByKeyTruncate = "truncate"
+	DistinctClause     = "distinct"
+	LimitClause        = "limit"
+	OffsetClause       = "offset"
+	AfterClause        = "after"
+	BeforeClause       = "before"
+	OrderClause        = "order"
+	DepthClause        = "depth"
 
 	DocIDArgName  = "docID"
 	DocIDsArgName = "docIDs"
 
-	AverageFieldName = "_avg"
-	CountFieldName   = "_count"
-	DocIDFieldName   = "_docID"
-	GroupFieldName   = "_group"
-	DeletedFieldName = "_deleted"
-	SumFieldName     = "_sum"
-	VersionFieldName = "_version"
+	OperationsArgName = "operations"
+
+	AverageFieldName  = "_avg"
+	CountFieldName    = "_count"
+	CursorFieldName   = "_cursor"
+	DocIDFieldName    = "_docID"
+	GroupFieldName    = "_group"
+	DeletedFieldName  = "_deleted"
+	PageInfoFieldName = "_pageInfo"
+	SumFieldName      = "_sum"
+	VersionFieldName  = "_version"
+
+	OperationFieldName     = "_operation"
+	ChangedFieldsFieldName = "_changedFields"
+
+	SubscriptionOperationTypeName = "SubscriptionOperation"
+
+	AsOfClockTypeName = "AsOfClock"
+
+	PageInfoTypeName         = "PageInfo"
+	HasNextPageFieldName     = "hasNextPage"
+	HasPreviousPageFieldName = "hasPreviousPage"
+	StartCursorFieldName     = "startCursor"
+	EndCursorFieldName       = "endCursor"
 
 	// New generated document id from a backed up document,
 	// which might have a different _docID originally.
@@ -61,16 +92,38 @@ const (
 	FieldNameFieldName       = "fieldName"
 	FieldIDFieldName         = "fieldId"
 	DeltaFieldName           = "delta"
+	TimestampFieldName       = "timestamp"
+	AuthorFieldName          = "author"
+	SignatureFieldName       = "signature"
+	SignerFieldName          = "signer"
 
 	DeltaArgFieldName       = "FieldName"
 	DeltaArgData            = "Data"
 	DeltaArgSchemaVersionID = "SchemaVersionID"
 	DeltaArgPriority        = "Priority"
 	DeltaArgDocID           = "DocID"
+	DeltaArgTimestamp       = "Timestamp"
+	DeltaArgAuthor          = "Author"
+	DeltaArgSignature       = "Signature"
+	DeltaArgPublicKey       = "PublicKey"
 
 	LinksNameFieldName = "name"
 	LinksCidFieldName  = "cid"
 
+	DiffName = "_diff"
+
+	DiffTypeName         = "Diff"
+	DiffFromArgName      = "from"
+	DiffToArgName        = "to"
+	DiffCommitsFieldName = "commits"
+	DiffFieldsFieldName  = "fields"
+
+	DiffFieldTypeName          = "DiffField"
+	DiffFieldNameFieldName     = "name"
+	DiffFieldRelationFieldName = "relation"
+	DiffFieldBeforeFieldName   = "before"
+	DiffFieldAfterFieldName    = "after"
+
 	ASC  = OrderDirection("ASC")
 	DESC = OrderDirection("DESC")
 )
@@ -90,6 +143,11 @@ var (
 		AverageFieldName:  true,
 		DocIDFieldName:    true,
 		DeletedFieldName:  true,
+		CursorFieldName:   true,
+		PageInfoFieldName: true,
+
+		OperationFieldName:     true,
+		ChangedFieldsFieldName: true,
 	}
 
 	Aggregates = map[string]struct{}{
@@ -112,10 +170,35 @@ var (
 		FieldNameFieldName,
 		FieldIDFieldName,
 		DeltaFieldName,
+		TimestampFieldName,
+		AuthorFieldName,
+		SignatureFieldName,
+		SignerFieldName,
 	}
 
 	LinksFields = []string{
 		LinksNameFieldName,
 		LinksCidFieldName,
 	}
+
+	DiffFields = []string{
+		DocIDArgName,
+		DiffFromArgName,
+		DiffToArgName,
+		DiffCommitsFieldName,
+	}
+
+	DiffFieldFields = []string{
+		DiffFieldNameFieldName,
+		DiffFieldRelationFieldName,
+		DiffFieldBeforeFieldName,
+		DiffFieldAfterFieldName,
+	}
+
+	PageInfoFields = []string{
+		HasNextPageFieldName,
+		HasPreviousPageFieldName,
+		StartCursorFieldName,
+		EndCursorFieldName,
+	}
 )
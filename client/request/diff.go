@@ -0,0 +1,39 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package request
+
+var (
+	_ Selection = (*DiffSelect)(nil)
+)
+
+// DiffSelect is a request for the field-level difference between two versions of a document.
+type DiffSelect struct {
+	Field
+
+	// From is the CID of the document commit of the version to diff from.
+	From string
+
+	// To is the CID of the document commit of the version to diff to.
+	To string
+
+	Fields []Selection
+}
+
+func (d DiffSelect) ToSelect() *Select {
+	return &Select{
+		Field: Field{
+			Name:  d.Name,
+			Alias: d.Alias,
+		},
+		Fields: d.Fields,
+		Root:   DiffSelection,
+	}
+}
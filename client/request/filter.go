@@ -14,6 +14,16 @@ const (
 	FilterOpOr  = "_or"
 	FilterOpAnd = "_and"
 	FilterOpNot = "_not"
+
+	// FilterOpAny, FilterOpAll and FilterOpNone apply their condition to each
+	// element of an inline array.
+	FilterOpAny  = "_any"
+	FilterOpAll  = "_all"
+	FilterOpNone = "_none"
+
+	// FilterOpSome applies its condition to each related object of a one-to-many
+	// relation.
+	FilterOpSome = "_some"
 )
 
 // Filter contains the parsed condition map to be
@@ -10,6 +10,44 @@
 
 package request
 
+import "github.com/sourcenetwork/immutable"
+
 type GroupBy struct {
+	// The names of the fields by which documents should be grouped.
 	Fields []string
+
+	// The keys derived from fields by which documents should be grouped, grouped by
+	// after the [Fields].
+	Keys []GroupByKey
+}
+
+// GroupByKey is a group key derived from a field, such as a DateTime truncated to a
+// day, or the field of a related object.
+type GroupByKey struct {
+	// The name of the field the key is derived from.
+	Field string
+
+	// The names of the related objects the field belongs to, in the order in which
+	// they are traversed from the host. Empty if the field belongs to the host.
+	RelationPath []string
+
+	// An optional unit that the DateTime value of the field is truncated to.
+	Truncation immutable.Option[GroupByTruncation]
+}
+
+// GroupByTruncation is a unit that DateTime values may be truncated to when grouped by.
+type GroupByTruncation string
+
+const (
+	GroupByDay   GroupByTruncation = "DAY"
+	GroupByWeek  GroupByTruncation = "WEEK"
+	GroupByMonth GroupByTruncation = "MONTH"
+)
+
+// GroupByTruncations contains all the units that DateTime values may be truncated to
+// when grouped by.
+var GroupByTruncations = []GroupByTruncation{
+	GroupByDay,
+	GroupByWeek,
+	GroupByMonth,
 }
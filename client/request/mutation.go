@@ -19,6 +19,7 @@ const (
 	CreateObjects
 	UpdateObjects
 	DeleteObjects
+	RevertObjects
 )
 
 // ObjectMutation is a field on the `mutation` operation of a graphql request. It includes
@@ -35,6 +36,9 @@ type ObjectMutation struct {
 	Filter immutable.Option[Filter]
 	Input  map[string]any
 
+	// CID is the version to revert the document to, if this is a revert mutation.
+	CID immutable.Option[string]
+
 	Fields []Selection
 }
 
@@ -0,0 +1,20 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package request
+
+// PageInfo represents a request for information about the page of documents
+// yielded by the host Select, such as whether there are further pages.
+type PageInfo struct {
+	Field
+
+	// Fields contains the page information properties requested.
+	Fields []Field
+}
@@ -12,6 +12,7 @@ package request
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/sourcenetwork/immutable"
 )
@@ -22,8 +23,32 @@ type SelectionType int
 const (
 	ObjectSelection SelectionType = iota
 	CommitSelection
+	DiffSelection
 )
 
+// AsOfClock is the clock by which the commits of documents are timed when selecting them as
+// they were at a point in time.
+type AsOfClock string
+
+const (
+	// AppliedClock times commits by the local time at which this node applied them, whether
+	// they were created locally or received from a peer.  It thus differs between nodes, and
+	// reflects changes to the clock of this node.
+	AppliedClock AsOfClock = "APPLIED"
+
+	// CommittedClock times commits by the timestamp recorded in them by the node creating them,
+	// and thus is the same on every node.  Commits without a timestamp are only selected if a
+	// selected commit follows them.
+	CommittedClock AsOfClock = "COMMITTED"
+)
+
+// AsOfTime is a point in time at which documents are selected, along with the clock by which
+// their commits are timed.
+type AsOfTime struct {
+	Time  time.Time
+	Clock AsOfClock
+}
+
 // Select is a complex Field with strong typing.
 // It is used for sub-types in a request.
 // Includes fields, and request arguments like filters, limits, etc.
@@ -33,6 +58,10 @@ type Select struct {
 	DocIDs immutable.Option[[]string]
 	CID    immutable.Option[string]
 
+	// AsOf is an optional point in time, if set documents, including related documents,
+	// will be returned as they were at that time.
+	AsOf immutable.Option[AsOfTime]
+
 	// Root is the top level type of parsed request
 	Root SelectionType
 
@@ -42,6 +71,16 @@ type Select struct {
 	GroupBy immutable.Option[GroupBy]
 	Filter  immutable.Option[Filter]
 
+	// Distinct is an optional set of fields, only the first document of each
+	// distinct combination of their values will be returned.
+	Distinct immutable.Option[Distinct]
+
+	// After is an optional cursor, only documents following it will be returned.
+	After immutable.Option[string]
+
+	// Before is an optional cursor, only documents preceding it will be returned.
+	Before immutable.Option[string]
+
 	Fields []Selection
 
 	ShowDeleted bool
@@ -99,6 +138,13 @@ func (s *Select) validateGroupBy() []error {
 					break
 				}
 			}
+			for _, groupByKey := range s.GroupBy.Value().Keys {
+				if len(groupByKey.RelationPath) == 0 && typedChildSelection.Name == groupByKey.Field {
+					// The (possibly truncated) value of the field will be rendered
+					fieldExistsInGroupBy = true
+					break
+				}
+			}
 			if !fieldExistsInGroupBy && !isAliasFieldInGroupBy {
 				result = append(result, NewErrSelectOfNonGroupField(typedChildSelection.Name))
 			}
@@ -122,6 +168,9 @@ type selectJson struct {
 	OrderBy     immutable.Option[OrderBy]
 	GroupBy     immutable.Option[GroupBy]
 	Filter      immutable.Option[Filter]
+	Distinct    immutable.Option[Distinct]
+	After       immutable.Option[string]
+	Before      immutable.Option[string]
 	ShowDeleted bool
 
 	// Properties above this line match the `Select` object and
@@ -148,6 +197,9 @@ func (s *Select) UnmarshalJSON(bytes []byte) error {
 	s.OrderBy = selectMap.OrderBy
 	s.GroupBy = selectMap.GroupBy
 	s.Filter = selectMap.Filter
+	s.Distinct = selectMap.Distinct
+	s.After = selectMap.After
+	s.Before = selectMap.Before
 	s.ShowDeleted = selectMap.ShowDeleted
 	s.Fields = make([]Selection, len(selectMap.Fields))
 
@@ -179,6 +231,14 @@ func (s *Select) UnmarshalJSON(bytes []byte) error {
 				return err
 			}
 			fieldValue = &fieldAggregate
+		} else if _, ok := field["Fields"]; ok {
+			// This must be a PageInfo, as it is the only remaining type with a `Fields` field
+			var fieldPageInfo PageInfo
+			err := json.Unmarshal(fieldJson, &fieldPageInfo)
+			if err != nil {
+				return err
+			}
+			fieldValue = &fieldPageInfo
 		} else {
 			// This must be a Field
 			var fieldField Field
@@ -14,6 +14,16 @@ import (
 	"github.com/sourcenetwork/immutable"
 )
 
+// SubscriptionOperation is a kind of change to a document that a subscription can be
+// notified of.
+type SubscriptionOperation string
+
+const (
+	CreateOperation SubscriptionOperation = "CREATE"
+	UpdateOperation SubscriptionOperation = "UPDATE"
+	DeleteOperation SubscriptionOperation = "DELETE"
+)
+
 // ObjectSubscription is a field on the SubscriptionType
 // of a graphql request. It includes all the possible
 // arguments
@@ -25,20 +35,45 @@ type ObjectSubscription struct {
 
 	Filter immutable.Option[Filter]
 
+	// Operations contains the kinds of changes the subscription is notified of.
+	//
+	// The subscription is notified of all kinds of changes if it is empty.
+	Operations []SubscriptionOperation
+
 	Fields []Selection
+
+	// EventFields contains the selected `_operation` and `_changedFields` fields, that are
+	// resolved from the change instead of the document.
+	EventFields []Field
+}
+
+// IsNotifiedOf returns true if the subscription is notified of changes of the given kind.
+func (m ObjectSubscription) IsNotifiedOf(operation SubscriptionOperation) bool {
+	if len(m.Operations) == 0 {
+		return true
+	}
+	for _, op := range m.Operations {
+		if op == operation {
+			return true
+		}
+	}
+	return false
 }
 
 // ToSelect returns a basic Select object, with the same Name, Alias, and Fields as
 // the Subscription object. Used to create a Select planNode for the event stream return objects.
-func (m ObjectSubscription) ToSelect(docID, cid string) *Select {
+//
+// Deleted documents are only selected if showDeleted is true.
+func (m ObjectSubscription) ToSelect(docID, cid string, showDeleted bool) *Select {
 	return &Select{
 		Field: Field{
 			Name:  m.Collection,
 			Alias: m.Alias,
 		},
-		DocIDs: immutable.Some([]string{docID}),
-		CID:    immutable.Some(cid),
-		Fields: m.Fields,
-		Filter: m.Filter,
+		DocIDs:      immutable.Some([]string{docID}),
+		CID:         immutable.Some(cid),
+		Fields:      m.Fields,
+		Filter:      m.Filter,
+		ShowDeleted: showDeleted,
 	}
 }
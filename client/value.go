@@ -11,7 +11,10 @@
 package client
 
 import (
+	"math/big"
+
 	"github.com/fxamacker/cbor/v2"
+	"github.com/shopspring/decimal"
 	"github.com/sourcenetwork/immutable"
 )
 
@@ -82,6 +85,12 @@ func (val FieldValue) Bytes() ([]byte, error) {
 		value = convertImmutable(tempVal)
 	case []immutable.Option[bool]:
 		value = convertImmutable(tempVal)
+	case *big.Int:
+		// Arbitrary precision numbers are stored as their canonical string form
+		// so that they may be decoded without loss of precision.
+		value = tempVal.String()
+	case decimal.Decimal:
+		value = tempVal.String()
 	default:
 		value = val.value
 	}
@@ -213,6 +213,10 @@ func (cfg *Config) paramsPreprocessing() error {
 	if !filepath.IsAbs(cfg.v.GetString("api.pubkeypath")) {
 		cfg.v.Set("api.pubkeypath", filepath.Join(cfg.Rootdir, cfg.v.GetString("api.pubkeypath")))
 	}
+	signingKeyPath := cfg.v.GetString("datastore.signingkeypath")
+	if signingKeyPath != "" && !filepath.IsAbs(signingKeyPath) {
+		cfg.v.Set("datastore.signingkeypath", filepath.Join(cfg.Rootdir, signingKeyPath))
+	}
 
 	// log.logger configuration as a string
 	logloggerAsStringSlice := cfg.v.GetStringSlice("log.logger")
@@ -228,6 +232,9 @@ func (cfg *Config) paramsPreprocessing() error {
 	if err := expandHomeDir(&cfg.API.PubKeyPath); err != nil {
 		return err
 	}
+	if err := expandHomeDir(&cfg.Datastore.SigningKeyPath); err != nil {
+		return err
+	}
 
 	var bs ByteSize
 	if err := bs.Set(cfg.v.GetString("datastore.badger.valuelogfilesize")); err != nil {
@@ -251,6 +258,21 @@ type DatastoreConfig struct {
 	Memory        MemoryConfig
 	Badger        BadgerConfig
 	MaxTxnRetries int
+	// CommitMetadata enables the recording of the creation time, and of the peer ID of the
+	// node if P2P is enabled, in new commits.
+	CommitMetadata bool
+	// SignCommits enables the signing of new commits with the libp2p identity key of the node,
+	// or with the key at SigningKeyPath if set.
+	SignCommits bool
+	// SigningKeyPath is the path to the private key used to sign new commits.
+	SigningKeyPath string
+	// RequireSignatures enables the rejection of unsigned commits received from other peers,
+	// or read when fetching previous document versions.
+	RequireSignatures bool
+	// TrustedSigners is the list of peer IDs whose signed commits are accepted.
+	//
+	// If not empty, unsigned commits and commits signed by any other key are rejected.
+	TrustedSigners []string
 }
 
 // BadgerConfig configures Badger's on-disk / filesystem mode.
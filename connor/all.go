@@ -0,0 +1,58 @@
+package connor
+
+import (
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/core"
+)
+
+// all is an operator which allows the evaluation of
+// a condition against each element of an array, matching
+// if all of the elements match.
+//
+// Empty arrays always match.
+func all(condition, data any) (bool, error) {
+	switch t := data.(type) {
+	case []string:
+		return allSlice(condition, t)
+
+	case []immutable.Option[string]:
+		return allSlice(condition, t)
+
+	case []int64:
+		return allSlice(condition, t)
+
+	case []immutable.Option[int64]:
+		return allSlice(condition, t)
+
+	case []bool:
+		return allSlice(condition, t)
+
+	case []immutable.Option[bool]:
+		return allSlice(condition, t)
+
+	case []float64:
+		return allSlice(condition, t)
+
+	case []immutable.Option[float64]:
+		return allSlice(condition, t)
+
+	case []core.Doc:
+		return allSlice(condition, t)
+
+	default:
+		return false, nil
+	}
+}
+
+func allSlice[T any](condition any, data []T) (bool, error) {
+	for _, c := range data {
+		m, err := eq(condition, c)
+		if err != nil {
+			return false, err
+		} else if !m {
+			return false, nil
+		}
+	}
+	return true, nil
+}
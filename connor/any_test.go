@@ -0,0 +1,52 @@
+package connor
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAny_WithMatchingElement_Matches(t *testing.T) {
+	result, err := anyOp(int64(2), []int64{1, 2, 3})
+	require.NoError(t, err)
+	require.True(t, result)
+
+	result, err = anyOp(int64(4), []int64{1, 2, 3})
+	require.NoError(t, err)
+	require.False(t, result)
+}
+
+func TestAll_WithNillableElements_MatchesOnlyIfAllMatch(t *testing.T) {
+	data := []immutable.Option[string]{immutable.Some("a"), immutable.None[string]()}
+
+	result, err := all("a", data)
+	require.NoError(t, err)
+	require.False(t, result)
+
+	result, err = all("a", data[:1])
+	require.NoError(t, err)
+	require.True(t, result)
+}
+
+func TestArrayOperators_WithEmptyArray(t *testing.T) {
+	result, err := anyOp(true, []bool{})
+	require.NoError(t, err)
+	require.False(t, result)
+
+	result, err = all(true, []bool{})
+	require.NoError(t, err)
+	require.True(t, result)
+
+	result, err = none(true, []bool{})
+	require.NoError(t, err)
+	require.True(t, result)
+}
+
+func TestArrayOperators_WithNonArrayData_DoNotMatch(t *testing.T) {
+	for _, op := range []func(any, any) (bool, error){anyOp, all, none, some} {
+		result, err := op("a", "a")
+		require.NoError(t, err)
+		require.False(t, result)
+	}
+}
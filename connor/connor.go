@@ -40,8 +40,24 @@ func matchWith(op string, conditions, data any) (bool, error) {
 		return like(conditions, data)
 	case "_nlike":
 		return nlike(conditions, data)
+	case "_ilike":
+		return ilike(conditions, data)
+	case "_nilike":
+		return nilike(conditions, data)
+	case "_regex":
+		return regex(conditions, data)
+	case "_nregex":
+		return nregex(conditions, data)
 	case "_not":
 		return not(conditions, data)
+	case "_any":
+		return anyOp(conditions, data)
+	case "_all":
+		return all(conditions, data)
+	case "_none":
+		return none(conditions, data)
+	case "_some":
+		return some(conditions, data)
 	default:
 		return false, NewErrUnknownOperator(op)
 	}
@@ -1,9 +1,11 @@
 package connor
 
 import (
+	"math/big"
 	"reflect"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/sourcenetwork/immutable"
 
 	"github.com/sourcenetwork/defradb/connor/numbers"
@@ -16,6 +18,11 @@ import (
 func eq(condition, data any) (bool, error) {
 	switch arr := data.(type) {
 	case []core.Doc:
+		if hasArrayOperator(condition) {
+			// The condition explicitly states how the related objects should be
+			// matched, so they must be given to it as a whole.
+			break
+		}
 		for _, item := range arr {
 			m, err := eq(condition, item)
 			if err != nil {
@@ -65,6 +72,8 @@ func eq(condition, data any) (bool, error) {
 		return numbers.Equal(cn, data), nil
 	case float64:
 		return numbers.Equal(cn, data), nil
+	case *big.Int, decimal.Decimal:
+		return numbers.Equal(cn, data), nil
 	case map[FilterKey]any:
 		m := true
 		for prop, cond := range cn {
@@ -87,3 +96,19 @@ func eq(condition, data any) (bool, error) {
 		return reflect.DeepEqual(condition, data), nil
 	}
 }
+
+// hasArrayOperator returns true if the given condition contains an operator
+// that is evaluated against the elements of an array as a whole.
+func hasArrayOperator(condition any) bool {
+	cn, ok := condition.(map[FilterKey]any)
+	if !ok {
+		return false
+	}
+	for prop := range cn {
+		switch prop.GetOperatorOrDefault("") {
+		case "_any", "_all", "_none", "_some":
+			return true
+		}
+	}
+	return false
+}
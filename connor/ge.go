@@ -26,10 +26,18 @@ func ge(condition, data any) (bool, error) {
 				return false, err
 			}
 			return dt.After(c) || dt.Equal(c), nil
+		case nil:
+			// nil, such as the timestamp of a commit without one, is not comparable to times
+			return false, nil
 		default:
 			return false, client.NewErrUnhandledType("data", d)
 		}
 	default:
+		if numbers.IsBig(condition) || numbers.IsBig(data) {
+			c, ok := numbers.CompareBig(data, condition)
+			return ok && c >= 0, nil
+		}
+
 		switch cn := numbers.TryUpcast(condition).(type) {
 		case float64:
 			switch dn := numbers.TryUpcast(data).(type) {
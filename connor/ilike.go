@@ -0,0 +1,30 @@
+package connor
+
+import (
+	"strings"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+// ilike is an operator which performs case-insensitive string equality
+// tests.
+func ilike(condition, data any) (bool, error) {
+	switch d := data.(type) {
+	case immutable.Option[string]:
+		if !d.HasValue() {
+			return condition == nil, nil
+		}
+		data = strings.ToLower(d.Value())
+	case string:
+		data = strings.ToLower(d)
+	}
+
+	switch cn := condition.(type) {
+	case string:
+		return like(strings.ToLower(cn), data)
+	default:
+		return false, client.NewErrUnhandledType("condition", cn)
+	}
+}
@@ -0,0 +1,41 @@
+package connor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestILike(t *testing.T) {
+	const testString = "Source is the glue of web3"
+
+	// exact match
+	result, err := ilike("source IS the GLUE of web3", testString)
+	require.NoError(t, err)
+	require.True(t, result)
+
+	// exact match error
+	result, err = ilike("source is the glue", testString)
+	require.NoError(t, err)
+	require.False(t, result)
+
+	// match prefix
+	result, err = ilike("SOURCE%", testString)
+	require.NoError(t, err)
+	require.True(t, result)
+
+	// match suffix
+	result, err = ilike("%WEB3", testString)
+	require.NoError(t, err)
+	require.True(t, result)
+
+	// match contains
+	result, err = ilike("%Glue%", testString)
+	require.NoError(t, err)
+	require.True(t, result)
+
+	// match start and end with
+	result, err = ilike("source%WEB3", testString)
+	require.NoError(t, err)
+	require.True(t, result)
+}
@@ -0,0 +1,54 @@
+package connor
+
+import (
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/core"
+)
+
+// none is an operator which allows the evaluation of
+// a condition against each element of an array, matching
+// if none of the elements match.
+//
+// Empty arrays always match.
+func none(condition, data any) (bool, error) {
+	switch t := data.(type) {
+	case []string:
+		return noneSlice(condition, t)
+
+	case []immutable.Option[string]:
+		return noneSlice(condition, t)
+
+	case []int64:
+		return noneSlice(condition, t)
+
+	case []immutable.Option[int64]:
+		return noneSlice(condition, t)
+
+	case []bool:
+		return noneSlice(condition, t)
+
+	case []immutable.Option[bool]:
+		return noneSlice(condition, t)
+
+	case []float64:
+		return noneSlice(condition, t)
+
+	case []immutable.Option[float64]:
+		return noneSlice(condition, t)
+
+	case []core.Doc:
+		return noneSlice(condition, t)
+
+	default:
+		return false, nil
+	}
+}
+
+func noneSlice[T any](condition any, data []T) (bool, error) {
+	m, err := anySlice(condition, data)
+	if err != nil {
+		return false, err
+	}
+	return !m, nil
+}
@@ -23,7 +23,7 @@ func TestNot_WithNotAndNotNot_NoError(t *testing.T) {
 func TestNot_WithEmptyCondition_ReturnError(t *testing.T) {
 	const testString = "Source is the glue of web3"
 
-	_, err := not(map[FilterKey]any{&operator{"_some"}: "test"}, testString)
+	_, err := not(map[FilterKey]any{&operator{"_unknown"}: "test"}, testString)
 	require.ErrorIs(t, err, ErrUnknownOperator)
 }
 
@@ -0,0 +1,13 @@
+package connor
+
+// nregex performs regular expression non-matching tests by inverting
+// the result of the Regex operator for non-error cases.
+func nregex(conditions, data any) (bool, error) {
+	m, err := regex(conditions, data)
+
+	if err != nil {
+		return false, err
+	}
+
+	return !m, err
+}
@@ -0,0 +1,53 @@
+package numbers
+
+import (
+	"math/big"
+
+	"github.com/shopspring/decimal"
+)
+
+// IsBig returns true if the given value is an arbitrary precision number.
+func IsBig(n any) bool {
+	switch n.(type) {
+	case *big.Int, decimal.Decimal:
+		return true
+	default:
+		return false
+	}
+}
+
+// ToDecimal converts the given number into an exact decimal.
+//
+// Returns false if the given value is not a number.
+func ToDecimal(n any) (decimal.Decimal, bool) {
+	switch nn := TryUpcast(n).(type) {
+	case decimal.Decimal:
+		return nn, true
+	case *big.Int:
+		return decimal.NewFromBigInt(nn, 0), true
+	case int64:
+		return decimal.NewFromInt(nn), true
+	case uint64:
+		return decimal.NewFromBigInt(new(big.Int).SetUint64(nn), 0), true
+	case float64:
+		return decimal.NewFromFloat(nn), true
+	default:
+		return decimal.Decimal{}, false
+	}
+}
+
+// CompareBig compares the two given numbers without loss of precision,
+// returning -1 if a < b, 0 if a == b, and +1 if a > b.
+//
+// Returns false if either value is not a number.
+func CompareBig(a, b any) (int, bool) {
+	da, ok := ToDecimal(a)
+	if !ok {
+		return 0, false
+	}
+	db, ok := ToDecimal(b)
+	if !ok {
+		return 0, false
+	}
+	return da.Cmp(db), true
+}
@@ -0,0 +1,70 @@
+package connor
+
+import (
+	"regexp"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+// compiledRegexCacheSize is the maximum number of compiled regular expressions kept in
+// compiledRegexes.
+const compiledRegexCacheSize = 256
+
+// compiledRegexes caches the regular expressions compiled by the regex operator,
+// as the same expression is typically matched against many documents.
+//
+// The least recently used expressions are evicted, so that requests with ever changing
+// expressions do not grow the cache without bound.
+var compiledRegexes = newRegexCache()
+
+func newRegexCache() *lru.Cache[string, *regexp.Regexp] {
+	cache, err := lru.New[string, *regexp.Regexp](compiledRegexCacheSize)
+	if err != nil {
+		// The size is a positive constant, so creating the cache cannot fail.
+		panic(err)
+	}
+	return cache
+}
+
+// regex is an operator which tests whether strings match a regular expression.
+//
+// The expression syntax is that of the Go regexp package.
+func regex(condition, data any) (bool, error) {
+	switch d := data.(type) {
+	case immutable.Option[string]:
+		if !d.HasValue() {
+			return condition == nil, nil
+		}
+		data = d.Value()
+	}
+
+	switch cn := condition.(type) {
+	case string:
+		re, err := CompileRegex(cn)
+		if err != nil {
+			return false, err
+		}
+		if d, ok := data.(string); ok {
+			return re.MatchString(d), nil
+		}
+		return false, nil
+	default:
+		return false, client.NewErrUnhandledType("condition", cn)
+	}
+}
+
+// CompileRegex compiles the given regular expression as used by the regex operators.
+func CompileRegex(expr string) (*regexp.Regexp, error) {
+	if re, ok := compiledRegexes.Get(expr); ok {
+		return re, nil
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, NewErrInvalidRegex(expr, err)
+	}
+	compiledRegexes.Add(expr, re)
+	return re, nil
+}
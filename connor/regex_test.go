@@ -0,0 +1,49 @@
+package connor
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegex(t *testing.T) {
+	const testString = "Source is the glue of web3"
+
+	// anchored match
+	result, err := regex("^Source.*web[0-9]$", testString)
+	require.NoError(t, err)
+	require.True(t, result)
+
+	// unanchored match
+	result, err = regex("glue", testString)
+	require.NoError(t, err)
+	require.True(t, result)
+
+	// case-sensitive by default
+	result, err = regex("^source", testString)
+	require.NoError(t, err)
+	require.False(t, result)
+
+	// case-insensitive flag
+	result, err = regex("(?i)^source", testString)
+	require.NoError(t, err)
+	require.True(t, result)
+
+	// non-string data
+	result, err = regex("glue", nil)
+	require.NoError(t, err)
+	require.False(t, result)
+
+	// invalid expression
+	_, err = regex("(glue", testString)
+	require.ErrorIs(t, err, ErrInvalidRegex)
+}
+
+func TestCompileRegex_WithMoreExpressionsThanCacheSize_ShouldBoundCache(t *testing.T) {
+	for i := 0; i < compiledRegexCacheSize*2; i++ {
+		_, err := CompileRegex(fmt.Sprintf("^value%d$", i))
+		require.NoError(t, err)
+	}
+	require.Equal(t, compiledRegexCacheSize, compiledRegexes.Len())
+}
@@ -0,0 +1,16 @@
+package connor
+
+import "github.com/sourcenetwork/defradb/core"
+
+// some is an operator which allows the evaluation of
+// a condition against each object of a one-to-many relation,
+// matching if any of the related objects match.
+func some(condition, data any) (bool, error) {
+	switch t := data.(type) {
+	case []core.Doc:
+		return anySlice(condition, t)
+
+	default:
+		return false, nil
+	}
+}
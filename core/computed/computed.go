@@ -0,0 +1,98 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+/*
+Package computed provides the expression language used to declare computed fields.
+
+An expression is made up of field references, number and string literals, parentheses,
+and the binary operators `+`, `-`, `*` and `/`.  Field references may be the name of a
+field on the host document (`price`), or the name of a relation followed by the name
+of a field on the related document (`author.name`).
+
+`+` concatenates its operands if either of them is a string, otherwise all operators are
+arithmetic.  If any operand is nil, the result of the operation is nil.
+*/
+package computed
+
+import (
+	"strings"
+)
+
+// Resolver returns the value held at the given field path.
+type Resolver func(path []string) (any, error)
+
+// Expression is a parsed computed field expression.
+type Expression struct {
+	source string
+	root   node
+	paths  [][]string
+}
+
+// Parse parses the given source into an [Expression].
+func Parse(source string) (*Expression, error) {
+	p := parser{
+		source: source,
+	}
+	if err := p.tokenize(); err != nil {
+		return nil, err
+	}
+	if len(p.tokens) == 0 {
+		return nil, ErrEmptyExpression
+	}
+
+	root, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+	if p.position < len(p.tokens) {
+		return nil, NewErrUnexpectedToken(source, p.tokens[p.position].offset)
+	}
+
+	return &Expression{
+		source: source,
+		root:   root,
+		paths:  p.paths,
+	}, nil
+}
+
+// Paths returns the distinct field paths referenced by this expression, in the order
+// in which they first appear.
+func (e *Expression) Paths() [][]string {
+	return e.paths
+}
+
+// Eval evaluates this expression, resolving any field references using the given resolver.
+func (e *Expression) Eval(resolve Resolver) (any, error) {
+	return e.root.eval(resolve)
+}
+
+// String returns the source of this expression.
+func (e *Expression) String() string {
+	return e.source
+}
+
+// RootFields returns the distinct names of the host document fields referenced by the given
+// paths, in the order in which they first appear.
+func RootFields(paths [][]string) []string {
+	names := []string{}
+	seen := map[string]struct{}{}
+	for _, path := range paths {
+		if _, ok := seen[path[0]]; ok {
+			continue
+		}
+		seen[path[0]] = struct{}{}
+		names = append(names, path[0])
+	}
+	return names
+}
+
+func joinPath(path []string) string {
+	return strings.Join(path, ".")
+}
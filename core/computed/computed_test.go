@@ -0,0 +1,136 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package computed
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testResolver(values map[string]any) Resolver {
+	return func(path []string) (any, error) {
+		return values[joinPath(path)], nil
+	}
+}
+
+func TestEval(t *testing.T) {
+	values := map[string]any{
+		"firstName":   "John",
+		"lastName":    "Grisham",
+		"price":       2.5,
+		"quantity":    int64(4),
+		"author.name": "Islam",
+	}
+
+	tests := []struct {
+		expression string
+		expected   any
+	}{
+		{"firstName + ' ' + lastName", "John Grisham"},
+		{"price * quantity", float64(10)},
+		{"quantity + 2 * 3", int64(10)},
+		{"(quantity + 2) * 3", int64(18)},
+		{"quantity / 8", 0.5},
+		{"-quantity", int64(-4)},
+		{"'by ' + author.name", "by Islam"},
+		{"\"n: \" + quantity", "n: 4"},
+		{"missing + 1", nil},
+		{"quantity / 0", nil},
+	}
+
+	for _, test := range tests {
+		expr, err := Parse(test.expression)
+		require.NoError(t, err, test.expression)
+
+		result, err := expr.Eval(testResolver(values))
+		require.NoError(t, err, test.expression)
+		assert.Equal(t, test.expected, result, test.expression)
+	}
+}
+
+func TestEval_WithBigNumbers(t *testing.T) {
+	balance, _ := new(big.Int).SetString("18446744073709551616", 10)
+	values := map[string]any{
+		"balance":  balance,
+		"rate":     decimal.RequireFromString("0.1"),
+		"quantity": int64(4),
+		"price":    2.5,
+	}
+
+	tests := []struct {
+		expression string
+		expected   string
+	}{
+		{"balance + quantity", "18446744073709551620"},
+		{"balance * 2", "36893488147419103232"},
+		{"-balance", "-18446744073709551616"},
+		{"balance / quantity", "4611686018427387904"},
+		{"balance * rate", "1844674407370955161.6"},
+		{"rate + 0.2", "0.3"},
+		{"rate * price", "0.25"},
+		{"'balance: ' + balance", "balance: 18446744073709551616"},
+	}
+
+	for _, test := range tests {
+		expr, err := Parse(test.expression)
+		require.NoError(t, err, test.expression)
+
+		result, err := expr.Eval(testResolver(values))
+		require.NoError(t, err, test.expression)
+		assert.Equal(t, test.expected, fmt.Sprint(result), test.expression)
+	}
+
+	expr, err := Parse("balance / 0")
+	require.NoError(t, err)
+	result, err := expr.Eval(testResolver(values))
+	require.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+func TestParse_ReturnsPaths(t *testing.T) {
+	expr, err := Parse("name + author.name + name")
+	require.NoError(t, err)
+
+	assert.Equal(t, [][]string{{"name"}, {"author", "name"}}, expr.Paths())
+	assert.Equal(t, []string{"name", "author"}, RootFields(expr.Paths()))
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		expression string
+		expected   string
+	}{
+		{"", ErrEmptyExpression.Error()},
+		{"price +", errUnexpectedToken},
+		{"(price", errUnexpectedToken},
+		{"price price", errUnexpectedToken},
+		{"'unterminated", errUnterminatedValue},
+	}
+
+	for _, test := range tests {
+		_, err := Parse(test.expression)
+		require.Error(t, err, test.expression)
+		assert.ErrorContains(t, err, test.expected, test.expression)
+	}
+}
+
+func TestEval_WithStringMultiplication_Errors(t *testing.T) {
+	expr, err := Parse("firstName * 2")
+	require.NoError(t, err)
+
+	_, err = expr.Eval(testResolver(map[string]any{"firstName": "John"}))
+	assert.ErrorContains(t, err, errInvalidOperand)
+}
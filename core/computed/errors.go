@@ -0,0 +1,51 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package computed
+
+import (
+	"fmt"
+
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+const (
+	errUnexpectedToken   string = "unexpected token in computed expression"
+	errUnterminatedValue string = "unterminated string in computed expression"
+	errInvalidOperand    string = "invalid operand in computed expression"
+)
+
+var (
+	ErrEmptyExpression = errors.New("computed expression must not be empty")
+)
+
+func NewErrUnexpectedToken(expression string, position int) error {
+	return errors.New(
+		errUnexpectedToken,
+		errors.NewKV("Expression", expression),
+		errors.NewKV("Position", position),
+	)
+}
+
+func NewErrUnterminatedString(expression string, position int) error {
+	return errors.New(
+		errUnterminatedValue,
+		errors.NewKV("Expression", expression),
+		errors.NewKV("Position", position),
+	)
+}
+
+func NewErrInvalidOperand(operator byte, value any) error {
+	return errors.New(
+		errInvalidOperand,
+		errors.NewKV("Operator", string(operator)),
+		errors.NewKV("Type", fmt.Sprintf("%T", value)),
+	)
+}
@@ -0,0 +1,200 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package computed
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/sourcenetwork/defradb/connor/numbers"
+)
+
+type node interface {
+	eval(resolve Resolver) (any, error)
+}
+
+type literalNode struct {
+	value any
+}
+
+func (n *literalNode) eval(Resolver) (any, error) {
+	return n.value, nil
+}
+
+type pathNode struct {
+	path []string
+}
+
+func (n *pathNode) eval(resolve Resolver) (any, error) {
+	value, err := resolve(n.path)
+	if err != nil {
+		return nil, err
+	}
+	return normalize(value), nil
+}
+
+type binaryNode struct {
+	operator byte
+	left     node
+	right    node
+}
+
+func (n *binaryNode) eval(resolve Resolver) (any, error) {
+	left, err := n.left.eval(resolve)
+	if err != nil {
+		return nil, err
+	}
+	right, err := n.right.eval(resolve)
+	if err != nil {
+		return nil, err
+	}
+	if left == nil || right == nil {
+		return nil, nil
+	}
+
+	_, leftIsString := left.(string)
+	_, rightIsString := right.(string)
+	if n.operator == '+' && (leftIsString || rightIsString) {
+		return toString(left) + toString(right), nil
+	}
+
+	leftInt, leftIsInt := left.(int64)
+	rightInt, rightIsInt := right.(int64)
+	if leftIsInt && rightIsInt && n.operator != '/' {
+		switch n.operator {
+		case '+':
+			return leftInt + rightInt, nil
+		case '-':
+			return leftInt - rightInt, nil
+		default:
+			return leftInt * rightInt, nil
+		}
+	}
+
+	if numbers.IsBig(left) || numbers.IsBig(right) {
+		return evalBig(n.operator, left, right)
+	}
+
+	leftFloat, ok := toFloat(left)
+	if !ok {
+		return nil, NewErrInvalidOperand(n.operator, left)
+	}
+	rightFloat, ok := toFloat(right)
+	if !ok {
+		return nil, NewErrInvalidOperand(n.operator, right)
+	}
+
+	switch n.operator {
+	case '+':
+		return leftFloat + rightFloat, nil
+	case '-':
+		return leftFloat - rightFloat, nil
+	case '*':
+		return leftFloat * rightFloat, nil
+	default:
+		if rightFloat == 0 {
+			return nil, nil
+		}
+		return leftFloat / rightFloat, nil
+	}
+}
+
+// evalBig applies the given operator to the given operands, at least one of which is an
+// arbitrary precision number, without loss of precision.
+//
+// Integers are computed as *big.Int, except when divided, other numbers as decimals.
+func evalBig(operator byte, left any, right any) (any, error) {
+	leftInt, leftIsInt := toBigInt(left)
+	rightInt, rightIsInt := toBigInt(right)
+	if leftIsInt && rightIsInt && operator != '/' {
+		switch operator {
+		case '+':
+			return new(big.Int).Add(leftInt, rightInt), nil
+		case '-':
+			return new(big.Int).Sub(leftInt, rightInt), nil
+		default:
+			return new(big.Int).Mul(leftInt, rightInt), nil
+		}
+	}
+
+	leftDecimal, ok := numbers.ToDecimal(left)
+	if !ok {
+		return nil, NewErrInvalidOperand(operator, left)
+	}
+	rightDecimal, ok := numbers.ToDecimal(right)
+	if !ok {
+		return nil, NewErrInvalidOperand(operator, right)
+	}
+
+	switch operator {
+	case '+':
+		return leftDecimal.Add(rightDecimal), nil
+	case '-':
+		return leftDecimal.Sub(rightDecimal), nil
+	case '*':
+		return leftDecimal.Mul(rightDecimal), nil
+	default:
+		if rightDecimal.IsZero() {
+			return nil, nil
+		}
+		return leftDecimal.Div(rightDecimal), nil
+	}
+}
+
+// normalize converts the numeric types that documents may hold into int64 or float64.
+func normalize(value any) any {
+	switch v := value.(type) {
+	case int:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case uint64:
+		return int64(v)
+	case float32:
+		return float64(v)
+	default:
+		return value
+	}
+}
+
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+func toBigInt(value any) (*big.Int, bool) {
+	switch v := value.(type) {
+	case int64:
+		return big.NewInt(v), true
+	case *big.Int:
+		return v, true
+	default:
+		return nil, false
+	}
+}
+
+func toString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package computed
+
+import (
+	"strconv"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokenNumber tokenKind = iota
+	tokenString
+	tokenPath
+	tokenOperator
+	tokenOpenParen
+	tokenCloseParen
+)
+
+type token struct {
+	kind   tokenKind
+	value  string
+	offset int
+}
+
+type parser struct {
+	source   string
+	tokens   []token
+	position int
+	paths    [][]string
+}
+
+func (p *parser) tokenize() error {
+	source := p.source
+	for i := 0; i < len(source); {
+		c := source[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+
+		case c == '+' || c == '-' || c == '*' || c == '/':
+			p.tokens = append(p.tokens, token{kind: tokenOperator, value: string(c), offset: i})
+			i++
+
+		case c == '(':
+			p.tokens = append(p.tokens, token{kind: tokenOpenParen, offset: i})
+			i++
+
+		case c == ')':
+			p.tokens = append(p.tokens, token{kind: tokenCloseParen, offset: i})
+			i++
+
+		case c == '"' || c == '\'':
+			value := strings.Builder{}
+			j := i + 1
+			for ; j < len(source) && source[j] != c; j++ {
+				if source[j] == '\\' && j+1 < len(source) {
+					j++
+				}
+				value.WriteByte(source[j])
+			}
+			if j >= len(source) {
+				return NewErrUnterminatedString(source, i)
+			}
+			p.tokens = append(p.tokens, token{kind: tokenString, value: value.String(), offset: i})
+			i = j + 1
+
+		case isDigit(c):
+			j := i
+			for j < len(source) && (isDigit(source[j]) || source[j] == '.') {
+				j++
+			}
+			p.tokens = append(p.tokens, token{kind: tokenNumber, value: source[i:j], offset: i})
+			i = j
+
+		case isNameStart(c):
+			j := i
+			for j < len(source) && (isNameStart(source[j]) || isDigit(source[j]) || source[j] == '.') {
+				j++
+			}
+			p.tokens = append(p.tokens, token{kind: tokenPath, value: source[i:j], offset: i})
+			i = j
+
+		default:
+			return NewErrUnexpectedToken(source, i)
+		}
+	}
+	return nil
+}
+
+// parseSum parses the `+` and `-` operations, which have the lowest precedence.
+func (p *parser) parseSum() (node, error) {
+	left, err := p.parseProduct()
+	if err != nil {
+		return nil, err
+	}
+	for p.nextIsOperator('+', '-') {
+		operator := p.tokens[p.position].value[0]
+		p.position++
+		right, err := p.parseProduct()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{operator: operator, left: left, right: right}
+	}
+	return left, nil
+}
+
+// parseProduct parses the `*` and `/` operations.
+func (p *parser) parseProduct() (node, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	for p.nextIsOperator('*', '/') {
+		operator := p.tokens[p.position].value[0]
+		p.position++
+		right, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{operator: operator, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseOperand() (node, error) {
+	if p.position >= len(p.tokens) {
+		return nil, NewErrUnexpectedToken(p.source, len(p.source))
+	}
+
+	current := p.tokens[p.position]
+	p.position++
+
+	switch current.kind {
+	case tokenNumber:
+		if intValue, err := strconv.ParseInt(current.value, 10, 64); err == nil {
+			return &literalNode{value: intValue}, nil
+		}
+		floatValue, err := strconv.ParseFloat(current.value, 64)
+		if err != nil {
+			return nil, NewErrUnexpectedToken(p.source, current.offset)
+		}
+		return &literalNode{value: floatValue}, nil
+
+	case tokenString:
+		return &literalNode{value: current.value}, nil
+
+	case tokenPath:
+		path := strings.Split(current.value, ".")
+		for _, part := range path {
+			if part == "" {
+				return nil, NewErrUnexpectedToken(p.source, current.offset)
+			}
+		}
+		p.addPath(path)
+		return &pathNode{path: path}, nil
+
+	case tokenOperator:
+		if current.value == "-" {
+			operand, err := p.parseOperand()
+			if err != nil {
+				return nil, err
+			}
+			return &binaryNode{operator: '-', left: &literalNode{value: int64(0)}, right: operand}, nil
+		}
+
+	case tokenOpenParen:
+		inner, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		if p.position >= len(p.tokens) || p.tokens[p.position].kind != tokenCloseParen {
+			return nil, NewErrUnexpectedToken(p.source, len(p.source))
+		}
+		p.position++
+		return inner, nil
+	}
+
+	return nil, NewErrUnexpectedToken(p.source, current.offset)
+}
+
+func (p *parser) nextIsOperator(operators ...byte) bool {
+	if p.position >= len(p.tokens) || p.tokens[p.position].kind != tokenOperator {
+		return false
+	}
+	for _, operator := range operators {
+		if p.tokens[p.position].value[0] == operator {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *parser) addPath(path []string) {
+	for _, existing := range p.paths {
+		if joinPath(existing) == joinPath(path) {
+			return
+		}
+	}
+	p.paths = append(p.paths, path)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
@@ -0,0 +1,245 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"math"
+	"math/big"
+
+	"github.com/fxamacker/cbor/v2"
+	dag "github.com/ipfs/boxo/ipld/merkledag"
+	ds "github.com/ipfs/go-datastore"
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/shopspring/decimal"
+	"github.com/ugorji/go/codec"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/db/base"
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+var (
+	// ensure types implements core interfaces
+	_ core.ReplicatedData = (*BigPNCounter)(nil)
+	_ core.MetadataDelta  = (*BigPNCounterDelta)(nil)
+	_ core.SignedDelta    = (*BigPNCounterDelta)(nil)
+)
+
+// BigPNCounterDelta is a single delta operation for a BigPNCounter
+type BigPNCounterDelta struct {
+	DocID     []byte
+	FieldName string
+	Priority  uint64
+	// Nonce is an added randomly generated number that ensures
+	// that each increment operation is unique.
+	Nonce int64
+	// SchemaVersionID is the schema version datastore key at the time of commit.
+	//
+	// It can be used to identify the collection datastructure state at the time of commit.
+	SchemaVersionID string
+	// Data is the canonical decimal string of the increment, this ensures that the
+	// value does not lose precision when encoded.
+	Data string
+	// Timestamp is the optional wall-clock time the commit was created at, in unix nanoseconds.
+	Timestamp int64 `json:",omitempty"`
+	// Author is the optional ID of the node, or peer, that created the commit.
+	Author string `json:",omitempty"`
+	// Signature is the optional signature of the commit.
+	Signature []byte `json:",omitempty"`
+	// PublicKey is the optional public key of the commit signer.
+	PublicKey []byte `json:",omitempty"`
+}
+
+// GetPriority gets the current priority for this delta.
+func (delta *BigPNCounterDelta) GetPriority() uint64 {
+	return delta.Priority
+}
+
+// SetPriority will set the priority for this delta.
+func (delta *BigPNCounterDelta) SetPriority(prio uint64) {
+	delta.Priority = prio
+}
+
+// SetMetadata will set the optional commit metadata for this delta.
+func (delta *BigPNCounterDelta) SetMetadata(metadata core.CommitMetadata) {
+	delta.Timestamp = metadata.Timestamp
+	delta.Author = metadata.Author
+}
+
+// GetSignature returns the optional commit signature of this delta.
+func (delta *BigPNCounterDelta) GetSignature() core.CommitSignature {
+	return core.CommitSignature{
+		Signature: delta.Signature,
+		PublicKey: delta.PublicKey,
+	}
+}
+
+// SetSignature will set the optional commit signature for this delta.
+func (delta *BigPNCounterDelta) SetSignature(signature core.CommitSignature) {
+	delta.Signature = signature.Signature
+	delta.PublicKey = signature.PublicKey
+}
+
+// Marshal encodes the delta using CBOR.
+func (delta *BigPNCounterDelta) Marshal() ([]byte, error) {
+	h := &codec.CborHandle{}
+	buf := bytes.NewBuffer(nil)
+	enc := codec.NewEncoder(buf, h)
+	err := enc.Encode(delta)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal decodes the delta from CBOR.
+func (delta *BigPNCounterDelta) Unmarshal(b []byte) error {
+	h := &codec.CborHandle{}
+	dec := codec.NewDecoderBytes(b, h)
+	return dec.Decode(delta)
+}
+
+// BigPNCounter is a PNCounter for arbitrary precision BigInt and Decimal data types.
+//
+// Values are summed exactly and stored using their canonical string representation.
+type BigPNCounter struct {
+	baseCRDT
+}
+
+// NewBigPNCounter returns a new instance of the BigPNCounter with the given ID.
+func NewBigPNCounter(
+	store datastore.DSReaderWriter,
+	schemaVersionKey core.CollectionSchemaVersionKey,
+	key core.DataStoreKey,
+	fieldName string,
+) BigPNCounter {
+	return BigPNCounter{newBaseCRDT(store, key, schemaVersionKey, fieldName)}
+}
+
+// Value gets the current register value
+func (reg BigPNCounter) Value(ctx context.Context) ([]byte, error) {
+	valueK := reg.key.WithValueFlag()
+	buf, err := reg.store.Get(ctx, valueK.ToDS())
+	if err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Increment generates a new delta with the supplied value
+func (reg BigPNCounter) Increment(ctx context.Context, value decimal.Decimal) (*BigPNCounterDelta, error) {
+	// To ensure that the dag block is unique, we add a random number to the delta.
+	// This is done only on update (if the doc doesn't already exist) to ensure that the
+	// initial dag block of a document can be reproducible.
+	exists, err := reg.store.Has(ctx, reg.key.ToPrimaryDataStoreKey().ToDS())
+	if err != nil {
+		return nil, err
+	}
+	var nonce int64
+	if exists {
+		r, err := rand.Int(rand.Reader, big.NewInt(math.MaxInt64))
+		if err != nil {
+			return nil, err
+		}
+		nonce = r.Int64()
+	}
+
+	return &BigPNCounterDelta{
+		DocID:           []byte(reg.key.DocID),
+		FieldName:       reg.fieldName,
+		Data:            value.String(),
+		SchemaVersionID: reg.schemaVersionKey.SchemaVersionId,
+		Nonce:           nonce,
+	}, nil
+}
+
+// Merge implements ReplicatedData interface.
+// It merges two BigPNCounters by adding the values together.
+func (reg BigPNCounter) Merge(ctx context.Context, delta core.Delta) error {
+	d, ok := delta.(*BigPNCounterDelta)
+	if !ok {
+		return ErrMismatchedMergeType
+	}
+
+	value, err := decimal.NewFromString(d.Data)
+	if err != nil {
+		return err
+	}
+
+	return reg.incrementValue(ctx, value, d.GetPriority())
+}
+
+func (reg BigPNCounter) incrementValue(ctx context.Context, value decimal.Decimal, priority uint64) error {
+	key := reg.key.WithValueFlag()
+	marker, err := reg.store.Get(ctx, reg.key.ToPrimaryDataStoreKey().ToDS())
+	if err != nil && !errors.Is(err, ds.ErrNotFound) {
+		return err
+	}
+	if bytes.Equal(marker, []byte{base.DeletedObjectMarker}) {
+		key = key.WithDeletedFlag()
+	}
+
+	curValue, err := reg.getCurrentValue(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	newValue := curValue.Add(value)
+	b, err := cbor.Marshal(newValue.String())
+	if err != nil {
+		return err
+	}
+
+	err = reg.store.Put(ctx, key.ToDS(), b)
+	if err != nil {
+		return NewErrFailedToStoreValue(err)
+	}
+
+	return reg.setPriority(ctx, reg.key, priority)
+}
+
+func (reg BigPNCounter) getCurrentValue(ctx context.Context, key core.DataStoreKey) (decimal.Decimal, error) {
+	curValue, err := reg.store.Get(ctx, key.ToDS())
+	if err != nil {
+		if errors.Is(err, ds.ErrNotFound) {
+			return decimal.Zero, nil
+		}
+		return decimal.Zero, err
+	}
+
+	var s string
+	err = cbor.Unmarshal(curValue, &s)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return decimal.NewFromString(s)
+}
+
+// DeltaDecode is a typed helper to extract a BigPNCounterDelta from a ipld.Node
+func (reg BigPNCounter) DeltaDecode(node ipld.Node) (core.Delta, error) {
+	pbNode, ok := node.(*dag.ProtoNode)
+	if !ok {
+		return nil, client.NewErrUnexpectedType[*dag.ProtoNode]("ipld.Node", node)
+	}
+
+	delta := &BigPNCounterDelta{}
+	err := delta.Unmarshal(pbNode.Data())
+	if err != nil {
+		return nil, err
+	}
+
+	return delta, nil
+}
@@ -45,9 +45,21 @@ type CompositeDAGDelta struct {
 	// stored as links in the DAG blocks. They are needed here to
 	// hold on to them for the block creation.
 	SubDAGs []core.DAGLink `json:"-"`
+	// Timestamp is the optional wall-clock time the commit was created at, in unix nanoseconds.
+	Timestamp int64 `json:",omitempty"`
+	// Author is the optional ID of the node, or peer, that created the commit.
+	Author string `json:",omitempty"`
+	// Signature is the optional signature of the commit.
+	Signature []byte `json:",omitempty"`
+	// PublicKey is the optional public key of the commit signer.
+	PublicKey []byte `json:",omitempty"`
 }
 
-var _ core.CompositeDelta = (*CompositeDAGDelta)(nil)
+var (
+	_ core.CompositeDelta = (*CompositeDAGDelta)(nil)
+	_ core.MetadataDelta  = (*CompositeDAGDelta)(nil)
+	_ core.SignedDelta    = (*CompositeDAGDelta)(nil)
+)
 
 // GetPriority gets the current priority for this delta.
 func (delta *CompositeDAGDelta) GetPriority() uint64 {
@@ -59,6 +71,26 @@ func (delta *CompositeDAGDelta) SetPriority(prio uint64) {
 	delta.Priority = prio
 }
 
+// SetMetadata will set the optional commit metadata for this delta.
+func (delta *CompositeDAGDelta) SetMetadata(metadata core.CommitMetadata) {
+	delta.Timestamp = metadata.Timestamp
+	delta.Author = metadata.Author
+}
+
+// GetSignature returns the optional commit signature of this delta.
+func (delta *CompositeDAGDelta) GetSignature() core.CommitSignature {
+	return core.CommitSignature{
+		Signature: delta.Signature,
+		PublicKey: delta.PublicKey,
+	}
+}
+
+// SetSignature will set the optional commit signature for this delta.
+func (delta *CompositeDAGDelta) SetSignature(signature core.CommitSignature) {
+	delta.Signature = signature.Signature
+	delta.PublicKey = signature.PublicKey
+}
+
 // Marshal will serialize this delta to a byte array.
 func (delta *CompositeDAGDelta) Marshal() ([]byte, error) {
 	h := &codec.CborHandle{}
@@ -37,9 +37,20 @@ type LWWRegDelta struct {
 	// It can be used to identify the collection datastructure state at the time of commit.
 	SchemaVersionID string
 	Data            []byte
+	// Timestamp is the optional wall-clock time the commit was created at, in unix nanoseconds.
+	Timestamp int64 `json:",omitempty"`
+	// Author is the optional ID of the node, or peer, that created the commit.
+	Author string `json:",omitempty"`
+	// Signature is the optional signature of the commit.
+	Signature []byte `json:",omitempty"`
+	// PublicKey is the optional public key of the commit signer.
+	PublicKey []byte `json:",omitempty"`
 }
 
-var _ core.Delta = (*LWWRegDelta)(nil)
+var (
+	_ core.MetadataDelta = (*LWWRegDelta)(nil)
+	_ core.SignedDelta   = (*LWWRegDelta)(nil)
+)
 
 // GetPriority gets the current priority for this delta.
 func (delta *LWWRegDelta) GetPriority() uint64 {
@@ -51,6 +62,26 @@ func (delta *LWWRegDelta) SetPriority(prio uint64) {
 	delta.Priority = prio
 }
 
+// SetMetadata will set the optional commit metadata for this delta.
+func (delta *LWWRegDelta) SetMetadata(metadata core.CommitMetadata) {
+	delta.Timestamp = metadata.Timestamp
+	delta.Author = metadata.Author
+}
+
+// GetSignature returns the optional commit signature of this delta.
+func (delta *LWWRegDelta) GetSignature() core.CommitSignature {
+	return core.CommitSignature{
+		Signature: delta.Signature,
+		PublicKey: delta.PublicKey,
+	}
+}
+
+// SetSignature will set the optional commit signature for this delta.
+func (delta *LWWRegDelta) SetSignature(signature core.CommitSignature) {
+	delta.Signature = signature.Signature
+	delta.PublicKey = signature.PublicKey
+}
+
 // Marshal encodes the delta using CBOR.
 // for now le'ts do cbor (quick to implement)
 func (delta *LWWRegDelta) Marshal() ([]byte, error) {
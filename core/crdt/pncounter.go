@@ -35,8 +35,10 @@ var (
 	// ensure types implements core interfaces
 	_ core.ReplicatedData = (*PNCounter[float64])(nil)
 	_ core.ReplicatedData = (*PNCounter[int64])(nil)
-	_ core.Delta          = (*PNCounterDelta[float64])(nil)
-	_ core.Delta          = (*PNCounterDelta[int64])(nil)
+	_ core.MetadataDelta  = (*PNCounterDelta[float64])(nil)
+	_ core.MetadataDelta  = (*PNCounterDelta[int64])(nil)
+	_ core.SignedDelta    = (*PNCounterDelta[float64])(nil)
+	_ core.SignedDelta    = (*PNCounterDelta[int64])(nil)
 )
 
 type Incrementable interface {
@@ -56,6 +58,14 @@ type PNCounterDelta[T Incrementable] struct {
 	// It can be used to identify the collection datastructure state at the time of commit.
 	SchemaVersionID string
 	Data            T
+	// Timestamp is the optional wall-clock time the commit was created at, in unix nanoseconds.
+	Timestamp int64 `json:",omitempty"`
+	// Author is the optional ID of the node, or peer, that created the commit.
+	Author string `json:",omitempty"`
+	// Signature is the optional signature of the commit.
+	Signature []byte `json:",omitempty"`
+	// PublicKey is the optional public key of the commit signer.
+	PublicKey []byte `json:",omitempty"`
 }
 
 // GetPriority gets the current priority for this delta.
@@ -68,6 +78,26 @@ func (delta *PNCounterDelta[T]) SetPriority(prio uint64) {
 	delta.Priority = prio
 }
 
+// SetMetadata will set the optional commit metadata for this delta.
+func (delta *PNCounterDelta[T]) SetMetadata(metadata core.CommitMetadata) {
+	delta.Timestamp = metadata.Timestamp
+	delta.Author = metadata.Author
+}
+
+// GetSignature returns the optional commit signature of this delta.
+func (delta *PNCounterDelta[T]) GetSignature() core.CommitSignature {
+	return core.CommitSignature{
+		Signature: delta.Signature,
+		PublicKey: delta.PublicKey,
+	}
+}
+
+// SetSignature will set the optional commit signature for this delta.
+func (delta *PNCounterDelta[T]) SetSignature(signature core.CommitSignature) {
+	delta.Signature = signature.Signature
+	delta.PublicKey = signature.PublicKey
+}
+
 // Marshal encodes the delta using CBOR.
 func (delta *PNCounterDelta[T]) Marshal() ([]byte, error) {
 	h := &codec.CborHandle{}
@@ -82,6 +82,61 @@ func TestMergeAscending_ReturnsItemsInOrder_GivenKeysInMixedOrder(t *testing.T)
 	assert.Equal(t, end2, result[2].End().ToString())
 }
 
+func TestMergeAscending_ReturnsItemsInOrder_GivenLastKeyBeforeAllOthers(t *testing.T) {
+	start1 := "/p/0/0/k4"
+	end1 := "/p/0/0/k5"
+	start2 := "/p/0/0/k7"
+	end2 := "/p/0/0/k8"
+	start3 := "/p/0/0/k1"
+	end3 := "/p/0/0/k2"
+
+	input := []Span{
+		NewSpan(MustNewDataStoreKey(start1), MustNewDataStoreKey(end1)),
+		NewSpan(MustNewDataStoreKey(start2), MustNewDataStoreKey(end2)),
+		NewSpan(MustNewDataStoreKey(start3), MustNewDataStoreKey(end3)),
+	}
+
+	result := MergeAscending(input)
+
+	assert.Len(t, result, 3)
+	assert.Equal(t, start3, result[0].Start().ToString())
+	assert.Equal(t, end3, result[0].End().ToString())
+	assert.Equal(t, start1, result[1].Start().ToString())
+	assert.Equal(t, end1, result[1].End().ToString())
+	assert.Equal(t, start2, result[2].Start().ToString())
+	assert.Equal(t, end2, result[2].End().ToString())
+}
+
+func TestMergeAscending_ReturnsItemsInOrder_GivenKeyBetweenOthers(t *testing.T) {
+	start1 := "/p/0/0/k1"
+	end1 := "/p/0/0/k2"
+	start2 := "/p/0/0/k5"
+	end2 := "/p/0/0/k6"
+	start3 := "/p/0/0/k8"
+	end3 := "/p/0/0/k9"
+	start4 := "/p/0/0/k3a"
+	end4 := "/p/0/0/k3b"
+
+	input := []Span{
+		NewSpan(MustNewDataStoreKey(start1), MustNewDataStoreKey(end1)),
+		NewSpan(MustNewDataStoreKey(start2), MustNewDataStoreKey(end2)),
+		NewSpan(MustNewDataStoreKey(start3), MustNewDataStoreKey(end3)),
+		NewSpan(MustNewDataStoreKey(start4), MustNewDataStoreKey(end4)),
+	}
+
+	result := MergeAscending(input)
+
+	assert.Len(t, result, 4)
+	assert.Equal(t, start1, result[0].Start().ToString())
+	assert.Equal(t, end1, result[0].End().ToString())
+	assert.Equal(t, start4, result[1].Start().ToString())
+	assert.Equal(t, end4, result[1].End().ToString())
+	assert.Equal(t, start2, result[2].Start().ToString())
+	assert.Equal(t, end2, result[2].End().ToString())
+	assert.Equal(t, start3, result[3].Start().ToString())
+	assert.Equal(t, end3, result[3].End().ToString())
+}
+
 func TestMergeAscending_ReturnsSingle_GivenStartBeforeEndEqualToStart(t *testing.T) {
 	start1 := "/p/0/0/k3"
 	end1 := "/p/0/0/k4"
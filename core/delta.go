@@ -11,7 +11,10 @@
 package core
 
 import (
+	"context"
+
 	cid "github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p/core/crypto"
 )
 
 // Delta represents a delta-state update to delta-CRDT.
@@ -23,6 +26,104 @@ type Delta interface {
 	Unmarshal(b []byte) error
 }
 
+// MetadataDelta represents a delta that records the optional metadata of the commit it
+// is written to.
+type MetadataDelta interface {
+	Delta
+	SetMetadata(CommitMetadata)
+}
+
+// CommitMetadata is the optional metadata of a commit.
+//
+// It is part of the commit block, and is thus covered by its CID.
+type CommitMetadata struct {
+	// Timestamp is the wall-clock time the commit was created at, in unix nanoseconds.
+	//
+	// Zero if the timestamp is not recorded.
+	Timestamp int64
+	// Author is the ID of the node, or peer, that created the commit.
+	//
+	// Empty if the author is not recorded.
+	Author string
+}
+
+type commitMetadataContextKey struct{}
+
+// ContextWithCommitMetadata returns a new context holding the given metadata, to be recorded
+// in any commit created with the returned context.
+func ContextWithCommitMetadata(ctx context.Context, metadata CommitMetadata) context.Context {
+	return context.WithValue(ctx, commitMetadataContextKey{}, metadata)
+}
+
+// CommitMetadataFromContext returns the commit metadata held by the given context, if any.
+func CommitMetadataFromContext(ctx context.Context) (CommitMetadata, bool) {
+	metadata, ok := ctx.Value(commitMetadataContextKey{}).(CommitMetadata)
+	return metadata, ok
+}
+
+// SignedDelta represents a delta that holds the optional signature of the commit it is
+// written to.
+type SignedDelta interface {
+	Delta
+	GetSignature() CommitSignature
+	SetSignature(CommitSignature)
+}
+
+// CommitSignature is the optional signature of a commit.
+//
+// It is stored in the commit block alongside the delta, and signs the block as it would be
+// encoded without the signature.
+type CommitSignature struct {
+	// Signature is the signature of the unsigned commit block.
+	//
+	// Empty if the commit is not signed.
+	Signature []byte
+	// PublicKey is the marshalled public key of the signer, used to verify the signature.
+	PublicKey []byte
+}
+
+// SignaturePolicy defines which commits are accepted when their signature is verified.
+//
+// Commits with an invalid signature are always rejected.
+type SignaturePolicy struct {
+	// RequireSignature rejects unsigned commits if true.
+	RequireSignature bool
+	// TrustedSigners is the list of peer IDs whose commits are accepted.
+	//
+	// If not empty, unsigned commits and commits signed by any other key are rejected.
+	TrustedSigners []string
+}
+
+type commitSigningKeyContextKey struct{}
+
+// ContextWithCommitSigningKey returns a new context holding the given private key, used to
+// sign any commit created with the returned context.
+func ContextWithCommitSigningKey(ctx context.Context, key crypto.PrivKey) context.Context {
+	return context.WithValue(ctx, commitSigningKeyContextKey{}, key)
+}
+
+// CommitSigningKeyFromContext returns the commit signing key held by the given context, if any.
+func CommitSigningKeyFromContext(ctx context.Context) (crypto.PrivKey, bool) {
+	key, ok := ctx.Value(commitSigningKeyContextKey{}).(crypto.PrivKey)
+	return key, ok
+}
+
+type signaturePolicyContextKey struct{}
+
+// ContextWithSignaturePolicy returns a new context holding the given policy, used to verify
+// any commit read with the returned context.
+func ContextWithSignaturePolicy(ctx context.Context, policy SignaturePolicy) context.Context {
+	return context.WithValue(ctx, signaturePolicyContextKey{}, policy)
+}
+
+// SignaturePolicyFromContext returns the signature policy held by the given context.
+//
+// The default policy is returned if the context does not hold any.
+func SignaturePolicyFromContext(ctx context.Context) SignaturePolicy {
+	policy, _ := ctx.Value(signaturePolicyContextKey{}).(SignaturePolicy)
+	return policy
+}
+
 // CompositeDelta represents a delta-state update to a composite CRDT.
 type CompositeDelta interface {
 	Delta
@@ -14,6 +14,9 @@ Package core provides commonly shared interfaces and building blocks.
 package core
 
 import (
+	"math/big"
+
+	"github.com/shopspring/decimal"
 	"github.com/sourcenetwork/immutable"
 
 	"github.com/sourcenetwork/defradb/client"
@@ -233,6 +236,12 @@ func (mapping *DocumentMapping) ToMap(doc Doc) map[string]any {
 		case Doc:
 			innerMapping := mapping.ChildMappings[renderKey.Index]
 			renderValue = innerMapping.ToMap(innerV)
+		case *big.Int:
+			// Arbitrary precision numbers are rendered as strings so that they
+			// do not lose precision when serialized by the client.
+			renderValue = innerV.String()
+		case decimal.Decimal:
+			renderValue = innerV.String()
 		default:
 			if mapping.typeInfo.HasValue() && renderKey.Index == mapping.typeInfo.Value().Index {
 				renderValue = mapping.typeInfo.Value().Name
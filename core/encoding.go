@@ -12,8 +12,10 @@ package core
 
 import (
 	"fmt"
+	"math/big"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/sourcenetwork/immutable"
 
 	"github.com/sourcenetwork/defradb/client"
@@ -125,6 +127,10 @@ func DecodeFieldValue(fieldDesc client.FieldDescription, val any) (any, error) {
 			case string:
 				return time.Parse(time.RFC3339, v)
 			}
+		case client.FieldKind_BIGINT:
+			return convertToBigInt(fieldDesc.Name, val)
+		case client.FieldKind_DECIMAL:
+			return convertToDecimal(fieldDesc.Name, val)
 		}
 	}
 
@@ -179,3 +185,43 @@ func convertToInt(propertyName string, untypedValue any) (int64, error) {
 		return 0, client.NewErrUnexpectedType[string](propertyName, untypedValue)
 	}
 }
+
+func convertToBigInt(propertyName string, untypedValue any) (*big.Int, error) {
+	switch value := untypedValue.(type) {
+	case *big.Int:
+		return value, nil
+	case string:
+		i, ok := new(big.Int).SetString(value, 10)
+		if !ok {
+			return nil, client.NewErrUnexpectedType[*big.Int](propertyName, untypedValue)
+		}
+		return i, nil
+	case uint64:
+		return new(big.Int).SetUint64(value), nil
+	case int64:
+		return big.NewInt(value), nil
+	default:
+		return nil, client.NewErrUnexpectedType[*big.Int](propertyName, untypedValue)
+	}
+}
+
+func convertToDecimal(propertyName string, untypedValue any) (decimal.Decimal, error) {
+	switch value := untypedValue.(type) {
+	case decimal.Decimal:
+		return value, nil
+	case string:
+		d, err := decimal.NewFromString(value)
+		if err != nil {
+			return decimal.Decimal{}, client.NewErrUnexpectedType[decimal.Decimal](propertyName, untypedValue)
+		}
+		return d, nil
+	case uint64:
+		return decimal.NewFromBigInt(new(big.Int).SetUint64(value), 0), nil
+	case int64:
+		return decimal.NewFromInt(value), nil
+	case float64:
+		return decimal.NewFromFloat(value), nil
+	default:
+		return decimal.Decimal{}, client.NewErrUnexpectedType[decimal.Decimal](propertyName, untypedValue)
+	}
+}
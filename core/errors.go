@@ -16,15 +16,23 @@ import (
 
 const (
 	errFailedToGetFieldIdOfKey string = "failed to get FieldID of Key"
+	errInvalidOrderedNumber    string = "invalid ordered number encoding"
 )
 
 var (
 	ErrFailedToGetFieldIdOfKey = errors.New(errFailedToGetFieldIdOfKey)
 	ErrEmptyKey                = errors.New("received empty key string")
 	ErrInvalidKey              = errors.New("invalid key string")
+	ErrInvalidOrderedNumber    = errors.New(errInvalidOrderedNumber)
 )
 
 // NewErrFailedToGetFieldIdOfKey returns the error indicating failure to get FieldID of Key.
 func NewErrFailedToGetFieldIdOfKey(inner error) error {
 	return errors.Wrap(errFailedToGetFieldIdOfKey, inner)
 }
+
+// NewErrInvalidOrderedNumber returns the error indicating that the given bytes are not
+// a valid ordered number encoding.
+func NewErrInvalidOrderedNumber(data []byte) error {
+	return errors.New(errInvalidOrderedNumber, errors.NewKV("Data", string(data)))
+}
@@ -46,6 +46,7 @@ const (
 	COLLECTION_NAME                = "/collection/name"
 	COLLECTION_SCHEMA_VERSION      = "/collection/version"
 	COLLECTION_INDEX               = "/collection/index"
+	COLLECTION_DOCUMENT_MIGRATION  = "/collection/migration"
 	SCHEMA_MIGRATION               = "/schema/migration"
 	SCHEMA_VERSION                 = "/schema/version/v"
 	SCHEMA_VERSION_HISTORY         = "/schema/version/h"
@@ -54,6 +55,17 @@ const (
 	DATASTORE_DOC_VERSION_FIELD_ID = "v"
 	REPLICATOR                     = "/replicator/id"
 	P2P_COLLECTION                 = "/p2p/collection"
+	COMMIT_HISTORY                 = "/collection/history"
+	COMMIT_HISTORY_BACKFILLED      = "/collection/backfilled/history"
+	INDEXES_REENCODED              = "/collection/reencoded/indexes"
+	COLLECTION_CHANGE              = "/collection/changes"
+	COLLECTION_PENDING_CHANGE      = "/collection/pending"
+	DOCUMENT_SNAPSHOT              = "/document/snapshot"
+	DOCUMENT_HISTORY               = "/document/history"
+	DOCUMENT_CHANGE                = "/document/changes"
+	DOCUMENT_PRUNED_BLOCK          = "/document/pruned"
+	DOCUMENT_PURGED                = "/document/purged"
+	DOCUMENT_LOCAL                 = "/document/local"
 )
 
 // Key is an interface that represents a key in the database.
@@ -130,6 +142,14 @@ type CollectionSchemaVersionKey struct {
 
 var _ Key = (*CollectionSchemaVersionKey)(nil)
 
+// CollectionDocumentMigrationKey points to the jsonified progress of the eager migration
+// of the documents of the collection of the given ID.
+type CollectionDocumentMigrationKey struct {
+	CollectionID uint32
+}
+
+var _ Key = (*CollectionDocumentMigrationKey)(nil)
+
 // CollectionIndexKey to a stored description of an index
 type CollectionIndexKey struct {
 	// CollectionName is the name of the collection that the index is on
@@ -176,6 +196,98 @@ type P2PCollectionKey struct {
 
 var _ Key = (*P2PCollectionKey)(nil)
 
+// CommitHistoryKey points to the CID of the document commit of the given CID applied by this
+// node to the collection of the given ID, at the given local time in nanoseconds since the Unix
+// epoch.
+//
+// The keys of a collection are ordered by the time at which their commits were applied. The
+// CID keeps the keys of commits of a document applied at the same time apart.
+type CommitHistoryKey struct {
+	CollectionID uint32
+	Timestamp    int64
+	DocID        string
+	Cid          string
+}
+
+var _ Key = (*CommitHistoryKey)(nil)
+
+// CollectionChangeKey points to the change of the given sequence number in the change log of
+// the collection of the given ID.
+//
+// The sequence numbers of a collection are assigned in the order in which the transactions
+// applying the changes are committed, and the keys of a collection are ordered by them.
+type CollectionChangeKey struct {
+	CollectionID uint32
+	Seq          uint64
+}
+
+var _ Key = (*CollectionChangeKey)(nil)
+
+// CollectionPendingChangeKey points to a change made by the transaction of the given ID to a
+// document of the collection of the given ID, through the commit of the given CID, at the given
+// local time in nanoseconds since the Unix epoch, that has yet to be appended to the change log
+// of the collection.
+//
+// The keys are ordered by transaction, then collection, then time.
+type CollectionPendingChangeKey struct {
+	TxnID        uint64
+	CollectionID uint32
+	Timestamp    int64
+	Cid          string
+}
+
+var _ Key = (*CollectionPendingChangeKey)(nil)
+
+// DocumentSnapshotKey points to the serialized state of a document at the version of the given
+// CID, stored when the history before this version is pruned.
+type DocumentSnapshotKey struct {
+	DocID string
+	Cid   string
+}
+
+var _ Key = (*DocumentSnapshotKey)(nil)
+
+// DocumentHistoryKey points to the commit history key of the document commit of the given CID,
+// indexing the commit history of a collection by document.
+type DocumentHistoryKey struct {
+	DocID string
+	Cid   string
+}
+
+var _ Key = (*DocumentHistoryKey)(nil)
+
+// DocumentChangeKey points to the change log key of the change made by the document commit of
+// the given CID, indexing the change log of a collection by document.
+type DocumentChangeKey struct {
+	DocID string
+	Cid   string
+}
+
+var _ Key = (*DocumentChangeKey)(nil)
+
+// PrunedBlockKey marks the block of the given CID, from the DAG of the given document, as
+// pruned from the blockstore.
+type PrunedBlockKey struct {
+	DocID string
+	Cid   string
+}
+
+var _ Key = (*PrunedBlockKey)(nil)
+
+// PurgedDocumentKey marks the document of the given ID as purged from this node.
+type PurgedDocumentKey struct {
+	DocID string
+}
+
+var _ Key = (*PurgedDocumentKey)(nil)
+
+// LocalDocumentKey marks the document of the given ID as created on this node.
+type LocalDocumentKey struct {
+	DocID string
+}
+
+var _ Key = (*LocalDocumentKey)(nil)
+
 type SequenceKey struct {
 	SequenceName string
 }
@@ -270,6 +382,10 @@ func NewCollectionNameKey(name string) CollectionNameKey {
 	return CollectionNameKey{Name: name}
 }
 
+func NewCollectionDocumentMigrationKey(collectionID uint32) CollectionDocumentMigrationKey {
+	return CollectionDocumentMigrationKey{CollectionID: collectionID}
+}
+
 func NewCollectionSchemaVersionKey(schemaVersionId string, collectionID uint32) CollectionSchemaVersionKey {
 	return CollectionSchemaVersionKey{
 		SchemaVersionId: schemaVersionId,
@@ -620,6 +736,18 @@ func (k CollectionKey) ToDS() ds.Key {
 	return ds.NewKey(k.ToString())
 }
 
+func (k CollectionDocumentMigrationKey) ToString() string {
+	return fmt.Sprintf("%s/%s", COLLECTION_DOCUMENT_MIGRATION, strconv.Itoa(int(k.CollectionID)))
+}
+
+func (k CollectionDocumentMigrationKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k CollectionDocumentMigrationKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
+
 func (k CollectionNameKey) ToString() string {
 	return fmt.Sprintf("%s/%s", COLLECTION_NAME, k.Name)
 }
@@ -761,6 +889,341 @@ func (k P2PCollectionKey) ToDS() ds.Key {
 	return ds.NewKey(k.ToString())
 }
 
+// NewCommitHistoryKey creates a new CommitHistoryKey.
+func NewCommitHistoryKey(collectionID uint32, timestamp int64, docID string, cid string) CommitHistoryKey {
+	return CommitHistoryKey{
+		CollectionID: collectionID,
+		Timestamp:    timestamp,
+		DocID:        docID,
+		Cid:          cid,
+	}
+}
+
+// NewCommitHistoryKeyFromString creates a new CommitHistoryKey from a string.
+// It expects the input string is in the following format:
+//
+// /collection/history/[CollectionID]/[Timestamp]/[DocID]/[Cid]
+func NewCommitHistoryKeyFromString(key string) (CommitHistoryKey, error) {
+	keyArr := strings.Split(key, "/")
+	if len(keyArr) != 7 || keyArr[1] != "collection" || keyArr[2] != "history" {
+		return CommitHistoryKey{}, errors.WithStack(ErrInvalidKey, errors.NewKV("Key", key))
+	}
+	colID, err := strconv.Atoi(keyArr[3])
+	if err != nil {
+		return CommitHistoryKey{}, err
+	}
+	timestamp, err := strconv.ParseInt(keyArr[4], 10, 64)
+	if err != nil {
+		return CommitHistoryKey{}, err
+	}
+	return NewCommitHistoryKey(uint32(colID), timestamp, keyArr[5], keyArr[6]), nil
+}
+
+// ToString returns the string representation of the key.
+//
+// The timestamp is zero padded so that the keys of a collection sort by time, it and the
+// rest of the key are omitted if the timestamp is zero.
+func (k CommitHistoryKey) ToString() string {
+	result := COMMIT_HISTORY
+
+	if k.CollectionID != 0 {
+		result = fmt.Sprintf("%s/%d", result, k.CollectionID)
+		if k.Timestamp != 0 {
+			result = fmt.Sprintf("%s/%020d", result, k.Timestamp)
+			if k.DocID != "" {
+				result = result + "/" + k.DocID
+				if k.Cid != "" {
+					result = result + "/" + k.Cid
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+func (k CommitHistoryKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k CommitHistoryKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
+
+// NewCollectionChangeKey creates a new CollectionChangeKey.
+func NewCollectionChangeKey(collectionID uint32, seq uint64) CollectionChangeKey {
+	return CollectionChangeKey{
+		CollectionID: collectionID,
+		Seq:          seq,
+	}
+}
+
+// NewCollectionChangeKeyFromString creates a new CollectionChangeKey from a string.
+// It expects the input string is in the following format:
+//
+// /collection/changes/[CollectionID]/[Seq]
+func NewCollectionChangeKeyFromString(key string) (CollectionChangeKey, error) {
+	keyArr := strings.Split(key, "/")
+	if len(keyArr) != 5 || keyArr[1] != "collection" || keyArr[2] != "changes" {
+		return CollectionChangeKey{}, errors.WithStack(ErrInvalidKey, errors.NewKV("Key", key))
+	}
+	colID, err := strconv.Atoi(keyArr[3])
+	if err != nil {
+		return CollectionChangeKey{}, err
+	}
+	seq, err := strconv.ParseUint(keyArr[4], 10, 64)
+	if err != nil {
+		return CollectionChangeKey{}, err
+	}
+	return NewCollectionChangeKey(uint32(colID), seq), nil
+}
+
+// ToString returns the string representation of the key.
+//
+// The sequence number is zero padded so that the keys of a collection sort by it, it is
+// omitted if zero.
+func (k CollectionChangeKey) ToString() string {
+	result := COLLECTION_CHANGE
+
+	if k.CollectionID != 0 {
+		result = fmt.Sprintf("%s/%d", result, k.CollectionID)
+		if k.Seq != 0 {
+			result = fmt.Sprintf("%s/%020d", result, k.Seq)
+		}
+	}
+
+	return result
+}
+
+func (k CollectionChangeKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k CollectionChangeKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
+
+// NewCollectionPendingChangeKey creates a new CollectionPendingChangeKey.
+func NewCollectionPendingChangeKey(
+	txnID uint64,
+	collectionID uint32,
+	timestamp int64,
+	cid string,
+) CollectionPendingChangeKey {
+	return CollectionPendingChangeKey{
+		TxnID:        txnID,
+		CollectionID: collectionID,
+		Timestamp:    timestamp,
+		Cid:          cid,
+	}
+}
+
+// NewCollectionPendingChangeKeyFromString creates a new CollectionPendingChangeKey from a string.
+// It expects the input string is in the following format:
+//
+// /collection/pending/[TxnID]/[CollectionID]/[Timestamp]/[Cid]
+func NewCollectionPendingChangeKeyFromString(key string) (CollectionPendingChangeKey, error) {
+	keyArr := strings.Split(key, "/")
+	if len(keyArr) != 7 || keyArr[1] != "collection" || keyArr[2] != "pending" {
+		return CollectionPendingChangeKey{}, errors.WithStack(ErrInvalidKey, errors.NewKV("Key", key))
+	}
+	txnID, err := strconv.ParseUint(keyArr[3], 10, 64)
+	if err != nil {
+		return CollectionPendingChangeKey{}, err
+	}
+	colID, err := strconv.Atoi(keyArr[4])
+	if err != nil {
+		return CollectionPendingChangeKey{}, err
+	}
+	timestamp, err := strconv.ParseInt(keyArr[5], 10, 64)
+	if err != nil {
+		return CollectionPendingChangeKey{}, err
+	}
+	return NewCollectionPendingChangeKey(txnID, uint32(colID), timestamp, keyArr[6]), nil
+}
+
+// ToString returns the string representation of the key.
+//
+// The transaction ID and timestamp are zero padded so that the keys sort by transaction then
+// time, each part of the key and the rest of the key are omitted if it is zero.
+func (k CollectionPendingChangeKey) ToString() string {
+	result := COLLECTION_PENDING_CHANGE
+
+	if k.TxnID != 0 {
+		result = fmt.Sprintf("%s/%020d", result, k.TxnID)
+		if k.CollectionID != 0 {
+			result = fmt.Sprintf("%s/%d", result, k.CollectionID)
+			if k.Timestamp != 0 {
+				result = fmt.Sprintf("%s/%020d", result, k.Timestamp)
+				if k.Cid != "" {
+					result = result + "/" + k.Cid
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+func (k CollectionPendingChangeKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k CollectionPendingChangeKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
+
+// NewDocumentSnapshotKey creates a new DocumentSnapshotKey.
+func NewDocumentSnapshotKey(docID string, cid string) DocumentSnapshotKey {
+	return DocumentSnapshotKey{
+		DocID: docID,
+		Cid:   cid,
+	}
+}
+
+// ToString returns the string representation of the key.
+//
+// The CID is omitted if the document ID is empty.
+func (k DocumentSnapshotKey) ToString() string {
+	return docBlockKeyToString(DOCUMENT_SNAPSHOT, k.DocID, k.Cid)
+}
+
+func (k DocumentSnapshotKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k DocumentSnapshotKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
+
+// NewDocumentHistoryKey creates a new DocumentHistoryKey.
+func NewDocumentHistoryKey(docID string, cid string) DocumentHistoryKey {
+	return DocumentHistoryKey{
+		DocID: docID,
+		Cid:   cid,
+	}
+}
+
+// ToString returns the string representation of the key.
+//
+// The CID is omitted if the document ID is empty.
+func (k DocumentHistoryKey) ToString() string {
+	return docBlockKeyToString(DOCUMENT_HISTORY, k.DocID, k.Cid)
+}
+
+func (k DocumentHistoryKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k DocumentHistoryKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
+
+// NewDocumentChangeKey creates a new DocumentChangeKey.
+func NewDocumentChangeKey(docID string, cid string) DocumentChangeKey {
+	return DocumentChangeKey{
+		DocID: docID,
+		Cid:   cid,
+	}
+}
+
+// ToString returns the string representation of the key.
+//
+// The CID is omitted if the document ID is empty.
+func (k DocumentChangeKey) ToString() string {
+	return docBlockKeyToString(DOCUMENT_CHANGE, k.DocID, k.Cid)
+}
+
+func (k DocumentChangeKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k DocumentChangeKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
+
+// NewPrunedBlockKey creates a new PrunedBlockKey.
+func NewPrunedBlockKey(docID string, cid string) PrunedBlockKey {
+	return PrunedBlockKey{
+		DocID: docID,
+		Cid:   cid,
+	}
+}
+
+// ToString returns the string representation of the key.
+//
+// The CID is omitted if the document ID is empty.
+func (k PrunedBlockKey) ToString() string {
+	return docBlockKeyToString(DOCUMENT_PRUNED_BLOCK, k.DocID, k.Cid)
+}
+
+func (k PrunedBlockKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k PrunedBlockKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
+
+func docBlockKeyToString(prefix string, docID string, cid string) string {
+	result := prefix
+
+	if docID != "" {
+		result = result + "/" + docID
+		if cid != "" {
+			result = result + "/" + cid
+		}
+	}
+
+	return result
+}
+
+// NewPurgedDocumentKey creates a new PurgedDocumentKey.
+func NewPurgedDocumentKey(docID string) PurgedDocumentKey {
+	return PurgedDocumentKey{DocID: docID}
+}
+
+func (k PurgedDocumentKey) ToString() string {
+	result := DOCUMENT_PURGED
+
+	if k.DocID != "" {
+		result = result + "/" + k.DocID
+	}
+
+	return result
+}
+
+func (k PurgedDocumentKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k PurgedDocumentKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
+
+// NewLocalDocumentKey creates a new LocalDocumentKey.
+func NewLocalDocumentKey(docID string) LocalDocumentKey {
+	return LocalDocumentKey{DocID: docID}
+}
+
+func (k LocalDocumentKey) ToString() string {
+	result := DOCUMENT_LOCAL
+
+	if k.DocID != "" {
+		result = result + "/" + k.DocID
+	}
+
+	return result
+}
+
+func (k LocalDocumentKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k LocalDocumentKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
+
 func NewReplicatorKey(id string) ReplicatorKey {
 	return ReplicatorKey{ReplicatorID: id}
 }
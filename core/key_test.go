@@ -421,3 +421,22 @@ func TestNewIndexDataStoreKey_InvalidKey(t *testing.T) {
 		assert.Error(t, err, "case %d: %s", i, key)
 	}
 }
+
+func TestCommitHistoryKey_WithCommitsOfDocumentAtSameTime_ReturnsDistinctKeys(t *testing.T) {
+	key1 := NewCommitHistoryKey(1, 2, "bae-doc", "bafy1")
+	key2 := NewCommitHistoryKey(1, 2, "bae-doc", "bafy2")
+
+	assert.NotEqual(t, key1.ToString(), key2.ToString())
+	assert.Equal(t, "/collection/history/1/00000000000000000002/bae-doc/bafy1", key1.ToString())
+}
+
+func TestNewCommitHistoryKeyFromString_IfFullKeyString_ReturnKey(t *testing.T) {
+	key, err := NewCommitHistoryKeyFromString("/collection/history/1/00000000000000000002/bae-doc/bafy1")
+	assert.NoError(t, err)
+	assert.Equal(t, NewCommitHistoryKey(1, 2, "bae-doc", "bafy1"), key)
+}
+
+func TestNewCommitHistoryKeyFromString_IfMissingCid_ReturnError(t *testing.T) {
+	_, err := NewCommitHistoryKeyFromString("/collection/history/1/00000000000000000002/bae-doc")
+	assert.ErrorIs(t, err, ErrInvalidKey)
+}
@@ -0,0 +1,138 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package core
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	orderedNegativePrefix = '1'
+	orderedZeroPrefix     = '2'
+	orderedPositivePrefix = '3'
+	// orderedNegativeEnd terminates the digits of negative numbers, so that a number
+	// sorts before any number whose digits it is a prefix of.
+	orderedNegativeEnd = '~'
+	// orderedExponentLength is the number of decimal digits needed to hold any uint64.
+	orderedExponentLength = 20
+)
+
+var (
+	// OrderedBigNumberMin sorts before the encoding of any number.
+	OrderedBigNumberMin = []byte{orderedNegativePrefix}
+	// OrderedBigNumberMax sorts after the encoding of any number.
+	OrderedBigNumberMax = []byte{orderedPositivePrefix + 1}
+)
+
+// EncodeOrderedBigNumber encodes the given arbitrary precision number so that the
+// byte-wise order of the encoded values matches the numeric order of the numbers.
+//
+// A number is encoded as its sign, followed by the exponent of its most significant digit
+// and its significant digits, with the exponent and digits of negative numbers inverted.
+// The encoding only contains printable characters and never contains a '/', so it may
+// be used within keys.
+func EncodeOrderedBigNumber(val any) ([]byte, error) {
+	var d decimal.Decimal
+	switch v := val.(type) {
+	case *big.Int:
+		d = decimal.NewFromBigInt(v, 0)
+	case decimal.Decimal:
+		d = v
+	default:
+		return nil, NewErrInvalidOrderedNumber([]byte(fmt.Sprint(val)))
+	}
+
+	if d.Sign() == 0 {
+		return []byte{orderedZeroPrefix}, nil
+	}
+
+	digits := new(big.Int).Abs(d.Coefficient()).String()
+	// The number is 0.digits * 10^exponent.
+	exponent := int64(len(digits)) + int64(d.Exponent())
+	digits = strings.TrimRight(digits, "0")
+	orderedExponent := uint64(exponent) ^ (1 << 63)
+
+	if d.Sign() > 0 {
+		return []byte(fmt.Sprintf(
+			"%c%0*d%s",
+			orderedPositivePrefix,
+			orderedExponentLength,
+			orderedExponent,
+			digits,
+		)), nil
+	}
+
+	invertedDigits := make([]byte, len(digits))
+	for i := 0; i < len(digits); i++ {
+		invertedDigits[i] = '9' - digits[i] + '0'
+	}
+	return []byte(fmt.Sprintf(
+		"%c%0*d%s%c",
+		orderedNegativePrefix,
+		orderedExponentLength,
+		math.MaxUint64-orderedExponent,
+		invertedDigits,
+		orderedNegativeEnd,
+	)), nil
+}
+
+// DecodeOrderedBigNumber decodes a number encoded with EncodeOrderedBigNumber.
+func DecodeOrderedBigNumber(data []byte) (decimal.Decimal, error) {
+	if len(data) == 1 && data[0] == orderedZeroPrefix {
+		return decimal.Zero, nil
+	}
+	if len(data) < orderedExponentLength+2 {
+		return decimal.Decimal{}, NewErrInvalidOrderedNumber(data)
+	}
+
+	orderedExponent, err := strconv.ParseUint(string(data[1:orderedExponentLength+1]), 10, 64)
+	if err != nil {
+		return decimal.Decimal{}, NewErrInvalidOrderedNumber(data)
+	}
+	digits := data[orderedExponentLength+1:]
+
+	isNegative := false
+	switch data[0] {
+	case orderedPositivePrefix:
+	case orderedNegativePrefix:
+		if digits[len(digits)-1] != orderedNegativeEnd {
+			return decimal.Decimal{}, NewErrInvalidOrderedNumber(data)
+		}
+		isNegative = true
+		orderedExponent = math.MaxUint64 - orderedExponent
+		invertedDigits := make([]byte, len(digits)-1)
+		for i := range invertedDigits {
+			invertedDigits[i] = '9' - digits[i] + '0'
+		}
+		digits = invertedDigits
+	default:
+		return decimal.Decimal{}, NewErrInvalidOrderedNumber(data)
+	}
+
+	coefficient, ok := new(big.Int).SetString(string(digits), 10)
+	if !ok || len(digits) == 0 {
+		return decimal.Decimal{}, NewErrInvalidOrderedNumber(data)
+	}
+	if isNegative {
+		coefficient.Neg(coefficient)
+	}
+	exponent := int64(orderedExponent^(1<<63)) - int64(len(digits))
+	if exponent < math.MinInt32 || exponent > math.MaxInt32 {
+		return decimal.Decimal{}, NewErrInvalidOrderedNumber(data)
+	}
+	return decimal.NewFromBigInt(coefficient, int32(exponent)), nil
+}
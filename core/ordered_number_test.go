@@ -0,0 +1,86 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package core
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeOrderedBigNumber_ShouldPreserveNumericOrder(t *testing.T) {
+	values := []string{
+		"-123456789012345678901234567890",
+		"-1000",
+		"-999.99",
+		"-100.5",
+		"-100",
+		"-12.3",
+		"-12",
+		"-0.12",
+		"-0.0001",
+		"0",
+		"0.0001",
+		"0.12",
+		"0.123",
+		"9.99",
+		"12",
+		"12.3",
+		"100",
+		"100.5",
+		"123456789012345678901234567890",
+	}
+
+	encoded := make([][]byte, len(values))
+	for i, value := range values {
+		var err error
+		encoded[i], err = EncodeOrderedBigNumber(decimal.RequireFromString(value))
+		require.NoError(t, err)
+	}
+
+	for i := 1; i < len(encoded); i++ {
+		assert.Equal(t, -1, bytes.Compare(encoded[i-1], encoded[i]), "%s < %s", values[i-1], values[i])
+	}
+}
+
+func TestEncodeOrderedBigNumber_WithEqualValues_ShouldReturnEqualEncodings(t *testing.T) {
+	fromDecimal, err := EncodeOrderedBigNumber(decimal.RequireFromString("100.50"))
+	require.NoError(t, err)
+	fromShortDecimal, err := EncodeOrderedBigNumber(decimal.RequireFromString("100.5"))
+	require.NoError(t, err)
+	assert.Equal(t, fromDecimal, fromShortDecimal)
+
+	fromBigInt, err := EncodeOrderedBigNumber(big.NewInt(-100))
+	require.NoError(t, err)
+	fromIntDecimal, err := EncodeOrderedBigNumber(decimal.RequireFromString("-100.0"))
+	require.NoError(t, err)
+	assert.Equal(t, fromBigInt, fromIntDecimal)
+}
+
+func TestDecodeOrderedBigNumber_ShouldReturnEncodedValue(t *testing.T) {
+	for _, value := range []string{"-123456789012345678901234567890", "-0.001", "0", "7", "100.5"} {
+		encoded, err := EncodeOrderedBigNumber(decimal.RequireFromString(value))
+		require.NoError(t, err)
+
+		decoded, err := DecodeOrderedBigNumber(encoded)
+		require.NoError(t, err)
+		assert.Equal(t, value, decoded.String())
+	}
+}
+
+func TestDecodeOrderedBigNumber_WithInvalidData_ShouldError(t *testing.T) {
+	_, err := DecodeOrderedBigNumber([]byte("invalid"))
+	require.ErrorIs(t, err, ErrInvalidOrderedNumber)
+}
@@ -12,6 +12,7 @@ package iterable
 
 import (
 	"context"
+	"strings"
 
 	ds "github.com/ipfs/go-datastore"
 	dsq "github.com/ipfs/go-datastore/query"
@@ -76,7 +77,10 @@ func (shim *iteratorShim) IteratePrefix(
 			}
 			lastSharedIndex += 1
 		}
-		query.Prefix = string(startBytes[:lastSharedIndex])
+		// Query prefixes only match whole key segments, so the shared prefix is cut back to
+		// the last complete segment.
+		sharedPrefix := string(startBytes[:lastSharedIndex])
+		query.Prefix = sharedPrefix[:strings.LastIndex(sharedPrefix, "/")+1]
 		query.Filters = append(query.Filters, betweenFilter{
 			start: startPrefix.String(),
 			end:   endPrefix.String(),
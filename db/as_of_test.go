@@ -0,0 +1,194 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/core"
+)
+
+// deleteCommitHistory removes the commit history of all the documents, along with the marker of
+// its backfill, as if the commits were written before the history was recorded.
+func deleteCommitHistory(t *testing.T, db *implicitTxnDB) {
+	ctx := context.Background()
+	txn, err := db.NewTxn(ctx, false)
+	require.NoError(t, err)
+	err = deleteWithPrefix(ctx, txn.Systemstore(), core.COMMIT_HISTORY)
+	require.NoError(t, err)
+	err = deleteWithPrefix(ctx, txn.Systemstore(), core.DOCUMENT_HISTORY)
+	require.NoError(t, err)
+	err = txn.Systemstore().Delete(ctx, commitHistoryBackfilledKey)
+	require.NoError(t, err)
+	err = txn.Commit(ctx)
+	require.NoError(t, err)
+}
+
+func TestAsOfQuery_WithCommitsWrittenBeforeHistory_ReturnsBackfilledDocuments(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx, WithCommitTimestamps())
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.AddSchema(ctx, `
+		type Book {
+			name: String
+			rating: Int
+		}
+	`)
+	require.NoError(t, err)
+
+	execTestRequest(t, db, `mutation {
+		create_Book(input: {name: "Painted House", rating: 1}) {
+			_docID
+		}
+	}`)
+	beforeUpdate := time.Now()
+
+	execTestRequest(t, db, `mutation {
+		update_Book(input: {rating: 4}) {
+			_docID
+		}
+	}`)
+
+	// remove the commit history, as if the commits were written before it was recorded
+	deleteCommitHistory(t, db)
+	err = db.upgradeData(ctx)
+	require.NoError(t, err)
+
+	request := `query {
+		Book(asOf: "%s") {
+			name
+			rating
+		}
+	}`
+
+	docs := execTestRequest(t, db, fmt.Sprintf(request, beforeUpdate.Format(time.RFC3339Nano)))
+	assert.Equal(t, []map[string]any{{"name": "Painted House", "rating": int64(1)}}, docs)
+
+	docs = execTestRequest(t, db, fmt.Sprintf(request, time.Now().Format(time.RFC3339Nano)))
+	assert.Equal(t, []map[string]any{{"name": "Painted House", "rating": int64(4)}}, docs)
+}
+
+func TestAsOfQuery_WithCommitsWithoutTimestampWrittenBeforeHistory_ReturnsDocumentsFromTheEarliestTime(
+	t *testing.T,
+) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.AddSchema(ctx, `
+		type Book {
+			name: String
+			rating: Int
+		}
+	`)
+	require.NoError(t, err)
+
+	beforeCreate := time.Now()
+	execTestRequest(t, db, `mutation {
+		create_Book(input: {name: "Painted House", rating: 1}) {
+			_docID
+		}
+	}`)
+	execTestRequest(t, db, `mutation {
+		update_Book(input: {rating: 4}) {
+			_docID
+		}
+	}`)
+
+	// remove the commit history, as if the commits were written before it was recorded
+	deleteCommitHistory(t, db)
+	err = db.upgradeData(ctx)
+	require.NoError(t, err)
+
+	docs := execTestRequest(t, db, fmt.Sprintf(`query {
+		Book(asOf: "%s") {
+			name
+			rating
+		}
+	}`, beforeCreate.Format(time.RFC3339Nano)))
+	assert.Equal(t, []map[string]any{{"name": "Painted House", "rating": int64(4)}}, docs)
+}
+
+func TestAsOfQuery_WithDocIDFilter_RecomposesOnlyTheFilteredDocuments(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.AddSchema(ctx, `
+		type Book {
+			name: String
+			rating: Int
+		}
+	`)
+	require.NoError(t, err)
+
+	docs := execTestRequest(t, db, `mutation {
+		create_Book(input: {name: "Painted House", rating: 1}) {
+			_docID
+		}
+	}`)
+	paintedHouseID := docs[0]["_docID"].(string)
+	docs = execTestRequest(t, db, `mutation {
+		create_Book(input: {name: "Theif Lord", rating: 5}) {
+			_docID
+		}
+	}`)
+	theifLordID := docs[0]["_docID"].(string)
+	beforeUpdate := time.Now()
+
+	execTestRequest(t, db, `mutation {
+		update_Book(input: {rating: 4}) {
+			_docID
+		}
+	}`)
+
+	// remove the commit history of the collection, the commits of the filtered documents
+	// must be found through the history of each document
+	txn, err := db.NewTxn(ctx, false)
+	require.NoError(t, err)
+	err = deleteWithPrefix(ctx, txn.Systemstore(), core.COMMIT_HISTORY)
+	require.NoError(t, err)
+	err = txn.Commit(ctx)
+	require.NoError(t, err)
+
+	docs = execTestRequest(t, db, fmt.Sprintf(`query {
+		Book(asOf: "%s") {
+			name
+		}
+	}`, beforeUpdate.Format(time.RFC3339Nano)))
+	assert.Empty(t, docs)
+
+	docs = execTestRequest(t, db, fmt.Sprintf(`query {
+		Book(asOf: "%s", filter: {_docID: {_eq: "%s"}}) {
+			name
+			rating
+		}
+	}`, beforeUpdate.Format(time.RFC3339Nano), paintedHouseID))
+	assert.Equal(t, []map[string]any{{"name": "Painted House", "rating": int64(1)}}, docs)
+
+	docs = execTestRequest(t, db, fmt.Sprintf(`query {
+		Book(asOf: "%s", filter: {_and: [{_docID: {_in: ["%s", "%s"]}}, {rating: {_gt: 2}}]}) {
+			name
+			rating
+		}
+	}`, beforeUpdate.Format(time.RFC3339Nano), paintedHouseID, theifLordID))
+	assert.Equal(t, []map[string]any{{"name": "Theif Lord", "rating": int64(5)}}, docs)
+}
@@ -12,8 +12,11 @@ package base
 
 import (
 	"bytes"
+	"math/big"
 	"strings"
 	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 // Compare compares two values of a Document field, and determines
@@ -47,6 +50,10 @@ func Compare(a, b any) int {
 		return compareString(v, b.(string))
 	case []byte:
 		return compareBytes(v, b.([]byte))
+	case *big.Int:
+		return compareBigInt(v, b.(*big.Int))
+	case decimal.Decimal:
+		return compareDecimal(v, b.(decimal.Decimal))
 	default:
 		return 0
 	}
@@ -107,3 +114,9 @@ func compareString(a, b string) int {
 func compareBytes(a, b []byte) int {
 	return bytes.Compare(a, b)
 }
+func compareBigInt(a, b *big.Int) int {
+	return a.Cmp(b)
+}
+func compareDecimal(a, b decimal.Decimal) int {
+	return a.Cmp(b)
+}
@@ -14,6 +14,35 @@ import (
 	"github.com/sourcenetwork/defradb/errors"
 )
 
+const (
+	errDeleteRestricted   string = "document is referenced by a relation that restricts its deletion"
+	errRelatedDocNotFound string = "related document does not exist"
+)
+
 var (
-	ErrInvalidCrdtType = errors.New("invalid CRDT type")
+	ErrInvalidCrdtType    = errors.New("invalid CRDT type")
+	ErrKeyEmpty           = errors.New("key cannot be empty")
+	ErrDeleteRestricted   = errors.New(errDeleteRestricted)
+	ErrRelatedDocNotFound = errors.New(errRelatedDocNotFound)
 )
+
+// NewErrDeleteRestricted returns an error indicating that the given document cannot be deleted
+// as it is referenced through the given relation field of the given collection.
+func NewErrDeleteRestricted(docID string, collection string, field string) error {
+	return errors.New(
+		errDeleteRestricted,
+		errors.NewKV("DocID", docID),
+		errors.NewKV("Collection", collection),
+		errors.NewKV("Field", field),
+	)
+}
+
+// NewErrRelatedDocNotFound returns an error indicating that the document referenced through
+// the given relation field does not exist.
+func NewErrRelatedDocNotFound(field string, docID string) error {
+	return errors.New(
+		errRelatedDocNotFound,
+		errors.NewKV("Field", field),
+		errors.NewKV("DocID", docID),
+	)
+}
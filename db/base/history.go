@@ -0,0 +1,452 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package base
+
+import (
+	"context"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/ipfs/go-cid"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+// RecordCommit records that the given document level commit of the given document has been
+// applied to the collection of the given ID, at the current time.
+//
+// Commits are recorded whether they were created locally or received from a peer, the
+// recorded time is the time at which this node applied them. The change made by the commit,
+// derived from its priority and document status, is added to the pending changes of the given
+// transaction, see [SequencePendingChanges].
+func RecordCommit(
+	ctx context.Context,
+	txn datastore.Txn,
+	collectionID uint32,
+	docID string,
+	commit cid.Cid,
+	priority uint64,
+	status client.DocumentStatus,
+) error {
+	now := time.Now().UnixNano()
+	key := core.NewCommitHistoryKey(collectionID, now, docID, commit.String())
+	err := PutCommitHistory(ctx, txn.Systemstore(), key, commit)
+	if err != nil {
+		return err
+	}
+
+	changeType := client.ChangeTypeUpdate
+	if status.IsDeleted() {
+		changeType = client.ChangeTypeDelete
+	} else if priority == 1 {
+		changeType = client.ChangeTypeCreate
+	}
+	buf, err := cbor.Marshal(ChangeEntry{DocID: docID, Cid: commit.String(), Type: changeType, Time: now})
+	if err != nil {
+		return err
+	}
+	pendingKey := core.NewCollectionPendingChangeKey(txn.ID(), collectionID, now, commit.String())
+	return txn.Systemstore().Put(ctx, pendingKey.ToDS(), buf)
+}
+
+// SequencePendingChanges appends the pending changes of the given transactions to the change
+// logs of their collections, under the next numbers of their sequences.
+//
+// Pending changes are only visible once the transaction recording them has been committed, so
+// sequencing the changes of the committed transactions in the order they were committed assigns
+// the sequence numbers in commit order, without the transactions recording changes conflicting
+// with each other. The changes of a transaction are sequenced in the order they were recorded.
+//
+// The pending changes of all transactions are sequenced, in transaction order, if none are given.
+func SequencePendingChanges(ctx context.Context, systemstore datastore.DSReaderWriter, txnIDs ...uint64) error {
+	prefixes := []string{core.COLLECTION_PENDING_CHANGE}
+	if len(txnIDs) > 0 {
+		prefixes = make([]string, len(txnIDs))
+		for i, txnID := range txnIDs {
+			prefixes[i] = core.NewCollectionPendingChangeKey(txnID, 0, 0, "").ToString()
+		}
+	}
+
+	pending := []query.Entry{}
+	for _, prefix := range prefixes {
+		entries, err := getPendingChanges(ctx, systemstore, prefix)
+		if err != nil {
+			return err
+		}
+		pending = append(pending, entries...)
+	}
+
+	sequences := map[uint32]*Sequence{}
+	for _, entry := range pending {
+		pendingKey, err := core.NewCollectionPendingChangeKeyFromString(entry.Key)
+		if err != nil {
+			return err
+		}
+		seq, ok := sequences[pendingKey.CollectionID]
+		if !ok {
+			seqKey := core.NewCollectionChangeKey(pendingKey.CollectionID, 0)
+			seq, err = GetSequence(ctx, systemstore, seqKey.ToString())
+			if err != nil {
+				return err
+			}
+			sequences[pendingKey.CollectionID] = seq
+		}
+		changeSeq, err := seq.Next(ctx, systemstore)
+		if err != nil {
+			return err
+		}
+		changeKey := core.NewCollectionChangeKey(pendingKey.CollectionID, changeSeq)
+		err = systemstore.Put(ctx, changeKey.ToDS(), entry.Value)
+		if err != nil {
+			return err
+		}
+		var change ChangeEntry
+		if err := cbor.Unmarshal(entry.Value, &change); err != nil {
+			return err
+		}
+		err = systemstore.Put(ctx, core.NewDocumentChangeKey(change.DocID, change.Cid).ToDS(), changeKey.Bytes())
+		if err != nil {
+			return err
+		}
+		err = systemstore.Delete(ctx, pendingKey.ToDS())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getPendingChanges returns the pending change entries with the given key prefix, in key order.
+func getPendingChanges(
+	ctx context.Context,
+	systemstore datastore.DSReaderWriter,
+	prefix string,
+) ([]query.Entry, error) {
+	results, err := systemstore.Query(ctx, query.Query{
+		Prefix: prefix,
+		Orders: []query.Order{query.OrderByKey{}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = results.Close()
+	}()
+
+	entries := []query.Entry{}
+	for {
+		result, hasNext := results.NextSync()
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		if !hasNext {
+			break
+		}
+		entries = append(entries, result.Entry)
+	}
+	return entries, nil
+}
+
+// PutCommitHistory records the given document commit in the commit history of its collection
+// under the given key, indexing it by document.
+func PutCommitHistory(
+	ctx context.Context,
+	systemstore datastore.DSReaderWriter,
+	key core.CommitHistoryKey,
+	commit cid.Cid,
+) error {
+	err := systemstore.Put(ctx, key.ToDS(), commit.Bytes())
+	if err != nil {
+		return err
+	}
+	return systemstore.Put(ctx, core.NewDocumentHistoryKey(key.DocID, commit.String()).ToDS(), key.Bytes())
+}
+
+// DeleteCommitHistory removes the given document commit, recorded under the given key, from the
+// commit history of its collection.
+func DeleteCommitHistory(
+	ctx context.Context,
+	systemstore datastore.DSReaderWriter,
+	key core.CommitHistoryKey,
+	commit cid.Cid,
+) error {
+	err := systemstore.Delete(ctx, key.ToDS())
+	if err != nil {
+		return err
+	}
+	return systemstore.Delete(ctx, core.NewDocumentHistoryKey(key.DocID, commit.String()).ToDS())
+}
+
+// GetDocumentCommitHistory returns the commit history keys of the given document, mapped by the
+// CID of their document commit.
+func GetDocumentCommitHistory(
+	ctx context.Context,
+	systemstore datastore.DSReaderWriter,
+	docID string,
+) (map[cid.Cid]core.CommitHistoryKey, error) {
+	results, err := systemstore.Query(ctx, query.Query{
+		Prefix: core.NewDocumentHistoryKey(docID, "").ToString(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = results.Close()
+	}()
+
+	keys := map[cid.Cid]core.CommitHistoryKey{}
+	for result := range results.Next() {
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		commit, err := cid.Decode(ds.NewKey(result.Key).BaseNamespace())
+		if err != nil {
+			return nil, err
+		}
+		key, err := core.NewCommitHistoryKeyFromString(string(result.Value))
+		if err != nil {
+			return nil, err
+		}
+		keys[commit] = key
+	}
+	return keys, nil
+}
+
+// GetCommitTime returns the time at which the given document commit was applied by this node,
+// and false if it has not been recorded.
+func GetCommitTime(
+	ctx context.Context,
+	systemstore datastore.DSReaderWriter,
+	docID string,
+	commit cid.Cid,
+) (time.Time, bool, error) {
+	buf, err := systemstore.Get(ctx, core.NewDocumentHistoryKey(docID, commit.String()).ToDS())
+	if errors.Is(err, ds.ErrNotFound) {
+		return time.Time{}, false, nil
+	}
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	key, err := core.NewCommitHistoryKeyFromString(string(buf))
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return time.Unix(0, key.Timestamp), true, nil
+}
+
+// DeleteDocumentHistory removes the commits and changes of the given document from the commit
+// history and change log of its collection.
+//
+// The entries are found through their document indexes, without iterating over those of the
+// other documents of the collection. The pending changes of the document, which are not
+// indexed, are found among the pending changes of all the collections.
+func DeleteDocumentHistory(
+	ctx context.Context,
+	systemstore datastore.DSReaderWriter,
+	docID string,
+) error {
+	prefixes := []string{
+		core.NewDocumentHistoryKey(docID, "").ToString(),
+		core.NewDocumentChangeKey(docID, "").ToString(),
+	}
+	for _, prefix := range prefixes {
+		results, err := systemstore.Query(ctx, query.Query{
+			Prefix: prefix,
+		})
+		if err != nil {
+			return err
+		}
+		entries := []query.Entry{}
+		for {
+			result, hasNext := results.NextSync()
+			if result.Error != nil {
+				_ = results.Close()
+				return result.Error
+			}
+			if !hasNext {
+				break
+			}
+			entries = append(entries, result.Entry)
+		}
+		if err := results.Close(); err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			if err := systemstore.Delete(ctx, ds.NewKey(string(entry.Value))); err != nil {
+				return err
+			}
+			if err := systemstore.Delete(ctx, ds.NewKey(entry.Key)); err != nil {
+				return err
+			}
+		}
+	}
+
+	pending, err := getPendingChanges(ctx, systemstore, core.COLLECTION_PENDING_CHANGE)
+	if err != nil {
+		return err
+	}
+	for _, entry := range pending {
+		var change ChangeEntry
+		if err := cbor.Unmarshal(entry.Value, &change); err != nil {
+			return err
+		}
+		if change.DocID != docID {
+			continue
+		}
+		if err := systemstore.Delete(ctx, ds.NewKey(entry.Key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ChangeEntry is the value of an entry of the change log of a collection.
+type ChangeEntry struct {
+	// DocID is the ID of the changed document.
+	DocID string
+	// Cid is the CID of the document commit that made the change.
+	Cid string
+	// Type is the kind of change.
+	Type client.ChangeType
+	// Time is the local time at which this node applied the change, in nanoseconds since the
+	// Unix epoch.
+	Time int64
+}
+
+// DocumentSnapshot is the serialized state of a document at a given version.
+//
+// Snapshots are stored when the history preceding the version is pruned, so that the version
+// and the versions following it can still be recomposed.
+type DocumentSnapshot struct {
+	// Data contains the datastore entries of the document, mapped by key.
+	Data map[string][]byte
+	// Heads contains the headstore entries of the document, mapped by key.
+	Heads map[string][]byte
+}
+
+// PutDocumentSnapshot stores the given snapshot of the given document at the version of the
+// given commit.
+func PutDocumentSnapshot(
+	ctx context.Context,
+	systemstore datastore.DSReaderWriter,
+	docID string,
+	commit cid.Cid,
+	snapshot DocumentSnapshot,
+) error {
+	buf, err := cbor.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	key := core.NewDocumentSnapshotKey(docID, commit.String())
+	return systemstore.Put(ctx, key.ToDS(), buf)
+}
+
+// GetDocumentSnapshot returns the snapshot of the given document at the version of the given
+// commit, or nil if there is none.
+func GetDocumentSnapshot(
+	ctx context.Context,
+	systemstore datastore.DSReaderWriter,
+	docID string,
+	commit cid.Cid,
+) (*DocumentSnapshot, error) {
+	key := core.NewDocumentSnapshotKey(docID, commit.String())
+	buf, err := systemstore.Get(ctx, key.ToDS())
+	if errors.Is(err, ds.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var snapshot DocumentSnapshot
+	if err := cbor.Unmarshal(buf, &snapshot); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+// FindDocumentSnapshot returns the CID of the version at which the given document has been
+// snapshotted and the serialized snapshot, or an undefined CID if the history of the document
+// has not been pruned.
+//
+// Snapshots are removed along with the history preceding later snapshots, so a document has at
+// most one.
+func FindDocumentSnapshot(
+	ctx context.Context,
+	systemstore datastore.DSReaderWriter,
+	docID string,
+) (cid.Cid, []byte, error) {
+	results, err := systemstore.Query(ctx, query.Query{
+		Prefix: core.NewDocumentSnapshotKey(docID, "").ToString(),
+	})
+	if err != nil {
+		return cid.Undef, nil, err
+	}
+	defer func() {
+		_ = results.Close()
+	}()
+
+	for result := range results.Next() {
+		if result.Error != nil {
+			return cid.Undef, nil, result.Error
+		}
+		version, err := cid.Decode(ds.NewKey(result.Key).BaseNamespace())
+		if err != nil {
+			return cid.Undef, nil, err
+		}
+		return version, result.Value, nil
+	}
+	return cid.Undef, nil, nil
+}
+
+// MarkBlockPruned marks the given block of the given document as pruned from the blockstore.
+func MarkBlockPruned(
+	ctx context.Context,
+	systemstore datastore.DSReaderWriter,
+	docID string,
+	block cid.Cid,
+) error {
+	return systemstore.Put(ctx, core.NewPrunedBlockKey(docID, block.String()).ToDS(), []byte{})
+}
+
+// IsBlockPruned returns true if the given block of the given document has been pruned from the
+// blockstore by history compaction.
+func IsBlockPruned(
+	ctx context.Context,
+	systemstore datastore.DSReaderWriter,
+	docID string,
+	block cid.Cid,
+) (bool, error) {
+	return systemstore.Has(ctx, core.NewPrunedBlockKey(docID, block.String()).ToDS())
+}
+
+// IsDocumentPurged returns true if the given document has been purged from this node.
+func IsDocumentPurged(
+	ctx context.Context,
+	systemstore datastore.DSReaderWriter,
+	docID string,
+) (bool, error) {
+	return systemstore.Has(ctx, core.NewPurgedDocumentKey(docID).ToDS())
+}
+
+// MarkDocumentPurged marks the given document as purged from this node.
+func MarkDocumentPurged(
+	ctx context.Context,
+	systemstore datastore.DSReaderWriter,
+	docID string,
+) error {
+	return systemstore.Put(ctx, core.NewPurgedDocumentKey(docID).ToDS(), []byte{})
+}
@@ -0,0 +1,122 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package base
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+// RelationReference is a relation field, with on-delete options, of a collection that
+// references documents of another schema.
+type RelationReference struct {
+	// CollectionName is the name of the collection that the field belongs to.
+	CollectionName string
+	Field          client.FieldDescription
+}
+
+// GetRelationReferences returns the relation fields with on-delete options of the given
+// collections, by the name of the schema that they reference.
+func GetRelationReferences(definitions []client.CollectionDefinition) map[string][]RelationReference {
+	references := map[string][]RelationReference{}
+	for _, definition := range definitions {
+		for _, field := range definition.Schema.Fields {
+			if field.Kind != client.FieldKind_FOREIGN_OBJECT || field.OnDelete == "" {
+				continue
+			}
+			references[field.Schema] = append(references[field.Schema], RelationReference{
+				CollectionName: definition.Description.Name,
+				Field:          field,
+			})
+		}
+	}
+	return references
+}
+
+// referencingFilter returns a filter matching the documents referencing the given document
+// through the given field.
+func referencingFilter(field client.FieldDescription, docID string) string {
+	return fmt.Sprintf(`{%s%s: {_eq: "%s"}}`, field.Name, request.RelatedObjectID, docID)
+}
+
+// ApplyRelationOnDelete applies the cascade and set null on-delete options of the given
+// relation references to the documents referencing the given deleted document.
+//
+// The store must be bound to the transaction within which the document was deleted.
+func ApplyRelationOnDelete(
+	ctx context.Context,
+	store client.Store,
+	txn datastore.Txn,
+	references []RelationReference,
+	docID string,
+) error {
+	for _, ref := range references {
+		if ref.Field.OnDelete == client.RelationOnDeleteRestrict {
+			continue
+		}
+		col, err := store.GetCollectionByName(ctx, ref.CollectionName)
+		if err != nil {
+			return err
+		}
+		col = col.WithTxn(txn)
+		filter := referencingFilter(ref.Field, docID)
+
+		switch ref.Field.OnDelete {
+		case client.RelationOnDeleteCascade:
+			_, err = col.DeleteWithFilter(ctx, filter)
+		case client.RelationOnDeleteSetNull:
+			_, err = col.UpdateWithFilter(
+				ctx,
+				filter,
+				fmt.Sprintf(`{"%s%s": null}`, ref.Field.Name, request.RelatedObjectID),
+			)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CheckRelatedDocExists returns an error if the document referenced by the given relation
+// ID field value does not exist, or has been deleted, and the relation requires it to exist.
+//
+// The given field must be the relation object field that the ID field belongs to.
+func CheckRelatedDocExists(
+	ctx context.Context,
+	store client.Store,
+	txn datastore.Txn,
+	field client.FieldDescription,
+	relatedDocID string,
+) error {
+	if !field.CheckExists {
+		return nil
+	}
+
+	col, err := store.GetCollectionByName(ctx, field.Schema)
+	if err != nil {
+		return err
+	}
+	docID, err := client.NewDocIDFromString(relatedDocID)
+	if err != nil {
+		return err
+	}
+	_, err = col.WithTxn(txn).Get(ctx, docID, false)
+	if errors.Is(err, client.ErrDocumentNotFound) {
+		return NewErrRelatedDocNotFound(field.Name, relatedDocID)
+	}
+	return err
+}
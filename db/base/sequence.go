@@ -8,7 +8,7 @@
 // by the Apache License, Version 2.0, included in the file
 // licenses/APL.txt.
 
-package db
+package base
 
 import (
 	"context"
@@ -21,24 +21,29 @@ import (
 	"github.com/sourcenetwork/defradb/errors"
 )
 
-type sequence struct {
+// Sequence is a persisted counter stored in the system store.
+//
+// The counter is read and written within the given transaction, so that concurrent
+// transactions incrementing it conflict and the values are assigned in commit order.
+type Sequence struct {
 	key core.SequenceKey
 	val uint64
 }
 
-func (db *db) getSequence(ctx context.Context, txn datastore.Txn, key string) (*sequence, error) {
+// GetSequence returns the sequence of the given key, creating it if it does not exist yet.
+func GetSequence(ctx context.Context, systemstore datastore.DSReaderWriter, key string) (*Sequence, error) {
 	if key == "" {
 		return nil, ErrKeyEmpty
 	}
 	seqKey := core.NewSequenceKey(key)
-	seq := &sequence{
+	seq := &Sequence{
 		key: seqKey,
 		val: uint64(0),
 	}
 
-	_, err := seq.get(ctx, txn)
+	_, err := seq.get(ctx, systemstore)
 	if errors.Is(err, ds.ErrNotFound) {
-		err = seq.update(ctx, txn)
+		err = seq.update(ctx, systemstore)
 		if err != nil {
 			return nil, err
 		}
@@ -49,8 +54,8 @@ func (db *db) getSequence(ctx context.Context, txn datastore.Txn, key string) (*
 	return seq, nil
 }
 
-func (seq *sequence) get(ctx context.Context, txn datastore.Txn) (uint64, error) {
-	val, err := txn.Systemstore().Get(ctx, seq.key.ToDS())
+func (seq *Sequence) get(ctx context.Context, systemstore datastore.DSReaderWriter) (uint64, error) {
+	val, err := systemstore.Get(ctx, seq.key.ToDS())
 	if err != nil {
 		return 0, err
 	}
@@ -59,22 +64,23 @@ func (seq *sequence) get(ctx context.Context, txn datastore.Txn) (uint64, error)
 	return seq.val, nil
 }
 
-func (seq *sequence) update(ctx context.Context, txn datastore.Txn) error {
+func (seq *Sequence) update(ctx context.Context, systemstore datastore.DSReaderWriter) error {
 	var buf [8]byte
 	binary.BigEndian.PutUint64(buf[:], seq.val)
-	if err := txn.Systemstore().Put(ctx, seq.key.ToDS(), buf[:]); err != nil {
+	if err := systemstore.Put(ctx, seq.key.ToDS(), buf[:]); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (seq *sequence) next(ctx context.Context, txn datastore.Txn) (uint64, error) {
-	_, err := seq.get(ctx, txn)
+// Next increments the sequence and returns its new value.
+func (seq *Sequence) Next(ctx context.Context, systemstore datastore.DSReaderWriter) (uint64, error) {
+	_, err := seq.get(ctx, systemstore)
 	if err != nil {
 		return 0, err
 	}
 
 	seq.val++
-	return seq.val, seq.update(ctx, txn)
+	return seq.val, seq.update(ctx, systemstore)
 }
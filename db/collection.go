@@ -14,6 +14,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -21,11 +22,14 @@ import (
 	ds "github.com/ipfs/go-datastore"
 	"github.com/ipfs/go-datastore/query"
 	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/lens-vm/lens/host-go/config/model"
 	"github.com/sourcenetwork/immutable"
+	"github.com/valyala/fastjson"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
 	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/core/computed"
 	"github.com/sourcenetwork/defradb/datastore"
 	"github.com/sourcenetwork/defradb/db/base"
 	"github.com/sourcenetwork/defradb/db/description"
@@ -104,16 +108,31 @@ func (db *db) createCollection(
 		return nil, ErrCollectionAlreadyExists
 	}
 
-	colSeq, err := db.getSequence(ctx, txn, core.COLLECTION)
+	colSeq, err := base.GetSequence(ctx, txn.Systemstore(), core.COLLECTION)
 	if err != nil {
 		return nil, err
 	}
-	colID, err := colSeq.next(ctx, txn)
+	colID, err := colSeq.Next(ctx, txn.Systemstore())
 	if err != nil {
 		return nil, err
 	}
 	desc.ID = uint32(colID)
 
+	for _, field := range schema.Fields {
+		err = validateFieldConstraints(schema, field)
+		if err != nil {
+			return nil, err
+		}
+		err = validateComputedField(schema, field)
+		if err != nil {
+			return nil, err
+		}
+		err = validateRelationOptions(field)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	schema, err = description.CreateSchemaVersion(ctx, txn, schema)
 	if err != nil {
 		return nil, err
@@ -131,6 +150,9 @@ func (db *db) createCollection(
 			return nil, err
 		}
 	}
+	if desc.TTL != nil {
+		txn.OnSuccess(db.startTTLSweeper)
+	}
 
 	return db.getCollectionByName(ctx, txn, desc.Name)
 }
@@ -194,6 +216,12 @@ func (db *db) updateSchema(
 		return err
 	}
 
+	existingSchema := existingSchemaByName[schema.Name]
+	err = db.setFieldMigration(ctx, txn, existingSchema, schema)
+	if err != nil {
+		return err
+	}
+
 	if setAsDefaultVersion {
 		cols, err := description.GetCollectionsBySchemaVersionID(ctx, txn, previousVersionID)
 		if err != nil {
@@ -212,12 +240,72 @@ func (db *db) updateSchema(
 			if err != nil {
 				return err
 			}
+
+			err = db.migrateCollectionIndexes(ctx, txn, col, existingSchema, schema)
+			if err != nil {
+				return err
+			}
 		}
 	}
 
 	return nil
 }
 
+// setFieldMigration registers a migration, composed of built-in lens modules, from the given
+// existing schema version to the given new schema version.
+//
+// Values of removed fields are dropped, renamed fields are renamed and fields with a changed kind
+// have their values cast to the new kind.  If no existing fields have been changed no migration
+// is registered.
+func (db *db) setFieldMigration(
+	ctx context.Context,
+	txn datastore.Txn,
+	existingSchema client.SchemaDescription,
+	newSchema client.SchemaDescription,
+) error {
+	newFieldsByID := make(map[client.FieldID]client.FieldDescription, len(newSchema.Fields))
+	for _, field := range newSchema.Fields {
+		newFieldsByID[field.ID] = field
+	}
+
+	drops := []model.LensModule{}
+	renames := map[string]string{}
+	casts := []model.LensModule{}
+	for _, existingField := range existingSchema.Fields {
+		newField, stillExists := newFieldsByID[existingField.ID]
+		if !stillExists {
+			drops = append(drops, lens.NewDropFieldModule(existingField.Name))
+			continue
+		}
+		if newField.Name != existingField.Name {
+			renames[existingField.Name] = newField.Name
+		}
+		if newField.Kind != existingField.Kind {
+			casts = append(casts, lens.NewCastFieldModule(newField.Name, existingField.Kind, newField.Kind))
+		}
+	}
+
+	// Fields must be dropped before the remaining fields are renamed, as their names may be reused,
+	// and values must be cast after the fields have been given their new names.
+	lenses := drops
+	if len(renames) > 0 {
+		lenses = append(lenses, lens.NewRenameFieldModule(renames))
+	}
+	lenses = append(lenses, casts...)
+
+	if len(lenses) == 0 {
+		return nil
+	}
+
+	return db.lensRegistry.WithTxn(txn).SetMigration(ctx, client.LensConfig{
+		SourceSchemaVersionID:      existingSchema.VersionID,
+		DestinationSchemaVersionID: newSchema.VersionID,
+		Lens: model.Lens{
+			Lenses: lenses,
+		},
+	})
+}
+
 // validateUpdateSchema validates that the given schema description is a valid update.
 //
 // Will return true if the given description differs from the current persisted state of the
@@ -262,7 +350,86 @@ func (db *db) validateUpdateSchema(
 		return hasChangedFields, err
 	}
 
-	return hasChangedFields, err
+	hasChangedEnums, err := validateUpdateSchemaEnums(existingDesc, proposedDesc)
+	if err != nil {
+		return false, err
+	}
+
+	return hasChangedFields || hasChangedEnums, nil
+}
+
+// validateUpdateSchemaEnums validates that the enums of the given proposed schema description
+// are a valid update of the existing description.
+//
+// New enums, and new values within existing enums, may be added, but existing values cannot
+// be removed or reordered.
+func validateUpdateSchemaEnums(
+	existingDesc client.SchemaDescription,
+	proposedDesc client.SchemaDescription,
+) (bool, error) {
+	hasChanged := false
+	proposedEnumNames := map[string]struct{}{}
+	for _, proposedEnum := range proposedDesc.Enums {
+		if _, isDuplicate := proposedEnumNames[proposedEnum.Name]; isDuplicate {
+			return false, NewErrDuplicateEnum(proposedEnum.Name)
+		}
+		proposedEnumNames[proposedEnum.Name] = struct{}{}
+
+		if len(proposedEnum.Values) == 0 {
+			return false, NewErrEnumHasNoValues(proposedEnum.Name)
+		}
+
+		proposedValues := map[string]struct{}{}
+		for _, value := range proposedEnum.Values {
+			if !isValidEnumValue(value) {
+				return false, NewErrInvalidEnumValueName(proposedEnum.Name, value)
+			}
+			if _, isDuplicate := proposedValues[value]; isDuplicate {
+				return false, NewErrDuplicateEnumValue(proposedEnum.Name, value)
+			}
+			proposedValues[value] = struct{}{}
+		}
+
+		existingEnum, enumAlreadyExists := existingDesc.GetEnum(proposedEnum.Name)
+		if !enumAlreadyExists {
+			hasChanged = true
+			continue
+		}
+
+		if len(proposedEnum.Values) < len(existingEnum.Values) {
+			return false, NewErrCannotMutateEnumValues(existingEnum.Name)
+		}
+		for i, value := range existingEnum.Values {
+			if proposedEnum.Values[i] != value {
+				return false, NewErrCannotMutateEnumValues(existingEnum.Name)
+			}
+		}
+		hasChanged = hasChanged || len(proposedEnum.Values) != len(existingEnum.Values)
+	}
+
+	for _, existingEnum := range existingDesc.Enums {
+		if _, stillExists := proposedEnumNames[existingEnum.Name]; !stillExists {
+			return false, NewErrCannotDeleteEnum(existingEnum.Name)
+		}
+	}
+
+	for _, field := range proposedDesc.Fields {
+		if !field.IsEnum() {
+			continue
+		}
+		if _, ok := proposedDesc.GetEnum(field.Schema); !ok {
+			return false, client.NewErrEnumNotFound(field.Name, field.Schema)
+		}
+	}
+
+	return hasChanged, nil
+}
+
+var enumValuePattern = regexp.MustCompile(`^[_a-zA-Z][_a-zA-Z0-9]*$`)
+
+// isValidEnumValue returns true if the given value is a valid GQL enum value name.
+func isValidEnumValue(value string) bool {
+	return enumValuePattern.MatchString(value) && value != "true" && value != "false" && value != "null"
 }
 
 func validateUpdateSchemaFields(
@@ -272,12 +439,26 @@ func validateUpdateSchemaFields(
 ) (bool, error) {
 	hasChanged := false
 	existingFieldsByID := map[client.FieldID]client.FieldDescription{}
-	existingFieldIndexesByName := map[string]int{}
-	for i, field := range existingDesc.Fields {
-		existingFieldIndexesByName[field.Name] = i
+	for _, field := range existingDesc.Fields {
 		existingFieldsByID[field.ID] = field
 	}
 
+	proposedFieldIDs := map[client.FieldID]struct{}{}
+	for _, proposedField := range proposedDesc.Fields {
+		if proposedField.ID != client.FieldID(0) || proposedField.Name == request.DocIDFieldName {
+			proposedFieldIDs[proposedField.ID] = struct{}{}
+		}
+	}
+
+	// Fields may be removed, but the remaining fields must keep their relative order, so the
+	// expected index of each remaining field skips over any removed fields preceding it.
+	expectedFieldIndexesByID := map[client.FieldID]int{}
+	for _, field := range existingDesc.Fields {
+		if _, stillExists := proposedFieldIDs[field.ID]; stillExists {
+			expectedFieldIndexesByID[field.ID] = len(expectedFieldIndexesByID)
+		}
+	}
+
 	newFieldNames := map[string]struct{}{}
 	newFieldIds := map[client.FieldID]struct{}{}
 	for proposedIndex, proposedField := range proposedDesc.Fields {
@@ -427,13 +608,18 @@ func validateUpdateSchemaFields(
 			return false, NewErrDuplicateField(proposedField.Name)
 		}
 
-		if fieldAlreadyExists && proposedField != existingField {
-			return false, NewErrCannotMutateField(proposedField.ID, proposedField.Name)
+		isMigratedField := false
+		if fieldAlreadyExists && !proposedField.Equal(existingField) {
+			if !isMigratableFieldChange(existingField, proposedField) {
+				return false, NewErrCannotMutateField(proposedField.ID, proposedField.Name)
+			}
+			isMigratedField = true
+			hasChanged = true
 		}
 
-		if existingIndex := existingFieldIndexesByName[proposedField.Name]; fieldAlreadyExists &&
-			proposedIndex != existingIndex {
-			return false, NewErrCannotMoveField(proposedField.Name, proposedIndex, existingIndex)
+		if expectedIndex := expectedFieldIndexesByID[proposedField.ID]; fieldAlreadyExists &&
+			proposedIndex != expectedIndex {
+			return false, NewErrCannotMoveField(proposedField.Name, proposedIndex, expectedIndex)
 		}
 
 		if !proposedField.Typ.IsSupportedFieldCType() {
@@ -444,18 +630,195 @@ func validateUpdateSchemaFields(
 			return false, client.NewErrCRDTKindMismatch(proposedField.Typ.String(), proposedField.Kind.String())
 		}
 
+		if !fieldAlreadyExists || isMigratedField {
+			err := validateFieldConstraints(proposedDesc, proposedField)
+			if err != nil {
+				return false, err
+			}
+			err = validateComputedField(proposedDesc, proposedField)
+			if err != nil {
+				return false, err
+			}
+			err = validateRelationOptions(proposedField)
+			if err != nil {
+				return false, err
+			}
+		}
+
 		newFieldNames[proposedField.Name] = struct{}{}
-		newFieldIds[proposedField.ID] = struct{}{}
+		if fieldAlreadyExists {
+			newFieldIds[proposedField.ID] = struct{}{}
+		}
 	}
 
 	for _, field := range existingDesc.Fields {
 		if _, stillExists := newFieldIds[field.ID]; !stillExists {
-			return false, NewErrCannotDeleteField(field.Name, field.ID)
+			if field.IsInternal() || field.IsRelation() {
+				return false, NewErrCannotDeleteField(field.Name, field.ID)
+			}
+			// The field has been removed, existing values will be dropped by the migration
+			// to the new schema version.
+			hasChanged = true
 		}
 	}
 	return hasChanged, nil
 }
 
+// isMigratableFieldChange returns true if the given existing field may be changed to the given
+// proposed field by a built-in migration.
+//
+// Only the name and the kind of non-relational fields may be changed, and the kind may only be
+// changed between kinds that can be cast to one another.
+func isMigratableFieldChange(existingField client.FieldDescription, proposedField client.FieldDescription) bool {
+	if existingField.IsInternal() || existingField.IsRelation() || proposedField.Name == "" {
+		return false
+	}
+
+	if existingField.Kind != proposedField.Kind {
+		if !lens.IsCastableKind(existingField.Kind) || !lens.IsCastableKind(proposedField.Kind) {
+			return false
+		}
+		if proposedField.Typ != client.LWW_REGISTER {
+			return false
+		}
+	}
+
+	existingField.Name = proposedField.Name
+	existingField.Kind = proposedField.Kind
+	return proposedField.Equal(existingField)
+}
+
+// validateFieldConstraints validates that the non-null, default value and constraint properties
+// of the given field are valid for the field.
+func validateFieldConstraints(schema client.SchemaDescription, field client.FieldDescription) error {
+	if !field.IsNonNull && !field.HasDefaultValue() && field.Constraint == nil {
+		return nil
+	}
+
+	if field.IsRelation() || field.Name == request.DocIDFieldName {
+		switch {
+		case field.IsNonNull:
+			return NewErrConstraintNotSupportedForField(field.Name, "nonNull", field.Kind)
+		case field.HasDefaultValue():
+			return NewErrConstraintNotSupportedForField(field.Name, "default", field.Kind)
+		default:
+			return NewErrConstraintNotSupportedForField(field.Name, "constraint", field.Kind)
+		}
+	}
+
+	if constraint := field.Constraint; constraint != nil {
+		isNumeric := field.Kind == client.FieldKind_INT || field.Kind == client.FieldKind_FLOAT ||
+			field.Kind == client.FieldKind_BIGINT || field.Kind == client.FieldKind_DECIMAL
+		if constraint.Min.HasValue() && !isNumeric {
+			return NewErrConstraintNotSupportedForField(field.Name, "min", field.Kind)
+		}
+		if constraint.Max.HasValue() && !isNumeric {
+			return NewErrConstraintNotSupportedForField(field.Name, "max", field.Kind)
+		}
+		if constraint.Min.HasValue() && constraint.Max.HasValue() &&
+			constraint.Min.Value().GreaterThan(constraint.Max.Value()) {
+			return NewErrConstraintMinGreaterThanMax(field.Name, constraint.Min.Value(), constraint.Max.Value())
+		}
+
+		if constraint.Pattern != "" {
+			if field.Kind != client.FieldKind_STRING {
+				return NewErrConstraintNotSupportedForField(field.Name, "pattern", field.Kind)
+			}
+			if _, err := regexp.Compile(constraint.Pattern); err != nil {
+				return client.NewErrInvalidFieldPattern(field.Name, constraint.Pattern, err)
+			}
+		}
+
+		if constraint.MaxLength.HasValue() {
+			if field.Kind != client.FieldKind_STRING && !field.IsArray() {
+				return NewErrConstraintNotSupportedForField(field.Name, "maxLength", field.Kind)
+			}
+			if constraint.MaxLength.Value() < 0 {
+				return NewErrConstraintNegativeMaxLength(field.Name, constraint.MaxLength.Value())
+			}
+		}
+	}
+
+	if field.HasDefaultValue() {
+		value, err := fastjson.Parse(field.DefaultValue)
+		if err != nil {
+			return NewErrInvalidDefaultValue(field.Name, field.DefaultValue, err)
+		}
+		// Setting the value on a document validates it against the field's kind and constraint.
+		err = client.NewDocWithID(client.DocID{}, schema).Set(field.Name, value)
+		if err != nil {
+			return NewErrInvalidDefaultValue(field.Name, field.DefaultValue, err)
+		}
+	}
+
+	return nil
+}
+
+// validateRelationOptions validates that the on-delete and existence check options of the given
+// field are only set on the primary side of a relation to a single document.
+func validateRelationOptions(field client.FieldDescription) error {
+	if field.OnDelete == "" && !field.CheckExists {
+		return nil
+	}
+	if field.Kind != client.FieldKind_FOREIGN_OBJECT || !field.IsPrimaryRelation() {
+		return NewErrRelationOptionsOnSecondary(field.Name)
+	}
+	switch field.OnDelete {
+	case "", client.RelationOnDeleteCascade, client.RelationOnDeleteSetNull, client.RelationOnDeleteRestrict:
+		return nil
+	default:
+		return NewErrInvalidRelationOnDelete(field.Name, field.OnDelete)
+	}
+}
+
+// validateComputedField validates that the expression of the given field, if it is a computed
+// field, is valid and only references fields that may be computed from.
+//
+// Expressions may reference the non-computed fields of the schema, and the fields of related
+// objects whose ids are held by the schema.
+func validateComputedField(schema client.SchemaDescription, field client.FieldDescription) error {
+	if !field.IsComputed() {
+		return nil
+	}
+
+	switch field.Kind {
+	case client.FieldKind_INT, client.FieldKind_FLOAT, client.FieldKind_BIGINT, client.FieldKind_DECIMAL,
+		client.FieldKind_STRING:
+	default:
+		return NewErrComputedFieldKindNotSupported(field.Name, field.Kind)
+	}
+
+	if field.IsNonNull || field.HasDefaultValue() || field.Constraint != nil {
+		return NewErrComputedFieldWithConstraint(field.Name)
+	}
+
+	expression, err := computed.Parse(field.Computed)
+	if err != nil {
+		return NewErrInvalidComputedExpression(field.Name, field.Computed, err)
+	}
+
+	for _, path := range expression.Paths() {
+		referencedField, ok := schema.GetField(path[0])
+		if !ok || referencedField.IsComputed() || len(path) > 2 {
+			return NewErrInvalidComputedFieldReference(field.Name, strings.Join(path, "."))
+		}
+
+		if len(path) == 1 {
+			if referencedField.IsObject() {
+				return NewErrInvalidComputedFieldReference(field.Name, strings.Join(path, "."))
+			}
+			continue
+		}
+
+		_, holdsRelatedID := schema.GetField(path[0] + request.RelatedObjectID)
+		if !referencedField.IsObject() || referencedField.IsObjectArray() || !holdsRelatedID {
+			return NewErrInvalidComputedFieldReference(field.Name, strings.Join(path, "."))
+		}
+	}
+
+	return nil
+}
+
 func (db *db) setDefaultSchemaVersion(
 	ctx context.Context,
 	txn datastore.Txn,
@@ -476,11 +839,21 @@ func (db *db) setDefaultSchemaVersion(
 	}
 
 	for _, col := range colDescs {
+		previousSchema, err := description.GetSchemaVersion(ctx, txn, col.SchemaVersionID)
+		if err != nil {
+			return err
+		}
+
 		col.SchemaVersionID = schemaVersionID
 		col, err = description.SaveCollection(ctx, txn, col)
 		if err != nil {
 			return err
 		}
+
+		err = db.migrateCollectionIndexes(ctx, txn, col, previousSchema, schema)
+		if err != nil {
+			return err
+		}
 	}
 
 	return db.loadSchema(ctx, txn)
@@ -623,6 +996,22 @@ func (db *db) getAllCollections(ctx context.Context, txn datastore.Txn) ([]clien
 	return collections, nil
 }
 
+// getAllPublicCollections gets all the currently defined collections, excluding the internal ones.
+func (db *db) getAllPublicCollections(ctx context.Context, txn datastore.Txn) ([]client.Collection, error) {
+	cols, err := db.getAllCollections(ctx, txn)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]client.Collection, 0, len(cols))
+	for _, col := range cols {
+		if !col.Description().Internal {
+			result = append(result, col)
+		}
+	}
+	return result, nil
+}
+
 // getAllActiveDefinitions returns all queryable collection/views and any embedded schema used by them.
 func (db *db) getAllActiveDefinitions(ctx context.Context, txn datastore.Txn) ([]client.CollectionDefinition, error) {
 	cols, err := description.GetCollections(ctx, txn)
@@ -842,6 +1231,11 @@ func (c *collection) create(ctx context.Context, txn datastore.Txn, doc *client.
 		return NewErrDocumentDeleted(primaryKey.DocID)
 	}
 
+	err = c.validateNonNullFields(doc)
+	if err != nil {
+		return err
+	}
+
 	// write value object marker if we have an empty doc
 	if len(doc.Values()) == 0 {
 		valueKey := c.getDataStoreKeyFromDocID(docID)
@@ -857,9 +1251,32 @@ func (c *collection) create(ctx context.Context, txn datastore.Txn, doc *client.
 		return err
 	}
 
+	if c.Description().TTL != nil {
+		// expired documents are only deleted by the node that created them
+		err = txn.Systemstore().Put(ctx, core.NewLocalDocumentKey(docID.String()).ToDS(), []byte{})
+		if err != nil {
+			return err
+		}
+	}
+
 	return c.indexNewDoc(ctx, txn, doc)
 }
 
+// validateNonNullFields returns an error if any of the non-null fields of this collection have
+// not been given a value in the given document.
+func (c *collection) validateNonNullFields(doc *client.Document) error {
+	for _, field := range c.Schema().Fields {
+		if !field.IsNonNull {
+			continue
+		}
+		val, err := doc.GetValue(field.Name)
+		if err != nil || val.IsDelete() || val.Value() == nil {
+			return client.NewErrNonNullFieldNotSet(field.Name)
+		}
+	}
+	return nil
+}
+
 // Update an existing document with the new values.
 // Any field that needs to be removed or cleared should call doc.Clear(field) before.
 // Any field that is nil/empty that hasn't called Clear will be ignored.
@@ -941,6 +1358,9 @@ func (c *collection) save(
 	doc *client.Document,
 	isCreate bool,
 ) (cid.Cid, error) {
+	// all the commits of the document share the same metadata
+	ctx = c.db.withCommitMetadata(ctx)
+
 	if !isCreate {
 		err := c.updateIndexedDoc(ctx, txn, doc)
 		if err != nil {
@@ -981,6 +1401,10 @@ func (c *collection) save(
 				return cid.Undef, client.NewErrFieldNotExist(k)
 			}
 
+			if fieldDescription.IsNonNull && (val.IsDelete() || val.Value() == nil) {
+				return cid.Undef, client.NewErrNonNullFieldNotSet(k)
+			}
+
 			// by default the type will have been set to LWW_REGISTER. We need to ensure
 			// that it's set to the same as the field description CRDT type.
 			val.SetType(fieldDescription.Typ)
@@ -1004,6 +1428,11 @@ func (c *collection) save(
 				return cid.Undef, err
 			}
 
+			err = c.validateRelatedDocExists(ctx, txn, fieldDescription, val.Value())
+			if err != nil {
+				return cid.Undef, err
+			}
+
 			merkleCRDT, err := merklecrdt.InstanceWithStore(
 				txn,
 				core.NewCollectionSchemaVersionKey(c.Schema().VersionID, c.ID()),
@@ -1063,6 +1492,31 @@ func (c *collection) save(
 	return headNode.Cid(), nil
 }
 
+// validateRelatedDocExists validates that the document referenced by the given value of a relation
+// ID field exists, if the relation requires it to.
+func (c *collection) validateRelatedDocExists(
+	ctx context.Context,
+	txn datastore.Txn,
+	fieldDescription client.FieldDescription,
+	value any,
+) error {
+	if !fieldDescription.RelationType.IsSet(client.Relation_Type_INTERNAL_ID) {
+		return nil
+	}
+
+	relatedDocID, ok := value.(string)
+	if !ok {
+		return nil
+	}
+
+	objFieldDescription, ok := c.Schema().GetField(strings.TrimSuffix(fieldDescription.Name, request.RelatedObjectID))
+	if !ok {
+		return client.NewErrFieldNotExist(strings.TrimSuffix(fieldDescription.Name, request.RelatedObjectID))
+	}
+
+	return base.CheckRelatedDocExists(ctx, c.db.WithTxn(txn), txn, objFieldDescription, relatedDocID)
+}
+
 func (c *collection) validateOneToOneLinkDoesntAlreadyExist(
 	ctx context.Context,
 	txn datastore.Txn,
@@ -1221,11 +1675,23 @@ func (c *collection) saveCompositeToMerkleCRDT(
 		"",
 	)
 
+	var node ipld.Node
+	var priority uint64
+	var err error
 	if status.IsDeleted() {
-		return merkleCRDT.Delete(ctx, links)
+		node, priority, err = merkleCRDT.Delete(ctx, links)
+	} else {
+		node, priority, err = merkleCRDT.Save(ctx, links)
+	}
+	if err != nil {
+		return nil, 0, err
 	}
 
-	return merkleCRDT.Save(ctx, links)
+	err = base.RecordCommit(ctx, txn, c.ID(), dsKey.DocID, node.Cid(), priority, status)
+	if err != nil {
+		return nil, 0, err
+	}
+	return node, priority, nil
 }
 
 // getTxn gets or creates a new transaction from the underlying db.
@@ -0,0 +1,253 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/ipfs/go-datastore/query"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/db/base"
+)
+
+// Changes returns the changes made to the documents of the collection after the change of
+// the given cursor, in the order this node applied them, up to the given limit.
+//
+// The cursor of a change is its sequence number in the change log, so the log is read from the
+// change following it.
+func (c *collection) Changes(ctx context.Context, since string, limit int) ([]client.Change, error) {
+	err := c.db.waitForCommittedChanges(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txn, err := c.getTxn(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer c.discardImplicitTxn(ctx, txn)
+
+	start := core.NewCollectionChangeKey(c.ID(), 1)
+	if since != "" {
+		seq, err := changeSeqFromCursor(since)
+		if err != nil {
+			return nil, err
+		}
+		start.Seq = seq + 1
+	}
+
+	changes := []client.Change{}
+	err = c.iterateChanges(ctx, txn, start, func(key core.CollectionChangeKey, entry base.ChangeEntry) bool {
+		changes = append(changes, client.Change{
+			Cursor: changeCursor(key),
+			DocID:  entry.DocID,
+			Cid:    entry.Cid,
+			Type:   entry.Type,
+			Time:   time.Unix(0, entry.Time).UTC(),
+		})
+		return limit <= 0 || len(changes) < limit
+	})
+	if err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// sequencePendingChanges appends the pending changes of the given committed transactions, in
+// the given order, to the change logs of their collections.
+//
+// The pending changes of all the committed transactions are appended if none are given.
+func (db *db) sequencePendingChanges(ctx context.Context, txnIDs ...uint64) error {
+	// The transaction is created directly so that its own commit is not queued for sequencing.
+	txn, err := datastore.NewTxnFrom(ctx, db.rootstore, db.previousTxnID.Add(1), false)
+	if err != nil {
+		return err
+	}
+	defer txn.Discard(ctx)
+
+	err = base.SequencePendingChanges(ctx, txn.Systemstore(), txnIDs...)
+	if err != nil {
+		return err
+	}
+	return txn.Commit(ctx)
+}
+
+// queueCommittedTxn queues the committed transaction of the given ID for its pending changes to
+// be sequenced by the change sequencer.
+func (db *db) queueCommittedTxn(txnID uint64) {
+	db.committedTxnsLock.Lock()
+	db.committedTxns = append(db.committedTxns, txnID)
+	db.committedTxnsLock.Unlock()
+
+	select {
+	case db.changeSequencerWake <- struct{}{}:
+	default:
+		// the sequencer has already been woken, and will sequence this transaction with the others
+	}
+}
+
+// sequenceCommittedTxns sequences the pending changes of the queued committed transactions,
+// all in a single transaction.
+//
+// The transactions are queued again if their changes could not be sequenced.
+func (db *db) sequenceCommittedTxns(ctx context.Context) error {
+	db.committedTxnsLock.Lock()
+	txnIDs := db.committedTxns
+	db.committedTxns = nil
+	db.committedTxnsLock.Unlock()
+
+	if len(txnIDs) == 0 {
+		return nil
+	}
+	err := db.sequencePendingChanges(ctx, txnIDs...)
+	if err != nil {
+		db.committedTxnsLock.Lock()
+		db.committedTxns = append(txnIDs, db.committedTxns...)
+		db.committedTxnsLock.Unlock()
+	}
+	return err
+}
+
+// startChangeSequencer starts the background sequencing of the pending changes of the committed
+// transactions.
+//
+// The transactions committed while the sequencer is busy are sequenced together once it is done,
+// so that committing a transaction never waits for its changes to be sequenced.
+func (db *db) startChangeSequencer() {
+	db.changeSequencerStop = make(chan struct{})
+	db.changeSequencerDone = make(chan struct{})
+	go func() {
+		defer close(db.changeSequencerDone)
+
+		ctx := context.Background()
+		for {
+			select {
+			case <-db.changeSequencerStop:
+				err := db.sequenceCommittedTxns(ctx)
+				if err != nil {
+					log.ErrorE(ctx, "Failed to sequence the pending changes", err)
+				}
+				return
+			case <-db.changeSequencerWake:
+				err := db.sequenceCommittedTxns(ctx)
+				if err != nil {
+					log.ErrorE(ctx, "Failed to sequence the pending changes", err)
+				}
+			case reply := <-db.changeSequencerFlush:
+				reply <- db.sequenceCommittedTxns(ctx)
+			}
+		}
+	}()
+}
+
+// stopChangeSequencer stops the background sequencing of pending changes, once the changes of
+// the transactions committed so far have been sequenced.
+func (db *db) stopChangeSequencer() {
+	if db.changeSequencerStop == nil {
+		return
+	}
+	close(db.changeSequencerStop)
+	<-db.changeSequencerDone
+	db.changeSequencerStop = nil
+}
+
+// waitForCommittedChanges waits for the pending changes of the transactions committed so far to
+// be appended to the change logs of their collections.
+func (db *db) waitForCommittedChanges(ctx context.Context) error {
+	reply := make(chan error, 1)
+	select {
+	case db.changeSequencerFlush <- reply:
+	case <-db.changeSequencerDone:
+		// the sequencer has stopped once the changes of all committed transactions were sequenced
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case err := <-reply:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// iterateChanges calls the given function with each entry of the change log of the collection,
+// in order from the given key, until it returns false.
+func (c *collection) iterateChanges(
+	ctx context.Context,
+	txn datastore.Txn,
+	start core.CollectionChangeKey,
+	fn func(core.CollectionChangeKey, base.ChangeEntry) bool,
+) error {
+	prefix := core.NewCollectionChangeKey(c.ID(), 0)
+	iterator, err := txn.Systemstore().GetIterator(query.Query{
+		Prefix: prefix.ToString(),
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = iterator.Close()
+	}()
+
+	end := core.NewCollectionChangeKey(c.ID(), math.MaxUint64)
+	results, err := iterator.IteratePrefix(ctx, start.ToDS(), end.ToDS())
+	if err != nil {
+		return err
+	}
+
+	for {
+		result, hasNext := results.NextSync()
+		if result.Error != nil {
+			return result.Error
+		}
+		if !hasNext {
+			break
+		}
+		key, err := core.NewCollectionChangeKeyFromString(result.Key)
+		if err != nil {
+			return err
+		}
+		var entry base.ChangeEntry
+		if err := cbor.Unmarshal(result.Value, &entry); err != nil {
+			return err
+		}
+		if !fn(key, entry) {
+			break
+		}
+	}
+	return nil
+}
+
+// changeCursor returns the cursor of the change of the given key.
+//
+// The sequence number is zero padded so that cursors sort in the same order as the change log.
+func changeCursor(key core.CollectionChangeKey) string {
+	return fmt.Sprintf("%020d", key.Seq)
+}
+
+// changeSeqFromCursor returns the sequence number of the change of the given cursor.
+func changeSeqFromCursor(cursor string) (uint64, error) {
+	seq, err := strconv.ParseUint(cursor, 10, 64)
+	if err != nil || seq == 0 || seq == math.MaxUint64 {
+		return 0, NewErrInvalidChangeCursor(cursor)
+	}
+	return seq, nil
+}
@@ -0,0 +1,284 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	badger "github.com/sourcenetwork/badger/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+	badgerds "github.com/sourcenetwork/defradb/datastore/badger/v4"
+)
+
+func TestChanges_WithCreateUpdateAndDelete_ReturnsChangesInOrder(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+
+	docID, commits := createHistoryTestDoc(t, db, historyTestSchema, 1)
+	col, err := db.GetCollectionByName(ctx, "Users")
+	require.NoError(t, err)
+	id, err := client.NewDocIDFromString(docID)
+	require.NoError(t, err)
+	_, err = col.Delete(ctx, id)
+	require.NoError(t, err)
+
+	changes, err := col.Changes(ctx, "", 0)
+	require.NoError(t, err)
+	require.Len(t, changes, 3)
+	require.Equal(t, client.ChangeTypeCreate, changes[0].Type)
+	require.Equal(t, commits[0], changes[0].Cid)
+	require.Equal(t, client.ChangeTypeUpdate, changes[1].Type)
+	require.Equal(t, commits[1], changes[1].Cid)
+	require.Equal(t, client.ChangeTypeDelete, changes[2].Type)
+	for _, change := range changes {
+		require.Equal(t, docID, change.DocID)
+		require.False(t, change.Time.IsZero())
+	}
+}
+
+func TestChanges_WithCursorAndLimit_ReturnsFollowingChanges(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, commits := createHistoryTestDoc(t, db, historyTestSchema, 3)
+	col, err := db.GetCollectionByName(ctx, "Users")
+	require.NoError(t, err)
+
+	first, err := col.Changes(ctx, "", 2)
+	require.NoError(t, err)
+	require.Len(t, first, 2)
+	require.Equal(t, commits[0], first[0].Cid)
+	require.Equal(t, commits[1], first[1].Cid)
+
+	next, err := col.Changes(ctx, first[1].Cursor, 0)
+	require.NoError(t, err)
+	require.Len(t, next, 2)
+	require.Equal(t, commits[2], next[0].Cid)
+	require.Equal(t, commits[3], next[1].Cid)
+
+	last, err := col.Changes(ctx, next[1].Cursor, 0)
+	require.NoError(t, err)
+	require.Empty(t, last)
+}
+
+func TestChanges_WithMultipleChanges_ReturnsSequentialCursors(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, commits := createHistoryTestDoc(t, db, historyTestSchema, 2)
+	col, err := db.GetCollectionByName(ctx, "Users")
+	require.NoError(t, err)
+
+	changes, err := col.Changes(ctx, "", 0)
+	require.NoError(t, err)
+	require.Len(t, changes, 3)
+	require.Equal(t, "00000000000000000001", changes[0].Cursor)
+	require.Equal(t, commits[0], changes[0].Cid)
+	require.Equal(t, "00000000000000000002", changes[1].Cursor)
+	require.Equal(t, commits[1], changes[1].Cid)
+	require.Equal(t, "00000000000000000003", changes[2].Cursor)
+	require.Equal(t, commits[2], changes[2].Cid)
+}
+
+func TestChanges_WithCursorOfLaterChange_ReturnsFollowingChanges(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, commits := createHistoryTestDoc(t, db, historyTestSchema, 3)
+	col, err := db.GetCollectionByName(ctx, "Users")
+	require.NoError(t, err)
+
+	changes, err := col.Changes(ctx, "00000000000000000002", 1)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.Equal(t, "00000000000000000003", changes[0].Cursor)
+	require.Equal(t, commits[2], changes[0].Cid)
+}
+
+func TestChanges_WithInvalidCursor_Error(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.AddSchema(ctx, historyTestSchema)
+	require.NoError(t, err)
+	col, err := db.GetCollectionByName(ctx, "Users")
+	require.NoError(t, err)
+
+	_, err = col.Changes(ctx, "invalid", 0)
+	require.ErrorIs(t, err, ErrInvalidChangeCursor)
+}
+
+func TestChanges_AfterRestart_ResumesFromCursor(t *testing.T) {
+	ctx := context.Background()
+	path := t.TempDir()
+	opts := badgerds.Options{Options: badger.DefaultOptions(path)}
+
+	rootstore, err := badgerds.NewDatastore(path, &opts)
+	require.NoError(t, err)
+	db, err := newDB(ctx, rootstore)
+	require.NoError(t, err)
+	_, commits := createHistoryTestDoc(t, db, historyTestSchema, 1)
+	col, err := db.GetCollectionByName(ctx, "Users")
+	require.NoError(t, err)
+	changes, err := col.Changes(ctx, "", 1)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	cursor := changes[0].Cursor
+	db.Close()
+
+	rootstore, err = badgerds.NewDatastore(path, &opts)
+	require.NoError(t, err)
+	db, err = newDB(ctx, rootstore)
+	require.NoError(t, err)
+	defer db.Close()
+	col, err = db.GetCollectionByName(ctx, "Users")
+	require.NoError(t, err)
+
+	changes, err = col.Changes(ctx, cursor, 0)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.Equal(t, commits[1], changes[0].Cid)
+	require.Equal(t, client.ChangeTypeUpdate, changes[0].Type)
+
+	execTestRequest(t, db, `mutation {
+		update_Users(input: {age: 30}) {
+			_docID
+		}
+	}`)
+	changes, err = col.Changes(ctx, changes[0].Cursor, 0)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.Equal(t, "00000000000000000003", changes[0].Cursor)
+}
+
+func TestChanges_WithPurgedDocument_RemovesChanges(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+
+	docID, _ := createHistoryTestDoc(t, db, historyTestSchema, 1)
+	col, err := db.GetCollectionByName(ctx, "Users")
+	require.NoError(t, err)
+	id, err := client.NewDocIDFromString(docID)
+	require.NoError(t, err)
+	_, err = col.Delete(ctx, id)
+	require.NoError(t, err)
+	err = col.Purge(ctx, id)
+	require.NoError(t, err)
+
+	changes, err := col.Changes(ctx, "", 0)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+}
+
+func TestChanges_WithConcurrentTransactions_ReturnsChangesInCommitOrder(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.AddSchema(ctx, historyTestSchema)
+	require.NoError(t, err)
+	col, err := db.GetCollectionByName(ctx, "Users")
+	require.NoError(t, err)
+
+	first, err := client.NewDocFromJSON([]byte(`{"name": "John"}`), col.Schema())
+	require.NoError(t, err)
+	second, err := client.NewDocFromJSON([]byte(`{"name": "Islam"}`), col.Schema())
+	require.NoError(t, err)
+
+	firstTxn, err := db.NewTxn(ctx, false)
+	require.NoError(t, err)
+	err = col.WithTxn(firstTxn).Create(ctx, first)
+	require.NoError(t, err)
+	secondTxn, err := db.NewTxn(ctx, false)
+	require.NoError(t, err)
+	err = col.WithTxn(secondTxn).Create(ctx, second)
+	require.NoError(t, err)
+
+	err = secondTxn.Commit(ctx)
+	require.NoError(t, err)
+	err = firstTxn.Commit(ctx)
+	require.NoError(t, err)
+
+	changes, err := col.Changes(ctx, "", 0)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	require.Equal(t, "00000000000000000001", changes[0].Cursor)
+	require.Equal(t, second.ID().String(), changes[0].DocID)
+	require.Equal(t, "00000000000000000002", changes[1].Cursor)
+	require.Equal(t, first.ID().String(), changes[1].DocID)
+}
+
+func TestChanges_WithManyConcurrentCommits_ReturnsEachChangeOnce(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.AddSchema(ctx, historyTestSchema)
+	require.NoError(t, err)
+	col, err := db.GetCollectionByName(ctx, "Users")
+	require.NoError(t, err)
+
+	const docCount = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, docCount)
+	for i := 0; i < docCount; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			// collections are not safe for concurrent use, so each commit gets its own
+			col, err := db.GetCollectionByName(ctx, "Users")
+			if err != nil {
+				errs <- err
+				return
+			}
+			doc, err := client.NewDocFromJSON([]byte(fmt.Sprintf(`{"name": "User %d"}`, i)), col.Schema())
+			if err != nil {
+				errs <- err
+				return
+			}
+			errs <- col.Create(ctx, doc)
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	changes, err := col.Changes(ctx, "", 0)
+	require.NoError(t, err)
+	require.Len(t, changes, docCount)
+	docIDs := map[string]struct{}{}
+	for i, change := range changes {
+		require.Equal(t, fmt.Sprintf("%020d", i+1), change.Cursor)
+		docIDs[change.DocID] = struct{}{}
+	}
+	require.Len(t, docIDs, docCount)
+}
@@ -16,10 +16,14 @@ import (
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/connor"
 	"github.com/sourcenetwork/defradb/core"
 	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/db/base"
+	"github.com/sourcenetwork/defradb/db/fetcher"
 	"github.com/sourcenetwork/defradb/events"
 	"github.com/sourcenetwork/defradb/merkle/clock"
+	"github.com/sourcenetwork/defradb/planner/mapper"
 )
 
 // DeleteWith deletes a target document.
@@ -242,6 +246,12 @@ func (c *collection) applyDelete(
 		return NewErrDocumentDeleted(primaryKey.DocID)
 	}
 
+	references := c.db.getRelationReferences(c.Schema().Name)
+	err = c.db.checkRelationOnDeleteRestrict(ctx, txn, references, primaryKey.DocID)
+	if err != nil {
+		return err
+	}
+
 	dsKey := primaryKey.ToDataStoreKey()
 
 	headset := clock.NewHeadSet(
@@ -262,7 +272,7 @@ func (c *collection) applyDelete(
 	}
 
 	headNode, priority, err := c.saveCompositeToMerkleCRDT(
-		ctx,
+		c.db.withCommitMetadata(ctx),
 		txn,
 		dsKey,
 		dagLinks,
@@ -272,6 +282,11 @@ func (c *collection) applyDelete(
 		return err
 	}
 
+	err = base.ApplyRelationOnDelete(ctx, c.db.WithTxn(txn), txn, references, primaryKey.DocID)
+	if err != nil {
+		return err
+	}
+
 	if c.db.events.Updates.HasValue() {
 		txn.OnSuccess(
 			func() {
@@ -282,6 +297,7 @@ func (c *collection) applyDelete(
 						SchemaRoot: c.Schema().Root,
 						Block:      headNode,
 						Priority:   priority,
+						IsDelete:   true,
 					},
 				)
 			},
@@ -290,3 +306,97 @@ func (c *collection) applyDelete(
 
 	return nil
 }
+
+// checkRelationOnDeleteRestrict returns an error if the given document is referenced through
+// one of the given relation references that restricts its deletion.
+func (db *db) checkRelationOnDeleteRestrict(
+	ctx context.Context,
+	txn datastore.Txn,
+	references []base.RelationReference,
+	docID string,
+) error {
+	for _, ref := range references {
+		if ref.Field.OnDelete != client.RelationOnDeleteRestrict {
+			continue
+		}
+		col, err := db.getCollectionByName(ctx, txn, ref.CollectionName)
+		if err != nil {
+			return err
+		}
+		isReferenced, err := col.(*collection).hasDocWithFieldValue(
+			ctx,
+			txn,
+			ref.Field.Name+request.RelatedObjectID,
+			docID,
+		)
+		if err != nil {
+			return err
+		}
+		if isReferenced {
+			return base.NewErrDeleteRestricted(docID, ref.CollectionName, ref.Field.Name)
+		}
+	}
+	return nil
+}
+
+// hasDocWithFieldValue returns true if a document of the collection, that has not been deleted,
+// holds the given value in the given field.
+//
+// The document is looked up through an index on the field if there is one, otherwise the field
+// of every document is fetched until one holding the value is found.
+func (c *collection) hasDocWithFieldValue(
+	ctx context.Context,
+	txn datastore.Txn,
+	fieldName string,
+	value any,
+) (bool, error) {
+	schema := c.Schema()
+	field, ok := schema.GetField(fieldName)
+	if !ok {
+		return false, client.NewErrFieldNotExist(fieldName)
+	}
+	docIDField, ok := schema.GetField(request.DocIDFieldName)
+	if !ok {
+		return false, client.NewErrFieldNotExist(request.DocIDFieldName)
+	}
+
+	mapping := core.NewDocumentMapping()
+	for _, fd := range schema.Fields {
+		mapping.Add(int(fd.ID), fd.Name)
+	}
+	filter := mapper.NewFilter()
+	filter.Conditions = map[connor.FilterKey]any{
+		&mapper.PropertyIndex{Index: int(field.ID)}: map[connor.FilterKey]any{
+			mapper.FilterEqOp: value,
+		},
+	}
+
+	var df fetcher.Fetcher = new(fetcher.DocumentFetcher)
+	for _, indexedField := range c.Description().CollectIndexedFields(&schema) {
+		if indexedField.Name == fieldName {
+			df = fetcher.NewIndexFetcher(df, field, filter)
+			filter = nil
+			break
+		}
+	}
+
+	// The document ID is fetched so that deleted documents, which remain in indexes, are skipped.
+	fields := []client.FieldDescription{docIDField, field}
+	err := df.Init(ctx, txn, c, fields, filter, mapping, false, false)
+	if err != nil {
+		_ = df.Close()
+		return false, err
+	}
+	start := base.MakeDataStoreKeyWithCollectionDescription(c.Description())
+	err = df.Start(ctx, core.NewSpans(core.NewSpan(start, start.PrefixEnd())))
+	if err != nil {
+		_ = df.Close()
+		return false, err
+	}
+	encodedDoc, _, err := df.FetchNext(ctx)
+	if err != nil {
+		_ = df.Close()
+		return false, err
+	}
+	return encodedDoc != nil, df.Close()
+}
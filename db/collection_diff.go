@@ -0,0 +1,225 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+
+	"github.com/ipfs/go-cid"
+	"github.com/shopspring/decimal"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/core/crdt"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/db/description"
+	"github.com/sourcenetwork/defradb/db/fetcher"
+	"github.com/sourcenetwork/defradb/merkle/clock"
+)
+
+// Diff returns the field-level difference between two versions of a document.
+func (c *collection) Diff(ctx context.Context, from cid.Cid, to cid.Cid) (*client.DocumentDiff, error) {
+	txn, err := c.getTxn(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer c.discardImplicitTxn(ctx, txn)
+
+	fromDocID, fromCommits, err := c.getDocumentCommits(ctx, txn, from)
+	if err != nil {
+		return nil, err
+	}
+	toDocID, toCommits, err := c.getDocumentCommits(ctx, txn, to)
+	if err != nil {
+		return nil, err
+	}
+	if fromDocID != toDocID {
+		return nil, NewErrDiffDocumentMismatch(from, to)
+	}
+
+	fromValues, fromDeleted, err := c.getVersion(ctx, txn, fromDocID, from)
+	if err != nil {
+		return nil, err
+	}
+	toValues, toDeleted, err := c.getVersion(ctx, txn, toDocID, to)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &client.DocumentDiff{
+		DocID:   toDocID,
+		From:    from.String(),
+		To:      to.String(),
+		Commits: []string{},
+		Fields:  diffFields(c.Schema(), fromValues, fromDeleted, toValues, toDeleted),
+	}
+
+	fromCids := make(map[cid.Cid]struct{}, len(fromCommits))
+	for _, commit := range fromCommits {
+		fromCids[commit.GetNode().Cid()] = struct{}{}
+	}
+	for _, commit := range toCommits {
+		if _, isFromCommit := fromCids[commit.GetNode().Cid()]; !isFromCommit {
+			diff.Commits = append(diff.Commits, commit.GetNode().Cid().String())
+		}
+	}
+
+	return diff, c.commitImplicitTxn(ctx, txn)
+}
+
+// getDocumentCommits returns the ID of the document that the given document commit belongs to,
+// along with the commit and all the document commits preceding it, in the order they were made.
+//
+// Returns an error if the given commit is not a document commit of this collection.
+func (c *collection) getDocumentCommits(
+	ctx context.Context,
+	txn datastore.Txn,
+	head cid.Cid,
+) (string, []clock.DeltaEntry, error) {
+	commits, err := clock.Walk(ctx, txn.DAGstore(), crdt.CompositeDAG{}.DeltaDecode, head)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(commits) == 0 {
+		// the commit is not in the store
+		return "", nil, NewErrNotDocumentCommit(head)
+	}
+	// the head always has the highest priority of the commits preceding it
+	delta, ok := commits[len(commits)-1].GetDelta().(*crdt.CompositeDAGDelta)
+	if !ok || delta.FieldName != "" {
+		return "", nil, NewErrNotDocumentCommit(head)
+	}
+	schema, err := description.GetSchemaVersion(ctx, txn, delta.SchemaVersionID)
+	if err != nil || schema.Root != c.Schema().Root {
+		return "", nil, NewErrNotDocumentCommit(head)
+	}
+
+	return string(delta.DocID), commits, nil
+}
+
+// getVersion returns the field values, mapped by field name, and the deleted status of the
+// given document at the given version.
+func (c *collection) getVersion(
+	ctx context.Context,
+	txn datastore.Txn,
+	docID string,
+	version cid.Cid,
+) (map[string]any, bool, error) {
+	ctx = c.db.withSignaturePolicy(ctx)
+
+	df := new(fetcher.VersionedFetcher)
+	err := df.Init(ctx, txn, c, nil, nil, nil, false, true)
+	if err != nil {
+		_ = df.Close()
+		return nil, false, err
+	}
+
+	err = df.Start(ctx, fetcher.NewVersionedSpan(core.DataStoreKey{DocID: docID}, version))
+	if err != nil {
+		_ = df.Close()
+		return nil, false, err
+	}
+
+	encodedDoc, _, err := df.FetchNext(ctx)
+	if err != nil {
+		_ = df.Close()
+		return nil, false, err
+	}
+
+	values := map[string]any{}
+	isDeleted := false
+	if encodedDoc != nil {
+		properties, err := encodedDoc.Properties(false)
+		if err != nil {
+			_ = df.Close()
+			return nil, false, err
+		}
+		for field, value := range properties {
+			values[field.Name] = value
+		}
+		isDeleted = encodedDoc.Status() == client.Deleted
+	}
+
+	return values, isDeleted, df.Close()
+}
+
+// diffFields returns the differences between the field values of two versions of a document,
+// in schema order.
+//
+// The fields without a value in a version, such as those added to the schema after it, are nil
+// in that version.
+func diffFields(
+	schema client.SchemaDescription,
+	fromValues map[string]any,
+	fromDeleted bool,
+	toValues map[string]any,
+	toDeleted bool,
+) []client.FieldDiff {
+	// the relation ID fields are mapped to the name of their relation field
+	relations := map[string]string{}
+	for _, field := range schema.Fields {
+		if field.IsObject() {
+			relations[field.Name+request.RelatedObjectID] = field.Name
+		}
+	}
+
+	diffs := []client.FieldDiff{}
+	for _, field := range schema.Fields {
+		if field.IsObject() || field.Name == request.DocIDFieldName {
+			continue
+		}
+		// the values of unset fields are missing from the maps, and so read as nil
+		before := fromValues[field.Name]
+		after := toValues[field.Name]
+		if equalFieldValues(before, after) {
+			continue
+		}
+		diffs = append(diffs, client.FieldDiff{
+			Name:     field.Name,
+			Relation: relations[field.Name],
+			Before:   before,
+			After:    after,
+		})
+	}
+
+	if fromDeleted != toDeleted {
+		diffs = append(diffs, client.FieldDiff{
+			Name:   request.DeletedFieldName,
+			Before: fromDeleted,
+			After:  toDeleted,
+		})
+	}
+	return diffs
+}
+
+// equalFieldValues returns true if the given field values are equal.
+//
+// Big numbers are compared by value, as equal numbers may be held with a different internal
+// representation, for example a decimal with trailing zeros.
+func equalFieldValues(a any, b any) bool {
+	switch a := a.(type) {
+	case *big.Int:
+		b, ok := b.(*big.Int)
+		if !ok || a == nil || b == nil {
+			return ok && a == b
+		}
+		return a.Cmp(b) == 0
+	case decimal.Decimal:
+		b, ok := b.(decimal.Decimal)
+		return ok && a.Equal(b)
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
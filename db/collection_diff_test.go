@@ -0,0 +1,63 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func TestDiffFields_WithEqualBigNumbersOfDifferentRepresentation_ReturnsNoDiff(t *testing.T) {
+	schema := client.SchemaDescription{
+		Fields: []client.FieldDescription{
+			{Name: "balance", Kind: client.FieldKind_BIGINT},
+			{Name: "rate", Kind: client.FieldKind_DECIMAL},
+		},
+	}
+	zero, _ := new(big.Int).SetString("0", 10)
+
+	diffs := diffFields(
+		schema,
+		map[string]any{"balance": big.NewInt(0), "rate": decimal.RequireFromString("1.5")},
+		false,
+		map[string]any{"balance": zero, "rate": decimal.RequireFromString("1.50")},
+		false,
+	)
+
+	assert.Empty(t, diffs)
+}
+
+func TestDiffFields_WithDifferentBigNumbers_ReturnsDiff(t *testing.T) {
+	schema := client.SchemaDescription{
+		Fields: []client.FieldDescription{
+			{Name: "balance", Kind: client.FieldKind_BIGINT},
+			{Name: "rate", Kind: client.FieldKind_DECIMAL},
+		},
+	}
+
+	diffs := diffFields(
+		schema,
+		map[string]any{"balance": big.NewInt(1), "rate": decimal.RequireFromString("1.5")},
+		false,
+		map[string]any{"balance": nil, "rate": decimal.RequireFromString("1.51")},
+		false,
+	)
+
+	assert.Equal(t, []client.FieldDiff{
+		{Name: "balance", Before: big.NewInt(1), After: nil},
+		{Name: "rate", Before: decimal.RequireFromString("1.5"), After: decimal.RequireFromString("1.51")},
+	}, diffs)
+}
@@ -21,8 +21,9 @@ import (
 )
 
 func (c *collection) Get(ctx context.Context, docID client.DocID, showDeleted bool) (*client.Document, error) {
-	// create txn
-	txn, err := c.getTxn(ctx, true)
+	// create txn, this must be writable as the lens fetcher writes the migrated values of
+	// documents of a previous schema version, these are persisted once the txn is committed
+	txn, err := c.getTxn(ctx, false)
 	if err != nil {
 		return nil, err
 	}
@@ -41,7 +42,12 @@ func (c *collection) Get(ctx context.Context, docID client.DocID, showDeleted bo
 	if err != nil {
 		return nil, err
 	}
-	return doc, c.commitImplicitTxn(ctx, txn)
+
+	err = c.commitImplicitTxn(ctx, txn)
+	if err != nil {
+		return nil, err
+	}
+	return doc, nil
 }
 
 func (c *collection) get(
@@ -51,9 +57,33 @@ func (c *collection) get(
 	fields []client.FieldDescription,
 	showDeleted bool,
 ) (*client.Document, error) {
-	// create a new document fetcher
-	df := c.newFetcher()
-	// initialize it with the primary index
+	encodedDoc, err := c.fetch(ctx, txn, c.newFetcher(), primaryKey, fields, showDeleted)
+	if err != nil {
+		return nil, err
+	}
+
+	if encodedDoc == nil {
+		return nil, nil
+	}
+
+	doc, err := fetcher.Decode(encodedDoc, c.Schema())
+	if err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// fetch returns the encoded document of the given primary key using the given fetcher.
+func (c *collection) fetch(
+	ctx context.Context,
+	txn datastore.Txn,
+	df fetcher.Fetcher,
+	primaryKey core.PrimaryDataStoreKey,
+	fields []client.FieldDescription,
+	showDeleted bool,
+) (fetcher.EncodedDocument, error) {
+	// initialize the fetcher with the primary index
 	err := df.Init(ctx, txn, c, fields, nil, nil, false, showDeleted)
 	if err != nil {
 		_ = df.Close()
@@ -69,7 +99,7 @@ func (c *collection) get(
 		return nil, err
 	}
 
-	// return first matched decoded doc
+	// return first matched encoded doc
 	encodedDoc, _, err := df.FetchNext(ctx)
 	if err != nil {
 		_ = df.Close()
@@ -81,14 +111,5 @@ func (c *collection) get(
 		return nil, err
 	}
 
-	if encodedDoc == nil {
-		return nil, nil
-	}
-
-	doc, err := fetcher.Decode(encodedDoc, c.Schema())
-	if err != nil {
-		return nil, err
-	}
-
-	return doc, nil
+	return encodedDoc, nil
 }
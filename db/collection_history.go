@@ -0,0 +1,584 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	dag "github.com/ipfs/boxo/ipld/merkledag"
+	"github.com/ipfs/go-cid"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	ipld "github.com/ipfs/go-ipld-format"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/core/crdt"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/db/base"
+	"github.com/sourcenetwork/defradb/db/fetcher"
+	"github.com/sourcenetwork/defradb/events"
+	"github.com/sourcenetwork/defradb/merkle/clock"
+)
+
+// CompactHistory applies the retention policy of the collection to the history of its documents.
+//
+// For each document, the oldest retained document commit is chosen so that all the older
+// commits precede it, the state of the document at this commit is snapshotted, and the older
+// commits and their field commits are pruned. Field commits that are still heads are kept, as
+// they are needed to build new commits.
+//
+// Each document is compacted within its own implicit transaction, so that the size of the
+// transactions does not grow with the size of the collection, or within the explicit
+// transaction if there is one.
+//
+// Pruned blocks are marked as such so that they are not fetched again from peers.
+func (c *collection) CompactHistory(ctx context.Context) error {
+	policy := c.Description().RetentionPolicy
+	if policy == nil {
+		return nil
+	}
+
+	txn, err := c.getTxn(ctx, true)
+	if err != nil {
+		return err
+	}
+	docIDs, err := c.getDocIDs(ctx, txn)
+	c.discardImplicitTxn(ctx, txn)
+	if err != nil {
+		return err
+	}
+
+	ctx = c.db.withSignaturePolicy(ctx)
+	now := time.Now()
+	for _, docID := range docIDs {
+		err := c.compactDocument(ctx, docID, *policy, now)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compactDocument applies the given retention policy to the history of the given document.
+func (c *collection) compactDocument(
+	ctx context.Context,
+	docID string,
+	policy client.RetentionPolicy,
+	now time.Time,
+) error {
+	txn, err := c.getTxn(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer c.discardImplicitTxn(ctx, txn)
+
+	commitKeys, err := base.GetDocumentCommitHistory(ctx, txn.Systemstore(), docID)
+	if err != nil {
+		return err
+	}
+	err = c.compactDocumentHistory(ctx, txn, docID, policy, commitKeys, now)
+	if err != nil {
+		return err
+	}
+	return c.commitImplicitTxn(ctx, txn)
+}
+
+func (c *collection) compactDocumentHistory(
+	ctx context.Context,
+	txn datastore.Txn,
+	docID string,
+	policy client.RetentionPolicy,
+	commitKeys map[cid.Cid]core.CommitHistoryKey,
+	now time.Time,
+) error {
+	headKey := core.HeadStoreKey{DocID: docID, FieldId: core.COMPOSITE_NAMESPACE}
+	heads, _, err := clock.NewHeadSet(txn.Headstore(), headKey).List(ctx)
+	if err != nil {
+		return NewErrFailedToGetHeads(err)
+	}
+	if len(heads) == 0 {
+		return nil
+	}
+
+	commits, err := clock.Walk(ctx, txn.DAGstore(), crdt.CompositeDAG{}.DeltaDecode, heads...)
+	if err != nil {
+		return err
+	}
+	if len(commits) == 0 {
+		return nil
+	}
+
+	height := retainedHeight(policy, commits, heads, commitKeys, now)
+	boundary, pruned := findHistoryBoundary(commits, height)
+	if boundary == nil {
+		return nil
+	}
+
+	snapshot, err := c.snapshotVersion(ctx, txn, docID, boundary.GetNode().Cid())
+	if err != nil {
+		return err
+	}
+
+	schema := c.Schema()
+	for _, commit := range pruned {
+		nd := commit.GetNode()
+		for _, l := range nd.Links() {
+			if l.Name == core.HEAD {
+				continue
+			}
+			field, ok := c.Description().GetFieldByName(l.Name, &schema)
+			if ok {
+				fieldHeadKey := core.HeadStoreKey{DocID: docID, FieldId: field.ID.String(), Cid: l.Cid}
+				isHead, err := txn.Headstore().Has(ctx, fieldHeadKey.ToDS())
+				if err != nil {
+					return err
+				}
+				if isHead {
+					continue
+				}
+			}
+			if err := pruneBlock(ctx, txn, docID, l.Cid); err != nil {
+				return err
+			}
+		}
+
+		if err := pruneBlock(ctx, txn, docID, nd.Cid()); err != nil {
+			return err
+		}
+		if key, ok := commitKeys[nd.Cid()]; ok {
+			if err := base.DeleteCommitHistory(ctx, txn.Systemstore(), key, nd.Cid()); err != nil {
+				return err
+			}
+		}
+		snapshotKey := core.NewDocumentSnapshotKey(docID, nd.Cid().String())
+		if err := txn.Systemstore().Delete(ctx, snapshotKey.ToDS()); err != nil {
+			return err
+		}
+	}
+
+	return base.PutDocumentSnapshot(ctx, txn.Systemstore(), docID, boundary.GetNode().Cid(), snapshot)
+}
+
+// retainedHeight returns the height of the oldest document commit retained by the given policy.
+//
+// The heads of the document are always retained.
+func retainedHeight(
+	policy client.RetentionPolicy,
+	commits []clock.DeltaEntry,
+	heads []cid.Cid,
+	commitKeys map[cid.Cid]core.CommitHistoryKey,
+	now time.Time,
+) uint64 {
+	maxHeight := commits[len(commits)-1].GetDelta().GetPriority()
+
+	var height uint64
+	if policy.MaxVersions.HasValue() {
+		versions := uint64(policy.MaxVersions.Value())
+		height = 1
+		if versions < maxHeight {
+			height = maxHeight - versions + 1
+		}
+	}
+	if policy.MaxAge.HasValue() {
+		cutoff := now.Add(-policy.MaxAge.Value()).UnixNano()
+		ageHeight := maxHeight
+		for _, commit := range commits {
+			// commits without a recorded time are treated as recent
+			key, ok := commitKeys[commit.GetNode().Cid()]
+			if (!ok || key.Timestamp >= cutoff) && commit.GetDelta().GetPriority() < ageHeight {
+				ageHeight = commit.GetDelta().GetPriority()
+			}
+		}
+		// the versions retained by either limit are kept
+		if !policy.MaxVersions.HasValue() || ageHeight < height {
+			height = ageHeight
+		}
+	}
+
+	isHead := make(map[cid.Cid]struct{}, len(heads))
+	for _, head := range heads {
+		isHead[head] = struct{}{}
+	}
+	for _, commit := range commits {
+		if _, ok := isHead[commit.GetNode().Cid()]; ok && commit.GetDelta().GetPriority() < height {
+			height = commit.GetDelta().GetPriority()
+		}
+	}
+
+	return height
+}
+
+// findHistoryBoundary returns the document commit, at or below the given height, that the
+// history can be pruned at, along with the commits that would be pruned.
+//
+// The history can only be pruned at a commit that is the only one at its height and that is
+// preceded by all the lower commits, so that its snapshot holds the whole pruned state.
+// Returns a nil boundary if there is no such commit with lower commits to prune.
+func findHistoryBoundary(
+	commits []clock.DeltaEntry,
+	height uint64,
+) (*clock.DeltaEntry, []clock.DeltaEntry) {
+	byCid := make(map[cid.Cid]clock.DeltaEntry, len(commits))
+	for _, commit := range commits {
+		byCid[commit.GetNode().Cid()] = commit
+	}
+
+	lowest := commits[0].GetDelta().GetPriority()
+	for ; height > lowest; height-- {
+		var atHeight []clock.DeltaEntry
+		var below []clock.DeltaEntry
+		for _, commit := range commits {
+			switch priority := commit.GetDelta().GetPriority(); {
+			case priority == height:
+				atHeight = append(atHeight, commit)
+			case priority < height:
+				below = append(below, commit)
+			}
+		}
+		if len(atHeight) != 1 {
+			continue
+		}
+		if countAncestors(byCid, atHeight[0]) == len(below) {
+			return &atHeight[0], below
+		}
+	}
+	return nil, nil
+}
+
+// countAncestors returns the number of the given commits that precede the given commit.
+func countAncestors(commits map[cid.Cid]clock.DeltaEntry, commit clock.DeltaEntry) int {
+	visited := map[cid.Cid]struct{}{}
+	queue := []clock.DeltaEntry{commit}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, l := range current.GetNode().Links() {
+			if l.Name != core.HEAD {
+				continue
+			}
+			if _, isVisited := visited[l.Cid]; isVisited {
+				continue
+			}
+			parent, ok := commits[l.Cid]
+			if !ok {
+				continue
+			}
+			visited[l.Cid] = struct{}{}
+			queue = append(queue, parent)
+		}
+	}
+	return len(visited)
+}
+
+// snapshotVersion returns the snapshot of the given document at the given version.
+func (c *collection) snapshotVersion(
+	ctx context.Context,
+	txn datastore.Txn,
+	docID string,
+	version cid.Cid,
+) (base.DocumentSnapshot, error) {
+	df := new(fetcher.VersionedFetcher)
+	err := df.Init(ctx, txn, c, nil, nil, nil, false, true)
+	if err != nil {
+		_ = df.Close()
+		return base.DocumentSnapshot{}, err
+	}
+
+	err = df.Start(ctx, fetcher.NewVersionedSpan(core.DataStoreKey{DocID: docID}, version))
+	if err != nil {
+		_ = df.Close()
+		return base.DocumentSnapshot{}, err
+	}
+
+	snapshot, err := df.Snapshot(ctx)
+	if err != nil {
+		_ = df.Close()
+		return base.DocumentSnapshot{}, err
+	}
+
+	return snapshot, df.Close()
+}
+
+// pruneBlock removes the given block of the given document from the blockstore, and marks it
+// as pruned.
+func pruneBlock(ctx context.Context, txn datastore.Txn, docID string, block cid.Cid) error {
+	if err := txn.DAGstore().DeleteBlock(ctx, block); err != nil {
+		return err
+	}
+	return base.MarkBlockPruned(ctx, txn.Systemstore(), docID, block)
+}
+
+// Purge permanently removes the deleted document with the given DocID, its blocks, heads,
+// history, changes and snapshots from this node, and marks it as purged.
+//
+// The purge is advertised to peers, which purge the document as well.
+func (c *collection) Purge(ctx context.Context, docID client.DocID) error {
+	// the changes of the document must be sequenced before they can be removed
+	err := c.db.waitForCommittedChanges(ctx)
+	if err != nil {
+		return err
+	}
+
+	txn, err := c.getTxn(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer c.discardImplicitTxn(ctx, txn)
+
+	primaryKey := c.getPrimaryKeyFromDocID(docID)
+	exists, isDeleted, err := c.exists(ctx, txn, primaryKey)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return client.ErrDocumentNotFound
+	}
+	if !isDeleted {
+		return NewErrPurgeDocumentNotDeleted(docID.String())
+	}
+
+	if err := purgeBlocks(ctx, txn, docID.String()); err != nil {
+		return err
+	}
+
+	if err := txn.Datastore().Delete(ctx, primaryKey.ToDS()); err != nil {
+		return err
+	}
+	dataKey := c.getDataStoreKeyFromDocID(docID)
+	dataPrefixes := []string{
+		dataKey.ToString(),
+		dataKey.WithDeletedFlag().ToString(),
+		dataKey.WithPriorityFlag().ToString(),
+	}
+	for _, prefix := range dataPrefixes {
+		if err := deleteWithPrefix(ctx, txn.Datastore(), prefix); err != nil {
+			return err
+		}
+	}
+	err = deleteWithPrefix(ctx, txn.Headstore(), core.HeadStoreKey{DocID: docID.String()}.ToString())
+	if err != nil {
+		return err
+	}
+
+	systemPrefixes := []string{
+		core.NewDocumentSnapshotKey(docID.String(), "").ToString(),
+		core.NewPrunedBlockKey(docID.String(), "").ToString(),
+	}
+	for _, prefix := range systemPrefixes {
+		if err := deleteWithPrefix(ctx, txn.Systemstore(), prefix); err != nil {
+			return err
+		}
+	}
+	err = txn.Systemstore().Delete(ctx, core.NewLocalDocumentKey(docID.String()).ToDS())
+	if err != nil {
+		return err
+	}
+	err = base.DeleteDocumentHistory(ctx, txn.Systemstore(), docID.String())
+	if err != nil {
+		return err
+	}
+
+	err = base.MarkDocumentPurged(ctx, txn.Systemstore(), docID.String())
+	if err != nil {
+		return err
+	}
+
+	if c.db.events.Updates.HasValue() {
+		// peers only accept purges signed by the author of the document, so the purge is
+		// signed with the key the commits of this node are signed with
+		var signature core.CommitSignature
+		if c.db.commitSigningKey != nil {
+			signature, err = clock.SignPurge(c.db.commitSigningKey, docID.String())
+			if err != nil {
+				return err
+			}
+		}
+		txn.OnSuccess(
+			func() {
+				c.db.events.Updates.Value().Publish(
+					events.Update{
+						DocID:          docID.String(),
+						SchemaRoot:     c.Schema().Root,
+						IsPurge:        true,
+						PurgeSignature: signature.Signature,
+						PurgePublicKey: signature.PublicKey,
+					},
+				)
+			},
+		)
+	}
+
+	return c.commitImplicitTxn(ctx, txn)
+}
+
+// purgeBlocks removes all the blocks reachable from the heads of the given document from the
+// blockstore.
+func purgeBlocks(ctx context.Context, txn datastore.Txn, docID string) error {
+	results, err := txn.Headstore().Query(ctx, query.Query{
+		Prefix:   core.HeadStoreKey{DocID: docID}.ToString(),
+		KeysOnly: true,
+	})
+	if err != nil {
+		return err
+	}
+	queue := []cid.Cid{}
+	for result := range results.Next() {
+		if result.Error != nil {
+			_ = results.Close()
+			return result.Error
+		}
+		headKey, err := core.NewHeadStoreKey(result.Key)
+		if err != nil {
+			_ = results.Close()
+			return err
+		}
+		queue = append(queue, headKey.Cid)
+	}
+	if err := results.Close(); err != nil {
+		return err
+	}
+
+	visited := map[cid.Cid]struct{}{}
+	for len(queue) > 0 {
+		c := queue[0]
+		queue = queue[1:]
+		if _, isVisited := visited[c]; isVisited {
+			continue
+		}
+		visited[c] = struct{}{}
+
+		blk, err := txn.DAGstore().Get(ctx, c)
+		if ipld.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		nd, err := dag.DecodeProtobuf(blk.RawData())
+		if err != nil {
+			return err
+		}
+		for _, l := range nd.Links() {
+			queue = append(queue, l.Cid)
+		}
+		if err := txn.DAGstore().DeleteBlock(ctx, c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// commitHistoryBackfilledKey marks the commit history of all the collections as backfilled.
+var commitHistoryBackfilledKey = ds.NewKey(core.COMMIT_HISTORY_BACKFILLED)
+
+// backfillDocumentCommitHistory records the document commits of the given document that are
+// missing from the commit history of its collection, such as those written before the commit
+// history was recorded.
+//
+// As the time at which this node applied them is unknown, commits are recorded at the time
+// they were created if it is part of the commit, or else at the earliest times, in the order
+// they were added to the document. Commits that are already recorded are skipped.
+func backfillDocumentCommitHistory(ctx context.Context, txn datastore.Txn, col *collection, docID string) error {
+	commitKeys, err := base.GetDocumentCommitHistory(ctx, txn.Systemstore(), docID)
+	if err != nil {
+		return err
+	}
+	headKey := core.HeadStoreKey{DocID: docID, FieldId: core.COMPOSITE_NAMESPACE}
+	heads, _, err := clock.NewHeadSet(txn.Headstore(), headKey).List(ctx)
+	if err != nil {
+		return NewErrFailedToGetHeads(err)
+	}
+	commits, err := clock.Walk(ctx, txn.DAGstore(), crdt.CompositeDAG{}.DeltaDecode, heads...)
+	if err != nil {
+		return err
+	}
+
+	// each commit without a timestamp is recorded one nanosecond after the previous one,
+	// so that they keep their order
+	var earliest int64
+	for _, commit := range commits {
+		nd := commit.GetNode()
+		var timestamp int64
+		if delta, ok := commit.Delta.(*crdt.CompositeDAGDelta); ok {
+			timestamp = delta.Timestamp
+		}
+		if timestamp <= 0 {
+			earliest++
+			timestamp = earliest
+		}
+		if _, isRecorded := commitKeys[nd.Cid()]; isRecorded {
+			continue
+		}
+		key := core.NewCommitHistoryKey(col.ID(), timestamp, docID, nd.Cid().String())
+		if err := base.PutCommitHistory(ctx, txn.Systemstore(), key, nd.Cid()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getDocIDs returns the IDs of all the documents of the collection, including deleted ones, in
+// order.
+func (c *collection) getDocIDs(ctx context.Context, txn datastore.Txn) ([]string, error) {
+	prefix := core.PrimaryDataStoreKey{CollectionId: fmt.Sprint(c.ID())}
+	results, err := txn.Datastore().Query(ctx, query.Query{
+		Prefix:   prefix.ToString(),
+		KeysOnly: true,
+		Orders:   []query.Order{query.OrderByKey{}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = results.Close()
+	}()
+
+	docIDs := []string{}
+	for result := range results.Next() {
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		docIDs = append(docIDs, ds.NewKey(result.Key).BaseNamespace())
+	}
+	return docIDs, nil
+}
+
+// deleteWithPrefix deletes all the entries of the given store with the given key prefix.
+func deleteWithPrefix(ctx context.Context, store datastore.DSReaderWriter, prefix string) error {
+	results, err := store.Query(ctx, query.Query{
+		Prefix:   prefix,
+		KeysOnly: true,
+	})
+	if err != nil {
+		return err
+	}
+	keys := []ds.Key{}
+	for result := range results.Next() {
+		if result.Error != nil {
+			_ = results.Close()
+			return result.Error
+		}
+		keys = append(keys, ds.NewKey(result.Key))
+	}
+	if err := results.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := store.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const historyTestSchema = `
+	type Users {
+		name: String
+		age: Int
+	}
+`
+
+// createHistoryTestDoc creates a document and updates it the given number of times, returning
+// its ID and the CIDs of its composite commits in the order they were made.
+func createHistoryTestDoc(t *testing.T, db *implicitTxnDB, schema string, updates int) (string, []string) {
+	_, err := db.AddSchema(context.Background(), schema)
+	require.NoError(t, err)
+
+	docs := execTestRequest(t, db, `mutation {
+		create_Users(input: {name: "John", age: 21}) {
+			_docID
+		}
+	}`)
+	require.Len(t, docs, 1)
+	for i := 1; i <= updates; i++ {
+		execTestRequest(t, db, fmt.Sprintf(`mutation {
+			update_Users(input: {age: %d}) {
+				_docID
+			}
+		}`, 21+i))
+	}
+
+	return docs[0]["_docID"].(string), getCompositeCommits(t, db)
+}
+
+func getCompositeCommits(t *testing.T, db *implicitTxnDB) []string {
+	commits := execTestRequest(t, db, `query {
+		commits(fieldId: "C", order: {height: ASC}) {
+			cid
+		}
+	}`)
+	cids := make([]string, len(commits))
+	for i, commit := range commits {
+		cids[i] = commit["cid"].(string)
+	}
+	return cids
+}
+
+func hasBlock(t *testing.T, db *implicitTxnDB, version string) bool {
+	c, err := cid.Decode(version)
+	require.NoError(t, err)
+	has, err := db.Blockstore().Has(context.Background(), c)
+	require.NoError(t, err)
+	return has
+}
+
+func TestCompactHistory_WithDiscardedExplicitTxn_KeepsHistory(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, commits := createHistoryTestDoc(t, db, `
+		type Users @retention(versions: 1) {
+			name: String
+			age: Int
+		}
+	`, 2)
+	require.Len(t, commits, 3)
+
+	col, err := db.GetCollectionByName(ctx, "Users")
+	require.NoError(t, err)
+
+	// the documents are compacted within the explicit transaction, instead of each within its own
+	txn, err := db.NewTxn(ctx, false)
+	require.NoError(t, err)
+	err = col.WithTxn(txn).CompactHistory(ctx)
+	require.NoError(t, err)
+	txn.Discard(ctx)
+
+	assert.Equal(t, commits, getCompositeCommits(t, db))
+	assert.True(t, hasBlock(t, db, commits[0]))
+}
@@ -18,6 +18,8 @@ import (
 	"strconv"
 	"strings"
 
+	ds "github.com/ipfs/go-datastore"
+
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/core"
 	"github.com/sourcenetwork/defradb/datastore"
@@ -98,6 +100,140 @@ func (db *db) fetchCollectionIndexDescriptions(
 	return indexDescriptions, nil
 }
 
+// migrateCollectionIndexes updates the indexes of the given collection, which has just been
+// moved from the given previous schema version to the given new schema version.
+//
+// Indexes on removed fields are dropped, indexes on renamed fields are updated to reference the
+// new field names, and indexes on fields with a changed kind are rebuilt from the migrated
+// documents.
+func (db *db) migrateCollectionIndexes(
+	ctx context.Context,
+	txn datastore.Txn,
+	col client.CollectionDescription,
+	previousSchema client.SchemaDescription,
+	newSchema client.SchemaDescription,
+) error {
+	if previousSchema.VersionID == newSchema.VersionID {
+		return nil
+	}
+
+	indexDescriptions, err := db.fetchCollectionIndexDescriptions(ctx, txn, col.Name)
+	if err != nil {
+		return err
+	}
+
+	newFieldsByID := make(map[client.FieldID]client.FieldDescription, len(newSchema.Fields))
+	for _, field := range newSchema.Fields {
+		newFieldsByID[field.ID] = field
+	}
+
+	previousCol := db.newCollection(col, previousSchema)
+	newCol := db.newCollection(col, newSchema)
+
+	for _, indexDesc := range indexDescriptions {
+		var isDropped, isRenamed, isCast bool
+		fields := make([]client.IndexedFieldDescription, len(indexDesc.Fields))
+		for i, indexedField := range indexDesc.Fields {
+			fields[i] = indexedField
+			previousField, ok := previousSchema.GetField(indexedField.Name)
+			if !ok {
+				continue
+			}
+			newField, stillExists := newFieldsByID[previousField.ID]
+			if !stillExists {
+				isDropped = true
+				break
+			}
+			if newField.Name != previousField.Name {
+				fields[i].Name = newField.Name
+				isRenamed = true
+			}
+			if newField.Kind != previousField.Kind {
+				isCast = true
+			}
+		}
+
+		if !isDropped && !isRenamed && !isCast {
+			continue
+		}
+
+		key := core.NewCollectionIndexKey(col.Name, indexDesc.Name)
+		if isDropped || isCast {
+			index, err := NewCollectionIndex(previousCol, indexDesc)
+			if err != nil {
+				return err
+			}
+			err = index.RemoveAll(ctx, txn)
+			if err != nil {
+				return err
+			}
+			err = txn.Systemstore().Delete(ctx, key.ToDS())
+			if err != nil {
+				return err
+			}
+		}
+
+		indexDesc.Fields = fields
+		switch {
+		case isDropped:
+			continue
+
+		case isCast:
+			// The index values are encoded according to the field kind, so the index
+			// must be rebuilt from the migrated documents.
+			indexDesc.ID = 0
+			_, err = newCol.createIndex(ctx, txn, indexDesc)
+			if err != nil {
+				return err
+			}
+
+		default:
+			buf, err := json.Marshal(indexDesc)
+			if err != nil {
+				return err
+			}
+			err = txn.Systemstore().Put(ctx, key.ToDS(), buf)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// indexesReencodedKey marks the index entries of all the collections as written in the current
+// encoding of the indexed values.
+var indexesReencodedKey = ds.NewKey(core.INDEXES_REENCODED)
+
+// reencodeDocumentIndexes replaces the index entries of the given document written in the
+// encoding of the indexed values prior to encodeIndexedValue, in which date times kept their
+// original offset and arbitrary precision numbers were not order preserving.
+//
+// Entries in the previous encoding are neither found by filters nor removed when their document
+// is updated or deleted. Deleted documents have no entries, so they are left as they are.
+func reencodeDocumentIndexes(ctx context.Context, txn datastore.Txn, col *collection, docID string) error {
+	id, err := client.NewDocIDFromString(docID)
+	if err != nil {
+		return err
+	}
+	doc, err := col.get(ctx, txn, col.getPrimaryKeyFromDocID(id), nil, false)
+	if err != nil || doc == nil {
+		return err
+	}
+	err = col.loadIndexes(ctx, txn)
+	if err != nil {
+		return err
+	}
+	for _, index := range col.indexes {
+		err = index.Reencode(ctx, txn, doc)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (c *collection) indexNewDoc(ctx context.Context, txn datastore.Txn, doc *client.Document) error {
 	err := c.loadIndexes(ctx, txn)
 	if err != nil {
@@ -196,11 +332,11 @@ func (c *collection) createIndex(
 		return nil, err
 	}
 
-	colSeq, err := c.db.getSequence(ctx, txn, fmt.Sprintf("%s/%d", core.COLLECTION_INDEX, c.ID()))
+	colSeq, err := base.GetSequence(ctx, txn.Systemstore(), fmt.Sprintf("%s/%d", core.COLLECTION_INDEX, c.ID()))
 	if err != nil {
 		return nil, err
 	}
-	colID, err := colSeq.next(ctx, txn)
+	colID, err := colSeq.Next(ctx, txn.Systemstore())
 	if err != nil {
 		return nil, err
 	}
@@ -407,6 +543,9 @@ func (c *collection) checkExistingFields(
 		found := false
 		for _, colField := range collectionFields {
 			if field.Name == colField.Name {
+				if colField.IsComputed() {
+					return NewErrIndexOnComputedField(field.Name)
+				}
 				found = true
 				break
 			}
@@ -0,0 +1,162 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ipfs/go-cid"
+	"github.com/shopspring/decimal"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/db/fetcher"
+)
+
+// Revert restores the field values of the document with the given ID to those of the given
+// version.
+//
+// The history of the document is left untouched, the restored values are written as new
+// commits on top of the current heads.
+//
+// Fields without a value in the given version, such as those added to the schema after it, are
+// set to nil. Counter fields cannot be unset and are reset to zero instead. The restored values
+// must satisfy the current constraints of their fields, the document is left unchanged if any
+// of them does not.
+func (c *collection) Revert(ctx context.Context, docID client.DocID, version cid.Cid) error {
+	txn, err := c.getTxn(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer c.discardImplicitTxn(ctx, txn)
+
+	versionDocID, _, err := c.getDocumentCommits(ctx, txn, version)
+	if err != nil {
+		return err
+	}
+	if versionDocID != docID.String() {
+		return NewErrRevertDocumentMismatch(docID.String(), version)
+	}
+
+	primaryKey := c.getPrimaryKeyFromDocID(docID)
+	exists, isDeleted, err := c.exists(ctx, txn, primaryKey)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return client.ErrDocumentNotFound
+	}
+	if isDeleted {
+		return NewErrDocumentDeleted(primaryKey.DocID)
+	}
+
+	versionValues, versionDeleted, err := c.getVersion(ctx, txn, versionDocID, version)
+	if err != nil {
+		return err
+	}
+	if versionDeleted {
+		return NewErrRevertToDeletedVersion(version)
+	}
+
+	encodedDoc, err := c.fetch(ctx, txn, c.newFetcher(), primaryKey, nil, false)
+	if err != nil {
+		return err
+	}
+	if encodedDoc == nil {
+		return client.ErrDocumentNotFound
+	}
+	properties, err := encodedDoc.Properties(false)
+	if err != nil {
+		return err
+	}
+	currentValues := map[string]any{}
+	for field, value := range properties {
+		currentValues[field.Name] = value
+	}
+
+	doc, err := fetcher.Decode(encodedDoc, c.Schema())
+	if err != nil {
+		return err
+	}
+
+	fieldDiffs := diffFields(c.Schema(), currentValues, false, versionValues, false)
+	if len(fieldDiffs) == 0 {
+		return c.commitImplicitTxn(ctx, txn)
+	}
+
+	fields := make([]client.FieldDescription, len(fieldDiffs))
+	for i, fieldDiff := range fieldDiffs {
+		field, _ := c.Schema().GetField(fieldDiff.Name)
+		// The constraint of a counter applies to the reverted value, not to the difference
+		// that is written, so every value is validated before the document is changed.
+		err = client.ValidateFieldConstraint(fieldDiff.After, field)
+		if err != nil {
+			return err
+		}
+		fields[i] = field
+	}
+
+	for i, fieldDiff := range fieldDiffs {
+		if fields[i].Typ == client.PN_COUNTER {
+			// counter values are merged by adding the new value to the current one,
+			// so the difference is written instead.
+			err = doc.SetCounterDelta(fieldDiff.Name, counterDifference(fieldDiff.Before, fieldDiff.After))
+		} else {
+			err = doc.Set(fieldDiff.Name, fieldDiff.After)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	err = c.update(ctx, txn, doc)
+	if err != nil {
+		return err
+	}
+
+	return c.commitImplicitTxn(ctx, txn)
+}
+
+// counterDifference returns the value that must be added to the current counter value to
+// obtain the target value.
+//
+// A nil target value is treated as zero.
+func counterDifference(current any, target any) any {
+	switch t := target.(type) {
+	case float64:
+		c, _ := current.(float64)
+		return t - c
+	case int64:
+		c, _ := current.(int64)
+		return t - c
+	case *big.Int:
+		c, ok := current.(*big.Int)
+		if !ok {
+			return t
+		}
+		return new(big.Int).Sub(t, c)
+	case decimal.Decimal:
+		c, _ := current.(decimal.Decimal)
+		return t.Sub(c)
+	default:
+		switch c := current.(type) {
+		case float64:
+			return -c
+		case int64:
+			return -c
+		case *big.Int:
+			return new(big.Int).Neg(c)
+		case decimal.Decimal:
+			return c.Neg()
+		}
+		return nil
+	}
+}
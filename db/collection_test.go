@@ -15,6 +15,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
 )
 
 func TestGetCollectionByNameReturnsErrorGivenNonExistantCollection(t *testing.T) {
@@ -34,3 +37,71 @@ func TestGetCollectionByNameReturnsErrorGivenEmptyString(t *testing.T) {
 	_, err = db.GetCollectionByName(ctx, "")
 	assert.EqualError(t, err, "collection name can't be empty")
 }
+
+func TestGetAllCollections_WithManyToManyRelation_ExcludesLinkCollection(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.AddSchema(ctx, `
+		type Post {
+			name: String
+			tags: [Tag]
+		}
+		type Tag {
+			name: String
+			posts: [Post]
+		}
+	`)
+	require.NoError(t, err)
+
+	cols, err := db.GetAllCollections(ctx)
+	require.NoError(t, err)
+	names := []string{}
+	for _, col := range cols {
+		names = append(names, col.Name())
+	}
+	assert.ElementsMatch(t, []string{"Post", "Tag"}, names)
+
+	linkCol, err := db.GetCollectionByName(ctx, "_post_tag")
+	require.NoError(t, err)
+	assert.True(t, linkCol.Description().Internal)
+}
+
+func TestUpdateWithFilter_WithFieldDefaultValue_DoesNotSetDefault(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.AddSchema(ctx, `type Users {
+		name: String
+		verified: Boolean
+	}`)
+	require.NoError(t, err)
+	col, err := db.GetCollectionByName(ctx, "Users")
+	require.NoError(t, err)
+	doc, err := client.NewDocFromJSON([]byte(`{"name": "John"}`), col.Schema())
+	require.NoError(t, err)
+	err = col.Create(ctx, doc)
+	require.NoError(t, err)
+
+	err = db.PatchSchema(
+		ctx,
+		`[{"op": "add", "path": "/Users/Fields/-", "value": {"Name": "age", "Kind": "Int", "DefaultValue": "18"}}]`,
+		true,
+	)
+	require.NoError(t, err)
+	col, err = db.GetCollectionByName(ctx, "Users")
+	require.NoError(t, err)
+
+	result, err := col.UpdateWithFilter(ctx, `{name: {_eq: "John"}}`, `{"verified": true}`)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), result.Count)
+
+	updated, err := col.Get(ctx, doc.ID(), false)
+	require.NoError(t, err)
+	_, err = updated.Get("age")
+	require.ErrorIs(t, err, client.ErrFieldNotExist)
+}
@@ -18,15 +18,18 @@ import (
 	"context"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	blockstore "github.com/ipfs/boxo/blockstore"
 	ds "github.com/ipfs/go-datastore"
 	dsq "github.com/ipfs/go-datastore/query"
+	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/sourcenetwork/immutable"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/core"
 	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/db/base"
 	"github.com/sourcenetwork/defradb/errors"
 	"github.com/sourcenetwork/defradb/events"
 	"github.com/sourcenetwork/defradb/lens"
@@ -66,6 +69,50 @@ type db struct {
 	// The maximum number of cached migrations instances to preserve per schema version.
 	lensPoolSize immutable.Option[int]
 
+	// Whether the wall-clock time is recorded in new commits.
+	commitTimestamps bool
+
+	// The author recorded in new commits, if any.
+	commitAuthor immutable.Option[string]
+
+	// The key used to sign new commits, if any.
+	commitSigningKey crypto.PrivKey
+
+	// The policy used to verify the signature of the commits read from the DAG.
+	signaturePolicy core.SignaturePolicy
+
+	// The interval at which expired documents are deleted.
+	ttlSweepInterval immutable.Option[time.Duration]
+
+	// The duration after which expired documents created by other nodes are deleted.
+	ttlGracePeriod immutable.Option[time.Duration]
+
+	// Closed to stop the deletion of expired documents, and closed by it once stopped.
+	ttlSweeperLock sync.Mutex
+	ttlSweeperStop chan struct{}
+	ttlSweeperDone chan struct{}
+
+	// The IDs of the committed transactions whose pending changes have yet to be appended to the
+	// change logs of their collections, in commit order.
+	committedTxnsLock sync.Mutex
+	committedTxns     []uint64
+
+	// Signalled when a transaction is committed, for its pending changes to be sequenced by the
+	// change sequencer, which closes changeSequencerDone once stopped.
+	changeSequencerWake  chan struct{}
+	changeSequencerFlush chan chan error
+	changeSequencerStop  chan struct{}
+	changeSequencerDone  chan struct{}
+
+	// Cancels the background upgrade of the data written by previous versions of the database,
+	// which closes dataUpgradesDone once stopped.
+	dataUpgradesCancel context.CancelFunc
+	dataUpgradesDone   chan struct{}
+
+	// The relation fields with on-delete options of the active collections, by the name of the
+	// schema that they reference.  Set whenever the schema is loaded.
+	relationReferences atomic.Pointer[map[string][]base.RelationReference]
+
 	// The options used to init the database
 	options any
 
@@ -81,9 +128,15 @@ const updateEventBufferSize = 100
 // WithUpdateEvents enables the update events channel.
 func WithUpdateEvents() Option {
 	return func(db *db) {
-		db.events = events.Events{
-			Updates: immutable.Some(events.New[events.Update](0, updateEventBufferSize)),
-		}
+		db.events.Updates = immutable.Some(events.New[events.Update](0, updateEventBufferSize))
+	}
+}
+
+// WithViolationEvents enables the violation events channel, onto which the blocks merged from
+// peers that do not satisfy the schema of their document are reported.
+func WithViolationEvents() Option {
+	return func(db *db) {
+		db.events.Violations = immutable.Some(events.New[events.Violation](0, updateEventBufferSize))
 	}
 }
 
@@ -103,6 +156,66 @@ func WithLensPoolSize(num int) Option {
 	}
 }
 
+// WithCommitTimestamps enables the recording of the wall-clock time in new commits.
+//
+// The timestamp is part of the commit blocks, and thus of their CIDs, meaning that identical
+// documents created on different nodes will no longer share the same initial commit.
+func WithCommitTimestamps() Option {
+	return func(db *db) {
+		db.commitTimestamps = true
+	}
+}
+
+// WithCommitAuthor sets the author, typically the peer ID of the node, recorded in new commits.
+//
+// The author is part of the commit blocks, and thus of their CIDs.
+func WithCommitAuthor(author string) Option {
+	return func(db *db) {
+		db.commitAuthor = immutable.Some(author)
+	}
+}
+
+// WithCommitSigningKey sets the key, typically the libp2p identity key of the node, used to
+// sign new commits.
+//
+// The signature and the public key of the signer are part of the commit blocks, and thus of
+// their CIDs.
+func WithCommitSigningKey(key crypto.PrivKey) Option {
+	return func(db *db) {
+		db.commitSigningKey = key
+	}
+}
+
+// WithSignaturePolicy sets the policy used to verify the signature of the commits read when
+// fetching previous document versions.
+func WithSignaturePolicy(policy core.SignaturePolicy) Option {
+	return func(db *db) {
+		db.signaturePolicy = policy
+	}
+}
+
+// WithTTLSweepInterval sets the interval at which the expired documents of the collections with
+// a TTL are deleted.
+//
+// Will default to `1m` if not set, a non-positive interval disables the deletion. The deletion
+// only runs once a collection with a TTL exists.
+func WithTTLSweepInterval(interval time.Duration) Option {
+	return func(db *db) {
+		db.ttlSweepInterval = immutable.Some(interval)
+	}
+}
+
+// WithTTLGracePeriod sets the duration for which documents created by other nodes must have been
+// expired before this node deletes them.
+//
+// Expired documents are normally deleted by the node that created them, the grace period allows
+// the other nodes to delete them should that node be unavailable. Will default to `1h` if not set.
+func WithTTLGracePeriod(period time.Duration) Option {
+	return func(db *db) {
+		db.ttlGracePeriod = immutable.Some(period)
+	}
+}
+
 // NewDB creates a new instance of the DB using the given options.
 func NewDB(ctx context.Context, rootstore datastore.RootStore, options ...Option) (client.DB, error) {
 	return newDB(ctx, rootstore, options...)
@@ -123,6 +236,9 @@ func newDB(ctx context.Context, rootstore datastore.RootStore, options ...Option
 
 		parser:  parser,
 		options: options,
+
+		changeSequencerWake:  make(chan struct{}, 1),
+		changeSequencerFlush: make(chan chan error),
 	}
 
 	// apply options
@@ -142,19 +258,50 @@ func newDB(ctx context.Context, rootstore datastore.RootStore, options ...Option
 		return nil, err
 	}
 
+	// Changes may be left pending if the node stopped before they could be sequenced.
+	err = db.sequencePendingChanges(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db.startChangeSequencer()
+	db.startDataUpgrades()
+
+	err = db.startTTLSweeperIfNeeded(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &implicitTxnDB{db}, nil
 }
 
 // NewTxn creates a new transaction.
 func (db *db) NewTxn(ctx context.Context, readonly bool) (datastore.Txn, error) {
 	txnId := db.previousTxnID.Add(1)
-	return datastore.NewTxnFrom(ctx, db.rootstore, txnId, readonly)
+	txn, err := datastore.NewTxnFrom(ctx, db.rootstore, txnId, readonly)
+	if err != nil {
+		return nil, err
+	}
+	if !readonly {
+		txn.OnSuccess(func() {
+			db.queueCommittedTxn(txnId)
+		})
+	}
+	return txn, nil
 }
 
 // NewConcurrentTxn creates a new transaction that supports concurrent API calls.
 func (db *db) NewConcurrentTxn(ctx context.Context, readonly bool) (datastore.Txn, error) {
 	txnId := db.previousTxnID.Add(1)
-	return datastore.NewConcurrentTxnFrom(ctx, db.rootstore, txnId, readonly)
+	txn, err := datastore.NewConcurrentTxnFrom(ctx, db.rootstore, txnId, readonly)
+	if err != nil {
+		return nil, err
+	}
+	if !readonly {
+		txn.OnSuccess(func() {
+			db.queueCommittedTxn(txnId)
+		})
+	}
+	return txn, nil
 }
 
 // WithTxn returns a new [client.Store] that respects the given transaction.
@@ -226,7 +373,7 @@ func (db *db) initialize(ctx context.Context) error {
 
 	// init meta data
 	// collection sequence
-	_, err = db.getSequence(ctx, txn, core.COLLECTION)
+	_, err = base.GetSequence(ctx, txn.Systemstore(), core.COLLECTION)
 	if err != nil {
 		return err
 	}
@@ -236,6 +383,18 @@ func (db *db) initialize(ctx context.Context) error {
 		return err
 	}
 
+	// new databases record the commit history from the start
+	err = txn.Systemstore().Put(ctx, commitHistoryBackfilledKey, []byte{1})
+	if err != nil {
+		return err
+	}
+
+	// new databases write their index entries in the current encoding from the start
+	err = txn.Systemstore().Put(ctx, indexesReencodedKey, []byte{1})
+	if err != nil {
+		return err
+	}
+
 	return txn.Commit(ctx)
 }
 
@@ -262,9 +421,15 @@ func (db *db) PrintDump(ctx context.Context) error {
 // This is the place for any last minute cleanup or releasing of resources (i.e.: Badger instance).
 func (db *db) Close() {
 	log.Info(context.Background(), "Closing DefraDB process...")
+	db.stopTTLSweeper()
+	db.stopDataUpgrades()
+	db.stopChangeSequencer()
 	if db.events.Updates.HasValue() {
 		db.events.Updates.Value().Close()
 	}
+	if db.events.Violations.HasValue() {
+		db.events.Violations.Value().Close()
+	}
 
 	err := db.rootstore.Close()
 	if err != nil {
@@ -291,3 +456,27 @@ func printStore(ctx context.Context, store datastore.DSReaderWriter) error {
 
 	return results.Close()
 }
+
+// withCommitMetadata returns a context holding the metadata to record in the commits created
+// with it, if the recording of any metadata is enabled, and the key to sign them with, if any.
+func (db *db) withCommitMetadata(ctx context.Context) context.Context {
+	if db.commitSigningKey != nil {
+		ctx = core.ContextWithCommitSigningKey(ctx, db.commitSigningKey)
+	}
+	if !db.commitTimestamps && !db.commitAuthor.HasValue() {
+		return ctx
+	}
+	metadata := core.CommitMetadata{
+		Author: db.commitAuthor.Value(),
+	}
+	if db.commitTimestamps {
+		metadata.Timestamp = time.Now().UnixNano()
+	}
+	return core.ContextWithCommitMetadata(ctx, metadata)
+}
+
+// withSignaturePolicy returns a context holding the policy used to verify the signature of
+// the commits read with it.
+func (db *db) withSignaturePolicy(ctx context.Context) context.Context {
+	return core.ContextWithSignaturePolicy(ctx, db.signaturePolicy)
+}
@@ -15,17 +15,28 @@ import (
 	"testing"
 
 	badger "github.com/sourcenetwork/badger/v4"
+	"github.com/stretchr/testify/require"
 
 	badgerds "github.com/sourcenetwork/defradb/datastore/badger/v4"
 )
 
-func newMemoryDB(ctx context.Context) (*implicitTxnDB, error) {
+func newMemoryDB(ctx context.Context, options ...Option) (*implicitTxnDB, error) {
 	opts := badgerds.Options{Options: badger.DefaultOptions("").WithInMemory(true)}
 	rootstore, err := badgerds.NewDatastore("", &opts)
 	if err != nil {
 		return nil, err
 	}
-	return newDB(ctx, rootstore)
+	return newDB(ctx, rootstore, options...)
+}
+
+// execTestRequest executes the given request on the given database, requiring it to succeed,
+// and returns the resulting documents.
+func execTestRequest(t *testing.T, db *implicitTxnDB, request string) []map[string]any {
+	result := db.ExecRequest(context.Background(), request)
+	require.Empty(t, result.GQL.Errors)
+	docs, ok := result.GQL.Data.([]map[string]any)
+	require.True(t, ok)
+	return docs
 }
 
 func TestNewDB(t *testing.T) {
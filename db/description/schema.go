@@ -17,6 +17,7 @@ import (
 	"github.com/ipfs/go-datastore/query"
 
 	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
 	"github.com/sourcenetwork/defradb/core"
 	"github.com/sourcenetwork/defradb/core/cid"
 	"github.com/sourcenetwork/defradb/datastore"
@@ -30,11 +31,26 @@ func CreateSchemaVersion(
 	txn datastore.Txn,
 	desc client.SchemaDescription,
 ) (client.SchemaDescription, error) {
-	for i := range desc.Fields {
-		// This is not wonderful and will probably break when we add the ability
-		// to delete fields, however it is good enough for now and matches the
-		// create behaviour.
-		desc.Fields[i].ID = client.FieldID(i)
+	isNew := desc.Root == ""
+
+	if isNew {
+		for i := range desc.Fields {
+			desc.Fields[i].ID = client.FieldID(i)
+		}
+	} else {
+		// Fields may have been removed from previous versions, so new fields are given IDs
+		// greater than any ID ever used by this schema.  This ensures that values written to
+		// a removed field are never read as the value of a new field.
+		nextID, err := getNextFieldID(ctx, txn, desc)
+		if err != nil {
+			return client.SchemaDescription{}, err
+		}
+		for i, field := range desc.Fields {
+			if field.ID == 0 && field.Name != request.DocIDFieldName {
+				desc.Fields[i].ID = nextID
+				nextID++
+			}
+		}
 	}
 
 	buf, err := json.Marshal(desc)
@@ -48,7 +64,6 @@ func CreateSchemaVersion(
 	}
 	versionID := scid.String()
 	previousSchemaVersionID := desc.VersionID
-	isNew := desc.Root == ""
 
 	desc.VersionID = versionID
 	if isNew {
@@ -80,6 +95,29 @@ func CreateSchemaVersion(
 	return desc, nil
 }
 
+// getNextFieldID returns the next unused field ID across all versions of the given schema.
+func getNextFieldID(
+	ctx context.Context,
+	txn datastore.Txn,
+	desc client.SchemaDescription,
+) (client.FieldID, error) {
+	schemas, err := GetSchemasByRoot(ctx, txn, desc.Root)
+	if err != nil {
+		return 0, err
+	}
+
+	var nextID client.FieldID
+	for _, schema := range append(schemas, desc) {
+		for _, field := range schema.Fields {
+			if field.ID >= nextID {
+				nextID = field.ID + 1
+			}
+		}
+	}
+
+	return nextID, nil
+}
+
 // GetSchemaVersion returns the schema description for the schema version of the
 // ID provided.
 //
@@ -11,6 +11,9 @@
 package db
 
 import (
+	"github.com/ipfs/go-cid"
+	"github.com/shopspring/decimal"
+
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/errors"
 )
@@ -57,6 +60,7 @@ const (
 	errInvalidStoredIndex                 string = "invalid stored index"
 	errInvalidStoredIndexKey              string = "invalid stored index key"
 	errNonExistingFieldForIndex           string = "creating an index on a non-existing property"
+	errIndexOnComputedField               string = "creating an index on a computed field is not supported"
 	errCollectionDoesntExisting           string = "collection with given name doesn't exist"
 	errFailedToStoreIndexedField          string = "failed to store indexed field"
 	errFailedToReadStoredIndexDesc        string = "failed to read stored index description"
@@ -88,6 +92,31 @@ const (
 	errIndexDoesNotMatchName              string = "the index used does not match the given name"
 	errCanNotIndexNonUniqueField          string = "can not index a doc's field that violates unique index"
 	errInvalidViewQuery                   string = "the query provided is not valid as a View"
+	errDuplicateEnum                      string = "duplicate enum"
+	errEnumHasNoValues                    string = "enum must define at least one value"
+	errInvalidEnumValueName               string = "invalid enum value name"
+	errDuplicateEnumValue                 string = "duplicate enum value"
+	errCannotMutateEnumValues             string = "removing or reordering existing enum values is not supported"
+	errCannotDeleteEnum                   string = "deleting an existing enum is not supported"
+	errConstraintNotSupportedForField     string = "constraint is not supported for the field"
+	errConstraintMinGreaterThanMax        string = "constraint min must not be greater than max"
+	errConstraintNegativeMaxLength        string = "constraint maxLength must not be negative"
+	errInvalidDefaultValue                string = "invalid default value for field"
+	errInvalidMigrationBatchSize          string = "invalid document migration batch size"
+	errInvalidMigrationBatchLimit         string = "invalid document migration batch limit"
+	errInvalidComputedExpression          string = "invalid computed expression for field"
+	errComputedFieldKindNotSupported      string = "computed fields must be of kind Int, Float, BigInt, Decimal or String"
+	errComputedFieldWithConstraint        string = "computed fields may not be non-null, nor have a default value or constraint"
+	errInvalidComputedFieldReference      string = "invalid field reference in computed expression"
+	errRelationOptionsOnSecondary         string = "relation options may only be set on the primary side of a relation"
+	errInvalidRelationOnDelete            string = "invalid relation onDelete option"
+	errNotDocumentCommit                  string = "the given CID is not a document commit of the collection"
+	errDiffDocumentMismatch               string = "the given commits do not belong to the same document"
+	errRevertDocumentMismatch             string = "the given commit does not belong to the document"
+	errRevertToDeletedVersion             string = "cannot revert a document to a deleted version"
+	errPurgeDocumentNotDeleted            string = "only deleted documents can be purged"
+	errInvalidChangeCursor                string = "invalid change cursor"
+	errInvalidExpiryTime                  string = "invalid expiry time"
 )
 
 var (
@@ -99,7 +128,6 @@ var (
 	ErrSchemaNameEmpty                = errors.New("schema name can't be empty")
 	ErrSchemaRootEmpty                = errors.New("schema root can't be empty")
 	ErrSchemaVersionIDEmpty           = errors.New("schema version ID can't be empty")
-	ErrKeyEmpty                       = errors.New("key cannot be empty")
 	ErrCannotSetVersionID             = errors.New(errCannotSetVersionID)
 	ErrIndexMissingFields             = errors.New(errIndexMissingFields)
 	ErrIndexFieldMissingName          = errors.New(errIndexFieldMissingName)
@@ -108,6 +136,13 @@ var (
 	ErrExpectedJSONObject             = errors.New(errExpectedJSONObject)
 	ErrExpectedJSONArray              = errors.New(errExpectedJSONArray)
 	ErrInvalidViewQuery               = errors.New(errInvalidViewQuery)
+	ErrNotDocumentCommit              = errors.New(errNotDocumentCommit)
+	ErrDiffDocumentMismatch           = errors.New(errDiffDocumentMismatch)
+	ErrRevertDocumentMismatch         = errors.New(errRevertDocumentMismatch)
+	ErrRevertToDeletedVersion         = errors.New(errRevertToDeletedVersion)
+	ErrPurgeDocumentNotDeleted        = errors.New(errPurgeDocumentNotDeleted)
+	ErrInvalidChangeCursor            = errors.New(errInvalidChangeCursor)
+	ErrInvalidExpiryTime              = errors.New(errInvalidExpiryTime)
 )
 
 // NewErrFailedToGetHeads returns a new error indicating that the heads of a document
@@ -140,6 +175,12 @@ func NewErrNonExistingFieldForIndex(field string) error {
 	return errors.New(errNonExistingFieldForIndex, errors.NewKV("Field", field))
 }
 
+// NewErrIndexOnComputedField returns a new error indicating the attempt to create an index
+// on a computed field.
+func NewErrIndexOnComputedField(field string) error {
+	return errors.New(errIndexOnComputedField, errors.NewKV("Field", field))
+}
+
 // NewErrCanNotReadCollection returns a new error indicating the collection doesn't exist.
 func NewErrCanNotReadCollection(colName string, inner error) error {
 	return errors.Wrap(errCollectionDoesntExisting, inner, errors.NewKV("Collection", colName))
@@ -381,6 +422,114 @@ func NewErrCannotDeleteField(name string, id client.FieldID) error {
 	)
 }
 
+func NewErrDuplicateEnum(name string) error {
+	return errors.New(errDuplicateEnum, errors.NewKV("Name", name))
+}
+
+func NewErrEnumHasNoValues(name string) error {
+	return errors.New(errEnumHasNoValues, errors.NewKV("Name", name))
+}
+
+func NewErrInvalidEnumValueName(name string, value string) error {
+	return errors.New(
+		errInvalidEnumValueName,
+		errors.NewKV("Name", name),
+		errors.NewKV("Value", value),
+	)
+}
+
+func NewErrDuplicateEnumValue(name string, value string) error {
+	return errors.New(
+		errDuplicateEnumValue,
+		errors.NewKV("Name", name),
+		errors.NewKV("Value", value),
+	)
+}
+
+func NewErrCannotMutateEnumValues(name string) error {
+	return errors.New(errCannotMutateEnumValues, errors.NewKV("Name", name))
+}
+
+func NewErrCannotDeleteEnum(name string) error {
+	return errors.New(errCannotDeleteEnum, errors.NewKV("Name", name))
+}
+
+func NewErrConstraintNotSupportedForField(fieldName string, constraint string, kind client.FieldKind) error {
+	return errors.New(
+		errConstraintNotSupportedForField,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Constraint", constraint),
+		errors.NewKV("Kind", kind),
+	)
+}
+
+func NewErrConstraintMinGreaterThanMax(fieldName string, min decimal.Decimal, max decimal.Decimal) error {
+	return errors.New(
+		errConstraintMinGreaterThanMax,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Min", min),
+		errors.NewKV("Max", max),
+	)
+}
+
+func NewErrConstraintNegativeMaxLength(fieldName string, maxLength int) error {
+	return errors.New(
+		errConstraintNegativeMaxLength,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("MaxLength", maxLength),
+	)
+}
+
+func NewErrInvalidDefaultValue(fieldName string, value string, inner error) error {
+	return errors.Wrap(
+		errInvalidDefaultValue,
+		inner,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Value", value),
+	)
+}
+
+func NewErrInvalidComputedExpression(fieldName string, expression string, inner error) error {
+	return errors.Wrap(
+		errInvalidComputedExpression,
+		inner,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Expression", expression),
+	)
+}
+
+func NewErrComputedFieldKindNotSupported(fieldName string, kind client.FieldKind) error {
+	return errors.New(
+		errComputedFieldKindNotSupported,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Kind", kind),
+	)
+}
+
+func NewErrComputedFieldWithConstraint(fieldName string) error {
+	return errors.New(errComputedFieldWithConstraint, errors.NewKV("Field", fieldName))
+}
+
+func NewErrInvalidComputedFieldReference(fieldName string, reference string) error {
+	return errors.New(
+		errInvalidComputedFieldReference,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Reference", reference),
+	)
+}
+
+func NewErrRelationOptionsOnSecondary(fieldName string) error {
+	return errors.New(errRelationOptionsOnSecondary, errors.NewKV("Field", fieldName))
+}
+
+func NewErrInvalidRelationOnDelete(fieldName string, onDelete client.RelationOnDelete) error {
+	return errors.New(
+		errInvalidRelationOnDelete,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("OnDelete", onDelete),
+	)
+}
+
 func NewErrDocumentAlreadyExists(docID string) error {
 	return errors.New(
 		errDocumentAlreadyExists,
@@ -589,3 +738,56 @@ func NewErrInvalidViewQueryMissingQuery() error {
 		errors.NewKV("Reason", "No query provided"),
 	)
 }
+
+// NewErrInvalidMigrationBatchSize returns an error indicating that the given document
+// migration batch size is negative.
+func NewErrInvalidMigrationBatchSize(batchSize int) error {
+	return errors.New(errInvalidMigrationBatchSize, errors.NewKV("BatchSize", batchSize))
+}
+
+// NewErrInvalidMigrationBatchLimit returns an error indicating that the given document
+// migration batch limit is negative.
+func NewErrInvalidMigrationBatchLimit(batchLimit int) error {
+	return errors.New(errInvalidMigrationBatchLimit, errors.NewKV("BatchLimit", batchLimit))
+}
+
+// NewErrNotDocumentCommit returns an error indicating that the given CID is not that of a
+// document (composite) commit of the collection.
+func NewErrNotDocumentCommit(c cid.Cid) error {
+	return errors.New(errNotDocumentCommit, errors.NewKV("CID", c))
+}
+
+// NewErrDiffDocumentMismatch returns an error indicating that the given commits, to diff,
+// belong to different documents.
+func NewErrDiffDocumentMismatch(from cid.Cid, to cid.Cid) error {
+	return errors.New(errDiffDocumentMismatch, errors.NewKV("From", from), errors.NewKV("To", to))
+}
+
+// NewErrRevertDocumentMismatch returns an error indicating that the given commit, to revert to,
+// belongs to a different document.
+func NewErrRevertDocumentMismatch(docID string, version cid.Cid) error {
+	return errors.New(errRevertDocumentMismatch, errors.NewKV("DocID", docID), errors.NewKV("CID", version))
+}
+
+// NewErrRevertToDeletedVersion returns an error indicating that the given commit, to revert to,
+// is that of a deleted document.
+func NewErrRevertToDeletedVersion(version cid.Cid) error {
+	return errors.New(errRevertToDeletedVersion, errors.NewKV("CID", version))
+}
+
+// NewErrPurgeDocumentNotDeleted returns an error indicating that the given document cannot be
+// purged as it has not been deleted.
+func NewErrPurgeDocumentNotDeleted(docID string) error {
+	return errors.New(errPurgeDocumentNotDeleted, errors.NewKV("DocID", docID))
+}
+
+// NewErrInvalidChangeCursor returns an error indicating that the given change cursor is invalid.
+func NewErrInvalidChangeCursor(cursor string) error {
+	return errors.New(errInvalidChangeCursor, errors.NewKV("Cursor", cursor))
+}
+
+// NewErrInvalidExpiryTime returns an error indicating that the given indexed expiry time of a
+// document is not a date time.
+func NewErrInvalidExpiryTime(value any) error {
+	return errors.New(errInvalidExpiryTime, errors.NewKV("Value", value))
+}
@@ -0,0 +1,551 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package fetcher
+
+import (
+	"context"
+	"math"
+	"time"
+
+	dag "github.com/ipfs/boxo/ipld/merkledag"
+	"github.com/ipfs/go-cid"
+	dsq "github.com/ipfs/go-datastore/query"
+	ipld "github.com/ipfs/go-ipld-format"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/connor"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/core/crdt"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/datastore/memory"
+	"github.com/sourcenetwork/defradb/db/base"
+	"github.com/sourcenetwork/defradb/merkle/clock"
+	merklecrdt "github.com/sourcenetwork/defradb/merkle/crdt"
+	"github.com/sourcenetwork/defradb/planner/mapper"
+)
+
+var (
+	// interface check
+	_ Fetcher = (*AsOfFetcher)(nil)
+)
+
+// AsOfFetcher is like the normal DocumentFetcher, except it returns the documents of a
+// collection as they were at a given point in time.
+//
+// The state of each document is recomposed, within a transient store, from the document
+// commits that had been applied by this node at that time, as recorded in the commit history
+// of the collection. Documents without any commits at that time are not returned.
+//
+// The clock by which commits are timed is given along with the point in time:
+//   - With the applied clock, the time of a commit is the local time at which this node applied
+//     it, as recorded by RecordCommit. Commits received from peers are timed by their arrival, so
+//     the same point in time may yield different states on different nodes, and changes to the
+//     local clock are reflected in the results.
+//   - With the committed clock, the time of a commit is the timestamp recorded in it by the node
+//     creating it, so the same point in time yields the same state on every node that applied
+//     the same commits. Commits without a timestamp are only included if a later commit that is
+//     included follows them.
+//
+// Only the documents within the requested spans, or those the filter restricts the _docID
+// to, are recomposed. Without such restrictions every document of the collection with commits
+// at that time is recomposed.
+//
+// Unlike the VersionedFetcher it is not limited to a single document, and the recomposed
+// documents are kept for the lifetime of the fetcher so that they may be fetched again, for
+// example as the related documents of many parent documents.
+type AsOfFetcher struct {
+	// embed the regular doc fetcher
+	*DocumentFetcher
+
+	asOf request.AsOfTime
+
+	txn    datastore.Txn
+	col    client.Collection
+	filter *mapper.Filter
+
+	// Transient state store
+	root  datastore.RootStore
+	store datastore.Txn
+
+	// recomposed holds the IDs of the documents whose state has been recomposed,
+	// recomposedAll is true if the state of all the documents of the collection has.
+	recomposed    map[string]struct{}
+	recomposedAll bool
+}
+
+// NewAsOfFetcher returns a new AsOfFetcher returning documents as they were at the given time.
+func NewAsOfFetcher(asOf request.AsOfTime) *AsOfFetcher {
+	return &AsOfFetcher{
+		asOf:       asOf,
+		recomposed: map[string]struct{}{},
+	}
+}
+
+// Init initializes the AsOfFetcher.
+func (f *AsOfFetcher) Init(
+	ctx context.Context,
+	txn datastore.Txn,
+	col client.Collection,
+	fields []client.FieldDescription,
+	filter *mapper.Filter,
+	docmapper *core.DocumentMapping,
+	reverse bool,
+	showDeleted bool,
+) error {
+	f.txn = txn
+	f.col = col
+	f.filter = filter
+
+	if f.root == nil {
+		root := memory.NewDatastore(ctx)
+		store, err := datastore.NewTxnFrom(ctx, root, txn.ID(), false)
+		if err != nil {
+			return err
+		}
+		f.root = root
+		f.store = store
+		f.DocumentFetcher = new(DocumentFetcher)
+	}
+
+	return f.DocumentFetcher.Init(ctx, f.store, col, fields, filter, docmapper, reverse, showDeleted)
+}
+
+// Start recomposes the state of the documents within the given spans, and starts fetching them.
+func (f *AsOfFetcher) Start(ctx context.Context, spans core.Spans) error {
+	if f.col == nil {
+		return client.NewErrUninitializeProperty("AsOfFetcher", "CollectionDescription")
+	}
+
+	docIDs := map[string]struct{}{}
+	all := !spans.HasValue || len(spans.Value) == 0
+	for _, span := range spans.Value {
+		if span.Start().DocID == "" {
+			all = true
+			break
+		}
+		if _, exists := f.recomposed[span.Start().DocID]; !exists {
+			docIDs[span.Start().DocID] = struct{}{}
+		}
+	}
+	if all && f.filter != nil {
+		if filtered, ok := filteredDocIDs(f.filter.Conditions); ok {
+			all = false
+			for docID := range filtered {
+				if _, exists := f.recomposed[docID]; !exists {
+					docIDs[docID] = struct{}{}
+				}
+			}
+		}
+	}
+
+	if !f.recomposedAll && (all || len(docIDs) > 0) {
+		commits, err := f.getCommits(ctx, docIDs, all)
+		if err != nil {
+			return err
+		}
+		for docID, heads := range commits {
+			if _, exists := f.recomposed[docID]; exists {
+				continue
+			}
+			if err := f.recompose(ctx, docID, heads); err != nil {
+				return err
+			}
+		}
+		for docID := range docIDs {
+			f.recomposed[docID] = struct{}{}
+		}
+		for docID := range commits {
+			f.recomposed[docID] = struct{}{}
+		}
+		f.recomposedAll = f.recomposedAll || all
+	}
+
+	return f.DocumentFetcher.Start(ctx, spans)
+}
+
+// getCommits returns the document commits of the collection made by the fetcher's time,
+// according to the fetcher's clock, grouped by document ID.
+//
+// If all is false only the commits of the given documents are returned, they are found through
+// the history of each document instead of the history of the whole collection.
+func (f *AsOfFetcher) getCommits(
+	ctx context.Context,
+	docIDs map[string]struct{},
+	all bool,
+) (map[string][]cid.Cid, error) {
+	commits := map[string][]cid.Cid{}
+	if f.asOf.Time.Before(time.Unix(0, 0)) {
+		// commits are never recorded, nor timestamped, before the epoch
+		return commits, nil
+	}
+	asOf := f.asOf.Time.UnixNano()
+	if f.asOf.Time.After(time.Unix(0, math.MaxInt64)) {
+		asOf = math.MaxInt64
+	}
+	appliedBy := asOf
+	if f.asOf.Clock == request.CommittedClock {
+		// commits may have been applied at any time after they were made
+		appliedBy = math.MaxInt64
+	}
+
+	if !all {
+		for docID := range docIDs {
+			if err := f.getDocumentCommits(ctx, docID, appliedBy, commits); err != nil {
+				return nil, err
+			}
+		}
+		return f.filterCommits(ctx, commits, asOf)
+	}
+
+	prefix := core.NewCommitHistoryKey(f.col.ID(), 0, "", "")
+	results, err := f.txn.Systemstore().Query(ctx, dsq.Query{
+		Prefix: prefix.ToString(),
+		Orders: []dsq.Order{dsq.OrderByKey{}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = results.Close()
+	}()
+
+	for result := range results.Next() {
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		key, err := core.NewCommitHistoryKeyFromString(result.Key)
+		if err != nil {
+			return nil, err
+		}
+		if key.Timestamp > appliedBy {
+			break
+		}
+		commit, err := cid.Cast(result.Value)
+		if err != nil {
+			return nil, err
+		}
+		commits[key.DocID] = append(commits[key.DocID], commit)
+	}
+	return f.filterCommits(ctx, commits, asOf)
+}
+
+// filterCommits removes from the given commits those that have not been made by the given time,
+// in nanoseconds since the Unix epoch, if the fetcher's clock is the committed clock.
+//
+// The commits are otherwise timed by the time at which they were applied, and have already
+// been selected by it.
+func (f *AsOfFetcher) filterCommits(
+	ctx context.Context,
+	commits map[string][]cid.Cid,
+	asOf int64,
+) (map[string][]cid.Cid, error) {
+	if f.asOf.Clock != request.CommittedClock {
+		return commits, nil
+	}
+
+	for docID, docCommits := range commits {
+		committed := []cid.Cid{}
+		for _, commit := range docCommits {
+			block, err := f.txn.DAGstore().Get(ctx, commit)
+			if err != nil {
+				return nil, err
+			}
+			nd, err := dag.DecodeProtobufBlock(block)
+			if err != nil {
+				return nil, err
+			}
+			delta, err := crdt.CompositeDAG{}.DeltaDecode(nd)
+			if err != nil {
+				return nil, err
+			}
+			compositeDelta, ok := delta.(*crdt.CompositeDAGDelta)
+			if ok && compositeDelta.Timestamp > 0 && compositeDelta.Timestamp <= asOf {
+				committed = append(committed, commit)
+			}
+		}
+		if len(committed) == 0 {
+			delete(commits, docID)
+		} else {
+			commits[docID] = committed
+		}
+	}
+	return commits, nil
+}
+
+// getDocumentCommits adds the commits of the given document applied by this node to the
+// collection by the given time, in nanoseconds since the Unix epoch, to the given commits.
+func (f *AsOfFetcher) getDocumentCommits(
+	ctx context.Context,
+	docID string,
+	asOf int64,
+	commits map[string][]cid.Cid,
+) error {
+	results, err := f.txn.Systemstore().Query(ctx, dsq.Query{
+		Prefix: core.NewDocumentHistoryKey(docID, "").ToString(),
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = results.Close()
+	}()
+
+	for result := range results.Next() {
+		if result.Error != nil {
+			return result.Error
+		}
+		key, err := core.NewCommitHistoryKeyFromString(string(result.Value))
+		if err != nil {
+			return err
+		}
+		if key.CollectionID != f.col.ID() || key.Timestamp > asOf {
+			continue
+		}
+		commit, err := cid.Decode(key.Cid)
+		if err != nil {
+			return err
+		}
+		commits[docID] = append(commits[docID], commit)
+	}
+	return nil
+}
+
+// filteredDocIDs returns the IDs of the documents the given filter conditions restrict the
+// _docID to, through _eq and _in conditions that all the matching documents must meet.
+//
+// It returns false if the conditions do not restrict the _docID to a known set of IDs.
+func filteredDocIDs(conditions map[connor.FilterKey]any) (map[string]struct{}, bool) {
+	var result map[string]struct{}
+	restrict := func(docIDs map[string]struct{}) {
+		if result == nil {
+			result = docIDs
+			return
+		}
+		for docID := range result {
+			if _, exists := docIDs[docID]; !exists {
+				delete(result, docID)
+			}
+		}
+	}
+
+	for key, value := range conditions {
+		switch typedKey := key.(type) {
+		case *mapper.PropertyIndex:
+			if typedKey.Index != core.DocIDFieldIndex {
+				continue
+			}
+			opConditions, ok := value.(map[connor.FilterKey]any)
+			if !ok {
+				continue
+			}
+			for opKey, opValue := range opConditions {
+				op, ok := opKey.(*mapper.Operator)
+				if !ok {
+					continue
+				}
+				switch op.Operation {
+				case "_eq":
+					if docID, ok := opValue.(string); ok {
+						restrict(map[string]struct{}{docID: {}})
+					}
+				case "_in":
+					if values, ok := opValue.([]any); ok {
+						docIDs := make(map[string]struct{}, len(values))
+						for _, v := range values {
+							if docID, ok := v.(string); ok {
+								docIDs[docID] = struct{}{}
+							}
+						}
+						if len(docIDs) == len(values) {
+							restrict(docIDs)
+						}
+					}
+				}
+			}
+
+		case *mapper.Operator:
+			if typedKey.Operation != request.FilterOpAnd {
+				continue
+			}
+			compound, ok := value.([]any)
+			if !ok {
+				continue
+			}
+			for _, c := range compound {
+				cConditions, ok := c.(map[connor.FilterKey]any)
+				if !ok {
+					continue
+				}
+				if docIDs, ok := filteredDocIDs(cConditions); ok {
+					restrict(docIDs)
+				}
+			}
+		}
+	}
+	return result, result != nil
+}
+
+// recompose writes the state of the given document to the transient store, from the given
+// document commits and all the document commits preceding them.
+func (f *AsOfFetcher) recompose(ctx context.Context, docID string, heads []cid.Cid) error {
+	mCRDTs := map[string]merklecrdt.MerkleCRDT{}
+
+	composite, err := f.getCRDT(mCRDTs, docID, client.COMPOSITE, client.FieldKind_None, "")
+	if err != nil {
+		return err
+	}
+
+	// collect the document commits reachable from the given heads, in the order they were made
+	commits, err := clock.Walk(ctx, f.txn.DAGstore(), composite.DeltaDecode, heads...)
+	if err != nil {
+		return err
+	}
+
+	// if the history preceding the oldest commit has been pruned, the state is restored from
+	// the snapshot taken at that commit
+	var snapshotted cid.Cid
+	if len(commits) > 0 {
+		oldest := commits[0].GetNode()
+		snapshot, err := base.GetDocumentSnapshot(ctx, f.txn.Systemstore(), docID, oldest.Cid())
+		if err != nil {
+			return err
+		}
+		if snapshot != nil {
+			if err := writeSnapshot(ctx, f.store, snapshot); err != nil {
+				return err
+			}
+			if err := f.store.DAGstore().Put(ctx, oldest); err != nil {
+				return NewErrVFetcherFailedToWriteBlock(err)
+			}
+			snapshotted = oldest.Cid()
+		}
+	}
+
+	schema := f.col.Schema()
+	for _, commit := range commits {
+		nd := commit.GetNode()
+		if nd.Cid() == snapshotted {
+			continue
+		}
+		if err := f.processNode(ctx, composite, nd); err != nil {
+			return err
+		}
+
+		for _, l := range nd.Links() {
+			if l.Name == core.HEAD {
+				continue
+			}
+
+			field, ok := f.col.Description().GetFieldByName(l.Name, &schema)
+			if !ok {
+				return client.NewErrFieldNotExist(l.Name)
+			}
+			mcrdt, err := f.getCRDT(mCRDTs, docID, field.Typ, field.Kind, l.Name)
+			if err != nil {
+				return err
+			}
+			subNd, err := f.getDAGNode(ctx, l.Cid)
+			if err != nil {
+				return err
+			}
+			if err := f.processNode(ctx, mcrdt, subNd); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// getCRDT returns the transient merkle CRDT of the given document field, creating it if
+// it does not yet exist.
+func (f *AsOfFetcher) getCRDT(
+	mCRDTs map[string]merklecrdt.MerkleCRDT,
+	docID string,
+	ctype client.CType,
+	kind client.FieldKind,
+	fieldName string,
+) (merklecrdt.MerkleCRDT, error) {
+	if mcrdt, exists := mCRDTs[fieldName]; exists {
+		return mcrdt, nil
+	}
+
+	dsKey, err := base.MakePrimaryIndexKeyForCRDT(
+		f.col.Description(),
+		f.col.Schema(),
+		ctype,
+		core.DataStoreKey{DocID: docID},
+		fieldName,
+	)
+	if err != nil {
+		return nil, err
+	}
+	mcrdt, err := merklecrdt.InstanceWithStore(
+		f.store,
+		core.CollectionSchemaVersionKey{},
+		ctype,
+		kind,
+		dsKey,
+		fieldName,
+	)
+	if err != nil {
+		return nil, err
+	}
+	mCRDTs[fieldName] = mcrdt
+	return mcrdt, nil
+}
+
+// processNode merges the given block into the given transient merkle CRDT.
+func (f *AsOfFetcher) processNode(
+	ctx context.Context,
+	mcrdt merklecrdt.MerkleCRDT,
+	nd ipld.Node,
+) error {
+	// the block must be in the transient store for the clock to find the heads it replaces
+	if err := f.store.DAGstore().Put(ctx, nd); err != nil {
+		return NewErrVFetcherFailedToWriteBlock(err)
+	}
+
+	delta, err := mcrdt.DeltaDecode(nd)
+	if err != nil {
+		return err
+	}
+	return mcrdt.Clock().ProcessNode(ctx, delta, nd)
+}
+
+func (f *AsOfFetcher) getDAGNode(ctx context.Context, c cid.Cid) (*dag.ProtoNode, error) {
+	blk, err := f.txn.DAGstore().Get(ctx, c)
+	if err != nil {
+		return nil, NewErrVFetcherFailedToGetBlock(err)
+	}
+	nd, err := dag.DecodeProtobuf(blk.RawData())
+	if err != nil {
+		return nil, err
+	}
+	// ensure the node yields the CID it was stored with
+	if err := nd.SetCidBuilder(c.Prefix()); err != nil {
+		return nil, err
+	}
+	return nd, nil
+}
+
+// Close closes the AsOfFetcher.
+func (f *AsOfFetcher) Close() error {
+	if f.root == nil {
+		return nil
+	}
+	if err := f.root.Close(); err != nil {
+		return err
+	}
+
+	return f.DocumentFetcher.Close()
+}
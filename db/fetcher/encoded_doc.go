@@ -53,23 +53,34 @@ type encProperty struct {
 }
 
 // Decode returns the decoded value and CRDT type for the given property.
-func (e encProperty) Decode() (any, error) {
+//
+// If the document is pending migration, values that cannot be decoded as the current kind
+// of the field are returned as is.  They may have been written with a previous kind of the
+// field, and are converted to the current kind by the migration of the document.
+func (e encProperty) Decode(isPendingMigration bool) (any, error) {
 	var val any
 	err := cbor.Unmarshal(e.Raw, &val)
 	if err != nil {
 		return nil, err
 	}
 
-	return core.DecodeFieldValue(e.Desc, val)
+	decodedVal, err := core.DecodeFieldValue(e.Desc, val)
+	if err != nil && isPendingMigration {
+		return val, nil
+	}
+	return decodedVal, err
 }
 
 // @todo: Implement Encoded Document type
 type encodedDocument struct {
-	id                   []byte
-	schemaVersionID      string
-	status               client.DocumentStatus
-	properties           map[client.FieldDescription]*encProperty
-	decodedPropertyCache map[client.FieldDescription]any
+	id              []byte
+	schemaVersionID string
+	// The schema version of the field descriptions of the properties, documents of
+	// other versions are pending migration to it.  It is kept on reset.
+	targetSchemaVersionID string
+	status                client.DocumentStatus
+	properties            map[client.FieldDescription]*encProperty
+	decodedPropertyCache  map[client.FieldDescription]any
 
 	// tracking bitsets
 	// A value of 1 indicates a required field
@@ -105,6 +116,14 @@ func (encdoc *encodedDocument) Reset() {
 	encdoc.decodedPropertyCache = nil
 }
 
+// isPendingMigration returns true if the document has been written with a schema version other
+// than the version of the field descriptions of its properties.
+func (encdoc *encodedDocument) isPendingMigration() bool {
+	return encdoc.schemaVersionID != "" &&
+		encdoc.targetSchemaVersionID != "" &&
+		encdoc.schemaVersionID != encdoc.targetSchemaVersionID
+}
+
 // Decode returns a properly decoded document object
 func Decode(encdoc EncodedDocument, sd client.SchemaDescription) (*client.Document, error) {
 	docID, err := client.NewDocIDFromString(string(encdoc.ID()))
@@ -191,7 +210,7 @@ func (encdoc *encodedDocument) Properties(onlyFilterProps bool) (map[client.Fiel
 			continue
 		}
 
-		val, err := prop.Decode()
+		val, err := prop.Decode(encdoc.isPendingMigration())
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,52 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package fetcher
+
+import (
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func newDateTimeTestDoc(t *testing.T, schemaVersionID string, value any) *encodedDocument {
+	raw, err := cbor.Marshal(value)
+	require.NoError(t, err)
+
+	field := client.FieldDescription{Name: "createdAt", ID: 1, Kind: client.FieldKind_DATETIME}
+	return &encodedDocument{
+		schemaVersionID:       schemaVersionID,
+		targetSchemaVersionID: "v2",
+		properties: map[client.FieldDescription]*encProperty{
+			field: {Desc: field, Raw: raw},
+		},
+	}
+}
+
+func TestEncodedDocumentProperties_WithInvalidValueOfCurrentVersion_ReturnsError(t *testing.T) {
+	doc := newDateTimeTestDoc(t, "v2", "yesterday")
+
+	_, err := doc.Properties(false)
+	require.Error(t, err)
+}
+
+func TestEncodedDocumentProperties_WithInvalidValuePendingMigration_ReturnsValueAsIs(t *testing.T) {
+	doc := newDateTimeTestDoc(t, "v1", "yesterday")
+
+	properties, err := doc.Properties(false)
+	require.NoError(t, err)
+	require.Len(t, properties, 1)
+	for _, value := range properties {
+		require.Equal(t, "yesterday", value)
+	}
+}
@@ -26,6 +26,7 @@ const (
 	errVFetcherFailedToGetDagLink   string = "(version fetcher) failed to get node link from DAG"
 	errFailedToGetDagNode           string = "failed to get DAG Node"
 	errMissingMapper                string = "missing document mapper"
+	errVersionPruned                string = "the version has been pruned from the document history"
 )
 
 var (
@@ -40,6 +41,7 @@ var (
 	ErrVFetcherFailedToGetDagLink   = errors.New(errVFetcherFailedToGetDagLink)
 	ErrFailedToGetDagNode           = errors.New(errFailedToGetDagNode)
 	ErrMissingMapper                = errors.New(errMissingMapper)
+	ErrVersionPruned                = errors.New(errVersionPruned)
 	ErrSingleSpanOnly               = errors.New("spans must contain only a single entry")
 )
 
@@ -53,6 +55,12 @@ func NewErrFailedToDecodeCIDForVFetcher(inner error) error {
 	return errors.Wrap(errFailedToDecodeCIDForVFetcher, inner)
 }
 
+// NewErrVersionPruned returns an error indicating that the given version has been pruned by
+// history compaction.
+func NewErrVersionPruned(version any) error {
+	return errors.New(errVersionPruned, errors.NewKV("Version", version))
+}
+
 // NewErrFailedToSeek returns an error indicating that the given target could not be seeked to.
 func NewErrFailedToSeek(target any, inner error) error {
 	return errors.Wrap(errFailedToSeek, inner, errors.NewKV("Target", target))
@@ -174,7 +174,7 @@ func (df *DocumentFetcher) init(
 	df.initialized = true
 	df.filter = filter
 	df.isReadingDocument = false
-	df.doc = new(encodedDocument)
+	df.doc = &encodedDocument{targetSchemaVersionID: col.Schema().VersionID}
 	df.mapping = docMapper
 
 	if df.filter != nil && docMapper == nil {
@@ -261,11 +261,18 @@ func (df *DocumentFetcher) start(ctx context.Context, spans core.Spans, withDele
 		valueSpans := make([]core.Span, len(spans.Value))
 		for i, span := range spans.Value {
 			// We can only handle value keys, so here we ensure we only read value keys
+			start, end := span.Start(), span.End()
 			if withDeleted {
-				valueSpans[i] = core.NewSpan(span.Start().WithDeletedFlag(), span.End().WithDeletedFlag())
+				start, end = start.WithDeletedFlag(), end.WithDeletedFlag()
 			} else {
-				valueSpans[i] = core.NewSpan(span.Start().WithValueFlag(), span.End().WithValueFlag())
+				start, end = start.WithValueFlag(), end.WithValueFlag()
 			}
+			if start.DocID == "" && end.DocID == "" {
+				// A collection wide span ends at the start of the next collection, flagging it would
+				// include the keys of other instance types of that collection.
+				end = start.PrefixEnd()
+			}
+			valueSpans[i] = core.NewSpan(start, end)
 		}
 
 		spans := core.MergeAscending(valueSpans)
@@ -11,6 +11,7 @@
 package fetcher
 
 import (
+	"bytes"
 	"context"
 
 	"github.com/sourcenetwork/defradb/client"
@@ -65,7 +66,7 @@ func (f *IndexFetcher) Init(
 ) error {
 	f.col = col
 	f.docFilter = filter
-	f.doc = &encodedDocument{}
+	f.doc = &encodedDocument{targetSchemaVersionID: col.Schema().VersionID}
 	f.mapping = docMapper
 	f.txn = txn
 
@@ -79,10 +80,16 @@ func (f *IndexFetcher) Init(
 
 	f.indexDataStoreKey.CollectionID = f.col.ID()
 
+	if len(fields) == 0 {
+		// If no fields have been specified all of them should be fetched, this
+		// is the case when documents are fetched for migration.
+		fields = col.Schema().Fields
+	}
+
+	f.docFields = make([]client.FieldDescription, 0, len(fields))
 	for i := range fields {
-		if fields[i].Name == f.indexedField.Name {
-			f.docFields = append(fields[:i], fields[i+1:]...)
-			break
+		if fields[i].Name != f.indexedField.Name {
+			f.docFields = append(f.docFields, fields[i])
 		}
 	}
 
@@ -123,9 +130,17 @@ func (f *IndexFetcher) FetchNext(ctx context.Context) (EncodedDocument, ExecInfo
 			return nil, f.execInfo, nil
 		}
 
+		rawValue := res.key.FieldValues[0]
+		if f.indexedField.Kind == client.FieldKind_BIGINT || f.indexedField.Kind == client.FieldKind_DECIMAL {
+			rawValue, err = decodeIndexedBigNumber(rawValue)
+			if err != nil {
+				return nil, ExecInfo{}, err
+			}
+		}
+
 		property := &encProperty{
 			Desc: f.indexedField,
-			Raw:  res.key.FieldValues[0],
+			Raw:  rawValue,
 		}
 
 		if f.indexDesc.Unique {
@@ -169,3 +184,18 @@ func (f *IndexFetcher) Close() error {
 	}
 	return nil
 }
+
+// decodeIndexedBigNumber converts the order preserving encoding of an indexed arbitrary
+// precision number into the encoding of document field values.
+func decodeIndexedBigNumber(indexedValue []byte) ([]byte, error) {
+	if bytes.Compare(indexedValue, core.OrderedBigNumberMin) < 0 ||
+		bytes.Compare(indexedValue, core.OrderedBigNumberMax) >= 0 {
+		// Values that are not numbers, such as nil, are encoded as any other field value.
+		return indexedValue, nil
+	}
+	number, err := core.DecodeOrderedBigNumber(indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	return client.NewFieldValue(client.LWW_REGISTER, number).Bytes()
+}
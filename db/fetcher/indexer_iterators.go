@@ -13,32 +13,44 @@ package fetcher
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"errors"
+	"math"
+	"regexp"
+	"regexp/syntax"
 	"strings"
+	"time"
+	"unicode"
 
 	"github.com/fxamacker/cbor/v2"
 	ds "github.com/ipfs/go-datastore"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/connor"
+	"github.com/sourcenetwork/defradb/connor/numbers"
 	"github.com/sourcenetwork/defradb/core"
 	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/datastore/iterable"
 	"github.com/sourcenetwork/defradb/planner/mapper"
 
 	"github.com/ipfs/go-datastore/query"
 )
 
 const (
-	opEq    = "_eq"
-	opGt    = "_gt"
-	opGe    = "_ge"
-	opLt    = "_lt"
-	opLe    = "_le"
-	opNe    = "_ne"
-	opIn    = "_in"
-	opNin   = "_nin"
-	opLike  = "_like"
-	opNlike = "_nlike"
+	opEq     = "_eq"
+	opGt     = "_gt"
+	opGe     = "_ge"
+	opLt     = "_lt"
+	opLe     = "_le"
+	opNe     = "_ne"
+	opIn     = "_in"
+	opNin    = "_nin"
+	opLike   = "_like"
+	opNlike  = "_nlike"
+	opILike  = "_ilike"
+	opNILike = "_nilike"
+	opRegex  = "_regex"
+	opNRegex = "_nregex"
 )
 
 // indexIterator is an iterator over index keys.
@@ -291,6 +303,244 @@ func (i *scanningIndexIterator) Next() (indexIterResult, error) {
 	return res, err
 }
 
+// seekingIndexIterator iterates over the index keys with values from the start value up to the
+// end value, returning those that satisfy the matcher.
+//
+// It is used for conditions that may only be satisfied by values within the given range,
+// allowing the keys outside of it to be skipped instead of scanned.
+type seekingIndexIterator struct {
+	queryResultIterator
+	indexKey   core.IndexDataStoreKey
+	matcher    indexMatcher
+	startValue []byte
+	endValue   []byte
+	iterator   iterable.Iterator
+	execInfo   *ExecInfo
+}
+
+func (i *seekingIndexIterator) Init(ctx context.Context, store datastore.DSReaderWriter) error {
+	prefix := i.indexKey.ToString()
+	// The matcher is applied on Next, as query filters would be given the keys of the
+	// underlying store.
+	iterator, err := store.GetIterator(query.Query{
+		Prefix: prefix,
+	})
+	if err != nil {
+		return err
+	}
+	i.iterator = iterator
+
+	startKey := i.indexKey
+	startKey.FieldValues = [][]byte{i.startValue}
+	endKey := i.indexKey
+	endKey.FieldValues = [][]byte{i.endValue}
+
+	resultIter, err := iterator.IteratePrefix(ctx, ds.RawKey(startKey.ToString()), ds.RawKey(endKey.ToString()))
+	if err != nil {
+		return err
+	}
+	i.resultIter = resultIter
+
+	return nil
+}
+
+func (i *seekingIndexIterator) Next() (indexIterResult, error) {
+	for {
+		res, err := i.queryResultIterator.Next()
+		if err != nil || !res.foundKey {
+			return res, err
+		}
+		i.execInfo.IndexesFetched++
+		matches, err := i.matcher.Match(res.key)
+		if err != nil {
+			return indexIterResult{}, err
+		}
+		if matches {
+			return res, nil
+		}
+	}
+}
+
+func (i *seekingIndexIterator) Close() error {
+	err := i.queryResultIterator.Close()
+	if err != nil {
+		return err
+	}
+	return i.iterator.Close()
+}
+
+// textIndexIterator iterates over the index keys of string values within the given range of
+// each length, returning those that satisfy the matcher.
+//
+// Strings are stored CBOR encoded, with their length preceding their bytes, so the index is
+// grouped by the length of the values and only ordered by value within each group. The iterator
+// seeks to the range of values of each group of strings at least as long as the given minimum
+// length, skipping the lengths no value has.
+type textIndexIterator struct {
+	queryResultIterator
+	indexKey core.IndexDataStoreKey
+	matcher  indexMatcher
+	// minLength is the length of the shortest strings that may satisfy the matcher.
+	minLength uint64
+	// valueRange returns the start and end of the encoded values that may satisfy the matcher
+	// amongst the strings of the given length.
+	valueRange func(length uint64) ([]byte, []byte)
+	iterator   iterable.Iterator
+	execInfo   *ExecInfo
+
+	ctx context.Context
+	// length is the length of the strings currently being iterated over.
+	length uint64
+}
+
+func (i *textIndexIterator) Init(ctx context.Context, store datastore.DSReaderWriter) error {
+	i.ctx = ctx
+	iterator, err := store.GetIterator(query.Query{
+		Prefix: i.indexKey.ToString(),
+	})
+	if err != nil {
+		return err
+	}
+	i.iterator = iterator
+	return i.seekLength(i.minLength)
+}
+
+// seekLength positions the iterator at the range of values of the shortest strings that are at
+// least as long as the given length.
+func (i *textIndexIterator) seekLength(minLength uint64) error {
+	err := i.closeResults()
+	if err != nil {
+		return err
+	}
+
+	firstResults, err := i.iterateValues(cborTextHeader(minLength), []byte{cborTextEnd})
+	if err != nil {
+		return err
+	}
+	res, hasVal := firstResults.NextSync()
+	err = firstResults.Close()
+	if err != nil {
+		return err
+	}
+	if res.Error != nil {
+		return res.Error
+	}
+	if !hasVal {
+		return nil
+	}
+	i.execInfo.IndexesFetched++
+	key, err := core.NewIndexDataStoreKey(res.Key)
+	if err != nil {
+		return err
+	}
+	length, ok := cborTextLength(key.FieldValues[0])
+	if !ok {
+		return nil
+	}
+
+	i.length = length
+	start, end := i.valueRange(length)
+	i.resultIter, err = i.iterateValues(start, end)
+	return err
+}
+
+func (i *textIndexIterator) iterateValues(startValue []byte, endValue []byte) (query.Results, error) {
+	startKey := i.indexKey
+	startKey.FieldValues = [][]byte{startValue}
+	endKey := i.indexKey
+	endKey.FieldValues = [][]byte{endValue}
+	return i.iterator.IteratePrefix(i.ctx, ds.RawKey(startKey.ToString()), ds.RawKey(endKey.ToString()))
+}
+
+func (i *textIndexIterator) closeResults() error {
+	if i.resultIter == nil {
+		return nil
+	}
+	err := i.resultIter.Close()
+	i.resultIter = nil
+	return err
+}
+
+func (i *textIndexIterator) Next() (indexIterResult, error) {
+	for i.resultIter != nil {
+		res, err := i.queryResultIterator.Next()
+		if err != nil {
+			return indexIterResult{}, err
+		}
+		if !res.foundKey {
+			err = i.seekLength(i.length + 1)
+			if err != nil {
+				return indexIterResult{}, err
+			}
+			continue
+		}
+		i.execInfo.IndexesFetched++
+		matches, err := i.matcher.Match(res.key)
+		if err != nil {
+			return indexIterResult{}, err
+		}
+		if matches {
+			return res, nil
+		}
+	}
+	return indexIterResult{}, nil
+}
+
+func (i *textIndexIterator) Close() error {
+	err := i.closeResults()
+	if err != nil {
+		return err
+	}
+	return i.iterator.Close()
+}
+
+const (
+	cborTextMajorType = 3 << 5
+	// cborTextEnd is the first byte following the headers of all CBOR text strings.
+	cborTextEnd = 4 << 5
+)
+
+// cborTextHeader returns the header of the CBOR encoding of a text string of the given length
+// in bytes.
+func cborTextHeader(length uint64) []byte {
+	switch {
+	case length < 24:
+		return []byte{cborTextMajorType | byte(length)}
+	case length <= math.MaxUint8:
+		return []byte{cborTextMajorType | 24, byte(length)}
+	case length <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16([]byte{cborTextMajorType | 25}, uint16(length))
+	case length <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32([]byte{cborTextMajorType | 26}, uint32(length))
+	default:
+		return binary.BigEndian.AppendUint64([]byte{cborTextMajorType | 27}, length)
+	}
+}
+
+// cborTextLength returns the length in bytes of the text string of the given CBOR encoding.
+//
+// It returns false if the encoding is not that of a text string.
+func cborTextLength(value []byte) (uint64, bool) {
+	if len(value) == 0 || value[0]&^0x1f != cborTextMajorType {
+		return 0, false
+	}
+	info := value[0] & 0x1f
+	switch {
+	case info < 24:
+		return uint64(info), true
+	case info == 24 && len(value) >= 2:
+		return uint64(value[1]), true
+	case info == 25 && len(value) >= 3:
+		return uint64(binary.BigEndian.Uint16(value[1:])), true
+	case info == 26 && len(value) >= 5:
+		return uint64(binary.BigEndian.Uint32(value[1:])), true
+	case info == 27 && len(value) >= 9:
+		return binary.BigEndian.Uint64(value[1:]), true
+	default:
+		return 0, false
+	}
+}
+
 // checks if the stored index value satisfies the condition
 type indexMatcher interface {
 	Match(core.IndexDataStoreKey) (bool, error)
@@ -309,6 +559,73 @@ func (m *indexByteValuesMatcher) Match(key core.IndexDataStoreKey) (bool, error)
 	return m.evalFunc(res), nil
 }
 
+// indexBigNumberMatcher is a filter that compares the index value with a given
+// arbitrary precision number.
+//
+// Arbitrary precision numbers are stored in an order preserving encoding, the value is
+// decoded and compared numerically with evalFunc.
+type indexBigNumberMatcher struct {
+	value any
+	// evalFunc receives a result of numbers.CompareBig
+	evalFunc func(int) bool
+}
+
+func (m *indexBigNumberMatcher) Match(key core.IndexDataStoreKey) (bool, error) {
+	currentNumber, err := core.DecodeOrderedBigNumber(key.FieldValues[0])
+	if err != nil {
+		return false, err
+	}
+	res, ok := numbers.CompareBig(currentNumber, m.value)
+	if !ok {
+		return false, nil
+	}
+	return m.evalFunc(res), nil
+}
+
+// indexStringMatcher is a filter that compares the index value with a given string.
+//
+// Strings are stored with their length preceding their bytes, so the value is decoded and
+// compared with strings.Compare, evaluating the result with evalFunc.
+type indexStringMatcher struct {
+	value string
+	// evalFunc receives a result of strings.Compare
+	evalFunc func(int) bool
+}
+
+func (m *indexStringMatcher) Match(key core.IndexDataStoreKey) (bool, error) {
+	// Values other than strings, such as nil, are not comparable to the string.
+	if _, ok := cborTextLength(key.FieldValues[0]); !ok {
+		return false, nil
+	}
+	var currentVal string
+	err := cbor.Unmarshal(key.FieldValues[0], &currentVal)
+	if err != nil {
+		return false, err
+	}
+	return m.evalFunc(strings.Compare(currentVal, m.value)), nil
+}
+
+// newRangeIndexMatcher returns a matcher that compares the index value with the given
+// filter value, evaluating the result of the comparison with evalFunc.
+func newRangeIndexMatcher(filterVal any, valueBytes []byte, evalFunc func(int) bool) indexMatcher {
+	if numbers.IsBig(filterVal) {
+		return &indexBigNumberMatcher{
+			value:    filterVal,
+			evalFunc: evalFunc,
+		}
+	}
+	if strVal, ok := filterVal.(string); ok {
+		return &indexStringMatcher{
+			value:    strVal,
+			evalFunc: evalFunc,
+		}
+	}
+	return &indexByteValuesMatcher{
+		value:    valueBytes,
+		evalFunc: evalFunc,
+	}
+}
+
 // matcher if _ne condition is met
 type neIndexMatcher struct {
 	value []byte
@@ -339,16 +656,21 @@ func (m *indexInArrayMatcher) Match(key core.IndexDataStoreKey) (bool, error) {
 
 // checks if the index value satisfies the LIKE condition
 type indexLikeMatcher struct {
-	hasPrefix   bool
-	hasSuffix   bool
-	startAndEnd []string
-	isLike      bool
-	value       string
+	hasPrefix         bool
+	hasSuffix         bool
+	startAndEnd       []string
+	isLike            bool
+	isCaseInsensitive bool
+	value             string
 }
 
-func newLikeIndexCmp(filterValue string, isLike bool) *indexLikeMatcher {
+func newLikeIndexCmp(filterValue string, isLike bool, isCaseInsensitive bool) *indexLikeMatcher {
 	matcher := &indexLikeMatcher{
-		isLike: isLike,
+		isLike:            isLike,
+		isCaseInsensitive: isCaseInsensitive,
+	}
+	if isCaseInsensitive {
+		filterValue = strings.ToLower(filterValue)
 	}
 	if len(filterValue) >= 2 {
 		if filterValue[0] == '%' {
@@ -368,12 +690,31 @@ func newLikeIndexCmp(filterValue string, isLike bool) *indexLikeMatcher {
 	return matcher
 }
 
+// likeLiteralPrefix returns the prefix all values matching the given LIKE pattern start with.
+//
+// Case insensitive patterns only have a literal prefix up to their first character with case.
+func likeLiteralPrefix(pattern string, isCaseInsensitive bool) string {
+	prefix, _, _ := strings.Cut(pattern, "%")
+	if !isCaseInsensitive {
+		return prefix
+	}
+	for i, r := range prefix {
+		if unicode.ToLower(r) != r || unicode.ToUpper(r) != r {
+			return prefix[:i]
+		}
+	}
+	return prefix
+}
+
 func (m *indexLikeMatcher) Match(key core.IndexDataStoreKey) (bool, error) {
 	var currentVal string
 	err := cbor.Unmarshal(key.FieldValues[0], &currentVal)
 	if err != nil {
 		return false, err
 	}
+	if m.isCaseInsensitive {
+		currentVal = strings.ToLower(currentVal)
+	}
 
 	return m.doesMatch(currentVal) == m.isLike, nil
 }
@@ -395,6 +736,186 @@ func (m *indexLikeMatcher) doesMatch(currentVal string) bool {
 	}
 }
 
+// checks if the index value satisfies the REGEX condition
+type indexRegexMatcher struct {
+	regex   *regexp.Regexp
+	isRegex bool
+}
+
+func newRegexIndexCmp(filterValue string, isRegex bool) (*indexRegexMatcher, error) {
+	re, err := connor.CompileRegex(filterValue)
+	if err != nil {
+		return nil, err
+	}
+	return &indexRegexMatcher{regex: re, isRegex: isRegex}, nil
+}
+
+// regexLiteralPrefix returns the prefix all values matching the given regular expression start
+// with, which only expressions anchored to the start of the text have.
+func regexLiteralPrefix(expr string) string {
+	re, err := syntax.Parse(expr, syntax.Perl)
+	if err != nil {
+		return ""
+	}
+	re = re.Simplify()
+	if re.Op != syntax.OpConcat || len(re.Sub) < 2 || re.Sub[0].Op != syntax.OpBeginText {
+		return ""
+	}
+	var prefix []rune
+	for _, sub := range re.Sub[1:] {
+		if sub.Op != syntax.OpLiteral || sub.Flags&syntax.FoldCase != 0 {
+			break
+		}
+		prefix = append(prefix, sub.Rune...)
+	}
+	return string(prefix)
+}
+
+func (m *indexRegexMatcher) Match(key core.IndexDataStoreKey) (bool, error) {
+	var currentVal any
+	err := cbor.Unmarshal(key.FieldValues[0], &currentVal)
+	if err != nil {
+		return false, err
+	}
+	// Values that are not strings, such as nil, never match the expression.
+	currentStr, ok := currentVal.(string)
+	return (ok && m.regex.MatchString(currentStr)) == m.isRegex, nil
+}
+
+// newGreaterIndexIterator returns an iterator over the index keys with values greater than (or
+// equal to, depending on evalFunc) the given filter value.
+//
+// Arbitrary precision numbers and date times have keys ordered by their value over the whole
+// range of the index, allowing the keys preceding the value to be skipped. Strings are only
+// ordered within each group of strings of the same length, the keys preceding the value are
+// skipped within each group. For other values the whole index is scanned.
+func newGreaterIndexIterator(
+	indexKey core.IndexDataStoreKey,
+	filterVal any,
+	valueBytes []byte,
+	evalFunc func(int) bool,
+	execInfo *ExecInfo,
+) indexIterator {
+	matcher := newRangeIndexMatcher(filterVal, valueBytes, evalFunc)
+	if numbers.IsBig(filterVal) {
+		return &seekingIndexIterator{
+			indexKey:   indexKey,
+			matcher:    matcher,
+			startValue: valueBytes,
+			endValue:   core.OrderedBigNumberMax,
+			execInfo:   execInfo,
+		}
+	}
+	switch v := filterVal.(type) {
+	case time.Time:
+		return &seekingIndexIterator{
+			indexKey:   indexKey,
+			matcher:    matcher,
+			startValue: valueBytes,
+			// Date times are indexed as fixed width RFC3339 text strings in UTC, with later
+			// years only ever being longer, so their keys are ordered by their value.
+			endValue: []byte{cborTextEnd},
+			execInfo: execInfo,
+		}
+	case string:
+		return &textIndexIterator{
+			indexKey: indexKey,
+			matcher:  matcher,
+			valueRange: func(length uint64) ([]byte, []byte) {
+				// A string shorter than the value is only greater if it is greater than the
+				// prefix of the value of the same length, a longer one if it is greater than
+				// or equal to the value, both sorting after the header followed by the prefix.
+				prefix := v
+				if length < uint64(len(v)) {
+					prefix = v[:length]
+				}
+				return append(cborTextHeader(length), prefix...), cborTextHeader(length + 1)
+			},
+			execInfo: execInfo,
+		}
+	}
+	return &scanningIndexIterator{
+		indexKey: indexKey,
+		matcher:  matcher,
+		execInfo: execInfo,
+	}
+}
+
+// newLesserIndexIterator returns an iterator over the index keys with values less than (or
+// equal to, depending on evalFunc) the given filter value.
+//
+// As with newGreaterIndexIterator, only the keys of arbitrary precision numbers are skipped.
+func newLesserIndexIterator(
+	indexKey core.IndexDataStoreKey,
+	filterVal any,
+	valueBytes []byte,
+	evalFunc func(int) bool,
+	execInfo *ExecInfo,
+) indexIterator {
+	matcher := newRangeIndexMatcher(filterVal, valueBytes, evalFunc)
+	if numbers.IsBig(filterVal) {
+		return &seekingIndexIterator{
+			indexKey:   indexKey,
+			matcher:    matcher,
+			startValue: core.OrderedBigNumberMin,
+			// The keys of the value are followed by a '/' or nothing at all, so they sort before
+			// the value followed by the next byte.
+			endValue: append(bytes.Clone(valueBytes), '/'+1),
+			execInfo: execInfo,
+		}
+	}
+	return &scanningIndexIterator{
+		indexKey: indexKey,
+		matcher:  matcher,
+		execInfo: execInfo,
+	}
+}
+
+// newStringIndexIterator returns an iterator over the index keys with string values that
+// satisfy the given matcher, all of which start with the given prefix.
+//
+// If there is a prefix the iterator seeks to the values with it, otherwise the whole index
+// is scanned.
+func newStringIndexIterator(
+	indexKey core.IndexDataStoreKey,
+	matcher indexMatcher,
+	prefix string,
+	execInfo *ExecInfo,
+) indexIterator {
+	if prefix == "" {
+		return &scanningIndexIterator{
+			indexKey: indexKey,
+			matcher:  matcher,
+			execInfo: execInfo,
+		}
+	}
+	return &textIndexIterator{
+		indexKey:  indexKey,
+		matcher:   matcher,
+		minLength: uint64(len(prefix)),
+		valueRange: func(length uint64) ([]byte, []byte) {
+			start := append(cborTextHeader(length), prefix...)
+			// Text strings are valid UTF-8 which never contains 0xff, so all the values with the
+			// prefix sort before it.
+			end := append(bytes.Clone(start), 0xff)
+			return start, end
+		},
+		execInfo: execInfo,
+	}
+}
+
+// encodeIndexFilterValue encodes the given filter value as it is stored in the index.
+func encodeIndexFilterValue(filterVal any) ([]byte, error) {
+	if numbers.IsBig(filterVal) {
+		return core.EncodeOrderedBigNumber(filterVal)
+	}
+	if timeVal, ok := filterVal.(time.Time); ok {
+		// Date times are indexed in UTC.
+		filterVal = timeVal.UTC()
+	}
+	return client.NewFieldValue(client.LWW_REGISTER, filterVal).Bytes()
+}
+
 func createIndexIterator(
 	indexDataStoreKey core.IndexDataStoreKey,
 	indexFilterConditions *mapper.Filter,
@@ -416,9 +937,7 @@ func createIndexIterator(
 
 	switch op {
 	case opEq, opGt, opGe, opLt, opLe, opNe:
-		fieldValue := client.NewFieldValue(client.LWW_REGISTER, filterVal)
-
-		valueBytes, err := fieldValue.Bytes()
+		valueBytes, err := encodeIndexFilterValue(filterVal)
 		if err != nil {
 			return nil, err
 		}
@@ -443,41 +962,37 @@ func createIndexIterator(
 				}, nil
 			}
 		case opGt:
-			return &scanningIndexIterator{
-				indexKey: indexDataStoreKey,
-				matcher: &indexByteValuesMatcher{
-					value:    valueBytes,
-					evalFunc: func(res int) bool { return res > 0 },
-				},
-				execInfo: execInfo,
-			}, nil
+			return newGreaterIndexIterator(
+				indexDataStoreKey,
+				filterVal,
+				valueBytes,
+				func(res int) bool { return res > 0 },
+				execInfo,
+			), nil
 		case opGe:
-			return &scanningIndexIterator{
-				indexKey: indexDataStoreKey,
-				matcher: &indexByteValuesMatcher{
-					value:    valueBytes,
-					evalFunc: func(res int) bool { return res > 0 || res == 0 },
-				},
-				execInfo: execInfo,
-			}, nil
+			return newGreaterIndexIterator(
+				indexDataStoreKey,
+				filterVal,
+				valueBytes,
+				func(res int) bool { return res > 0 || res == 0 },
+				execInfo,
+			), nil
 		case opLt:
-			return &scanningIndexIterator{
-				indexKey: indexDataStoreKey,
-				matcher: &indexByteValuesMatcher{
-					value:    valueBytes,
-					evalFunc: func(res int) bool { return res < 0 },
-				},
-				execInfo: execInfo,
-			}, nil
+			return newLesserIndexIterator(
+				indexDataStoreKey,
+				filterVal,
+				valueBytes,
+				func(res int) bool { return res < 0 },
+				execInfo,
+			), nil
 		case opLe:
-			return &scanningIndexIterator{
-				indexKey: indexDataStoreKey,
-				matcher: &indexByteValuesMatcher{
-					value:    valueBytes,
-					evalFunc: func(res int) bool { return res < 0 || res == 0 },
-				},
-				execInfo: execInfo,
-			}, nil
+			return newLesserIndexIterator(
+				indexDataStoreKey,
+				filterVal,
+				valueBytes,
+				func(res int) bool { return res < 0 || res == 0 },
+				execInfo,
+			), nil
 		case opNe:
 			return &scanningIndexIterator{
 				indexKey: indexDataStoreKey,
@@ -494,8 +1009,7 @@ func createIndexIterator(
 		}
 		valArr := make([][]byte, 0, len(inArr))
 		for _, v := range inArr {
-			fieldValue := client.NewFieldValue(client.LWW_REGISTER, v)
-			valueBytes, err := fieldValue.Bytes()
+			valueBytes, err := encodeIndexFilterValue(v)
 			if err != nil {
 				return nil, err
 			}
@@ -522,16 +1036,33 @@ func createIndexIterator(
 				execInfo: execInfo,
 			}, nil
 		}
-	case opLike:
+	case opLike, opNlike, opILike, opNILike:
+		matcher := newLikeIndexCmp(
+			filterVal.(string),
+			op == opLike || op == opILike,
+			op == opILike || op == opNILike,
+		)
+		if op == opLike || op == opILike {
+			prefix := likeLiteralPrefix(filterVal.(string), op == opILike)
+			return newStringIndexIterator(indexDataStoreKey, matcher, prefix, execInfo), nil
+		}
 		return &scanningIndexIterator{
 			indexKey: indexDataStoreKey,
-			matcher:  newLikeIndexCmp(filterVal.(string), true),
+			matcher:  matcher,
 			execInfo: execInfo,
 		}, nil
-	case opNlike:
+	case opRegex, opNRegex:
+		matcher, err := newRegexIndexCmp(filterVal.(string), op == opRegex)
+		if err != nil {
+			return nil, err
+		}
+		if op == opRegex {
+			prefix := regexLiteralPrefix(filterVal.(string))
+			return newStringIndexIterator(indexDataStoreKey, matcher, prefix, execInfo), nil
+		}
 		return &scanningIndexIterator{
 			indexKey: indexDataStoreKey,
-			matcher:  newLikeIndexCmp(filterVal.(string), false),
+			matcher:  matcher,
 			execInfo: execInfo,
 		}, nil
 	}
@@ -17,6 +17,7 @@ import (
 	dag "github.com/ipfs/boxo/ipld/merkledag"
 	"github.com/ipfs/go-cid"
 	ds "github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/go-datastore/query"
 	format "github.com/ipfs/go-ipld-format"
 
 	"github.com/sourcenetwork/defradb/client"
@@ -25,6 +26,7 @@ import (
 	"github.com/sourcenetwork/defradb/datastore/memory"
 	"github.com/sourcenetwork/defradb/db/base"
 	"github.com/sourcenetwork/defradb/errors"
+	"github.com/sourcenetwork/defradb/merkle/clock"
 	merklecrdt "github.com/sourcenetwork/defradb/merkle/crdt"
 	"github.com/sourcenetwork/defradb/planner/mapper"
 )
@@ -209,8 +211,16 @@ func (vf *VersionedFetcher) seekTo(c cid.Cid) error {
 	// reinit the queued cids list
 	vf.queuedCids = list.New()
 
+	isPruned, err := base.IsBlockPruned(vf.ctx, vf.txn.Systemstore(), vf.dsKey.DocID, c)
+	if err != nil {
+		return err
+	}
+	if isPruned {
+		return NewErrVersionPruned(c)
+	}
+
 	// recursive step through the graph
-	err := vf.seekNext(c, true)
+	err = vf.seekNext(c, true)
 	if err != nil {
 		return err
 	}
@@ -271,7 +281,28 @@ func (vf *VersionedFetcher) seekNext(c cid.Cid, topParent bool) error {
 		return nil
 	}
 
+	// if the history preceding this block has been pruned, the state is restored from the
+	// snapshot taken at this version instead of being recomposed
+	snapshot, err := base.GetDocumentSnapshot(vf.ctx, vf.txn.Systemstore(), vf.dsKey.DocID, c)
+	if err != nil {
+		return err
+	}
+	if snapshot != nil {
+		return vf.loadSnapshot(c, snapshot)
+	}
+
 	blk, err := vf.txn.DAGstore().Get(vf.ctx, c)
+	if format.IsNotFound(err) {
+		isPruned, err := base.IsBlockPruned(vf.ctx, vf.txn.Systemstore(), vf.dsKey.DocID, c)
+		if err != nil {
+			return err
+		}
+		if isPruned {
+			// the state of pruned blocks is part of the snapshot the traversal stops at
+			return nil
+		}
+		return NewErrVFetcherFailedToGetBlock(format.ErrNotFound{Cid: c})
+	}
 	if err != nil {
 		return NewErrVFetcherFailedToGetBlock(err)
 	}
@@ -322,6 +353,94 @@ func (vf *VersionedFetcher) seekNext(c cid.Cid, topParent bool) error {
 	return nil
 }
 
+// loadSnapshot writes the given snapshot of the document state at the version of the given CID
+// to the transient store.
+//
+// The block of the version and its field blocks are also transferred, so that the traversal
+// does not step past them.
+func (vf *VersionedFetcher) loadSnapshot(c cid.Cid, snapshot *base.DocumentSnapshot) error {
+	if err := writeSnapshot(vf.ctx, vf.store, snapshot); err != nil {
+		return err
+	}
+
+	blk, err := vf.txn.DAGstore().Get(vf.ctx, c)
+	if err != nil {
+		return NewErrVFetcherFailedToGetBlock(err)
+	}
+	if err := vf.store.DAGstore().Put(vf.ctx, blk); err != nil {
+		return NewErrVFetcherFailedToWriteBlock(err)
+	}
+	nd, err := dag.DecodeProtobuf(blk.RawData())
+	if err != nil {
+		return NewErrVFetcherFailedToDecodeNode(err)
+	}
+	for _, l := range nd.Links() {
+		if l.Name == core.HEAD {
+			continue
+		}
+		blk, err := vf.txn.DAGstore().Get(vf.ctx, l.Cid)
+		if err != nil {
+			return NewErrVFetcherFailedToGetBlock(err)
+		}
+		if err := vf.store.DAGstore().Put(vf.ctx, blk); err != nil {
+			return NewErrVFetcherFailedToWriteBlock(err)
+		}
+	}
+
+	return nil
+}
+
+// Snapshot returns the serialized state of the document at the version the fetcher has
+// seeked to.
+func (vf *VersionedFetcher) Snapshot(ctx context.Context) (base.DocumentSnapshot, error) {
+	data, err := dumpStore(ctx, vf.store.Datastore())
+	if err != nil {
+		return base.DocumentSnapshot{}, err
+	}
+	heads, err := dumpStore(ctx, vf.store.Headstore())
+	if err != nil {
+		return base.DocumentSnapshot{}, err
+	}
+	return base.DocumentSnapshot{
+		Data:  data,
+		Heads: heads,
+	}, nil
+}
+
+// writeSnapshot writes the given document snapshot to the given transient store.
+func writeSnapshot(ctx context.Context, store datastore.Txn, snapshot *base.DocumentSnapshot) error {
+	for key, value := range snapshot.Data {
+		if err := store.Datastore().Put(ctx, ds.NewKey(key), value); err != nil {
+			return err
+		}
+	}
+	for key, value := range snapshot.Heads {
+		if err := store.Headstore().Put(ctx, ds.NewKey(key), value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func dumpStore(ctx context.Context, store datastore.DSReaderWriter) (map[string][]byte, error) {
+	results, err := store.Query(ctx, dsq.Query{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = results.Close()
+	}()
+
+	entries := map[string][]byte{}
+	for result := range results.Next() {
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		entries[result.Key] = result.Value
+	}
+	return entries, nil
+}
+
 // merge in the state of the IPLD Block identified by CID c into the VersionedFetcher state.
 // Requires the CID to already exist in the DAGStore.
 // This function only works for merging Composite MerkleCRDT objects.
@@ -403,6 +522,11 @@ func (vf *VersionedFetcher) processNode(
 		return err
 	}
 
+	err = clock.VerifyNode(nd, delta, core.SignaturePolicyFromContext(vf.ctx))
+	if err != nil {
+		return err
+	}
+
 	err = mcrdt.Clock().ProcessNode(vf.ctx, delta, nd)
 	return err
 }
@@ -415,8 +539,12 @@ func (vf *VersionedFetcher) getDAGNode(c cid.Cid) (*dag.ProtoNode, error) {
 	}
 
 	// get node
-	// decode the block
-	return dag.DecodeProtobuf(blk.RawData())
+	// decode the block, keeping its CID so that the heads are recorded under it
+	nd, err := dag.DecodeProtobufBlock(blk)
+	if err != nil {
+		return nil, err
+	}
+	return nd.(*dag.ProtoNode), nil
 }
 
 // Close closes the VersionedFetcher.
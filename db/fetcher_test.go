@@ -13,9 +13,11 @@ package db
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/sourcenetwork/defradb/client/request"
 	"github.com/sourcenetwork/defradb/core"
 	"github.com/sourcenetwork/defradb/db/fetcher"
 )
@@ -26,3 +28,10 @@ func TestFetcherStartWithoutInit(t *testing.T) {
 	err := df.Start(ctx, core.Spans{})
 	assert.Error(t, err)
 }
+
+func TestAsOfFetcherStartWithoutInit(t *testing.T) {
+	ctx := context.Background()
+	df := fetcher.NewAsOfFetcher(request.AsOfTime{Time: time.Now()})
+	err := df.Start(ctx, core.Spans{})
+	assert.Error(t, err)
+}
@@ -12,8 +12,12 @@ package db
 
 import (
 	"context"
+	"math/big"
 	"time"
 
+	ds "github.com/ipfs/go-datastore"
+	"github.com/shopspring/decimal"
+
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/core"
 	"github.com/sourcenetwork/defradb/datastore"
@@ -29,6 +33,12 @@ type CollectionIndex interface {
 	Save(context.Context, datastore.Txn, *client.Document) error
 	// Update updates an existing document in the index
 	Update(context.Context, datastore.Txn, *client.Document, *client.Document) error
+	// Migrate replaces any entry of a document's values prior to a schema migration with
+	// one of its migrated values
+	Migrate(context.Context, datastore.Txn, *client.Document, *client.Document) error
+	// Reencode replaces any entry of a document written in a previous encoding of the indexed
+	// values with one in the current encoding
+	Reencode(context.Context, datastore.Txn, *client.Document) error
 	// RemoveAll removes all documents from the index
 	RemoveAll(context.Context, datastore.Txn) error
 	// Name returns the name of the index
@@ -44,7 +54,7 @@ func canConvertIndexFieldValue[T any](val any) bool {
 
 func getValidateIndexFieldFunc(kind client.FieldKind) func(any) bool {
 	switch kind {
-	case client.FieldKind_STRING, client.FieldKind_FOREIGN_OBJECT:
+	case client.FieldKind_STRING, client.FieldKind_DocID, client.FieldKind_FOREIGN_OBJECT, client.FieldKind_ENUM:
 		return canConvertIndexFieldValue[string]
 	case client.FieldKind_INT:
 		return canConvertIndexFieldValue[int64]
@@ -52,6 +62,10 @@ func getValidateIndexFieldFunc(kind client.FieldKind) func(any) bool {
 		return canConvertIndexFieldValue[float64]
 	case client.FieldKind_BOOL:
 		return canConvertIndexFieldValue[bool]
+	case client.FieldKind_BIGINT:
+		return canConvertIndexFieldValue[*big.Int]
+	case client.FieldKind_DECIMAL:
+		return canConvertIndexFieldValue[decimal.Decimal]
 	case client.FieldKind_BLOB:
 		return func(val any) bool {
 			blobStrVal, ok := val.(string)
@@ -62,12 +76,15 @@ func getValidateIndexFieldFunc(kind client.FieldKind) func(any) bool {
 		}
 	case client.FieldKind_DATETIME:
 		return func(val any) bool {
-			timeStrVal, ok := val.(string)
-			if !ok {
+			switch timeVal := val.(type) {
+			case time.Time:
+				return true
+			case string:
+				_, err := time.Parse(time.RFC3339, timeVal)
+				return err == nil
+			default:
 				return false
 			}
-			_, err := time.Parse(time.RFC3339, timeStrVal)
-			return err == nil
 		}
 	default:
 		return nil
@@ -115,21 +132,69 @@ type collectionBaseIndex struct {
 	fieldDesc         client.FieldDescription
 }
 
-func (i *collectionBaseIndex) getDocFieldValue(doc *client.Document) ([]byte, error) {
+// getDocIndexedValue returns the value of the indexed field of the given document, which is nil
+// if the field has no value.
+func (i *collectionBaseIndex) getDocIndexedValue(doc *client.Document) (*client.FieldValue, error) {
 	// collectionSimpleIndex only supports single field indexes, that's why we
 	// can safely access the first field
 	indexedFieldName := i.desc.Fields[0].Name
 	fieldVal, err := doc.GetValue(indexedFieldName)
 	if err != nil {
 		if errors.Is(err, client.ErrFieldNotExist) {
-			return client.NewFieldValue(client.LWW_REGISTER, nil).Bytes()
+			return nil, nil
 		} else {
 			return nil, err
 		}
 	}
+	if fieldVal.Value() == nil {
+		// Fields set to null are indexed as fields without a value.
+		return nil, nil
+	}
 	if !i.validateFieldFunc(fieldVal.Value()) {
 		return nil, NewErrInvalidFieldValue(i.fieldDesc.Kind, fieldVal)
 	}
+	return fieldVal, nil
+}
+
+func (i *collectionBaseIndex) getDocFieldValue(doc *client.Document) ([]byte, error) {
+	fieldVal, err := i.getDocIndexedValue(doc)
+	if err != nil {
+		return nil, err
+	}
+	if fieldVal == nil {
+		return client.NewFieldValue(client.LWW_REGISTER, nil).Bytes()
+	}
+	return encodeIndexedValue(i.fieldDesc.Kind, fieldVal)
+}
+
+// encodeIndexedValue encodes the given field value as it is stored in the index entries.
+//
+// Unlike the encoding of the stored field values, the encoding of the indexed values preserves
+// their order, so that range filters may seek to the matching values. Entries written before this
+// encoding store the field value encoding, and are rewritten by reencodeDocumentIndexes.
+func encodeIndexedValue(kind client.FieldKind, fieldVal *client.FieldValue) ([]byte, error) {
+	if kind == client.FieldKind_BIGINT || kind == client.FieldKind_DECIMAL {
+		// Arbitrary precision numbers are indexed in an order preserving form.
+		return core.EncodeOrderedBigNumber(fieldVal.Value())
+	}
+	if timeVal, ok := fieldVal.Value().(time.Time); ok {
+		// Date times are indexed in UTC, so that the byte-wise order of their fixed width
+		// encoding matches their chronological order.
+		return client.NewFieldValue(fieldVal.Type(), timeVal.UTC()).Bytes()
+	}
+	return fieldVal.Bytes()
+}
+
+// getDocPreviousFieldValue returns the indexed value of the given document in the encoding of the
+// index entries written before encodeIndexedValue, which is the encoding of the stored field values.
+func (i *collectionBaseIndex) getDocPreviousFieldValue(doc *client.Document) ([]byte, error) {
+	fieldVal, err := i.getDocIndexedValue(doc)
+	if err != nil {
+		return nil, err
+	}
+	if fieldVal == nil {
+		return client.NewFieldValue(client.LWW_REGISTER, nil).Bytes()
+	}
 	return fieldVal.Bytes()
 }
 
@@ -140,12 +205,27 @@ func (i *collectionBaseIndex) getDocumentsIndexKey(
 	if err != nil {
 		return core.IndexDataStoreKey{}, err
 	}
+	return i.newIndexKey(fieldValue), nil
+}
+
+// getDocumentsPreviousIndexKey returns the key of the entry of the given document written in
+// the encoding of the indexed values prior to encodeIndexedValue.
+func (i *collectionBaseIndex) getDocumentsPreviousIndexKey(
+	doc *client.Document,
+) (core.IndexDataStoreKey, error) {
+	fieldValue, err := i.getDocPreviousFieldValue(doc)
+	if err != nil {
+		return core.IndexDataStoreKey{}, err
+	}
+	return i.newIndexKey(fieldValue), nil
+}
 
+func (i *collectionBaseIndex) newIndexKey(fieldValue []byte) core.IndexDataStoreKey {
 	indexDataStoreKey := core.IndexDataStoreKey{}
 	indexDataStoreKey.CollectionID = i.collection.ID()
 	indexDataStoreKey.IndexID = i.desc.ID
 	indexDataStoreKey.FieldValues = [][]byte{fieldValue}
-	return indexDataStoreKey, nil
+	return indexDataStoreKey
 }
 
 func (i *collectionBaseIndex) deleteIndexKey(
@@ -215,6 +295,18 @@ func (i *collectionSimpleIndex) getDocumentsIndexKey(
 	return key, nil
 }
 
+func (i *collectionSimpleIndex) getDocumentsPreviousIndexKey(
+	doc *client.Document,
+) (core.IndexDataStoreKey, error) {
+	key, err := i.collectionBaseIndex.getDocumentsPreviousIndexKey(doc)
+	if err != nil {
+		return core.IndexDataStoreKey{}, err
+	}
+
+	key.FieldValues = append(key.FieldValues, []byte(doc.ID().String()))
+	return key, nil
+}
+
 // Save indexes a document by storing the indexed field value.
 func (i *collectionSimpleIndex) Save(
 	ctx context.Context,
@@ -257,6 +349,48 @@ func (i *collectionSimpleIndex) deleteDocIndex(
 	return i.deleteIndexKey(ctx, txn, key)
 }
 
+// Migrate replaces any entry of the old document's values with one of the new document's values.
+//
+// Unlike Update, the old entry may be absent, as the index may have been built from migrated values.
+// The old document may be nil if its values could not be decoded using the current schema.
+func (i *collectionSimpleIndex) Migrate(
+	ctx context.Context,
+	txn datastore.Txn,
+	oldDoc *client.Document,
+	newDoc *client.Document,
+) error {
+	if oldDoc != nil {
+		// The old values may not be valid for the current field kind, in which case they
+		// cannot have been indexed by this index.
+		oldKey, err := i.getDocumentsIndexKey(oldDoc)
+		if err == nil {
+			err = txn.Datastore().Delete(ctx, oldKey.ToDS())
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return i.Save(ctx, txn, newDoc)
+}
+
+// Reencode replaces the entry of the document written in the encoding of the indexed values
+// prior to encodeIndexedValue, if any, with one in the current encoding.
+func (i *collectionSimpleIndex) Reencode(
+	ctx context.Context,
+	txn datastore.Txn,
+	doc *client.Document,
+) error {
+	previousKey, err := i.getDocumentsPreviousIndexKey(doc)
+	if err != nil {
+		return err
+	}
+	err = txn.Datastore().Delete(ctx, previousKey.ToDS())
+	if err != nil {
+		return err
+	}
+	return i.Save(ctx, txn, doc)
+}
+
 type collectionUniqueIndex struct {
 	collectionBaseIndex
 }
@@ -339,3 +473,100 @@ func (i *collectionUniqueIndex) deleteDocIndex(
 	}
 	return i.deleteIndexKey(ctx, txn, key)
 }
+
+// Migrate replaces any entry of the old document's values with one of the new document's values.
+//
+// Unlike Update, the old entry may be absent, as the index may have been built from migrated values.
+// The old document may be nil if its values could not be decoded using the current schema.
+func (i *collectionUniqueIndex) Migrate(
+	ctx context.Context,
+	txn datastore.Txn,
+	oldDoc *client.Document,
+	newDoc *client.Document,
+) error {
+	newKey, err := i.getDocumentsIndexKey(newDoc)
+	if err != nil {
+		return err
+	}
+	if oldDoc != nil {
+		// The old values may not be valid for the current field kind, in which case they
+		// cannot have been indexed by this index.
+		oldKey, err := i.getDocumentsIndexKey(oldDoc)
+		if err == nil && oldKey.ToString() != newKey.ToString() {
+			err = i.deleteIndexKeyOfDoc(ctx, txn, oldKey, oldDoc.ID())
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return i.saveIndexKeyOfDoc(ctx, txn, newKey, newDoc)
+}
+
+// Reencode replaces the entry of the document written in the encoding of the indexed values
+// prior to encodeIndexedValue, if any, with one in the current encoding.
+func (i *collectionUniqueIndex) Reencode(
+	ctx context.Context,
+	txn datastore.Txn,
+	doc *client.Document,
+) error {
+	newKey, err := i.getDocumentsIndexKey(doc)
+	if err != nil {
+		return err
+	}
+	previousKey, err := i.getDocumentsPreviousIndexKey(doc)
+	if err != nil {
+		return err
+	}
+	if previousKey.ToString() != newKey.ToString() {
+		err = i.deleteIndexKeyOfDoc(ctx, txn, previousKey, doc.ID())
+		if err != nil {
+			return err
+		}
+	}
+	return i.saveIndexKeyOfDoc(ctx, txn, newKey, doc)
+}
+
+// saveIndexKeyOfDoc stores the given key for the given document, unless the document already
+// owns it.
+func (i *collectionUniqueIndex) saveIndexKeyOfDoc(
+	ctx context.Context,
+	txn datastore.Txn,
+	key core.IndexDataStoreKey,
+	doc *client.Document,
+) error {
+	owner, err := txn.Datastore().Get(ctx, key.ToDS())
+	if err == nil {
+		if string(owner) == doc.ID().String() {
+			return nil
+		}
+		return i.newUniqueIndexError(doc)
+	}
+	if !errors.Is(err, ds.ErrNotFound) {
+		return err
+	}
+	err = txn.Datastore().Put(ctx, key.ToDS(), []byte(doc.ID().String()))
+	if err != nil {
+		return NewErrFailedToStoreIndexedField(key.ToDS().String(), err)
+	}
+	return nil
+}
+
+// deleteIndexKeyOfDoc deletes the given key if it exists and is owned by the given document.
+func (i *collectionUniqueIndex) deleteIndexKeyOfDoc(
+	ctx context.Context,
+	txn datastore.Txn,
+	key core.IndexDataStoreKey,
+	docID client.DocID,
+) error {
+	owner, err := txn.Datastore().Get(ctx, key.ToDS())
+	if err != nil {
+		if errors.Is(err, ds.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if string(owner) != docID.String() {
+		return nil
+	}
+	return txn.Datastore().Delete(ctx, key.ToDS())
+}
@@ -16,6 +16,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	ipfsDatastore "github.com/ipfs/go-datastore"
 	"github.com/ipfs/go-datastore/query"
@@ -195,6 +196,7 @@ func (f *indexTestFixture) getPrefixFromDataStore(prefix string) [][]byte {
 
 func (f *indexTestFixture) mockTxn() *mocks.MultiStoreTxn {
 	mockedTxn := mocks.NewTxnWithMultistore(f.t)
+	mockedTxn.EXPECT().ID().Maybe().Return(uint64(0))
 
 	systemStoreOn := mockedTxn.MockSystemstore.EXPECT()
 	f.resetSystemStoreStubs(systemStoreOn)
@@ -314,6 +316,7 @@ func TestNonUnique_IfSystemStorageHasInvalidIndexDescription_Error(t *testing.T)
 	systemStoreOn := mockTxn.MockSystemstore.EXPECT()
 	systemStoreOn.Query(mock.Anything, mock.Anything).
 		Return(mocks.NewQueryResultsWithValues(t, []byte("invalid")), nil)
+	systemStoreOn.Put(mock.Anything, mock.Anything, mock.Anything).Maybe().Return(nil)
 
 	err := f.users.WithTxn(mockTxn).Create(f.ctx, doc)
 	assert.ErrorIs(t, err, datastore.NewErrInvalidStoredValue(nil))
@@ -332,6 +335,7 @@ func TestNonUnique_IfSystemStorageFailsToReadIndexDesc_Error(t *testing.T) {
 	systemStoreOn := mockTxn.MockSystemstore.EXPECT()
 	systemStoreOn.Query(mock.Anything, mock.Anything).
 		Return(nil, testErr)
+	systemStoreOn.Put(mock.Anything, mock.Anything, mock.Anything).Maybe().Return(nil)
 
 	err := f.users.WithTxn(mockTxn).Create(f.ctx, doc)
 	require.ErrorIs(t, err, testErr)
@@ -1119,3 +1123,83 @@ func TestUniqueUpdate_ShouldDeleteOldValueAndStoreNewOne(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+func TestReencodeIndexes_WithEntriesInPreviousEncoding_ReplacesThem(t *testing.T) {
+	for _, directive := range []string{"@index", "@index(unique: true)"} {
+		t.Run(directive, func(t *testing.T) {
+			testReencodeIndexesWithEntriesInPreviousEncoding(t, directive)
+		})
+	}
+}
+
+func testReencodeIndexesWithEntriesInPreviousEncoding(t *testing.T, directive string) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.AddSchema(ctx, `
+		type User {
+			name: String
+			birthday: DateTime `+directive+`
+		}
+	`)
+	require.NoError(t, err)
+
+	docs := execTestRequest(t, db, `mutation {
+		create_User(input: {name: "John", birthday: "2000-07-23T03:00:00+02:00"}) {
+			_docID
+		}
+	}`)
+	require.Len(t, docs, 1)
+	docID := docs[0]["_docID"].(string)
+
+	col, err := db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+	indexes, err := col.GetIndexes(ctx)
+	require.NoError(t, err)
+	require.Len(t, indexes, 1)
+	prefix := core.IndexDataStoreKey{CollectionID: col.ID(), IndexID: indexes[0].ID}
+
+	// replace the entry with one in the previous encoding, which kept the original offset
+	birthday, err := time.Parse(time.RFC3339, "2000-07-23T03:00:00+02:00")
+	require.NoError(t, err)
+	previousValue, err := client.NewFieldValue(client.LWW_REGISTER, birthday).Bytes()
+	require.NoError(t, err)
+	previousKey := prefix
+	previousKey.FieldValues = [][]byte{previousValue}
+	previousEntry := []byte(docID)
+	if !indexes[0].Unique {
+		previousKey.FieldValues = append(previousKey.FieldValues, []byte(docID))
+		previousEntry = []byte{}
+	}
+
+	txn, err := db.NewTxn(ctx, false)
+	require.NoError(t, err)
+	err = deleteWithPrefix(ctx, txn.Datastore(), prefix.ToString())
+	require.NoError(t, err)
+	err = txn.Datastore().Put(ctx, previousKey.ToDS(), previousEntry)
+	require.NoError(t, err)
+	err = txn.Systemstore().Delete(ctx, indexesReencodedKey)
+	require.NoError(t, err)
+	err = txn.Commit(ctx)
+	require.NoError(t, err)
+
+	err = db.upgradeData(ctx)
+	require.NoError(t, err)
+
+	docs = execTestRequest(t, db, `query {
+		User(filter: {birthday: {_eq: "2000-07-23T01:00:00Z"}}) {
+			name
+		}
+	}`)
+	assert.Equal(t, []map[string]any{{"name": "John"}}, docs)
+
+	txn, err = db.NewTxn(ctx, true)
+	require.NoError(t, err)
+	defer txn.Discard(ctx)
+	keys, err := datastore.FetchKeysForPrefix(ctx, prefix.ToString(), txn.Datastore())
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.NotEqual(t, previousKey.ToDS(), keys[0])
+}
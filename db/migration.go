@@ -0,0 +1,385 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/db/description"
+	"github.com/sourcenetwork/defradb/db/fetcher"
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+// documentMigrationCheckpoint records the progress of the eager migration of the documents of
+// a collection, allowing it to be resumed.
+type documentMigrationCheckpoint struct {
+	// SchemaVersionID is the ID of the schema version the documents are being migrated to.
+	//
+	// If the default schema version of the collection changes the migration restarts from the
+	// first document.
+	SchemaVersionID string
+
+	// LastDocID is the ID of the last document migrated, documents are migrated in ID order.
+	LastDocID string
+}
+
+// migrateDocuments migrates the documents of the given collection to its default schema version,
+// calling the given function to obtain a transaction for each batch.
+//
+// The function returned alongside each transaction is called once the batch has been migrated
+// within it, allowing implicit transactions to be committed.
+func (db *db) migrateDocuments(
+	ctx context.Context,
+	collectionName string,
+	opts client.MigrateDocumentsOptions,
+	newBatchTxn func() (datastore.Txn, func() error, error),
+) error {
+	batchSize := opts.BatchSize
+	if batchSize < 0 {
+		return NewErrInvalidMigrationBatchSize(batchSize)
+	}
+	if batchSize == 0 {
+		batchSize = client.DefaultMigrationBatchSize
+	}
+	if opts.BatchLimit < 0 {
+		return NewErrInvalidMigrationBatchLimit(opts.BatchLimit)
+	}
+
+	for batch := 0; opts.BatchLimit == 0 || batch < opts.BatchLimit; batch++ {
+		txn, complete, err := newBatchTxn()
+		if err != nil {
+			return err
+		}
+
+		done, err := db.migrateDocumentBatch(ctx, txn, collectionName, batchSize)
+		if err != nil {
+			return err
+		}
+
+		err = complete()
+		if err != nil {
+			return err
+		}
+
+		if done {
+			break
+		}
+	}
+
+	return nil
+}
+
+// migrateDocumentBatch migrates the next batch of up to the given number of documents of the given
+// collection to its default schema version.
+//
+// It returns true if there are no more documents to migrate after this batch.
+func (db *db) migrateDocumentBatch(
+	ctx context.Context,
+	txn datastore.Txn,
+	collectionName string,
+	batchSize int,
+) (bool, error) {
+	col, err := db.getCollectionByName(ctx, txn, collectionName)
+	if err != nil {
+		return false, err
+	}
+	c := col.(*collection)
+
+	checkpoint, err := getDocumentMigrationCheckpoint(ctx, txn, c)
+	if err != nil {
+		return false, err
+	}
+
+	docIDs, done, err := c.getDocIDsToMigrate(ctx, txn, checkpoint.LastDocID, batchSize)
+	if err != nil {
+		return false, err
+	}
+
+	for _, docID := range docIDs {
+		err = c.migrateDocument(ctx, txn, docID)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	key := core.NewCollectionDocumentMigrationKey(c.ID())
+	if done {
+		err = txn.Systemstore().Delete(ctx, key.ToDS())
+		return true, err
+	}
+
+	checkpoint.LastDocID = docIDs[len(docIDs)-1]
+	buf, err := json.Marshal(checkpoint)
+	if err != nil {
+		return false, err
+	}
+
+	return false, txn.Systemstore().Put(ctx, key.ToDS(), buf)
+}
+
+// getDocumentMigrationCheckpoint returns the persisted progress of the migration of the documents of
+// the given collection to its default schema version.
+func getDocumentMigrationCheckpoint(
+	ctx context.Context,
+	txn datastore.Txn,
+	c *collection,
+) (documentMigrationCheckpoint, error) {
+	checkpoint := documentMigrationCheckpoint{
+		SchemaVersionID: c.Schema().VersionID,
+	}
+
+	buf, err := txn.Systemstore().Get(ctx, core.NewCollectionDocumentMigrationKey(c.ID()).ToDS())
+	if err != nil {
+		if errors.Is(err, ds.ErrNotFound) {
+			return checkpoint, nil
+		}
+		return documentMigrationCheckpoint{}, err
+	}
+
+	var existing documentMigrationCheckpoint
+	err = json.Unmarshal(buf, &existing)
+	if err != nil {
+		return documentMigrationCheckpoint{}, err
+	}
+
+	if existing.SchemaVersionID != checkpoint.SchemaVersionID {
+		// The default schema version has changed since the checkpoint was made, so any
+		// document may need migrating again.
+		return checkpoint, nil
+	}
+
+	return existing, nil
+}
+
+// getDocIDsToMigrate returns the IDs of up to the given number of active documents, following the
+// given document ID, that are not at the default schema version of the collection.
+//
+// It returns true if there are no more documents to migrate following those returned.
+func (c *collection) getDocIDsToMigrate(
+	ctx context.Context,
+	txn datastore.Txn,
+	afterDocID string,
+	limit int,
+) ([]string, bool, error) {
+	docIDs := []string{}
+	done := true
+	err := c.iterateDocVersions(ctx, txn, afterDocID, func(docID string, schemaVersionID string) bool {
+		if schemaVersionID == c.Schema().VersionID {
+			return true
+		}
+		if len(docIDs) == limit {
+			done = false
+			return false
+		}
+		docIDs = append(docIDs, docID)
+		return true
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return docIDs, done, nil
+}
+
+// iterateDocVersions calls the given function with the ID and schema version ID of each active
+// document of the collection following the given document ID in document ID order, until the
+// function returns false.
+//
+// If no document ID is given the iteration starts from the first document of the collection.
+func (c *collection) iterateDocVersions(
+	ctx context.Context,
+	txn datastore.Txn,
+	afterDocID string,
+	exec func(docID string, schemaVersionID string) bool,
+) error {
+	prefix := core.PrimaryDataStoreKey{
+		CollectionId: c.Description().IDString(),
+	}
+	iterator, err := txn.Datastore().GetIterator(query.Query{
+		KeysOnly: true,
+		Orders:   []query.Order{query.OrderByKey{}},
+	})
+	if err != nil {
+		return err
+	}
+
+	startKey := prefix
+	startKey.DocID = afterDocID
+	// All primary keys of the collection are prefixed with the collection's primary key prefix
+	// followed by a '/', so they sort before the prefix followed by the next byte.
+	endKey := prefix.ToString() + string(rune('/'+1))
+
+	q, err := iterator.IteratePrefix(ctx, ds.RawKey(startKey.ToString()), ds.RawKey(endKey))
+	if err != nil {
+		_ = iterator.Close()
+		return err
+	}
+
+	for res := range q.Next() {
+		if res.Error != nil {
+			_ = q.Close()
+			_ = iterator.Close()
+			return res.Error
+		}
+
+		docID := ds.NewKey(res.Key).BaseNamespace()
+		if docID == afterDocID {
+			continue
+		}
+		schemaVersionID, err := txn.Datastore().Get(ctx, c.getDocVersionKey(docID).ToDS())
+		if err != nil {
+			if errors.Is(err, ds.ErrNotFound) {
+				// The document has been deleted.
+				continue
+			}
+			_ = q.Close()
+			_ = iterator.Close()
+			return err
+		}
+
+		if !exec(docID, string(schemaVersionID)) {
+			break
+		}
+	}
+
+	err = q.Close()
+	if err != nil {
+		_ = iterator.Close()
+		return err
+	}
+	return iterator.Close()
+}
+
+// getDocVersionKey returns the key of the schema version ID of the active document of the given ID.
+func (c *collection) getDocVersionKey(docID string) core.DataStoreKey {
+	return core.DataStoreKey{
+		CollectionID: c.Description().IDString(),
+		InstanceType: core.ValueKey,
+		DocID:        docID,
+		FieldId:      core.DATASTORE_DOC_VERSION_FIELD_ID,
+	}
+}
+
+// migrateDocument migrates the document of the given ID to the default schema version of the
+// collection, persisting the migrated values and replacing its index entries.
+func (c *collection) migrateDocument(ctx context.Context, txn datastore.Txn, rawDocID string) error {
+	docID, err := client.NewDocIDFromString(rawDocID)
+	if err != nil {
+		return err
+	}
+	primaryKey := c.getPrimaryKeyFromDocID(docID)
+
+	// The values prior to the migration are fetched without the lens so that any index entries
+	// of them may be replaced.
+	encodedDoc, err := c.fetch(ctx, txn, new(fetcher.DocumentFetcher), primaryKey, nil, false)
+	if err != nil {
+		return err
+	}
+	if encodedDoc == nil {
+		return nil
+	}
+	hasPath, err := c.hasMigrationPath(ctx, txn, encodedDoc.SchemaVersionID())
+	if err != nil {
+		return err
+	}
+	if !hasPath {
+		// The lens would yield the document unchanged, so it is left at its schema version.
+		return nil
+	}
+	// The values may not be decodable using the current schema, for example if the kind of a field
+	// has changed, in which case they cannot have been indexed using it either.
+	oldDoc, err := fetcher.Decode(encodedDoc, c.Schema())
+	if err != nil {
+		oldDoc = nil
+	}
+
+	// Fetching the document through the lens migrates it and persists the migrated values.
+	newDoc, err := c.get(ctx, txn, primaryKey, nil, false)
+	if err != nil {
+		return err
+	}
+	if newDoc == nil {
+		// The migration has chosen not to yield the document.
+		return nil
+	}
+
+	// If there are no migrations registered between the schema version of the document and the
+	// target, the lens yields the document unchanged without updating its version.  As all the local
+	// versions of a schema identify their fields by the same IDs, the document is then already valid
+	// at the target version.
+	err = txn.Datastore().Put(ctx, c.getDocVersionKey(rawDocID).ToDS(), []byte(c.Schema().VersionID))
+	if err != nil {
+		return err
+	}
+
+	for _, index := range c.indexes {
+		err = index.Migrate(ctx, txn, oldDoc, newDoc)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// hasMigrationPath returns true if documents at the given schema version can be migrated to the
+// default schema version of the collection.
+//
+// This is the case if it is a local version of the schema of the collection, or if a migration
+// has been registered from it.  The lens yields documents at any other version unchanged.
+func (c *collection) hasMigrationPath(ctx context.Context, txn datastore.Txn, schemaVersionID string) (bool, error) {
+	schema, err := description.GetSchemaVersion(ctx, txn, schemaVersionID)
+	if err == nil {
+		return schema.Root == c.Schema().Root, nil
+	}
+	if !errors.Is(err, ds.ErrNotFound) {
+		return false, err
+	}
+	return c.db.lensRegistry.WithTxn(txn).HasMigration(ctx, schemaVersionID)
+}
+
+// getDocumentMigrationStatus returns how many documents of the given collection are at, and are yet
+// to be migrated to, the default schema version of the collection.
+func (db *db) getDocumentMigrationStatus(
+	ctx context.Context,
+	txn datastore.Txn,
+	collectionName string,
+) (client.DocumentMigrationStatus, error) {
+	col, err := db.getCollectionByName(ctx, txn, collectionName)
+	if err != nil {
+		return client.DocumentMigrationStatus{}, err
+	}
+	c := col.(*collection)
+
+	status := client.DocumentMigrationStatus{
+		CollectionName:  c.Name(),
+		SchemaVersionID: c.Schema().VersionID,
+	}
+	err = c.iterateDocVersions(ctx, txn, "", func(docID string, schemaVersionID string) bool {
+		if schemaVersionID == status.SchemaVersionID {
+			status.Migrated++
+		} else {
+			status.Pending++
+		}
+		return true
+	})
+	if err != nil {
+		return client.DocumentMigrationStatus{}, err
+	}
+
+	return status, nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func TestMigrateDocuments_WithDocumentAtUnknownSchemaVersion_LeavesItPending(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.AddSchema(ctx, `
+		type Users {
+			name: String
+		}
+	`)
+	require.NoError(t, err)
+
+	docs := execTestRequest(t, db, `mutation {
+		create_Users(input: {name: "John"}) {
+			_docID
+		}
+	}`)
+	docID := docs[0]["_docID"].(string)
+
+	err = db.PatchSchema(ctx, `[
+		{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "email", "Kind": "String"} }
+	]`, true)
+	require.NoError(t, err)
+
+	col, err := db.GetCollectionByName(ctx, "Users")
+	require.NoError(t, err)
+
+	// the document may for example have been synced from a peer on a version unknown to this node
+	txn, err := db.NewTxn(ctx, false)
+	require.NoError(t, err)
+	versionKey := col.(*collection).getDocVersionKey(docID).ToDS()
+	err = txn.Datastore().Put(ctx, versionKey, []byte("bafkreiunknownversion"))
+	require.NoError(t, err)
+	require.NoError(t, txn.Commit(ctx))
+
+	status, err := db.MigrateDocuments(ctx, "Users", client.MigrateDocumentsOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, status.Migrated)
+	assert.Equal(t, 1, status.Pending)
+
+	txn, err = db.NewTxn(ctx, true)
+	require.NoError(t, err)
+	defer txn.Discard(ctx)
+	version, err := txn.Datastore().Get(ctx, versionKey)
+	require.NoError(t, err)
+	assert.Equal(t, "bafkreiunknownversion", string(version))
+}
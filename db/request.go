@@ -19,7 +19,14 @@ import (
 )
 
 // execRequest executes a request against the database.
-func (db *db) execRequest(ctx context.Context, request string, txn datastore.Txn) *client.RequestResult {
+func (db *db) execRequest(
+	ctx context.Context,
+	request string,
+	options client.GQLOptions,
+	txn datastore.Txn,
+) *client.RequestResult {
+	ctx = db.withSignaturePolicy(ctx)
+
 	res := &client.RequestResult{}
 	ast, err := db.parser.BuildRequestAST(request)
 	if err != nil {
@@ -27,10 +34,10 @@ func (db *db) execRequest(ctx context.Context, request string, txn datastore.Txn
 		return res
 	}
 	if db.parser.IsIntrospection(ast) {
-		return db.parser.ExecuteIntrospection(request)
+		return db.parser.ExecuteIntrospection(request, options)
 	}
 
-	parsedRequest, errors := db.parser.Parse(ast)
+	parsedRequest, errors := db.parser.Parse(ast, options)
 	if len(errors) > 0 {
 		res.GQL.Errors = errors
 		return res
@@ -50,17 +57,18 @@ func (db *db) execRequest(ctx context.Context, request string, txn datastore.Txn
 
 	planner := planner.New(ctx, db.WithTxn(txn), txn)
 
-	results, err := planner.RunRequest(ctx, parsedRequest)
+	results, extensions, err := planner.RunRequest(ctx, parsedRequest)
 	if err != nil {
 		res.GQL.Errors = []error{err}
 		return res
 	}
 
 	res.GQL.Data = results
+	res.GQL.Extensions = extensions
 	return res
 }
 
 // ExecIntrospection executes an introspection request against the database.
 func (db *db) ExecIntrospection(request string) *client.RequestResult {
-	return db.parser.ExecuteIntrospection(request)
+	return db.parser.ExecuteIntrospection(request, client.GQLOptions{})
 }
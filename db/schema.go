@@ -23,6 +23,7 @@ import (
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/db/base"
 	"github.com/sourcenetwork/defradb/db/description"
 )
 
@@ -77,9 +78,24 @@ func (db *db) loadSchema(ctx context.Context, txn datastore.Txn) error {
 		return err
 	}
 
+	references := base.GetRelationReferences(definitions)
+	txn.OnSuccess(func() {
+		db.relationReferences.Store(&references)
+	})
+
 	return db.parser.SetSchema(ctx, txn, definitions)
 }
 
+// getRelationReferences returns the relation fields with on-delete options that reference
+// documents of the given schema.
+func (db *db) getRelationReferences(schemaName string) []base.RelationReference {
+	references := db.relationReferences.Load()
+	if references == nil {
+		return nil
+	}
+	return (*references)[schemaName]
+}
+
 // patchSchema takes the given JSON patch string and applies it to the set of SchemaDescriptions
 // present in the database.
 //
@@ -227,7 +243,11 @@ func substituteSchemaPatch(
 
 			if isField {
 				if kind, isString := field["Kind"].(string); isString {
-					substitute, schemaName, err := getSubstituteFieldKind(kind, schemaByName)
+					substitute, schemaName, err := getSubstituteFieldKind(
+						kind,
+						splitPath[schemaNamePathIndex],
+						schemaByName,
+					)
 					if err != nil {
 						return nil, err
 					}
@@ -250,7 +270,7 @@ func substituteSchemaPatch(
 				}
 
 				if kind, isString := kind.(string); isString {
-					substitute, _, err := getSubstituteFieldKind(kind, schemaByName)
+					substitute, _, err := getSubstituteFieldKind(kind, splitPath[schemaNamePathIndex], schemaByName)
 					if err != nil {
 						return nil, err
 					}
@@ -308,14 +328,18 @@ func (db *db) getAllSchemas(
 // getSubstituteFieldKind checks and attempts to get the underlying integer value for the given string
 // Field Kind value. It will return the value if one is found, else returns an [ErrFieldKindNotFound].
 //
-// If the value represents a foreign relation the collection name will also be returned.
+// If the value represents a foreign relation the collection name will also be returned, if it
+// represents an enum declared on the host schema the enum name will be returned.
 func getSubstituteFieldKind(
 	kind string,
+	hostSchemaName string,
 	schemaByName map[string]client.SchemaDescription,
 ) (client.FieldKind, string, error) {
 	substitute, substituteFound := client.FieldKindStringToEnumMapping[kind]
 	if substituteFound {
 		return substitute, "", nil
+	} else if _, isEnum := schemaByName[hostSchemaName].GetEnum(kind); isEnum {
+		return client.FieldKind_ENUM, kind, nil
 	} else {
 		var collectionName string
 		var substitute client.FieldKind
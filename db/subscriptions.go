@@ -15,6 +15,7 @@ import (
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/core"
 	"github.com/sourcenetwork/defradb/datastore"
 	"github.com/sourcenetwork/defradb/events"
 	"github.com/sourcenetwork/defradb/planner"
@@ -72,9 +73,24 @@ func (db *db) handleEvent(
 	evt events.Update,
 	r *request.ObjectSubscription,
 ) {
+	if evt.IsPurge {
+		// purged documents can no longer be queried
+		return
+	}
+
+	operation := request.UpdateOperation
+	if evt.IsDelete {
+		operation = request.DeleteOperation
+	} else if evt.Priority == 1 {
+		operation = request.CreateOperation
+	}
+	if !r.IsNotifiedOf(operation) {
+		return
+	}
+
 	p := planner.New(ctx, db.WithTxn(txn), txn)
 
-	s := r.ToSelect(evt.DocID, evt.Cid.String())
+	s := r.ToSelect(evt.DocID, evt.Cid.String(), evt.IsDelete)
 
 	result, err := p.RunSubscriptionRequest(ctx, s)
 	if err != nil {
@@ -89,7 +105,38 @@ func (db *db) handleEvent(
 		return
 	}
 
+	for _, field := range r.EventFields {
+		name := field.Name
+		if field.Alias.HasValue() {
+			name = field.Alias.Value()
+		}
+		for _, doc := range result {
+			switch field.Name {
+			case request.OperationFieldName:
+				doc[name] = string(operation)
+			case request.ChangedFieldsFieldName:
+				doc[name] = changedFields(evt)
+			}
+		}
+	}
+
 	pub.Publish(client.GQLResult{
 		Data: result,
 	})
 }
+
+// changedFields returns the names of the fields changed by the given update, from the links of
+// its composite block to the blocks of the fields.
+func changedFields(evt events.Update) []string {
+	fields := []string{}
+	if evt.IsDelete || evt.Block == nil {
+		return fields
+	}
+	for _, link := range evt.Block.Links() {
+		if link.Name == core.HEAD {
+			continue
+		}
+		fields = append(fields, link.Name)
+	}
+	return fields
+}
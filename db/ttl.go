@@ -0,0 +1,272 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/ipfs/go-datastore/query"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/logging"
+)
+
+const (
+	defaultTTLSweepInterval = time.Minute
+	defaultTTLGracePeriod   = time.Hour
+)
+
+// startTTLSweeperIfNeeded starts the background deletion of expired documents if any of the
+// collections has a TTL.
+func (db *db) startTTLSweeperIfNeeded(ctx context.Context) error {
+	txn, err := db.NewTxn(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer txn.Discard(ctx)
+
+	cols, err := db.getAllCollections(ctx, txn)
+	if err != nil {
+		return err
+	}
+	for _, col := range cols {
+		if col.Description().TTL != nil {
+			db.startTTLSweeper()
+			return nil
+		}
+	}
+	return nil
+}
+
+// startTTLSweeper starts the background deletion of the expired documents of the collections
+// with a TTL.
+//
+// Does nothing if the sweep interval is not positive, or if the deletion has already started.
+func (db *db) startTTLSweeper() {
+	interval := defaultTTLSweepInterval
+	if db.ttlSweepInterval.HasValue() {
+		interval = db.ttlSweepInterval.Value()
+	}
+	if interval <= 0 {
+		return
+	}
+
+	db.ttlSweeperLock.Lock()
+	defer db.ttlSweeperLock.Unlock()
+	if db.ttlSweeperStop != nil {
+		return
+	}
+
+	db.ttlSweeperStop = make(chan struct{})
+	db.ttlSweeperDone = make(chan struct{})
+	go func() {
+		defer close(db.ttlSweeperDone)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-db.ttlSweeperStop:
+				return
+			case <-ticker.C:
+				db.deleteExpiredDocuments(context.Background())
+			}
+		}
+	}()
+}
+
+// stopTTLSweeper stops the background deletion of expired documents, and waits for any ongoing
+// deletion to complete.
+func (db *db) stopTTLSweeper() {
+	db.ttlSweeperLock.Lock()
+	defer db.ttlSweeperLock.Unlock()
+	if db.ttlSweeperStop == nil {
+		return
+	}
+	close(db.ttlSweeperStop)
+	<-db.ttlSweeperDone
+	db.ttlSweeperStop = nil
+}
+
+// deleteExpiredDocuments deletes the documents of the collections with a TTL whose expiry time
+// has passed.
+//
+// Documents are deleted through the regular delete path, so that the deletions are replicated
+// to peers. Only the documents created by this node are deleted, the peers receive the
+// deletions instead of each deleting the documents on their own, unless the documents have been
+// expired for longer than the grace period, in case the node that created them is unavailable.
+func (db *db) deleteExpiredDocuments(ctx context.Context) {
+	txn, err := db.NewTxn(ctx, true)
+	if err != nil {
+		log.ErrorE(ctx, "Failed to delete expired documents", err)
+		return
+	}
+	cols, err := db.getAllCollections(ctx, txn)
+	txn.Discard(ctx)
+	if err != nil {
+		log.ErrorE(ctx, "Failed to delete expired documents", err)
+		return
+	}
+
+	now := time.Now()
+	for _, col := range cols {
+		if col.Description().TTL == nil {
+			continue
+		}
+
+		docIDs, err := db.getExpiredDocIDs(ctx, col, now)
+		if err == nil && len(docIDs) > 0 {
+			_, err = col.DeleteWithDocIDs(ctx, docIDs)
+		}
+		if err != nil {
+			// the deletion is attempted again on the next sweep
+			log.ErrorE(
+				ctx,
+				"Failed to delete expired documents",
+				err,
+				logging.NewKV("Collection", col.Name()),
+			)
+			continue
+		}
+		if len(docIDs) > 0 {
+			log.Debug(
+				ctx,
+				"Deleted expired documents",
+				logging.NewKV("Collection", col.Name()),
+				logging.NewKV("Count", len(docIDs)),
+			)
+		}
+	}
+}
+
+// logInvalidExpiry logs the given error with the given index entry of the expiry times of the
+// given collection, which is skipped so that it does not prevent the expiry of other documents.
+func logInvalidExpiry(ctx context.Context, col client.Collection, key string, err error) {
+	log.ErrorE(
+		ctx,
+		"Skipping invalid expiry time",
+		err,
+		logging.NewKV("Collection", col.Name()),
+		logging.NewKV("Key", key),
+	)
+}
+
+// getExpiredDocIDs returns the IDs of the documents of the given collection, created by this
+// node, that expired before the given time, and those created by other nodes that expired
+// before the grace period preceding it.
+//
+// The expiry times are indexed in ascending order, so the index is iterated until the first
+// time that has not passed. Entries that are not valid expiry times are skipped. A document is
+// never found expired before the time given for its expiry, though it may be found up to a
+// second after it, as the fractional seconds of the time are not stored.
+func (db *db) getExpiredDocIDs(
+	ctx context.Context,
+	col client.Collection,
+	now time.Time,
+) ([]client.DocID, error) {
+	index, ok := getTTLIndex(col.Description())
+	if !ok {
+		return nil, nil
+	}
+	gracePeriod := defaultTTLGracePeriod
+	if db.ttlGracePeriod.HasValue() {
+		gracePeriod = db.ttlGracePeriod.Value()
+	}
+
+	txn, err := db.NewTxn(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Discard(ctx)
+
+	prefix := core.IndexDataStoreKey{CollectionID: col.ID(), IndexID: index.ID}
+	results, err := txn.Datastore().Query(ctx, query.Query{
+		Prefix: prefix.ToString(),
+		Orders: []query.Order{query.OrderByKey{}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = results.Close()
+	}()
+
+	docIDs := []client.DocID{}
+	for result := range results.Next() {
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		key, err := core.NewIndexDataStoreKey(result.Key)
+		if err != nil {
+			return nil, err
+		}
+		var value any
+		if err := cbor.Unmarshal(key.FieldValues[0], &value); err != nil {
+			logInvalidExpiry(ctx, col, result.Key, err)
+			continue
+		}
+		if value == nil {
+			// documents without an expiry time are indexed after all the others
+			break
+		}
+		expiry, ok := value.(string)
+		if !ok {
+			logInvalidExpiry(ctx, col, result.Key, NewErrInvalidExpiryTime(value))
+			continue
+		}
+		expiresAt, err := time.Parse(time.RFC3339, expiry)
+		if err != nil {
+			logInvalidExpiry(ctx, col, result.Key, err)
+			continue
+		}
+		// date times are stored and indexed in whole seconds, so the expiry time given for the
+		// document may be any time within the indexed second, which has only passed once the
+		// following second has started
+		expiresAt = expiresAt.Add(time.Second)
+		if expiresAt.After(now) {
+			break
+		}
+
+		docIDStr := string(key.FieldValues[len(key.FieldValues)-1])
+		if index.Unique {
+			docIDStr = string(result.Value)
+		}
+		isLocal, err := txn.Systemstore().Has(ctx, core.NewLocalDocumentKey(docIDStr).ToDS())
+		if err != nil {
+			return nil, err
+		}
+		if !isLocal && expiresAt.After(now.Add(-gracePeriod)) {
+			continue
+		}
+		docID, err := client.NewDocIDFromString(docIDStr)
+		if err != nil {
+			return nil, err
+		}
+		docIDs = append(docIDs, docID)
+	}
+	return docIDs, nil
+}
+
+// getTTLIndex returns the index of the given collection with the expiry field of its TTL as
+// first field, in ascending order.
+func getTTLIndex(desc client.CollectionDescription) (client.IndexDescription, bool) {
+	for _, index := range desc.Indexes {
+		if len(index.Fields) > 0 &&
+			index.Fields[0].Name == desc.TTL.Field &&
+			index.Fields[0].Direction == client.Ascending {
+			return index, true
+		}
+	}
+	return client.IndexDescription{}, false
+}
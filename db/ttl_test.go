@@ -0,0 +1,244 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/core"
+)
+
+const ttlTestSchema = `
+	type Sessions @ttl(field: "expiresAt") {
+		name: String
+		expiresAt: DateTime
+	}
+`
+
+// newTTLTestDB returns a new database with the given schema, whose expired documents are only
+// deleted when the test deletes them, unless the options set a sweep interval.
+func newTTLTestDB(t *testing.T, schema string, options ...Option) *implicitTxnDB {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx, append([]Option{WithTTLSweepInterval(0)}, options...)...)
+	require.NoError(t, err)
+	t.Cleanup(db.Close)
+
+	_, err = db.AddSchema(ctx, schema)
+	require.NoError(t, err)
+	return db
+}
+
+// createTTLTestDoc creates a session with the given name and expiry time, returning its ID.
+func createTTLTestDoc(t *testing.T, db *implicitTxnDB, name string, expiresAt time.Time) string {
+	docs := execTestRequest(t, db, fmt.Sprintf(`mutation {
+		create_Sessions(input: {name: %q, expiresAt: %q}) {
+			_docID
+		}
+	}`, name, expiresAt.Format(time.RFC3339)))
+	require.Len(t, docs, 1)
+	return docs[0]["_docID"].(string)
+}
+
+// getTTLTestDocNames returns the name of each remaining session, in ascending order.
+func getTTLTestDocNames(t *testing.T, db *implicitTxnDB) []map[string]any {
+	return execTestRequest(t, db, `query {
+		Sessions(order: {name: ASC}) {
+			name
+		}
+	}`)
+}
+
+// unmarkLocalDocument removes the mark of the given document as created by this node, as if it
+// had been received from a peer.
+func unmarkLocalDocument(t *testing.T, db *implicitTxnDB, docID string) {
+	ctx := context.Background()
+	txn, err := db.NewTxn(ctx, false)
+	require.NoError(t, err)
+	err = txn.Systemstore().Delete(ctx, core.NewLocalDocumentKey(docID).ToDS())
+	require.NoError(t, err)
+	err = txn.Commit(ctx)
+	require.NoError(t, err)
+}
+
+func TestTTL_WithExpiredDocuments_DeletesThem(t *testing.T) {
+	ctx := context.Background()
+	db := newTTLTestDB(t, ttlTestSchema, WithUpdateEvents())
+
+	sub, err := db.events.Updates.Value().Subscribe()
+	require.NoError(t, err)
+
+	createTTLTestDoc(t, db, "expired", time.Now().Add(-time.Hour).UTC())
+	createTTLTestDoc(t, db, "active", time.Now().Add(time.Hour).UTC())
+	execTestRequest(t, db, `mutation {
+		create_Sessions(input: {name: "permanent"}) {
+			_docID
+		}
+	}`)
+
+	db.deleteExpiredDocuments(ctx)
+
+	assert.Equal(t, []map[string]any{{"name": "active"}, {"name": "permanent"}}, getTTLTestDocNames(t, db))
+
+	// the deletion is published like any other, so that it is replicated to peers
+	var deleteEvents int
+	for len(sub) > 0 {
+		if evt := <-sub; evt.Priority > 1 {
+			deleteEvents++
+		}
+	}
+	assert.Equal(t, 1, deleteEvents)
+}
+
+func TestTTL_WithDescendingIndexOnExpiryField_DeletesExpiredDocuments(t *testing.T) {
+	ctx := context.Background()
+	db := newTTLTestDB(t, `
+		type Sessions @ttl(field: "expiresAt") @index(fields: ["expiresAt", "name"], directions: [DESC, ASC]) {
+			name: String
+			expiresAt: DateTime
+		}
+	`)
+
+	createTTLTestDoc(t, db, "expired", time.Now().Add(-time.Hour).UTC())
+
+	db.deleteExpiredDocuments(ctx)
+
+	assert.Empty(t, getTTLTestDocNames(t, db))
+}
+
+func TestTTL_WithSweepInterval_DeletesExpiredDocumentsInBackground(t *testing.T) {
+	db := newTTLTestDB(t, ttlTestSchema, WithTTLSweepInterval(10*time.Millisecond))
+
+	createTTLTestDoc(t, db, "expired", time.Now().Add(-time.Second).UTC())
+
+	require.Eventually(t, func() bool {
+		return len(getTTLTestDocNames(t, db)) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestTTL_WithExpiryTimesInOtherTimeZones_DeletesExpiredDocuments(t *testing.T) {
+	ctx := context.Background()
+	db := newTTLTestDB(t, ttlTestSchema)
+
+	// the expired time is written in a time zone ahead of the active one, so that the index
+	// would order them the other way around if they were not indexed in UTC
+	createTTLTestDoc(t, db, "expired", time.Now().Add(-time.Hour).In(time.FixedZone("", 12*60*60)))
+	createTTLTestDoc(t, db, "active", time.Now().Add(time.Hour).In(time.FixedZone("", -12*60*60)))
+
+	db.deleteExpiredDocuments(ctx)
+
+	assert.Equal(t, []map[string]any{{"name": "active"}}, getTTLTestDocNames(t, db))
+}
+
+func TestTTL_WithInvalidIndexedExpiryTimes_SkipsThem(t *testing.T) {
+	ctx := context.Background()
+	db := newTTLTestDB(t, ttlTestSchema)
+
+	createTTLTestDoc(t, db, "expired", time.Now().Add(-time.Hour).UTC())
+
+	col, err := db.GetCollectionByName(ctx, "Sessions")
+	require.NoError(t, err)
+	index, ok := getTTLIndex(col.Description())
+	require.True(t, ok)
+
+	// the invalid entries are ordered before the expired document
+	txn, err := db.NewTxn(ctx, false)
+	require.NoError(t, err)
+	for i, value := range []any{int64(1), "0000-not-a-time", []byte{0x1c}} {
+		fieldValue, err := cbor.Marshal(value)
+		require.NoError(t, err)
+		if i == 2 {
+			fieldValue = value.([]byte)
+		}
+		key := core.IndexDataStoreKey{
+			CollectionID: col.ID(),
+			IndexID:      index.ID,
+			FieldValues:  [][]byte{fieldValue, []byte(fmt.Sprintf("bae-invalid-%d", i))},
+		}
+		err = txn.Datastore().Put(ctx, key.ToDS(), []byte{})
+		require.NoError(t, err)
+	}
+	err = txn.Commit(ctx)
+	require.NoError(t, err)
+
+	db.deleteExpiredDocuments(ctx)
+
+	assert.Empty(t, getTTLTestDocNames(t, db))
+}
+
+func TestTTL_WithDocumentCreatedByPeerWithinGracePeriod_DoesNotDeleteIt(t *testing.T) {
+	ctx := context.Background()
+	db := newTTLTestDB(t, ttlTestSchema)
+
+	docID := createTTLTestDoc(t, db, "expired", time.Now().Add(-time.Minute).UTC())
+	unmarkLocalDocument(t, db, docID)
+
+	db.deleteExpiredDocuments(ctx)
+
+	assert.Equal(t, []map[string]any{{"name": "expired"}}, getTTLTestDocNames(t, db))
+}
+
+func TestTTL_WithDocumentCreatedByPeerExpiredForLongerThanGracePeriod_DeletesIt(t *testing.T) {
+	ctx := context.Background()
+	db := newTTLTestDB(t, ttlTestSchema, WithTTLGracePeriod(time.Hour))
+
+	docID := createTTLTestDoc(t, db, "within", time.Now().Add(-time.Minute).UTC())
+	unmarkLocalDocument(t, db, docID)
+	docID = createTTLTestDoc(t, db, "past", time.Now().Add(-2*time.Hour).UTC())
+	unmarkLocalDocument(t, db, docID)
+
+	db.deleteExpiredDocuments(ctx)
+
+	assert.Equal(t, []map[string]any{{"name": "within"}}, getTTLTestDocNames(t, db))
+}
+
+func TestTTL_WithoutTTLCollection_DoesNotStartSweeper(t *testing.T) {
+	ctx := context.Background()
+	db := newTTLTestDB(t, `
+		type Users {
+			name: String
+		}
+	`, WithTTLSweepInterval(10*time.Millisecond))
+	require.Nil(t, db.ttlSweeperStop)
+
+	_, err := db.AddSchema(ctx, ttlTestSchema)
+	require.NoError(t, err)
+	require.NotNil(t, db.ttlSweeperStop)
+}
+
+func TestTTL_WithExpiryWithinTheCurrentSecond_DoesNotDeleteDocumentEarly(t *testing.T) {
+	ctx := context.Background()
+	db := newTTLTestDB(t, ttlTestSchema)
+
+	execTestRequest(t, db, `mutation {
+		create_Sessions(input: {name: "expiring", expiresAt: "2000-07-23T03:00:00.750Z"}) {
+			_docID
+		}
+	}`)
+	col, err := db.GetCollectionByName(ctx, "Sessions")
+	require.NoError(t, err)
+
+	// the fractional seconds of the expiry time are not stored, so the document is only
+	// expired once the second of its expiry time has passed
+	docIDs, err := db.getExpiredDocIDs(ctx, col, time.Date(2000, 7, 23, 3, 0, 0, 500_000_000, time.UTC))
+	require.NoError(t, err)
+	assert.Empty(t, docIDs)
+
+	docIDs, err = db.getExpiredDocIDs(ctx, col, time.Date(2000, 7, 23, 3, 0, 1, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Len(t, docIDs, 1)
+}
@@ -33,7 +33,11 @@ type explicitTxnDB struct {
 }
 
 // ExecRequest executes a request against the database.
-func (db *implicitTxnDB) ExecRequest(ctx context.Context, request string) *client.RequestResult {
+func (db *implicitTxnDB) ExecRequest(
+	ctx context.Context,
+	request string,
+	opts ...client.RequestOption,
+) *client.RequestResult {
 	txn, err := db.NewTxn(ctx, false)
 	if err != nil {
 		res := &client.RequestResult{}
@@ -42,7 +46,7 @@ func (db *implicitTxnDB) ExecRequest(ctx context.Context, request string) *clien
 	}
 	defer txn.Discard(ctx)
 
-	res := db.execRequest(ctx, request, txn)
+	res := db.execRequest(ctx, request, client.NewGQLOptions(opts...), txn)
 	if len(res.GQL.Errors) > 0 {
 		return res
 	}
@@ -59,8 +63,9 @@ func (db *implicitTxnDB) ExecRequest(ctx context.Context, request string) *clien
 func (db *explicitTxnDB) ExecRequest(
 	ctx context.Context,
 	request string,
+	opts ...client.RequestOption,
 ) *client.RequestResult {
-	return db.execRequest(ctx, request, db.txn)
+	return db.execRequest(ctx, request, client.NewGQLOptions(opts...), db.txn)
 }
 
 // GetCollectionByName returns an existing collection within the database.
@@ -167,12 +172,12 @@ func (db *implicitTxnDB) GetAllCollections(ctx context.Context) ([]client.Collec
 	}
 	defer txn.Discard(ctx)
 
-	return db.getAllCollections(ctx, txn)
+	return db.getAllPublicCollections(ctx, txn)
 }
 
 // GetAllCollections gets all the currently defined collections.
 func (db *explicitTxnDB) GetAllCollections(ctx context.Context) ([]client.Collection, error) {
-	return db.getAllCollections(ctx, db.txn)
+	return db.getAllPublicCollections(ctx, db.txn)
 }
 
 // GetSchemasByName returns the all schema versions with the given name.
@@ -378,6 +383,72 @@ func (db *explicitTxnDB) SetMigration(ctx context.Context, cfg client.LensConfig
 	return db.lensRegistry.SetMigration(ctx, cfg)
 }
 
+// MigrateDocuments eagerly migrates the documents of the given collection to the default schema
+// version of the collection, committing each batch within its own transaction.
+func (db *implicitTxnDB) MigrateDocuments(
+	ctx context.Context,
+	collectionName string,
+	opts client.MigrateDocumentsOptions,
+) (client.DocumentMigrationStatus, error) {
+	var txn datastore.Txn
+	defer func() {
+		if txn != nil {
+			txn.Discard(ctx)
+		}
+	}()
+
+	err := db.migrateDocuments(ctx, collectionName, opts, func() (datastore.Txn, func() error, error) {
+		var err error
+		txn, err = db.NewTxn(ctx, false)
+		if err != nil {
+			return nil, nil, err
+		}
+		return txn, func() error { return txn.Commit(ctx) }, nil
+	})
+	if err != nil {
+		return client.DocumentMigrationStatus{}, err
+	}
+
+	return db.GetDocumentMigrationStatus(ctx, collectionName)
+}
+
+// MigrateDocuments eagerly migrates the documents of the given collection to the default schema
+// version of the collection, all batches are migrated within the explicit transaction.
+func (db *explicitTxnDB) MigrateDocuments(
+	ctx context.Context,
+	collectionName string,
+	opts client.MigrateDocumentsOptions,
+) (client.DocumentMigrationStatus, error) {
+	err := db.migrateDocuments(ctx, collectionName, opts, func() (datastore.Txn, func() error, error) {
+		return db.txn, func() error { return nil }, nil
+	})
+	if err != nil {
+		return client.DocumentMigrationStatus{}, err
+	}
+
+	return db.getDocumentMigrationStatus(ctx, db.txn, collectionName)
+}
+
+func (db *implicitTxnDB) GetDocumentMigrationStatus(
+	ctx context.Context,
+	collectionName string,
+) (client.DocumentMigrationStatus, error) {
+	txn, err := db.NewTxn(ctx, true)
+	if err != nil {
+		return client.DocumentMigrationStatus{}, err
+	}
+	defer txn.Discard(ctx)
+
+	return db.getDocumentMigrationStatus(ctx, txn, collectionName)
+}
+
+func (db *explicitTxnDB) GetDocumentMigrationStatus(
+	ctx context.Context,
+	collectionName string,
+) (client.DocumentMigrationStatus, error) {
+	return db.getDocumentMigrationStatus(ctx, db.txn, collectionName)
+}
+
 func (db *implicitTxnDB) AddView(ctx context.Context, query string, sdl string) ([]client.CollectionDefinition, error) {
 	txn, err := db.NewTxn(ctx, false)
 	if err != nil {
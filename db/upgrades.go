@@ -0,0 +1,212 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"sort"
+
+	"github.com/fxamacker/cbor/v2"
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+// documentUpgradeFn upgrades the data of the given document of the given collection within the
+// given transaction.
+//
+// It must be safe to run again on a document that has already been upgraded.
+type documentUpgradeFn func(ctx context.Context, txn datastore.Txn, col *collection, docID string) error
+
+// startDataUpgrades starts upgrading, in the background, the data written by previous versions
+// of the database, such as the commit history that was not yet recorded or the index entries
+// written in a previous encoding.
+//
+// The data is upgraded outside of the transaction opening the database, one document per
+// transaction, so that the size of the data does not prevent the database from opening. Until
+// an upgrade is completed, the requests relying on it may not see the documents that have yet
+// to be upgraded, such as asOf requests before the commit history is backfilled, or filters
+// using an index before its entries are reencoded.
+func (db *db) startDataUpgrades() {
+	ctx, cancel := context.WithCancel(context.Background())
+	db.dataUpgradesCancel = cancel
+	db.dataUpgradesDone = make(chan struct{})
+	go func() {
+		defer close(db.dataUpgradesDone)
+
+		err := db.upgradeData(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.ErrorE(ctx, "Failed to upgrade the data of the database", err)
+		}
+	}()
+}
+
+// stopDataUpgrades stops the background upgrade of the data, and waits for the document being
+// upgraded to be committed. The upgrade resumes the next time the database is opened.
+func (db *db) stopDataUpgrades() {
+	if db.dataUpgradesCancel == nil {
+		return
+	}
+	db.dataUpgradesCancel()
+	<-db.dataUpgradesDone
+	db.dataUpgradesCancel = nil
+}
+
+// upgradeData runs each upgrade of the data that has yet to be completed, in order.
+func (db *db) upgradeData(ctx context.Context) error {
+	err := db.upgradeDocuments(ctx, commitHistoryBackfilledKey, backfillDocumentCommitHistory)
+	if err != nil {
+		return err
+	}
+	return db.upgradeDocuments(ctx, indexesReencodedKey, reencodeDocumentIndexes)
+}
+
+// upgradeDocuments runs the given upgrade on every document of every collection, unless the
+// given marker key shows that it has already been completed.
+//
+// Each document is upgraded in its own transaction, retried on failure up to the maximum number
+// of transaction retries. The last upgraded document is recorded along with its upgrade, so that
+// an interrupted upgrade resumes from the following document, and the marker is set once all the
+// documents have been upgraded.
+func (db *db) upgradeDocuments(ctx context.Context, marker ds.Key, upgrade documentUpgradeFn) error {
+	progressKey := marker.ChildString(upgradeProgressKeyName)
+
+	txn, err := db.NewTxn(ctx, true)
+	if err != nil {
+		return err
+	}
+	isDone, err := txn.Systemstore().Has(ctx, marker)
+	if err != nil {
+		txn.Discard(ctx)
+		return err
+	}
+	if isDone {
+		txn.Discard(ctx)
+		return nil
+	}
+	progress, err := getUpgradeProgress(ctx, txn, progressKey)
+	if err != nil {
+		txn.Discard(ctx)
+		return err
+	}
+	cols, err := db.getAllCollections(ctx, txn)
+	txn.Discard(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the documents are upgraded in collection then document ID order, so that the progress of
+	// the upgrade can be recorded as its last upgraded document
+	sort.Slice(cols, func(i, j int) bool {
+		return cols[i].ID() < cols[j].ID()
+	})
+	for _, col := range cols {
+		if col.ID() < progress.CollectionID {
+			continue
+		}
+		docIDs, err := db.getDocIDs(ctx, col.(*collection))
+		if err != nil {
+			return err
+		}
+		for _, docID := range docIDs {
+			if col.ID() == progress.CollectionID && docID <= progress.DocID {
+				continue
+			}
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			err := db.runUpgradeTxn(ctx, func(txn datastore.Txn) error {
+				err := upgrade(ctx, txn, col.(*collection), docID)
+				if err != nil {
+					return err
+				}
+				buf, err := cbor.Marshal(upgradeProgress{CollectionID: col.ID(), DocID: docID})
+				if err != nil {
+					return err
+				}
+				return txn.Systemstore().Put(ctx, progressKey, buf)
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return db.runUpgradeTxn(ctx, func(txn datastore.Txn) error {
+		err := txn.Systemstore().Delete(ctx, progressKey)
+		if err != nil {
+			return err
+		}
+		return txn.Systemstore().Put(ctx, marker, []byte{1})
+	})
+}
+
+// upgradeProgressKeyName is the name of the child key of the marker of an upgrade, holding the
+// progress of the upgrade until it is completed.
+const upgradeProgressKeyName = "progress"
+
+// upgradeProgress is the last document upgraded by an interrupted upgrade.
+type upgradeProgress struct {
+	CollectionID uint32
+	DocID        string
+}
+
+// getUpgradeProgress returns the progress recorded under the given key, which is empty if the
+// upgrade has not started yet.
+func getUpgradeProgress(ctx context.Context, txn datastore.Txn, key ds.Key) (upgradeProgress, error) {
+	var progress upgradeProgress
+	buf, err := txn.Systemstore().Get(ctx, key)
+	if errors.Is(err, ds.ErrNotFound) {
+		return progress, nil
+	}
+	if err != nil {
+		return progress, err
+	}
+	err = cbor.Unmarshal(buf, &progress)
+	return progress, err
+}
+
+// getDocIDs returns the IDs of all the documents of the given collection, including deleted ones.
+func (db *db) getDocIDs(ctx context.Context, col *collection) ([]string, error) {
+	txn, err := db.NewTxn(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Discard(ctx)
+
+	return col.getDocIDs(ctx, txn)
+}
+
+// runUpgradeTxn runs the given function in a new transaction and commits it, retrying up to the
+// maximum number of transaction retries if either fails, for example because of a conflict with
+// a concurrent write to the same document.
+func (db *db) runUpgradeTxn(ctx context.Context, fn func(datastore.Txn) error) error {
+	var err error
+	for i := 0; i < db.MaxTxnRetries(); i++ {
+		err = func() error {
+			txn, err := db.NewTxn(ctx, false)
+			if err != nil {
+				return err
+			}
+			defer txn.Discard(ctx)
+
+			if err := fn(txn); err != nil {
+				return err
+			}
+			return txn.Commit(ctx)
+		}()
+		if err == nil {
+			return nil
+		}
+	}
+	return err
+}
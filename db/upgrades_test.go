@@ -0,0 +1,125 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	badger "github.com/sourcenetwork/badger/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/core"
+	badgerds "github.com/sourcenetwork/defradb/datastore/badger/v4"
+)
+
+func openTestDB(t *testing.T, path string) *implicitTxnDB {
+	opts := badgerds.Options{Options: badger.DefaultOptions(path)}
+	rootstore, err := badgerds.NewDatastore(path, &opts)
+	require.NoError(t, err)
+	db, err := newDB(context.Background(), rootstore)
+	require.NoError(t, err)
+	return db
+}
+
+func getSystemKeys(t *testing.T, db *implicitTxnDB, prefix string) []string {
+	ctx := context.Background()
+	txn, err := db.NewTxn(ctx, true)
+	require.NoError(t, err)
+	defer txn.Discard(ctx)
+
+	results, err := txn.Systemstore().Query(ctx, query.Query{Prefix: prefix, KeysOnly: true})
+	require.NoError(t, err)
+	entries, err := results.Rest()
+	require.NoError(t, err)
+	keys := make([]string, len(entries))
+	for i, entry := range entries {
+		keys[i] = entry.Key
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func requireUpgradeDone(t *testing.T, db *implicitTxnDB, marker ds.Key) {
+	ctx := context.Background()
+	txn, err := db.NewTxn(ctx, true)
+	require.NoError(t, err)
+	defer txn.Discard(ctx)
+
+	isDone, err := txn.Systemstore().Has(ctx, marker)
+	require.NoError(t, err)
+	require.True(t, isDone)
+	hasProgress, err := txn.Systemstore().Has(ctx, marker.ChildString(upgradeProgressKeyName))
+	require.NoError(t, err)
+	require.False(t, hasProgress)
+}
+
+func TestOpen_WithExistingHistory_KeepsHistory(t *testing.T) {
+	path := t.TempDir()
+
+	db := openTestDB(t, path)
+	createHistoryTestDoc(t, db, historyTestSchema, 2)
+	history := getSystemKeys(t, db, core.COMMIT_HISTORY)
+	require.Len(t, history, 3)
+	db.Close()
+
+	db = openTestDB(t, path)
+	defer db.Close()
+	<-db.dataUpgradesDone
+
+	require.Equal(t, history, getSystemKeys(t, db, core.COMMIT_HISTORY))
+	requireUpgradeDone(t, db, commitHistoryBackfilledKey)
+}
+
+func TestOpen_WithInterruptedBackfill_ResumesAfterLastBackfilledDocument(t *testing.T) {
+	ctx := context.Background()
+	path := t.TempDir()
+
+	db := openTestDB(t, path)
+	_, err := db.AddSchema(ctx, historyTestSchema)
+	require.NoError(t, err)
+	docIDs := []string{}
+	for _, name := range []string{"John", "Islam"} {
+		docs := execTestRequest(t, db, `mutation {
+			create_Users(input: {name: "`+name+`"}) {
+				_docID
+			}
+		}`)
+		docIDs = append(docIDs, docs[0]["_docID"].(string))
+	}
+	sort.Strings(docIDs)
+	col, err := db.GetCollectionByName(ctx, "Users")
+	require.NoError(t, err)
+
+	// remove the commit history, as if the backfill stopped after the first document
+	deleteCommitHistory(t, db)
+	txn, err := db.NewTxn(ctx, false)
+	require.NoError(t, err)
+	progress, err := cbor.Marshal(upgradeProgress{CollectionID: col.ID(), DocID: docIDs[0]})
+	require.NoError(t, err)
+	err = txn.Systemstore().Put(ctx, commitHistoryBackfilledKey.ChildString(upgradeProgressKeyName), progress)
+	require.NoError(t, err)
+	err = txn.Commit(ctx)
+	require.NoError(t, err)
+	db.Close()
+
+	db = openTestDB(t, path)
+	defer db.Close()
+	<-db.dataUpgradesDone
+
+	require.Empty(t, getSystemKeys(t, db, core.NewDocumentHistoryKey(docIDs[0], "").ToString()))
+	require.Len(t, getSystemKeys(t, db, core.NewDocumentHistoryKey(docIDs[1], "").ToString()), 1)
+	requireUpgradeDone(t, db, commitHistoryBackfilledKey)
+}
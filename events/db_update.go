@@ -30,4 +30,16 @@ type Update struct {
 	SchemaRoot string
 	Block      ipld.Node
 	Priority   uint64
+
+	// IsDelete is true if the update deletes the document.
+	IsDelete bool
+
+	// IsPurge is true if the document has been purged, in which case the update has no block.
+	IsPurge bool
+
+	// PurgeSignature is the signature of the purge, if the document has been purged by a node
+	// signing its commits.
+	PurgeSignature []byte
+	// PurgePublicKey is the marshalled public key used to verify PurgeSignature.
+	PurgePublicKey []byte
 }
@@ -49,4 +49,8 @@ func New[T any](commandBufferSize int, eventBufferSize int) Channel[T] {
 type Events struct {
 	// Updates publishes an `Update` for each document written to in the database.
 	Updates UpdateChannel
+
+	// Violations publishes a `Violation` for each block merged from a peer that does not satisfy
+	// the schema of its document.
+	Violations ViolationChannel
 }
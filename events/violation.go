@@ -0,0 +1,41 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package events
+
+import (
+	"github.com/ipfs/go-cid"
+	"github.com/sourcenetwork/immutable"
+)
+
+// ViolationChannel is the bus onto which constraint violations are published.
+type ViolationChannel = immutable.Option[Channel[Violation]]
+
+// EmptyViolationChannel is an empty ViolationChannel.
+var EmptyViolationChannel = immutable.None[Channel[Violation]]()
+
+// Violation represents a block merged from a peer that does not satisfy the schema of its
+// document, such as a null value for a non-null field or a value outside of the constraint of
+// its field.
+//
+// Merged blocks cannot be rejected without causing peers to diverge, so the document holds the
+// violating value all the same.
+type Violation struct {
+	DocID      string
+	Cid        cid.Cid
+	SchemaRoot string
+
+	// Field is the name of the field holding the violating value, or of the non-null field missing
+	// from the block creating the document.
+	Field string
+
+	// Err describes the violation.
+	Err error
+}
@@ -193,6 +193,52 @@ func (c *Client) SetMigration(ctx context.Context, config client.LensConfig) err
 	return c.LensRegistry().SetMigration(ctx, config)
 }
 
+type migrateDocumentsRequest struct {
+	CollectionName string
+	client.MigrateDocumentsOptions
+}
+
+func (c *Client) MigrateDocuments(
+	ctx context.Context,
+	collectionName string,
+	opts client.MigrateDocumentsOptions,
+) (client.DocumentMigrationStatus, error) {
+	methodURL := c.http.baseURL.JoinPath("schema", "migration", "documents")
+
+	body, err := json.Marshal(migrateDocumentsRequest{collectionName, opts})
+	if err != nil {
+		return client.DocumentMigrationStatus{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, methodURL.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return client.DocumentMigrationStatus{}, err
+	}
+	var status client.DocumentMigrationStatus
+	if err := c.http.requestJson(req, &status); err != nil {
+		return client.DocumentMigrationStatus{}, err
+	}
+	return status, nil
+}
+
+func (c *Client) GetDocumentMigrationStatus(
+	ctx context.Context,
+	collectionName string,
+) (client.DocumentMigrationStatus, error) {
+	methodURL := c.http.baseURL.JoinPath("schema", "migration", "documents")
+	methodURL.RawQuery = url.Values{"name": []string{collectionName}}.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, methodURL.String(), nil)
+	if err != nil {
+		return client.DocumentMigrationStatus{}, err
+	}
+	var status client.DocumentMigrationStatus
+	if err := c.http.requestJson(req, &status); err != nil {
+		return client.DocumentMigrationStatus{}, err
+	}
+	return status, nil
+}
+
 func (c *Client) LensRegistry() client.LensRegistry {
 	return &LensRegistry{c.http}
 }
@@ -341,11 +387,20 @@ func (c *Client) GetAllIndexes(ctx context.Context) (map[client.CollectionName][
 	return indexes, nil
 }
 
-func (c *Client) ExecRequest(ctx context.Context, query string) *client.RequestResult {
+func (c *Client) ExecRequest(
+	ctx context.Context,
+	query string,
+	opts ...client.RequestOption,
+) *client.RequestResult {
 	methodURL := c.http.baseURL.JoinPath("graphql")
 	result := &client.RequestResult{}
 
-	body, err := json.Marshal(&GraphQLRequest{query})
+	options := client.NewGQLOptions(opts...)
+	body, err := json.Marshal(&GraphQLRequest{
+		Query:         query,
+		OperationName: options.OperationName,
+		Variables:     options.Variables,
+	})
 	if err != nil {
 		result.GQL.Errors = []error{err}
 		return result
@@ -383,6 +438,7 @@ func (c *Client) ExecRequest(ctx context.Context, query string) *client.RequestR
 	}
 	result.GQL.Data = response.Data
 	result.GQL.Errors = response.Errors
+	result.GQL.Extensions = response.Extensions
 	return result
 }
 
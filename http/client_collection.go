@@ -20,6 +20,7 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/ipfs/go-cid"
 	sse "github.com/vito/go-sse/sse"
 
 	"github.com/sourcenetwork/defradb/client"
@@ -312,6 +313,85 @@ func (c *Collection) Get(ctx context.Context, docID client.DocID, showDeleted bo
 	return doc, nil
 }
 
+func (c *Collection) Diff(ctx context.Context, from cid.Cid, to cid.Cid) (*client.DocumentDiff, error) {
+	query := url.Values{}
+	query.Add("from", from.String())
+	query.Add("to", to.String())
+
+	methodURL := c.http.baseURL.JoinPath("collections", c.Description().Name, "diff")
+	methodURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, methodURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	var diff client.DocumentDiff
+	if err := c.http.requestJson(req, &diff); err != nil {
+		return nil, err
+	}
+	return &diff, nil
+}
+
+func (c *Collection) Revert(ctx context.Context, docID client.DocID, version cid.Cid) error {
+	methodURL := c.http.baseURL.JoinPath("collections", c.Description().Name, docID.String(), "revert")
+
+	body, err := json.Marshal(&CollectionRevertRequest{CID: version.String()})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, methodURL.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	_, err = c.http.request(req)
+	return err
+}
+
+func (c *Collection) CompactHistory(ctx context.Context) error {
+	methodURL := c.http.baseURL.JoinPath("collections", c.Description().Name, "compact")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, methodURL.String(), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.http.request(req)
+	return err
+}
+
+func (c *Collection) Purge(ctx context.Context, docID client.DocID) error {
+	methodURL := c.http.baseURL.JoinPath("collections", c.Description().Name, docID.String(), "purge")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, methodURL.String(), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.http.request(req)
+	return err
+}
+
+func (c *Collection) Changes(ctx context.Context, since string, limit int) ([]client.Change, error) {
+	query := url.Values{}
+	if since != "" {
+		query.Add("since", since)
+	}
+	if limit > 0 {
+		query.Add("limit", fmt.Sprint(limit))
+	}
+
+	methodURL := c.http.baseURL.JoinPath("collections", c.Description().Name, "changes")
+	methodURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, methodURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	var changes []client.Change
+	if err := c.http.requestJson(req, &changes); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
 func (c *Collection) WithTxn(tx datastore.Txn) client.Collection {
 	return &Collection{
 		http: c.http.withTxn(tx.ID()),
@@ -19,6 +19,7 @@ import (
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
+	"github.com/ipfs/go-cid"
 
 	"github.com/sourcenetwork/defradb/client"
 )
@@ -38,6 +39,10 @@ type CollectionUpdateRequest struct {
 	Updater string   `json:"updater"`
 }
 
+type CollectionRevertRequest struct {
+	CID string `json:"cid"`
+}
+
 func (s *collectionHandler) Create(rw http.ResponseWriter, req *http.Request) {
 	col := req.Context().Value(colContextKey).(client.Collection)
 
@@ -242,6 +247,100 @@ func (s *collectionHandler) Get(rw http.ResponseWriter, req *http.Request) {
 	responseJSON(rw, http.StatusOK, docMap)
 }
 
+func (s *collectionHandler) Diff(rw http.ResponseWriter, req *http.Request) {
+	col := req.Context().Value(colContextKey).(client.Collection)
+
+	from, err := cid.Decode(req.URL.Query().Get("from"))
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	to, err := cid.Decode(req.URL.Query().Get("to"))
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	diff, err := col.Diff(req.Context(), from, to)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	responseJSON(rw, http.StatusOK, diff)
+}
+
+func (s *collectionHandler) Revert(rw http.ResponseWriter, req *http.Request) {
+	col := req.Context().Value(colContextKey).(client.Collection)
+
+	docID, err := client.NewDocIDFromString(chi.URLParam(req, "docID"))
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	var request CollectionRevertRequest
+	if err := requestJSON(req, &request); err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	version, err := cid.Decode(request.CID)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	err = col.Revert(req.Context(), docID, version)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	rw.WriteHeader(http.StatusOK)
+}
+
+func (s *collectionHandler) CompactHistory(rw http.ResponseWriter, req *http.Request) {
+	col := req.Context().Value(colContextKey).(client.Collection)
+
+	err := col.CompactHistory(req.Context())
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	rw.WriteHeader(http.StatusOK)
+}
+
+func (s *collectionHandler) Purge(rw http.ResponseWriter, req *http.Request) {
+	col := req.Context().Value(colContextKey).(client.Collection)
+
+	docID, err := client.NewDocIDFromString(chi.URLParam(req, "docID"))
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	err = col.Purge(req.Context(), docID)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	rw.WriteHeader(http.StatusOK)
+}
+
+func (s *collectionHandler) Changes(rw http.ResponseWriter, req *http.Request) {
+	col := req.Context().Value(colContextKey).(client.Collection)
+
+	var limit int
+	if value := req.URL.Query().Get("limit"); value != "" {
+		var err error
+		limit, err = strconv.Atoi(value)
+		if err != nil {
+			responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+			return
+		}
+	}
+	changes, err := col.Changes(req.Context(), req.URL.Query().Get("since"), limit)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	responseJSON(rw, http.StatusOK, changes)
+}
+
 type DocIDResult struct {
 	DocID string `json:"docID"`
 	Error string `json:"error"`
@@ -352,6 +451,12 @@ func (h *collectionHandler) bindRoutes(router *Router) {
 	indexSchema := &openapi3.SchemaRef{
 		Ref: "#/components/schemas/index",
 	}
+	documentDiffSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/document_diff",
+	}
+	changeSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/change",
+	}
 
 	collectionNamePathParam := openapi3.NewPathParameter("name").
 		WithDescription("Collection name").
@@ -505,6 +610,94 @@ func (h *collectionHandler) bindRoutes(router *Router) {
 	collectionDelete.Responses.Set("200", successResponse)
 	collectionDelete.Responses.Set("400", errorResponse)
 
+	collectionRevertSchema := openapi3.NewObjectSchema().
+		WithProperty("cid", openapi3.NewStringSchema())
+
+	collectionRevertRequest := openapi3.NewRequestBody().
+		WithRequired(true).
+		WithJSONSchema(collectionRevertSchema)
+
+	collectionRevert := openapi3.NewOperation()
+	collectionRevert.Description = "Revert a document by docID to a previous version"
+	collectionRevert.OperationID = "collection_revert"
+	collectionRevert.Tags = []string{"collection"}
+	collectionRevert.AddParameter(collectionNamePathParam)
+	collectionRevert.AddParameter(documentIDPathParam)
+	collectionRevert.RequestBody = &openapi3.RequestBodyRef{
+		Value: collectionRevertRequest,
+	}
+	collectionRevert.Responses = openapi3.NewResponses()
+	collectionRevert.Responses.Set("200", successResponse)
+	collectionRevert.Responses.Set("400", errorResponse)
+
+	collectionCompact := openapi3.NewOperation()
+	collectionCompact.Description = "Compact the history of the documents of a collection by its retention policy"
+	collectionCompact.OperationID = "collection_compact"
+	collectionCompact.Tags = []string{"collection"}
+	collectionCompact.AddParameter(collectionNamePathParam)
+	collectionCompact.Responses = openapi3.NewResponses()
+	collectionCompact.Responses.Set("200", successResponse)
+	collectionCompact.Responses.Set("400", errorResponse)
+
+	collectionPurge := openapi3.NewOperation()
+	collectionPurge.Description = "Permanently remove a deleted document and its history by docID"
+	collectionPurge.OperationID = "collection_purge"
+	collectionPurge.Tags = []string{"collection"}
+	collectionPurge.AddParameter(collectionNamePathParam)
+	collectionPurge.AddParameter(documentIDPathParam)
+	collectionPurge.Responses = openapi3.NewResponses()
+	collectionPurge.Responses.Set("200", successResponse)
+	collectionPurge.Responses.Set("400", errorResponse)
+
+	diffFromQueryParam := openapi3.NewQueryParameter("from").
+		WithDescription("CID of the document commit to diff from").
+		WithRequired(true).
+		WithSchema(openapi3.NewStringSchema())
+
+	diffToQueryParam := openapi3.NewQueryParameter("to").
+		WithDescription("CID of the document commit to diff to").
+		WithRequired(true).
+		WithSchema(openapi3.NewStringSchema())
+
+	collectionDiffResponse := openapi3.NewResponse().
+		WithDescription("Document diff").
+		WithJSONSchemaRef(documentDiffSchema)
+
+	collectionDiff := openapi3.NewOperation()
+	collectionDiff.Description = "Get the field-level difference between two versions of a document"
+	collectionDiff.OperationID = "collection_diff"
+	collectionDiff.Tags = []string{"collection"}
+	collectionDiff.AddParameter(collectionNamePathParam)
+	collectionDiff.AddParameter(diffFromQueryParam)
+	collectionDiff.AddParameter(diffToQueryParam)
+	collectionDiff.AddResponse(200, collectionDiffResponse)
+	collectionDiff.Responses.Set("400", errorResponse)
+
+	changesSinceQueryParam := openapi3.NewQueryParameter("since").
+		WithDescription("Cursor of the change to list the following changes of").
+		WithSchema(openapi3.NewStringSchema())
+
+	changesLimitQueryParam := openapi3.NewQueryParameter("limit").
+		WithDescription("Maximum number of changes to list").
+		WithSchema(openapi3.NewIntegerSchema())
+
+	changeArraySchema := openapi3.NewArraySchema()
+	changeArraySchema.Items = changeSchema
+
+	collectionChangesResponse := openapi3.NewResponse().
+		WithDescription("Changes").
+		WithJSONSchema(changeArraySchema)
+
+	collectionChanges := openapi3.NewOperation()
+	collectionChanges.Description = "List the changes made to the documents of a collection"
+	collectionChanges.OperationID = "collection_changes"
+	collectionChanges.Tags = []string{"collection"}
+	collectionChanges.AddParameter(collectionNamePathParam)
+	collectionChanges.AddParameter(changesSinceQueryParam)
+	collectionChanges.AddParameter(changesLimitQueryParam)
+	collectionChanges.AddResponse(200, collectionChangesResponse)
+	collectionChanges.Responses.Set("400", errorResponse)
+
 	collectionKeys := openapi3.NewOperation()
 	collectionKeys.AddParameter(collectionNamePathParam)
 	collectionKeys.Description = "Get all document IDs"
@@ -521,7 +714,12 @@ func (h *collectionHandler) bindRoutes(router *Router) {
 	router.AddRoute("/collections/{name}/indexes", http.MethodPost, createIndex, h.CreateIndex)
 	router.AddRoute("/collections/{name}/indexes", http.MethodGet, getIndexes, h.GetIndexes)
 	router.AddRoute("/collections/{name}/indexes/{index}", http.MethodDelete, dropIndex, h.DropIndex)
+	router.AddRoute("/collections/{name}/diff", http.MethodGet, collectionDiff, h.Diff)
+	router.AddRoute("/collections/{name}/changes", http.MethodGet, collectionChanges, h.Changes)
+	router.AddRoute("/collections/{name}/compact", http.MethodPost, collectionCompact, h.CompactHistory)
 	router.AddRoute("/collections/{name}/{docID}", http.MethodGet, collectionGet, h.Get)
 	router.AddRoute("/collections/{name}/{docID}", http.MethodPatch, collectionUpdate, h.Update)
 	router.AddRoute("/collections/{name}/{docID}", http.MethodDelete, collectionDelete, h.Delete)
+	router.AddRoute("/collections/{name}/{docID}/revert", http.MethodPost, collectionRevert, h.Revert)
+	router.AddRoute("/collections/{name}/{docID}/purge", http.MethodPost, collectionPurge, h.Purge)
 }
@@ -16,6 +16,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
 
@@ -106,6 +107,34 @@ func (s *storeHandler) SetDefaultSchemaVersion(rw http.ResponseWriter, req *http
 	rw.WriteHeader(http.StatusOK)
 }
 
+func (s *storeHandler) MigrateDocuments(rw http.ResponseWriter, req *http.Request) {
+	store := req.Context().Value(storeContextKey).(client.Store)
+
+	var message migrateDocumentsRequest
+	err := requestJSON(req, &message)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	status, err := store.MigrateDocuments(req.Context(), message.CollectionName, message.MigrateDocumentsOptions)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	responseJSON(rw, http.StatusOK, status)
+}
+
+func (s *storeHandler) GetDocumentMigrationStatus(rw http.ResponseWriter, req *http.Request) {
+	store := req.Context().Value(storeContextKey).(client.Store)
+
+	status, err := store.GetDocumentMigrationStatus(req.Context(), req.URL.Query().Get("name"))
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	responseJSON(rw, http.StatusOK, status)
+}
+
 func (s *storeHandler) AddView(rw http.ResponseWriter, req *http.Request) {
 	store := req.Context().Value(storeContextKey).(client.Store)
 
@@ -229,12 +258,31 @@ func (s *storeHandler) PrintDump(rw http.ResponseWriter, req *http.Request) {
 }
 
 type GraphQLRequest struct {
-	Query string `json:"query"`
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+func (r *GraphQLRequest) UnmarshalJSON(data []byte) error {
+	// decode numbers to json.Number so that variables keep their precision
+	type graphQLRequest GraphQLRequest
+	dec := json.NewDecoder(bytes.NewBuffer(data))
+	dec.UseNumber()
+	return dec.Decode((*graphQLRequest)(r))
+}
+
+// options returns the request options of the GraphQL request.
+func (r GraphQLRequest) options() []client.RequestOption {
+	return []client.RequestOption{
+		client.WithOperationName(r.OperationName),
+		client.WithVariables(r.Variables),
+	}
 }
 
 type GraphQLResponse struct {
-	Data   any     `json:"data"`
-	Errors []error `json:"errors,omitempty"`
+	Data       any            `json:"data"`
+	Errors     []error        `json:"errors,omitempty"`
+	Extensions map[string]any `json:"extensions,omitempty"`
 }
 
 func (res GraphQLResponse) MarshalJSON() ([]byte, error) {
@@ -242,7 +290,11 @@ func (res GraphQLResponse) MarshalJSON() ([]byte, error) {
 	for _, err := range res.Errors {
 		errors = append(errors, err.Error())
 	}
-	return json.Marshal(map[string]any{"data": res.Data, "errors": errors})
+	out := map[string]any{"data": res.Data, "errors": errors}
+	if len(res.Extensions) > 0 {
+		out["extensions"] = res.Extensions
+	}
+	return json.Marshal(out)
 }
 
 func (res *GraphQLResponse) UnmarshalJSON(data []byte) error {
@@ -279,6 +331,10 @@ func (res *GraphQLResponse) UnmarshalJSON(data []byte) error {
 		res.Data = []map[string]any{}
 	}
 
+	if extensions, ok := out["extensions"].(map[string]any); ok {
+		res.Extensions = extensions
+	}
+
 	return nil
 }
 
@@ -289,6 +345,16 @@ func (s *storeHandler) ExecRequest(rw http.ResponseWriter, req *http.Request) {
 	switch {
 	case req.URL.Query().Get("query") != "":
 		request.Query = req.URL.Query().Get("query")
+		request.OperationName = req.URL.Query().Get("operationName")
+		if variables := req.URL.Query().Get("variables"); variables != "" {
+			// decode numbers to json.Number so that variables keep their precision
+			dec := json.NewDecoder(strings.NewReader(variables))
+			dec.UseNumber()
+			if err := dec.Decode(&request.Variables); err != nil {
+				responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+				return
+			}
+		}
 	case req.Body != nil:
 		if err := requestJSON(req, &request); err != nil {
 			responseJSON(rw, http.StatusBadRequest, errorResponse{err})
@@ -298,10 +364,10 @@ func (s *storeHandler) ExecRequest(rw http.ResponseWriter, req *http.Request) {
 		responseJSON(rw, http.StatusBadRequest, errorResponse{ErrMissingRequest})
 		return
 	}
-	result := store.ExecRequest(req.Context(), request.Query)
+	result := store.ExecRequest(req.Context(), request.Query, request.options()...)
 
 	if result.Pub == nil {
-		responseJSON(rw, http.StatusOK, GraphQLResponse{result.GQL.Data, result.GQL.Errors})
+		responseJSON(rw, http.StatusOK, GraphQLResponse{result.GQL.Data, result.GQL.Errors, result.GQL.Extensions})
 		return
 	}
 	flusher, ok := rw.(http.Flusher)
@@ -363,6 +429,12 @@ func (h *storeHandler) bindRoutes(router *Router) {
 	addViewSchema := &openapi3.SchemaRef{
 		Ref: "#/components/schemas/add_view_request",
 	}
+	migrateDocumentsRequestSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/migrate_documents_request",
+	}
+	documentMigrationStatusSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/document_migration_status",
+	}
 	patchSchemaRequestSchema := &openapi3.SchemaRef{
 		Ref: "#/components/schemas/patch_schema_request",
 	}
@@ -415,6 +487,36 @@ func (h *storeHandler) bindRoutes(router *Router) {
 	setDefaultSchemaVersion.Responses.Set("200", successResponse)
 	setDefaultSchemaVersion.Responses.Set("400", errorResponse)
 
+	documentMigrationStatusResponse := openapi3.NewResponse().
+		WithDescription("Document migration status").
+		WithJSONSchemaRef(documentMigrationStatusSchema)
+
+	migrateDocumentsRequest := openapi3.NewRequestBody().
+		WithRequired(true).
+		WithJSONSchemaRef(migrateDocumentsRequestSchema)
+
+	migrateDocuments := openapi3.NewOperation()
+	migrateDocuments.OperationID = "migrate_documents"
+	migrateDocuments.Description = "Migrate the documents of a collection to its default schema version"
+	migrateDocuments.Tags = []string{"schema"}
+	migrateDocuments.RequestBody = &openapi3.RequestBodyRef{
+		Value: migrateDocumentsRequest,
+	}
+	migrateDocuments.AddResponse(200, documentMigrationStatusResponse)
+	migrateDocuments.Responses.Set("400", errorResponse)
+
+	documentMigrationNameQueryParam := openapi3.NewQueryParameter("name").
+		WithDescription("Collection name").
+		WithSchema(openapi3.NewStringSchema())
+
+	documentMigrationStatus := openapi3.NewOperation()
+	documentMigrationStatus.OperationID = "document_migration_status"
+	documentMigrationStatus.Description = "Get the progress of the migration of the documents of a collection"
+	documentMigrationStatus.Tags = []string{"schema"}
+	documentMigrationStatus.AddParameter(documentMigrationNameQueryParam)
+	documentMigrationStatus.AddResponse(200, documentMigrationStatusResponse)
+	documentMigrationStatus.Responses.Set("400", errorResponse)
+
 	backupRequest := openapi3.NewRequestBody().
 		WithRequired(true).
 		WithJSONSchemaRef(backupConfigSchema)
@@ -554,11 +656,20 @@ func (h *storeHandler) bindRoutes(router *Router) {
 	graphQLQueryParam := openapi3.NewQueryParameter("query").
 		WithSchema(openapi3.NewStringSchema())
 
+	graphQLOperationNameParam := openapi3.NewQueryParameter("operationName").
+		WithSchema(openapi3.NewStringSchema())
+
+	graphQLVariablesParam := openapi3.NewQueryParameter("variables").
+		WithDescription("JSON encoded object of variable values").
+		WithSchema(openapi3.NewStringSchema())
+
 	graphQLGet := openapi3.NewOperation()
 	graphQLGet.Description = "GraphQL GET endpoint"
 	graphQLGet.OperationID = "graphql_get"
 	graphQLGet.Tags = []string{"graphql"}
 	graphQLGet.AddParameter(graphQLQueryParam)
+	graphQLGet.AddParameter(graphQLOperationNameParam)
+	graphQLGet.AddParameter(graphQLVariablesParam)
 	graphQLGet.AddResponse(200, graphQLResponse)
 	graphQLGet.Responses.Set("400", errorResponse)
 
@@ -581,4 +692,11 @@ func (h *storeHandler) bindRoutes(router *Router) {
 	router.AddRoute("/schema", http.MethodPatch, patchSchema, h.PatchSchema)
 	router.AddRoute("/schema", http.MethodGet, schemaDescribe, h.GetSchema)
 	router.AddRoute("/schema/default", http.MethodPost, setDefaultSchemaVersion, h.SetDefaultSchemaVersion)
+	router.AddRoute("/schema/migration/documents", http.MethodPost, migrateDocuments, h.MigrateDocuments)
+	router.AddRoute(
+		"/schema/migration/documents",
+		http.MethodGet,
+		documentMigrationStatus,
+		h.GetDocumentMigrationStatus,
+	)
 }
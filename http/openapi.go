@@ -20,26 +20,30 @@ import (
 
 // openApiSchemas is a mapping of types to auto generate schemas for.
 var openApiSchemas = map[string]any{
-	"error":                 &errorResponse{},
-	"create_tx":             &CreateTxResponse{},
-	"collection_update":     &CollectionUpdateRequest{},
-	"collection_delete":     &CollectionDeleteRequest{},
-	"peer_info":             &peer.AddrInfo{},
-	"graphql_request":       &GraphQLRequest{},
-	"graphql_response":      &GraphQLResponse{},
-	"backup_config":         &client.BackupConfig{},
-	"collection":            &client.CollectionDescription{},
-	"schema":                &client.SchemaDescription{},
-	"collection_definition": &client.CollectionDefinition{},
-	"index":                 &client.IndexDescription{},
-	"delete_result":         &client.DeleteResult{},
-	"update_result":         &client.UpdateResult{},
-	"lens_config":           &client.LensConfig{},
-	"replicator":            &client.Replicator{},
-	"ccip_request":          &CCIPRequest{},
-	"ccip_response":         &CCIPResponse{},
-	"patch_schema_request":  &patchSchemaRequest{},
-	"add_view_request":      &addViewRequest{},
+	"error":                     &errorResponse{},
+	"create_tx":                 &CreateTxResponse{},
+	"collection_update":         &CollectionUpdateRequest{},
+	"collection_delete":         &CollectionDeleteRequest{},
+	"peer_info":                 &peer.AddrInfo{},
+	"graphql_request":           &GraphQLRequest{},
+	"graphql_response":          &GraphQLResponse{},
+	"backup_config":             &client.BackupConfig{},
+	"collection":                &client.CollectionDescription{},
+	"schema":                    &client.SchemaDescription{},
+	"collection_definition":     &client.CollectionDefinition{},
+	"index":                     &client.IndexDescription{},
+	"delete_result":             &client.DeleteResult{},
+	"update_result":             &client.UpdateResult{},
+	"lens_config":               &client.LensConfig{},
+	"replicator":                &client.Replicator{},
+	"ccip_request":              &CCIPRequest{},
+	"ccip_response":             &CCIPResponse{},
+	"patch_schema_request":      &patchSchemaRequest{},
+	"add_view_request":          &addViewRequest{},
+	"migrate_documents_request": &migrateDocumentsRequest{},
+	"document_migration_status": &client.DocumentMigrationStatus{},
+	"document_diff":             &client.DocumentDiff{},
+	"change":                    &client.Change{},
 }
 
 func NewOpenAPISpec() (*openapi3.T, error) {
@@ -0,0 +1,419 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package lens
+
+import (
+	"encoding/hex"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lens-vm/lens/host-go/config/model"
+	"github.com/shopspring/decimal"
+	"github.com/sourcenetwork/immutable/enumerable"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+// BuiltinModulePathPrefix is the path prefix of the lens modules that are implemented natively
+// by Defra instead of by a wasm binary.
+const BuiltinModulePathPrefix = "defradb://builtin/"
+
+const (
+	// DropFieldModulePath is the path of the built-in module that removes a field from the
+	// document.
+	//
+	// It requires a `field` argument naming the field to remove.  Its inverse is a no-op as
+	// the dropped value cannot be recovered.
+	DropFieldModulePath = BuiltinModulePathPrefix + "drop"
+
+	// RenameFieldModulePath is the path of the built-in module that renames fields.
+	//
+	// It requires a `fields` argument mapping the current field names to their new names.  All
+	// fields are renamed simultaneously, allowing names to be swapped.  Its inverse renames the
+	// fields back to their original names.
+	RenameFieldModulePath = BuiltinModulePathPrefix + "rename"
+
+	// CastFieldModulePath is the path of the built-in module that converts the value of the
+	// `field` field from the `sourceKind` field kind to the `kind` field kind.
+	//
+	// Values that cannot be converted are set to nil.  Its inverse converts the value back
+	// to the source kind.
+	CastFieldModulePath = BuiltinModulePathPrefix + "cast"
+)
+
+// IsBuiltinModule returns true if the given module path refers to a built-in lens module.
+func IsBuiltinModule(path string) bool {
+	return strings.HasPrefix(path, BuiltinModulePathPrefix)
+}
+
+// NewDropFieldModule returns a built-in lens module that removes the given field.
+func NewDropFieldModule(field string) model.LensModule {
+	return model.LensModule{
+		Path: DropFieldModulePath,
+		Arguments: map[string]any{
+			"field": field,
+		},
+	}
+}
+
+// NewRenameFieldModule returns a built-in lens module that renames the given fields, the given
+// map is keyed by the current field names.
+func NewRenameFieldModule(names map[string]string) model.LensModule {
+	fields := make(map[string]any, len(names))
+	for src, dst := range names {
+		fields[src] = dst
+	}
+	return model.LensModule{
+		Path: RenameFieldModulePath,
+		Arguments: map[string]any{
+			"fields": fields,
+		},
+	}
+}
+
+// NewCastFieldModule returns a built-in lens module that converts the values of the given
+// field from the given source kind to the given kind.
+func NewCastFieldModule(field string, sourceKind client.FieldKind, kind client.FieldKind) model.LensModule {
+	return model.LensModule{
+		Path: CastFieldModulePath,
+		Arguments: map[string]any{
+			"field":      field,
+			"sourceKind": sourceKind.String(),
+			"kind":       kind.String(),
+		},
+	}
+}
+
+// IsCastableKind returns true if values of the given field kind may be converted to and
+// from other castable kinds by the built-in cast module.
+func IsCastableKind(kind client.FieldKind) bool {
+	switch kind {
+	case client.FieldKind_BOOL,
+		client.FieldKind_INT,
+		client.FieldKind_FLOAT,
+		client.FieldKind_STRING,
+		client.FieldKind_DATETIME,
+		client.FieldKind_BLOB,
+		client.FieldKind_BIGINT,
+		client.FieldKind_DECIMAL:
+		return true
+	default:
+		return false
+	}
+}
+
+type builtinTransform func(LensDoc) LensDoc
+
+// newBuiltinTransform returns the document transform described by the given built-in module
+// configuration.
+func newBuiltinTransform(moduleCfg model.LensModule) (builtinTransform, error) {
+	switch moduleCfg.Path {
+	case DropFieldModulePath:
+		field, err := getStringArgument(moduleCfg, "field")
+		if err != nil {
+			return nil, err
+		}
+		if moduleCfg.Inverse {
+			return func(doc LensDoc) LensDoc { return doc }, nil
+		}
+		return func(doc LensDoc) LensDoc {
+			result := copyLensDoc(doc)
+			delete(result, field)
+			return result
+		}, nil
+
+	case RenameFieldModulePath:
+		names, err := getNamesArgument(moduleCfg, "fields")
+		if err != nil {
+			return nil, err
+		}
+		if moduleCfg.Inverse {
+			inversedNames := make(map[string]string, len(names))
+			for src, dst := range names {
+				inversedNames[dst] = src
+			}
+			names = inversedNames
+		}
+		return func(doc LensDoc) LensDoc {
+			result := copyLensDoc(doc)
+			for src := range names {
+				delete(result, src)
+			}
+			for src, dst := range names {
+				if value, ok := doc[src]; ok {
+					result[dst] = value
+				}
+			}
+			return result
+		}, nil
+
+	case CastFieldModulePath:
+		field, err := getStringArgument(moduleCfg, "field")
+		if err != nil {
+			return nil, err
+		}
+		kind, err := getKindArgument(moduleCfg, "kind")
+		if err != nil {
+			return nil, err
+		}
+		sourceKind, err := getKindArgument(moduleCfg, "sourceKind")
+		if err != nil {
+			return nil, err
+		}
+		if moduleCfg.Inverse {
+			kind = sourceKind
+		}
+		return func(doc LensDoc) LensDoc {
+			value, ok := doc[field]
+			if !ok {
+				return doc
+			}
+			result := copyLensDoc(doc)
+			result[field] = castValue(value, kind)
+			return result
+		}, nil
+
+	default:
+		return nil, NewErrUnknownBuiltinModule(moduleCfg.Path)
+	}
+}
+
+func getStringArgument(moduleCfg model.LensModule, name string) (string, error) {
+	value, ok := moduleCfg.Arguments[name].(string)
+	if !ok || value == "" {
+		return "", NewErrInvalidBuiltinArgument(moduleCfg.Path, name, moduleCfg.Arguments[name])
+	}
+	return value, nil
+}
+
+func getNamesArgument(moduleCfg model.LensModule, name string) (map[string]string, error) {
+	value, ok := moduleCfg.Arguments[name].(map[string]any)
+	if !ok || len(value) == 0 {
+		return nil, NewErrInvalidBuiltinArgument(moduleCfg.Path, name, moduleCfg.Arguments[name])
+	}
+	names := make(map[string]string, len(value))
+	for src, untypedDst := range value {
+		dst, ok := untypedDst.(string)
+		if !ok || src == "" || dst == "" {
+			return nil, NewErrInvalidBuiltinArgument(moduleCfg.Path, name, value)
+		}
+		names[src] = dst
+	}
+	return names, nil
+}
+
+func getKindArgument(moduleCfg model.LensModule, name string) (client.FieldKind, error) {
+	value, err := getStringArgument(moduleCfg, name)
+	if err != nil {
+		return 0, err
+	}
+	kind, ok := client.FieldKindStringToEnumMapping[value]
+	if !ok || !IsCastableKind(kind) {
+		return 0, NewErrInvalidBuiltinArgument(moduleCfg.Path, name, value)
+	}
+	return kind, nil
+}
+
+// copyLensDoc returns a shallow copy of the given document.
+//
+// Documents yielded by the source must not be mutated, as the lens fetcher compares them with
+// the migrated result in order to determine which values need to be persisted.
+func copyLensDoc(doc LensDoc) LensDoc {
+	result := make(LensDoc, len(doc))
+	for key, value := range doc {
+		result[key] = value
+	}
+	return result
+}
+
+// castValue converts the given value to the given field kind.
+//
+// BigInt, Decimal and DateTime values are returned in their string representation, matching
+// the way they are persisted.  If the value cannot be converted nil is returned.
+func castValue(value any, kind client.FieldKind) any {
+	if value == nil {
+		return nil
+	}
+
+	switch kind {
+	case client.FieldKind_BOOL:
+		switch v := value.(type) {
+		case bool:
+			return v
+		case string:
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil
+			}
+			return b
+		}
+		d, ok := toDecimal(value)
+		if !ok {
+			return nil
+		}
+		return !d.IsZero()
+
+	case client.FieldKind_INT:
+		d, ok := toDecimal(value)
+		if !ok {
+			return nil
+		}
+		i := d.Truncate(0).BigInt()
+		if !i.IsInt64() {
+			return nil
+		}
+		return i.Int64()
+
+	case client.FieldKind_FLOAT:
+		if f, ok := value.(float64); ok {
+			return f
+		}
+		d, ok := toDecimal(value)
+		if !ok {
+			return nil
+		}
+		f := d.InexactFloat64()
+		if math.IsInf(f, 0) {
+			return nil
+		}
+		return f
+
+	case client.FieldKind_STRING:
+		switch v := value.(type) {
+		case string:
+			return v
+		case bool:
+			return strconv.FormatBool(v)
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64)
+		case time.Time:
+			return v.Format(time.RFC3339)
+		case []byte:
+			return hex.EncodeToString(v)
+		}
+		d, ok := toDecimal(value)
+		if !ok {
+			return nil
+		}
+		return d.String()
+
+	case client.FieldKind_DATETIME:
+		switch v := value.(type) {
+		case time.Time:
+			return v.Format(time.RFC3339)
+		case string:
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return nil
+			}
+			return t.Format(time.RFC3339)
+		}
+		return nil
+
+	case client.FieldKind_BLOB:
+		switch v := value.(type) {
+		case []byte:
+			return hex.EncodeToString(v)
+		case string:
+			if _, err := hex.DecodeString(v); err != nil || v == "" {
+				return nil
+			}
+			return v
+		}
+		return nil
+
+	case client.FieldKind_BIGINT:
+		d, ok := toDecimal(value)
+		if !ok {
+			return nil
+		}
+		return d.Truncate(0).BigInt().String()
+
+	case client.FieldKind_DECIMAL:
+		d, ok := toDecimal(value)
+		if !ok {
+			return nil
+		}
+		return d.String()
+	}
+
+	return nil
+}
+
+// toDecimal converts the given numeric, boolean or string value to a decimal.
+func toDecimal(value any) (decimal.Decimal, bool) {
+	switch v := value.(type) {
+	case bool:
+		if v {
+			return decimal.NewFromInt(1), true
+		}
+		return decimal.Zero, true
+	case int:
+		return decimal.NewFromInt(int64(v)), true
+	case int64:
+		return decimal.NewFromInt(v), true
+	case uint64:
+		return decimal.NewFromBigInt(big.NewInt(0).SetUint64(v), 0), true
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return decimal.Decimal{}, false
+		}
+		return decimal.NewFromFloat(v), true
+	case *big.Int:
+		return decimal.NewFromBigInt(v, 0), true
+	case decimal.Decimal:
+		return v, true
+	case string:
+		d, err := decimal.NewFromString(strings.TrimSpace(v))
+		if err != nil {
+			return decimal.Decimal{}, false
+		}
+		return d, true
+	default:
+		return decimal.Decimal{}, false
+	}
+}
+
+// builtinEnumerable applies a built-in transform to each item yielded by its source.
+type builtinEnumerable struct {
+	source    enumerable.Enumerable[LensDoc]
+	transform builtinTransform
+	current   LensDoc
+}
+
+var _ enumerable.Enumerable[LensDoc] = (*builtinEnumerable)(nil)
+
+func (e *builtinEnumerable) Next() (bool, error) {
+	hasNext, err := e.source.Next()
+	if err != nil || !hasNext {
+		return false, err
+	}
+
+	value, err := e.source.Value()
+	if err != nil {
+		return false, err
+	}
+
+	e.current = e.transform(value)
+	return true, nil
+}
+
+func (e *builtinEnumerable) Value() (LensDoc, error) {
+	return e.current, nil
+}
+
+func (e *builtinEnumerable) Reset() {
+	e.current = nil
+	e.source.Reset()
+}
@@ -0,0 +1,40 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package lens
+
+import "github.com/sourcenetwork/defradb/errors"
+
+const (
+	errUnknownBuiltinModule   string = "unknown built-in lens module"
+	errInvalidBuiltinArgument string = "invalid built-in lens module argument"
+)
+
+var (
+	ErrUnknownBuiltinModule   = errors.New(errUnknownBuiltinModule)
+	ErrInvalidBuiltinArgument = errors.New(errInvalidBuiltinArgument)
+)
+
+// NewErrUnknownBuiltinModule returns an error indicating that the given path uses the
+// built-in module prefix but does not match any known built-in module.
+func NewErrUnknownBuiltinModule(path string) error {
+	return errors.New(errUnknownBuiltinModule, errors.NewKV("Path", path))
+}
+
+// NewErrInvalidBuiltinArgument returns an error indicating that the given argument of a
+// built-in module is missing or of an unexpected value.
+func NewErrInvalidBuiltinArgument(path string, name string, value any) error {
+	return errors.New(
+		errInvalidBuiltinArgument,
+		errors.NewKV("Path", path),
+		errors.NewKV("Argument", name),
+		errors.NewKV("Value", value),
+	)
+}
@@ -15,12 +15,15 @@ import (
 	"reflect"
 
 	"github.com/fxamacker/cbor/v2"
+	ds "github.com/ipfs/go-datastore"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
 	"github.com/sourcenetwork/defradb/core"
 	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/db/description"
 	"github.com/sourcenetwork/defradb/db/fetcher"
+	"github.com/sourcenetwork/defradb/errors"
 	"github.com/sourcenetwork/defradb/planner/mapper"
 )
 
@@ -37,6 +40,8 @@ type lensedFetcher struct {
 	col client.Collection
 	// Cache the fieldDescriptions mapped by name to allow for cheaper access within the fetcher loop
 	fieldDescriptionsByName map[string]client.FieldDescription
+	// Cache the field names of the schema versions of the fetched documents
+	fieldNamesBySchemaVersionID map[string]map[client.FieldID]string
 
 	targetVersionID string
 
@@ -66,6 +71,7 @@ func (f *lensedFetcher) Init(
 	showDeleted bool,
 ) error {
 	f.col = col
+	f.fieldNamesBySchemaVersionID = map[string]map[client.FieldID]string{}
 
 	f.fieldDescriptionsByName = make(map[string]client.FieldDescription, len(col.Schema().Fields))
 	// Add cache the field descriptions in reverse, allowing smaller-index fields to overwrite any later
@@ -76,7 +82,11 @@ func (f *lensedFetcher) Init(
 		f.fieldDescriptionsByName[field.Name] = field
 	}
 
-	cfg, err := f.registry.Config(ctx)
+	// Scope the registry to the given transaction so that any migrations registered within it
+	// (for example by a schema patch) are visible to the fetcher.
+	registry := f.registry.WithTxn(txn)
+
+	cfg, err := registry.Config(ctx)
 	if err != nil {
 		return err
 	}
@@ -85,11 +95,11 @@ func (f *lensedFetcher) Init(
 	if err != nil {
 		return err
 	}
-	f.lens = new(ctx, f.registry, f.col.Schema().VersionID, history)
+	f.lens = new(ctx, registry, f.col.Schema().VersionID, history)
 	f.txn = txn
 
 	for schemaVersionID := range history {
-		hasMigration, err := f.registry.HasMigration(ctx, schemaVersionID)
+		hasMigration, err := registry.HasMigration(ctx, schemaVersionID)
 		if err != nil {
 			return err
 		}
@@ -134,7 +144,7 @@ func (f *lensedFetcher) FetchNext(ctx context.Context) (fetcher.EncodedDocument,
 		return doc, execInfo, nil
 	}
 
-	sourceLensDoc, err := encodedDocToLensDoc(doc)
+	sourceLensDoc, err := f.encodedDocToLensDoc(ctx, doc)
 	if err != nil {
 		return nil, fetcher.ExecInfo{}, err
 	}
@@ -181,7 +191,10 @@ func (f *lensedFetcher) Close() error {
 }
 
 // encodedDocToLensDoc converts a [fetcher.EncodedDocument] to a LensDoc.
-func encodedDocToLensDoc(doc fetcher.EncodedDocument) (LensDoc, error) {
+//
+// The properties are keyed by the names the fields had in the schema version of the document,
+// as fields may have been renamed since.
+func (f *lensedFetcher) encodedDocToLensDoc(ctx context.Context, doc fetcher.EncodedDocument) (LensDoc, error) {
 	docAsMap := map[string]any{}
 
 	properties, err := doc.Properties(false)
@@ -189,8 +202,17 @@ func encodedDocToLensDoc(doc fetcher.EncodedDocument) (LensDoc, error) {
 		return nil, err
 	}
 
+	fieldNamesByID, err := f.getFieldNamesByID(ctx, doc.SchemaVersionID())
+	if err != nil {
+		return nil, err
+	}
+
 	for field, fieldValue := range properties {
-		docAsMap[field.Name] = fieldValue
+		if name, ok := fieldNamesByID[field.ID]; ok {
+			docAsMap[name] = fieldValue
+		} else {
+			docAsMap[field.Name] = fieldValue
+		}
 	}
 	docAsMap[request.DocIDFieldName] = string(doc.ID())
 
@@ -201,6 +223,31 @@ func encodedDocToLensDoc(doc fetcher.EncodedDocument) (LensDoc, error) {
 	return docAsMap, nil
 }
 
+// getFieldNamesByID returns the field names of the given schema version by field ID.
+func (f *lensedFetcher) getFieldNamesByID(
+	ctx context.Context,
+	schemaVersionID string,
+) (map[client.FieldID]string, error) {
+	if fieldNamesByID, ok := f.fieldNamesBySchemaVersionID[schemaVersionID]; ok {
+		return fieldNamesByID, nil
+	}
+
+	// The schema version may be unknown to this node, for example if the document was received
+	// over P2P, in which case the current field names are used.
+	schema, err := description.GetSchemaVersion(ctx, f.txn, schemaVersionID)
+	if err != nil && !errors.Is(err, ds.ErrNotFound) {
+		return nil, err
+	}
+
+	fieldNamesByID := make(map[client.FieldID]string, len(schema.Fields))
+	for _, field := range schema.Fields {
+		fieldNamesByID[field.ID] = field.Name
+	}
+	f.fieldNamesBySchemaVersionID[schemaVersionID] = fieldNamesByID
+
+	return fieldNamesByID, nil
+}
+
 func (f *lensedFetcher) lensDocToEncodedDoc(docAsMap LensDoc) (fetcher.EncodedDocument, error) {
 	var key string
 	status := client.Active
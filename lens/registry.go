@@ -476,7 +476,7 @@ func (r *lensRegistry) newLensPipe(cfg client.LensConfig) (*lensPipe, error) {
 	socket := enumerable.NewSocket[LensDoc]()
 
 	r.moduleLock.Lock()
-	enumerable, err := config.LoadInto[LensDoc, LensDoc](r.runtime, r.modulesByPath, cfg.Lens, socket)
+	enumerable, err := r.loadInto(cfg.Lens, socket)
 	r.moduleLock.Unlock()
 
 	if err != nil {
@@ -489,6 +489,61 @@ func (r *lensRegistry) newLensPipe(cfg client.LensConfig) (*lensPipe, error) {
 	}, nil
 }
 
+// loadInto constructs a lens from the given config and applies it to the given source.
+//
+// Consecutive wasm modules are loaded together using the registry's runtime, built-in modules
+// are applied natively in between them.
+func (r *lensRegistry) loadInto(
+	lens model.Lens,
+	src enumerable.Enumerable[LensDoc],
+) (enumerable.Enumerable[LensDoc], error) {
+	result := src
+	wasmModules := []model.LensModule{}
+
+	appendWasmModules := func() error {
+		if len(wasmModules) == 0 {
+			return nil
+		}
+		var err error
+		result, err = config.LoadInto[LensDoc, LensDoc](
+			r.runtime,
+			r.modulesByPath,
+			model.Lens{Lenses: wasmModules},
+			result,
+		)
+		wasmModules = []model.LensModule{}
+		return err
+	}
+
+	for _, moduleCfg := range lens.Lenses {
+		if !IsBuiltinModule(moduleCfg.Path) {
+			wasmModules = append(wasmModules, moduleCfg)
+			continue
+		}
+
+		err := appendWasmModules()
+		if err != nil {
+			return nil, err
+		}
+
+		transform, err := newBuiltinTransform(moduleCfg)
+		if err != nil {
+			return nil, err
+		}
+		result = &builtinEnumerable{
+			source:    result,
+			transform: transform,
+		}
+	}
+
+	err := appendWasmModules()
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 func (p *lensPipe) SetSource(newSource enumerable.Enumerable[LensDoc]) {
 	p.input.SetSource(newSource)
 }
@@ -57,7 +57,13 @@ func (mc *MerkleClock) putBlock(
 	heads []cid.Cid,
 	delta core.Delta,
 ) (ipld.Node, error) {
-	node, err := makeNode(delta, heads)
+	var node ipld.Node
+	var err error
+	if key, signedDelta, ok := signingKey(ctx, delta); ok {
+		node, err = makeSignedNode(key, signedDelta, heads)
+	} else {
+		node, err = makeNode(delta, heads)
+	}
 	if err != nil {
 		return nil, NewErrCreatingBlock(err)
 	}
@@ -84,6 +90,9 @@ func (mc *MerkleClock) putBlock(
 
 // AddDAGNode adds a new delta to the existing DAG for this MerkleClock: checks the current heads,
 // sets the delta priority in the Merkle DAG, and adds it to the blockstore the runs ProcessNode.
+//
+// Any commit metadata held by the given context is recorded in the delta, and the block is
+// signed if the context holds a commit signing key.
 func (mc *MerkleClock) AddDAGNode(
 	ctx context.Context,
 	delta core.Delta,
@@ -95,6 +104,11 @@ func (mc *MerkleClock) AddDAGNode(
 	height = height + 1
 
 	delta.SetPriority(height)
+	if metadata, ok := core.CommitMetadataFromContext(ctx); ok {
+		if metadataDelta, ok := delta.(core.MetadataDelta); ok {
+			metadataDelta.SetMetadata(metadata)
+		}
+	}
 
 	// write the delta and heads to a new block
 	nd, err := mc.putBlock(ctx, heads, delta)
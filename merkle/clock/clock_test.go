@@ -157,6 +157,77 @@ func TestMerkleClockAddDAGNodeWithHeads(t *testing.T) {
 	}
 }
 
+func TestWalkReturnsDeltasInPriorityOrder(t *testing.T) {
+	ctx := context.Background()
+	clk := newTestMerkleClock()
+	reg := crdt.LWWRegister{}
+
+	var head cid.Cid
+	for _, value := range []string{"test", "test2", "test3"} {
+		node, err := clk.AddDAGNode(ctx, reg.Set([]byte(value)))
+		if err != nil {
+			t.Error("Failed to add dag node:", err)
+			return
+		}
+		head = node.Cid()
+	}
+
+	entries, err := Walk(ctx, clk.dagstore, reg.DeltaDecode, head)
+	if err != nil {
+		t.Error("Failed to walk the clock:", err)
+		return
+	}
+
+	if len(entries) != 3 {
+		t.Errorf("Walk returned an incorrect number of deltas. Have %v, want %v", len(entries), 3)
+		return
+	}
+	for i, entry := range entries {
+		if entry.Delta.GetPriority() != uint64(i+1) {
+			t.Errorf("Walk returned deltas out of order. Have %v, want %v", entry.Delta.GetPriority(), i+1)
+		}
+	}
+	if entries[2].Node.Cid() != head {
+		t.Errorf("Walk did not return the head last. Have %v, want %v", entries[2].Node.Cid(), head)
+	}
+}
+
+func TestWalkSkipsMissingBlocks(t *testing.T) {
+	ctx := context.Background()
+	clk := newTestMerkleClock()
+	reg := crdt.LWWRegister{}
+
+	var cids []cid.Cid
+	for _, value := range []string{"test", "test2", "test3"} {
+		node, err := clk.AddDAGNode(ctx, reg.Set([]byte(value)))
+		if err != nil {
+			t.Error("Failed to add dag node:", err)
+			return
+		}
+		cids = append(cids, node.Cid())
+	}
+
+	err := clk.dagstore.DeleteBlock(ctx, cids[1])
+	if err != nil {
+		t.Error("Failed to delete block:", err)
+		return
+	}
+
+	entries, err := Walk(ctx, clk.dagstore, reg.DeltaDecode, cids[2])
+	if err != nil {
+		t.Error("Failed to walk the clock:", err)
+		return
+	}
+
+	if len(entries) != 1 {
+		t.Errorf("Walk returned an incorrect number of deltas. Have %v, want %v", len(entries), 1)
+		return
+	}
+	if entries[0].Node.Cid() != cids[2] {
+		t.Errorf("Walk returned an incorrect delta. Have %v, want %v", entries[0].Node.Cid(), cids[2])
+	}
+}
+
 // func TestMerkleClockProcessNode(t *testing.T) {
 // 	t.Error("Test not implemented")
 // }
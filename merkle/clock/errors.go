@@ -12,6 +12,7 @@ package clock
 
 import (
 	cid "github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p/core/peer"
 
 	"github.com/sourcenetwork/defradb/errors"
 )
@@ -26,6 +27,11 @@ const (
 	errReplacingHead          = "error replacing head"
 	errCouldNotFindBlock      = "error checking for known block "
 	errFailedToGetNextQResult = "failed to get next query result"
+	errUnsignedCommit         = "commit is not signed"
+	errInvalidCommitSignature = "invalid commit signature"
+	errUntrustedCommitSigner  = "commit is not signed by a trusted signer"
+	errUnsignedPurge          = "purge is not signed"
+	errInvalidPurgeSignature  = "invalid purge signature"
 )
 
 var (
@@ -39,6 +45,11 @@ var (
 	ErrCouldNotFindBlock      = errors.New(errCouldNotFindBlock)
 	ErrFailedToGetNextQResult = errors.New(errFailedToGetNextQResult)
 	ErrDecodingHeight         = errors.New("error decoding height")
+	ErrUnsignedCommit         = errors.New(errUnsignedCommit)
+	ErrInvalidCommitSignature = errors.New(errInvalidCommitSignature)
+	ErrUntrustedCommitSigner  = errors.New(errUntrustedCommitSigner)
+	ErrUnsignedPurge          = errors.New(errUnsignedPurge)
+	ErrInvalidPurgeSignature  = errors.New(errInvalidPurgeSignature)
 )
 
 func NewErrCreatingBlock(inner error) error {
@@ -81,3 +92,23 @@ func NewErrCouldNotFindBlock(cid cid.Cid, inner error) error {
 func NewErrFailedToGetNextQResult(inner error) error {
 	return errors.Wrap(errFailedToGetNextQResult, inner)
 }
+
+func NewErrUnsignedCommit(cid cid.Cid) error {
+	return errors.New(errUnsignedCommit, errors.NewKV("Cid", cid))
+}
+
+func NewErrInvalidCommitSignature(cid cid.Cid) error {
+	return errors.New(errInvalidCommitSignature, errors.NewKV("Cid", cid))
+}
+
+func NewErrUntrustedCommitSigner(cid cid.Cid, signer peer.ID) error {
+	return errors.New(errUntrustedCommitSigner, errors.NewKV("Cid", cid), errors.NewKV("Signer", signer))
+}
+
+func NewErrUnsignedPurge(docID string) error {
+	return errors.New(errUnsignedPurge, errors.NewKV("DocID", docID))
+}
+
+func NewErrInvalidPurgeSignature(docID string) error {
+	return errors.New(errInvalidPurgeSignature, errors.NewKV("DocID", docID))
+}
@@ -0,0 +1,180 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package clock
+
+import (
+	"context"
+
+	dag "github.com/ipfs/boxo/ipld/merkledag"
+	cid "github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"golang.org/x/exp/slices"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
+)
+
+// makeSignedNode creates a new block for the given delta and heads, signed with the given key.
+//
+// The signature is made over the block as it is encoded without the signature, and is then
+// stored in the delta alongside the signer public key.
+func makeSignedNode(key crypto.PrivKey, delta core.SignedDelta, heads []cid.Cid) (ipld.Node, error) {
+	delta.SetSignature(core.CommitSignature{})
+	unsigned, err := makeNode(delta, heads)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := key.Sign(unsigned.RawData())
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err := crypto.MarshalPublicKey(key.GetPublic())
+	if err != nil {
+		return nil, err
+	}
+	delta.SetSignature(core.CommitSignature{
+		Signature: signature,
+		PublicKey: publicKey,
+	})
+
+	return makeNode(delta, heads)
+}
+
+// VerifyNode verifies the signature of the given block, and that the block is accepted by
+// the given policy.
+//
+// The given delta must be the delta decoded from the block.
+func VerifyNode(nd ipld.Node, delta core.Delta, policy core.SignaturePolicy) error {
+	var signature core.CommitSignature
+	signedDelta, isSignedDelta := delta.(core.SignedDelta)
+	if isSignedDelta {
+		signature = signedDelta.GetSignature()
+	}
+
+	if len(signature.Signature) == 0 {
+		if policy.RequireSignature || len(policy.TrustedSigners) > 0 {
+			return NewErrUnsignedCommit(nd.Cid())
+		}
+		return nil
+	}
+
+	signer, err := verifySignature(nd, signedDelta, signature)
+	if err != nil {
+		return err
+	}
+
+	if len(policy.TrustedSigners) > 0 && !slices.Contains(policy.TrustedSigners, signer.String()) {
+		return NewErrUntrustedCommitSigner(nd.Cid(), signer)
+	}
+	return nil
+}
+
+// verifySignature verifies the given signature against the given block, and returns the ID
+// of the signer.
+func verifySignature(
+	nd ipld.Node,
+	delta core.SignedDelta,
+	signature core.CommitSignature,
+) (peer.ID, error) {
+	pbNode, ok := nd.(*dag.ProtoNode)
+	if !ok {
+		return "", client.NewErrUnexpectedType[*dag.ProtoNode]("ipld.Node", nd)
+	}
+	publicKey, err := crypto.UnmarshalPublicKey(signature.PublicKey)
+	if err != nil {
+		return "", NewErrInvalidCommitSignature(nd.Cid())
+	}
+
+	// the delta is re-encoded without its signature to rebuild the block that was signed
+	delta.SetSignature(core.CommitSignature{})
+	data, err := delta.Marshal()
+	delta.SetSignature(signature)
+	if err != nil {
+		return "", err
+	}
+	unsigned := pbNode.Copy().(*dag.ProtoNode)
+	unsigned.SetData(data)
+
+	valid, err := publicKey.Verify(unsigned.RawData(), signature.Signature)
+	if err != nil || !valid {
+		return "", NewErrInvalidCommitSignature(nd.Cid())
+	}
+
+	return peer.IDFromPublicKey(publicKey)
+}
+
+// NodeSigner returns the ID of the signer of the given block, or an empty ID if the block is
+// not signed.
+//
+// The given delta must be the delta decoded from the block.
+func NodeSigner(nd ipld.Node, delta core.Delta) (peer.ID, error) {
+	signedDelta, isSignedDelta := delta.(core.SignedDelta)
+	if !isSignedDelta {
+		return "", nil
+	}
+	signature := signedDelta.GetSignature()
+	if len(signature.Signature) == 0 {
+		return "", nil
+	}
+	return verifySignature(nd, signedDelta, signature)
+}
+
+// SignPurge signs the purge of the given document with the given key.
+func SignPurge(key crypto.PrivKey, docID string) (core.CommitSignature, error) {
+	signature, err := key.Sign(purgeMessage(docID))
+	if err != nil {
+		return core.CommitSignature{}, err
+	}
+	publicKey, err := crypto.MarshalPublicKey(key.GetPublic())
+	if err != nil {
+		return core.CommitSignature{}, err
+	}
+	return core.CommitSignature{
+		Signature: signature,
+		PublicKey: publicKey,
+	}, nil
+}
+
+// VerifyPurge verifies the given signature of the purge of the given document, and returns the
+// ID of the signer.
+func VerifyPurge(docID string, signature core.CommitSignature) (peer.ID, error) {
+	if len(signature.Signature) == 0 {
+		return "", NewErrUnsignedPurge(docID)
+	}
+	publicKey, err := crypto.UnmarshalPublicKey(signature.PublicKey)
+	if err != nil {
+		return "", NewErrInvalidPurgeSignature(docID)
+	}
+	valid, err := publicKey.Verify(purgeMessage(docID), signature.Signature)
+	if err != nil || !valid {
+		return "", NewErrInvalidPurgeSignature(docID)
+	}
+	return peer.IDFromPublicKey(publicKey)
+}
+
+// purgeMessage returns the message signed to purge the given document.
+func purgeMessage(docID string) []byte {
+	return []byte("purge/" + docID)
+}
+
+// signingKey returns the commit signing key held by the given context if the given delta
+// can be signed.
+func signingKey(ctx context.Context, delta core.Delta) (crypto.PrivKey, core.SignedDelta, bool) {
+	signedDelta, ok := delta.(core.SignedDelta)
+	if !ok {
+		return nil, nil, false
+	}
+	key, ok := core.CommitSigningKeyFromContext(ctx)
+	return key, signedDelta, ok
+}
@@ -0,0 +1,166 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package clock
+
+import (
+	"context"
+	"testing"
+
+	dag "github.com/ipfs/boxo/ipld/merkledag"
+	cid "github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/core"
+	ccid "github.com/sourcenetwork/defradb/core/cid"
+	"github.com/sourcenetwork/defradb/core/crdt"
+)
+
+func newTestSigningKey(t *testing.T) (crypto.PrivKey, peer.ID) {
+	key, _, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+	require.NoError(t, err)
+	signer, err := peer.IDFromPrivateKey(key)
+	require.NoError(t, err)
+	return key, signer
+}
+
+func newTestHeads(t *testing.T) []cid.Cid {
+	head, err := ccid.NewSHA256CidV1([]byte("head"))
+	require.NoError(t, err)
+	return []cid.Cid{head}
+}
+
+func TestVerifyNode_WithUnsignedNode_NoError(t *testing.T) {
+	delta := &crdt.LWWRegDelta{Data: []byte("test"), Priority: 1}
+	nd, err := makeNode(delta, newTestHeads(t))
+	require.NoError(t, err)
+
+	err = VerifyNode(nd, delta, core.SignaturePolicy{})
+	require.NoError(t, err)
+}
+
+func TestVerifyNode_WithUnsignedNodeAndRequiredSignature_Error(t *testing.T) {
+	delta := &crdt.LWWRegDelta{Data: []byte("test"), Priority: 1}
+	nd, err := makeNode(delta, newTestHeads(t))
+	require.NoError(t, err)
+
+	err = VerifyNode(nd, delta, core.SignaturePolicy{RequireSignature: true})
+	require.ErrorIs(t, err, ErrUnsignedCommit)
+}
+
+func TestVerifyNode_WithSignedNode_NoError(t *testing.T) {
+	key, signer := newTestSigningKey(t)
+	heads := newTestHeads(t)
+
+	nd, err := makeSignedNode(key, &crdt.LWWRegDelta{Data: []byte("test"), Priority: 1}, heads)
+	require.NoError(t, err)
+
+	// the delta is decoded from the block, as it would be once received from another peer
+	delta := &crdt.LWWRegDelta{}
+	err = delta.Unmarshal(nd.(*dag.ProtoNode).Data())
+	require.NoError(t, err)
+	signature := delta.GetSignature()
+	assert.NotEmpty(t, signature.Signature)
+
+	err = VerifyNode(nd, delta, core.SignaturePolicy{
+		RequireSignature: true,
+		TrustedSigners:   []string{signer.String()},
+	})
+	require.NoError(t, err)
+	// the signature must be left untouched by the verification
+	assert.Equal(t, signature, delta.GetSignature())
+}
+
+func TestVerifyNode_WithTamperedNode_Error(t *testing.T) {
+	key, _ := newTestSigningKey(t)
+	heads := newTestHeads(t)
+
+	delta := &crdt.LWWRegDelta{Data: []byte("test"), Priority: 1}
+	_, err := makeSignedNode(key, delta, heads)
+	require.NoError(t, err)
+
+	// the data is changed while keeping the original signature
+	delta.Data = []byte("tampered")
+	nd, err := makeNode(delta, heads)
+	require.NoError(t, err)
+
+	err = VerifyNode(nd, delta, core.SignaturePolicy{})
+	require.ErrorIs(t, err, ErrInvalidCommitSignature)
+}
+
+func TestVerifyNode_WithChangedHeads_Error(t *testing.T) {
+	key, _ := newTestSigningKey(t)
+
+	delta := &crdt.LWWRegDelta{Data: []byte("test"), Priority: 1}
+	_, err := makeSignedNode(key, delta, newTestHeads(t))
+	require.NoError(t, err)
+
+	// the block is rebuilt on top of different heads while keeping the original signature
+	otherHead, err := ccid.NewSHA256CidV1([]byte("other head"))
+	require.NoError(t, err)
+	nd, err := makeNode(delta, []cid.Cid{otherHead})
+	require.NoError(t, err)
+
+	err = VerifyNode(nd, delta, core.SignaturePolicy{})
+	require.ErrorIs(t, err, ErrInvalidCommitSignature)
+}
+
+func TestVerifyNode_WithUntrustedSigner_Error(t *testing.T) {
+	key, _ := newTestSigningKey(t)
+	_, trustedSigner := newTestSigningKey(t)
+
+	delta := &crdt.LWWRegDelta{Data: []byte("test"), Priority: 1}
+	nd, err := makeSignedNode(key, delta, newTestHeads(t))
+	require.NoError(t, err)
+
+	err = VerifyNode(nd, delta, core.SignaturePolicy{TrustedSigners: []string{trustedSigner.String()}})
+	require.ErrorIs(t, err, ErrUntrustedCommitSigner)
+}
+
+func TestVerifyPurge_WithSignedPurge_ReturnsSigner(t *testing.T) {
+	key, signer := newTestSigningKey(t)
+	signature, err := SignPurge(key, "bae-123")
+	require.NoError(t, err)
+
+	purgeSigner, err := VerifyPurge("bae-123", signature)
+	require.NoError(t, err)
+	assert.Equal(t, signer, purgeSigner)
+}
+
+func TestVerifyPurge_WithOtherDocument_Error(t *testing.T) {
+	key, _ := newTestSigningKey(t)
+	signature, err := SignPurge(key, "bae-123")
+	require.NoError(t, err)
+
+	_, err = VerifyPurge("bae-456", signature)
+	require.ErrorIs(t, err, ErrInvalidPurgeSignature)
+}
+
+func TestVerifyPurge_WithUnsignedPurge_Error(t *testing.T) {
+	_, err := VerifyPurge("bae-123", core.CommitSignature{})
+	require.ErrorIs(t, err, ErrUnsignedPurge)
+}
+
+func TestMerkleClockAddDAGNode_WithSigningKey_SignsBlock(t *testing.T) {
+	key, signer := newTestSigningKey(t)
+	ctx := core.ContextWithCommitSigningKey(context.Background(), key)
+	clk := newTestMerkleClock()
+
+	delta := &crdt.LWWRegDelta{Data: []byte("test")}
+	nd, err := clk.AddDAGNode(ctx, delta)
+	require.NoError(t, err)
+	assert.NotEmpty(t, delta.Signature)
+
+	err = VerifyNode(nd, delta, core.SignaturePolicy{TrustedSigners: []string{signer.String()}})
+	require.NoError(t, err)
+}
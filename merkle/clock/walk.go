@@ -0,0 +1,83 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package clock
+
+import (
+	"context"
+	"sort"
+
+	dag "github.com/ipfs/boxo/ipld/merkledag"
+	cid "github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/datastore"
+)
+
+// Walk walks a MerkleClock back from the given heads, through the head links of its blocks,
+// and returns the deltas of all the blocks visited, including the heads.
+//
+// The entries are returned in the order the deltas were added to the clock, from the lowest
+// priority to the highest.
+//
+// Blocks that are no longer in the store, such as those pruned by history compaction, end the
+// walk along their path.
+func Walk(
+	ctx context.Context,
+	dagstore datastore.DAGStore,
+	extractor DeltaExtractorFn,
+	heads ...cid.Cid,
+) ([]DeltaEntry, error) {
+	entries := []DeltaEntry{}
+	visited := map[cid.Cid]struct{}{}
+
+	queue := heads
+	for len(queue) > 0 {
+		c := queue[0]
+		queue = queue[1:]
+		if _, isVisited := visited[c]; isVisited {
+			continue
+		}
+		visited[c] = struct{}{}
+
+		blk, err := dagstore.Get(ctx, c)
+		if ipld.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, NewErrCouldNotFindBlock(c, err)
+		}
+		nd, err := dag.DecodeProtobuf(blk.RawData())
+		if err != nil {
+			return nil, err
+		}
+		// ensure the node yields the CID it was stored with
+		if err := nd.SetCidBuilder(c.Prefix()); err != nil {
+			return nil, err
+		}
+		delta, err := extractor(nd)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, DeltaEntry{Delta: delta, Node: nd})
+
+		for _, l := range nd.Links() {
+			if l.Name == core.HEAD {
+				queue = append(queue, l.Cid)
+			}
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Delta.GetPriority() < entries[j].Delta.GetPriority()
+	})
+	return entries, nil
+}
@@ -100,6 +100,13 @@ func InstanceWithStore(
 				key,
 				fieldName,
 			), nil
+		case client.FieldKind_BIGINT, client.FieldKind_DECIMAL:
+			return NewMerkleBigPNCounter(
+				store,
+				schemaVersionKey,
+				key,
+				fieldName,
+			), nil
 		}
 	case client.COMPOSITE:
 		return NewMerkleCompositeDAG(
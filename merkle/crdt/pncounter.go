@@ -12,8 +12,10 @@ package merklecrdt
 
 import (
 	"context"
+	"math/big"
 
 	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/shopspring/decimal"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/core"
@@ -58,3 +60,50 @@ func (mPNC *MerklePNCounter[T]) Save(ctx context.Context, data any) (ipld.Node,
 	nd, err := mPNC.clock.AddDAGNode(ctx, delta)
 	return nd, delta.GetPriority(), err
 }
+
+// MerkleBigPNCounter is a MerkleCRDT implementation of the BigPNCounter using MerkleClocks.
+type MerkleBigPNCounter struct {
+	*baseMerkleCRDT
+
+	reg crdt.BigPNCounter
+}
+
+// NewMerkleBigPNCounter creates a new instance (or loaded from DB) of a MerkleCRDT
+// backed by a BigPNCounter CRDT.
+func NewMerkleBigPNCounter(
+	store Stores,
+	schemaVersionKey core.CollectionSchemaVersionKey,
+	key core.DataStoreKey,
+	fieldName string,
+) *MerkleBigPNCounter {
+	register := crdt.NewBigPNCounter(store.Datastore(), schemaVersionKey, key, fieldName)
+	clk := clock.NewMerkleClock(store.Headstore(), store.DAGstore(), key.ToHeadStoreKey(), register)
+	base := &baseMerkleCRDT{clock: clk, crdt: register}
+	return &MerkleBigPNCounter{
+		baseMerkleCRDT: base,
+		reg:            register,
+	}
+}
+
+// Save the value of the Big PN Counter to the DAG.
+func (mPNC *MerkleBigPNCounter) Save(ctx context.Context, data any) (ipld.Node, uint64, error) {
+	value, ok := data.(*client.FieldValue)
+	if !ok {
+		return nil, 0, NewErrUnexpectedValueType(client.PN_COUNTER, &client.FieldValue{}, data)
+	}
+	var increment decimal.Decimal
+	switch v := value.Value().(type) {
+	case *big.Int:
+		increment = decimal.NewFromBigInt(v, 0)
+	case decimal.Decimal:
+		increment = v
+	default:
+		return nil, 0, NewErrUnexpectedValueType(client.PN_COUNTER, decimal.Decimal{}, v)
+	}
+	delta, err := mPNC.reg.Increment(ctx, increment)
+	if err != nil {
+		return nil, 0, err
+	}
+	nd, err := mPNC.clock.AddDAGNode(ctx, delta)
+	return nd, delta.GetPriority(), err
+}
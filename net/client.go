@@ -42,12 +42,21 @@ func (s *server) pushLog(ctx context.Context, evt events.Update, pid peer.ID) er
 
 	body := &pb.PushLogRequest_Body{
 		DocID:      []byte(evt.DocID),
-		Cid:        evt.Cid.Bytes(),
 		SchemaRoot: []byte(evt.SchemaRoot),
 		Creator:    s.peer.host.ID().String(),
-		Log: &pb.Document_Log{
+	}
+	if evt.IsPurge {
+		body.Purged = true
+		body.PurgeSignature = evt.PurgeSignature
+		body.PurgePublicKey = evt.PurgePublicKey
+	} else {
+		body.Cid = evt.Cid.Bytes()
+		body.Log = &pb.Document_Log{
 			Block: evt.Block.RawData(),
-		},
+		}
+		if err := s.peer.setDocumentSnapshot(ctx, body); err != nil {
+			return NewErrPushLog(err)
+		}
 	}
 	req := &pb.PushLogRequest{
 		Body: body,
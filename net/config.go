@@ -22,6 +22,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/sourcenetwork/defradb/config"
+	"github.com/sourcenetwork/defradb/core"
 )
 
 // Options is the node options.
@@ -33,6 +34,7 @@ type Options struct {
 	GRPCServerOptions []grpc.ServerOption
 	GRPCDialOptions   []grpc.DialOption
 	ConnManager       cconnmgr.ConnManager
+	SignaturePolicy   core.SignaturePolicy
 }
 
 type NodeOpt func(*Options) error
@@ -86,6 +88,15 @@ func WithPrivateKey(priv crypto.PrivKey) NodeOpt {
 	}
 }
 
+// WithSignaturePolicy sets the policy used to verify the signature of the blocks received
+// from other peers.
+func WithSignaturePolicy(policy core.SignaturePolicy) NodeOpt {
+	return func(opt *Options) error {
+		opt.SignaturePolicy = policy
+		return nil
+	}
+}
+
 // WithPubSub enables the pubsub feature.
 func WithPubSub(enable bool) NodeOpt {
 	return func(opt *Options) error {
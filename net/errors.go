@@ -23,6 +23,11 @@ const (
 	errFailedToGetDocID        = "failed to get DocID from broadcast message"
 	errPublishingToDocIDTopic  = "can't publish log %s for docID %s"
 	errPublishingToSchemaTopic = "can't publish log %s for schema %s"
+	errPublishingPurge         = "can't publish the purge of docID %s to topic %s"
+	errSnapshotOtherDocument   = "snapshot contains entries of another document"
+	errSnapshotHeadMismatch    = "snapshot heads do not match the advertised version"
+	errSnapshotBlockMismatch   = "snapshot entries do not match the blocks of its heads"
+	errUnauthorizedPurge       = "purge is not signed by the author of the document or a trusted signer"
 	errReplicatorExists        = "replicator already exists for %s with peerID %s"
 	errReplicatorDocID         = "failed to get docID for replicator %s with peerID %s"
 	errReplicatorCollections   = "failed to get collections for replicator"
@@ -35,6 +40,9 @@ var (
 	ErrNilDB                    = errors.New("database object can't be nil")
 	ErrNilUpdateChannel         = errors.New("tried to subscribe to update channel, but update channel is nil")
 	ErrSelfTargetForReplicator  = errors.New("can't target ourselves as a replicator")
+	ErrSnapshotHeadMismatch     = errors.New(errSnapshotHeadMismatch)
+	ErrSnapshotBlockMismatch    = errors.New(errSnapshotBlockMismatch)
+	ErrUnauthorizedPurge        = errors.New(errUnauthorizedPurge)
 )
 
 func NewErrPushLog(inner error, kv ...errors.KV) error {
@@ -53,6 +61,34 @@ func NewErrPublishingToSchemaTopic(inner error, cid, docID string, kv ...errors.
 	return errors.Wrap(fmt.Sprintf(errPublishingToSchemaTopic, cid, docID), inner, kv...)
 }
 
+func NewErrPublishingPurge(inner error, docID, topic string, kv ...errors.KV) error {
+	return errors.Wrap(fmt.Sprintf(errPublishingPurge, docID, topic), inner, kv...)
+}
+
+// NewErrSnapshotOtherDocument returns an error indicating that the snapshot sent along with the log
+// of a document contains entries of another document.
+func NewErrSnapshotOtherDocument(docID string, key string) error {
+	return errors.New(errSnapshotOtherDocument, errors.NewKV("DocID", docID), errors.NewKV("Key", key))
+}
+
+// NewErrSnapshotHeadMismatch returns an error indicating that the heads of the snapshot sent along
+// with the log of a document are not those of the advertised version.
+func NewErrSnapshotHeadMismatch(docID string, key string) error {
+	return errors.New(errSnapshotHeadMismatch, errors.NewKV("DocID", docID), errors.NewKV("Key", key))
+}
+
+// NewErrSnapshotBlockMismatch returns an error indicating that an entry of the snapshot sent along
+// with the log of a document is not the value set by the blocks of its heads.
+func NewErrSnapshotBlockMismatch(docID string, key string) error {
+	return errors.New(errSnapshotBlockMismatch, errors.NewKV("DocID", docID), errors.NewKV("Key", key))
+}
+
+// NewErrUnauthorizedPurge returns an error indicating that the purge of a document advertised by
+// a peer is signed by neither the author of the document nor a trusted signer.
+func NewErrUnauthorizedPurge(docID string, signer peer.ID) error {
+	return errors.New(errUnauthorizedPurge, errors.NewKV("DocID", docID), errors.NewKV("Signer", signer))
+}
+
 func NewErrReplicatorExists(collection string, peerID peer.ID, kv ...errors.KV) error {
 	return errors.New(fmt.Sprintf(errReplicatorExists, collection, peerID), kv...)
 }
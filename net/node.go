@@ -164,6 +164,7 @@ func NewNode(
 		cancel()
 		return nil, fin.Cleanup(err)
 	}
+	peer.signaturePolicy = options.SignaturePolicy
 
 	n := &Node{
 		// WARNING: The current usage of these channels means that consumers of them
@@ -37,6 +37,7 @@ import (
 	"github.com/sourcenetwork/defradb/core"
 	corenet "github.com/sourcenetwork/defradb/core/net"
 	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/db/base"
 	"github.com/sourcenetwork/defradb/errors"
 	"github.com/sourcenetwork/defradb/events"
 	"github.com/sourcenetwork/defradb/logging"
@@ -75,6 +76,10 @@ type Peer struct {
 	replicators map[string]map[peer.ID]struct{}
 	mu          sync.Mutex
 
+	// signaturePolicy is the policy used to verify the signature of the blocks received
+	// from other peers.
+	signaturePolicy core.SignaturePolicy
+
 	// peer DAG service
 	ipld.DAGService
 	exch  exchange.Interface
@@ -249,7 +254,9 @@ func (p *Peer) handleBroadcastLoop() {
 		// check log priority, 1 is new doc log
 		// 2 is update log
 		var err error
-		if update.Priority == 1 {
+		if update.IsPurge {
+			err = p.handleDocPurgeLog(update)
+		} else if update.Priority == 1 {
 			err = p.handleDocCreateLog(update)
 		} else if update.Priority > 1 {
 			err = p.handleDocUpdateLog(update)
@@ -458,6 +465,9 @@ func (p *Peer) handleDocUpdateLog(evt events.Update) error {
 			Block: evt.Block.RawData(),
 		},
 	}
+	if err := p.setDocumentSnapshot(p.ctx, body); err != nil {
+		return err
+	}
 	req := &pb.PushLogRequest{
 		Body: body,
 	}
@@ -476,6 +486,61 @@ func (p *Peer) handleDocUpdateLog(evt events.Update) error {
 	return nil
 }
 
+// handleDocPurgeLog advertises the purge of a document to the peers and replicators of the
+// document, so that they purge it as well.
+func (p *Peer) handleDocPurgeLog(evt events.Update) error {
+	log.Debug(
+		p.ctx,
+		"Preparing pubsub purge request from broadcast",
+		logging.NewKV("DocID", evt.DocID),
+		logging.NewKV("SchemaRoot", evt.SchemaRoot))
+
+	req := &pb.PushLogRequest{
+		Body: &pb.PushLogRequest_Body{
+			DocID:          []byte(evt.DocID),
+			SchemaRoot:     []byte(evt.SchemaRoot),
+			Creator:        p.host.ID().String(),
+			Purged:         true,
+			PurgeSignature: evt.PurgeSignature,
+			PurgePublicKey: evt.PurgePublicKey,
+		},
+	}
+
+	// push to each peer (replicator)
+	p.pushLogToReplicators(p.ctx, evt)
+
+	if err := p.server.publishLog(p.ctx, evt.DocID, req); err != nil {
+		return NewErrPublishingPurge(err, evt.DocID, evt.DocID)
+	}
+
+	if err := p.server.publishLog(p.ctx, evt.SchemaRoot, req); err != nil {
+		return NewErrPublishingPurge(err, evt.DocID, evt.SchemaRoot)
+	}
+
+	return nil
+}
+
+// setDocumentSnapshot adds the snapshot of the document of the given log to it if the history
+// of the document has been pruned, so that peers lacking the history can load the snapshot
+// instead of fetching the pruned blocks.
+func (p *Peer) setDocumentSnapshot(ctx context.Context, body *pb.PushLogRequest_Body) error {
+	txn, err := p.db.NewTxn(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer txn.Discard(ctx)
+
+	version, snapshot, err := base.FindDocumentSnapshot(ctx, txn.Systemstore(), string(body.DocID))
+	if err != nil {
+		return err
+	}
+	if version.Defined() {
+		body.SnapshotCid = version.Bytes()
+		body.Snapshot = snapshot
+	}
+	return nil
+}
+
 func (p *Peer) pushLogToReplicators(ctx context.Context, lg events.Update) {
 	// push to each peer (replicator)
 	peers := make(map[string]struct{})
@@ -40,6 +40,11 @@ func (p *Peer) AddP2PCollections(ctx context.Context, collectionIDs []string) er
 		}
 		storeCollections = append(storeCollections, storeCol...)
 	}
+	storeCollections, err = withLinkCollections(p.ctx, p.db.WithTxn(txn), storeCollections)
+	if err != nil {
+		return err
+	}
+	collectionIDs = schemaRoots(storeCollections)
 
 	// Ensure we can add all the collections to the store on the transaction
 	// before adding to topics.
@@ -105,6 +110,11 @@ func (p *Peer) RemoveP2PCollections(ctx context.Context, collectionIDs []string)
 		}
 		storeCollections = append(storeCollections, storeCol...)
 	}
+	storeCollections, err = withLinkCollections(p.ctx, p.db.WithTxn(txn), storeCollections)
+	if err != nil {
+		return err
+	}
+	collectionIDs = schemaRoots(storeCollections)
 
 	// Ensure we can remove all the collections to the store on the transaction
 	// before adding to topics.
@@ -177,3 +187,53 @@ func (p *Peer) GetAllP2PCollections(ctx context.Context) ([]string, error) {
 
 	return collectionIDs, nil
 }
+
+// withLinkCollections returns the given collections along with the internal link collections
+// of their many-to-many relations.
+//
+// Link collections are not returned by GetAllCollections, but the links of a relation must be
+// synced alongside its collections for the relation to be resolved on the other peers.
+func withLinkCollections(
+	ctx context.Context,
+	store client.Store,
+	cols []client.Collection,
+) ([]client.Collection, error) {
+	names := make(map[string]struct{}, len(cols))
+	for _, col := range cols {
+		names[col.Name()] = struct{}{}
+	}
+
+	result := cols
+	for _, col := range cols {
+		for _, field := range col.Schema().Fields {
+			if !field.IsManyToMany() {
+				continue
+			}
+			linkName := client.LinkCollectionName(field.RelationName)
+			if _, exists := names[linkName]; exists {
+				continue
+			}
+			linkCol, err := store.GetCollectionByName(ctx, linkName)
+			if err != nil {
+				return nil, err
+			}
+			names[linkName] = struct{}{}
+			result = append(result, linkCol)
+		}
+	}
+	return result, nil
+}
+
+// schemaRoots returns the unique schema roots of the given collections.
+func schemaRoots(cols []client.Collection) []string {
+	roots := []string{}
+	seen := make(map[string]struct{}, len(cols))
+	for _, col := range cols {
+		if _, exists := seen[col.SchemaRoot()]; exists {
+			continue
+		}
+		seen[col.SchemaRoot()] = struct{}{}
+		roots = append(roots, col.SchemaRoot())
+	}
+	return roots
+}
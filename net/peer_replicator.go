@@ -50,6 +50,10 @@ func (p *Peer) SetReplicator(ctx context.Context, rep client.Replicator) error {
 			}
 			collections = append(collections, col)
 		}
+		collections, err = withLinkCollections(ctx, p.db.WithTxn(txn), collections)
+		if err != nil {
+			return NewErrReplicatorCollections(err)
+		}
 
 	default:
 		// default to all collections
@@ -57,6 +61,10 @@ func (p *Peer) SetReplicator(ctx context.Context, rep client.Replicator) error {
 		if err != nil {
 			return NewErrReplicatorCollections(err)
 		}
+		collections, err = withLinkCollections(ctx, p.db.WithTxn(txn), collections)
+		if err != nil {
+			return NewErrReplicatorCollections(err)
+		}
 	}
 	rep.Schemas = nil
 
@@ -130,6 +138,10 @@ func (p *Peer) DeleteReplicator(ctx context.Context, rep client.Replicator) erro
 			}
 			collections = append(collections, col)
 		}
+		collections, err = withLinkCollections(ctx, p.db.WithTxn(txn), collections)
+		if err != nil {
+			return NewErrReplicatorCollections(err)
+		}
 		// make sure the replicator exists in the datastore
 		key := core.NewReplicatorKey(rep.Info.ID.String())
 		_, err = txn.Systemstore().Get(ctx, key.ToDS())
@@ -143,6 +155,10 @@ func (p *Peer) DeleteReplicator(ctx context.Context, rep client.Replicator) erro
 		if err != nil {
 			return NewErrReplicatorCollections(err)
 		}
+		collections, err = withLinkCollections(ctx, p.db.WithTxn(txn), collections)
+		if err != nil {
+			return NewErrReplicatorCollections(err)
+		}
 	}
 	rep.Schemas = nil
 
@@ -114,7 +114,7 @@ func createCID(doc *client.Document) (cid.Cid, error) {
 
 const randomMultiaddr = "/ip4/127.0.0.1/tcp/0"
 
-func newTestNode(ctx context.Context, t *testing.T) (client.DB, *Node) {
+func newTestNode(ctx context.Context, t *testing.T, opts ...NodeOpt) (client.DB, *Node) {
 	store := memory.NewDatastore(ctx)
 	db, err := db.NewDB(ctx, store, db.WithUpdateEvents())
 	require.NoError(t, err)
@@ -125,7 +125,7 @@ func newTestNode(ctx context.Context, t *testing.T) (client.DB, *Node) {
 	n, err := NewNode(
 		ctx,
 		db,
-		WithConfig(cfg),
+		append([]NodeOpt{WithConfig(cfg)}, opts...)...,
 	)
 	require.NoError(t, err)
 
@@ -463,6 +463,76 @@ func TestSetReplicator_ForAllCollections_NoError(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSetReplicator_WithManyToManyCollection_IncludesLinkCollection(t *testing.T) {
+	ctx := context.Background()
+	db, n := newTestNode(ctx, t)
+	defer n.Close()
+
+	_, err := db.AddSchema(ctx, `
+		type Post {
+			name: String
+			tags: [Tag]
+		}
+		type Tag {
+			name: String
+			posts: [Post]
+		}
+	`)
+	require.NoError(t, err)
+
+	linkCol, err := db.GetCollectionByName(ctx, "_post_tag")
+	require.NoError(t, err)
+
+	info, err := peer.AddrInfoFromString("/ip4/0.0.0.0/tcp/0/p2p/QmYyQSo1c1Ym7orWxLYvCrM2EmxFTANf8wXmmE7DWjhx5N")
+	require.NoError(t, err)
+
+	err = n.Peer.SetReplicator(ctx, client.Replicator{
+		Info:    *info,
+		Schemas: []string{"Post"},
+	})
+	require.NoError(t, err)
+
+	reps, err := n.Peer.GetAllReplicators(ctx)
+	require.NoError(t, err)
+	require.Len(t, reps, 1)
+	require.Contains(t, reps[0].Schemas, linkCol.SchemaRoot())
+}
+
+func TestSetReplicator_ForAllCollectionsWithManyToMany_IncludesLinkCollection(t *testing.T) {
+	ctx := context.Background()
+	db, n := newTestNode(ctx, t)
+	defer n.Close()
+
+	_, err := db.AddSchema(ctx, `
+		type Post {
+			name: String
+			tags: [Tag]
+		}
+		type Tag {
+			name: String
+			posts: [Post]
+		}
+	`)
+	require.NoError(t, err)
+
+	linkCol, err := db.GetCollectionByName(ctx, "_post_tag")
+	require.NoError(t, err)
+
+	info, err := peer.AddrInfoFromString("/ip4/0.0.0.0/tcp/0/p2p/QmYyQSo1c1Ym7orWxLYvCrM2EmxFTANf8wXmmE7DWjhx5N")
+	require.NoError(t, err)
+
+	err = n.Peer.SetReplicator(ctx, client.Replicator{
+		Info: *info,
+	})
+	require.NoError(t, err)
+
+	reps, err := n.Peer.GetAllReplicators(ctx)
+	require.NoError(t, err)
+	require.Len(t, reps, 1)
+	require.Len(t, reps[0].Schemas, 3)
+	require.Contains(t, reps[0].Schemas, linkCol.SchemaRoot())
+}
+
 func TestPushToReplicator_SingleDocumentNoPeer_FailedToReplicateLogError(t *testing.T) {
 	ctx := context.Background()
 	db, n := newTestNode(ctx, t)
@@ -720,6 +790,36 @@ func TestAddP2PCollections_NoError(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestAddP2PCollections_WithManyToManyCollection_IncludesLinkCollection(t *testing.T) {
+	ctx := context.Background()
+	db, n := newTestNode(ctx, t)
+	defer n.Close()
+
+	_, err := db.AddSchema(ctx, `
+		type Post {
+			name: String
+			tags: [Tag]
+		}
+		type Tag {
+			name: String
+			posts: [Post]
+		}
+	`)
+	require.NoError(t, err)
+
+	col, err := db.GetCollectionByName(ctx, "Post")
+	require.NoError(t, err)
+	linkCol, err := db.GetCollectionByName(ctx, "_post_tag")
+	require.NoError(t, err)
+
+	err = n.Peer.AddP2PCollections(ctx, []string{col.SchemaRoot()})
+	require.NoError(t, err)
+
+	cols, err := n.Peer.GetAllP2PCollections(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{col.SchemaRoot(), linkCol.SchemaRoot()}, cols)
+}
+
 func TestRemoveP2PCollectionsWithInvalidCollectionID(t *testing.T) {
 	ctx := context.Background()
 	_, n := newTestNode(ctx, t)
@@ -13,22 +13,30 @@
 package net
 
 import (
+	"bytes"
 	"container/list"
 	"context"
+	"encoding/binary"
 	"fmt"
+	"strings"
 	"sync"
 
+	"github.com/fxamacker/cbor/v2"
 	dag "github.com/ipfs/boxo/ipld/merkledag"
 	blocks "github.com/ipfs/go-block-format"
 	"github.com/ipfs/go-cid"
 	ipld "github.com/ipfs/go-ipld-format"
 
 	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
 	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/core/crdt"
 	"github.com/sourcenetwork/defradb/datastore"
 	"github.com/sourcenetwork/defradb/db/base"
 	"github.com/sourcenetwork/defradb/errors"
+	"github.com/sourcenetwork/defradb/events"
 	"github.com/sourcenetwork/defradb/logging"
+	"github.com/sourcenetwork/defradb/merkle/clock"
 	merklecrdt "github.com/sourcenetwork/defradb/merkle/crdt"
 )
 
@@ -87,11 +95,40 @@ func (bp *blockProcessor) processBlock(ctx context.Context, nd ipld.Node, field
 		return errors.Wrap("failed to decode delta object", err)
 	}
 
+	err = clock.VerifyNode(nd, delta, bp.signaturePolicy)
+	if err != nil {
+		return err
+	}
+
 	err = crdt.Clock().ProcessNode(ctx, delta, nd)
 	if err != nil {
 		return err
 	}
 
+	err = bp.setHeadOverPrunedBlocks(ctx, nd, field, delta.GetPriority())
+	if err != nil {
+		return err
+	}
+
+	if field != "" {
+		bp.reportInvalidFieldValue(ctx, nd, field, delta)
+		bp.reportMissingRelatedDoc(ctx, nd, field, delta)
+	} else {
+		bp.reportMissingNonNullFields(ctx, nd, delta)
+		err = base.RecordCommit(
+			ctx,
+			bp.txn,
+			bp.col.ID(),
+			bp.dsKey.DocID,
+			nd.Cid(),
+			delta.GetPriority(),
+			documentStatus(delta),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
 	for _, link := range nd.Links() {
 		if link.Name == core.HEAD {
 			continue
@@ -120,6 +157,194 @@ func (bp *blockProcessor) processBlock(ctx context.Context, nd ipld.Node, field
 	return nil
 }
 
+// setHeadOverPrunedBlocks sets the given block as a head of the given field if it links to a block
+// that has been pruned by history compaction.
+//
+// The merkle clock only sets a block as a head if its parents are heads or are known, pruned
+// blocks are neither but are part of the known history all the same.
+func (bp *blockProcessor) setHeadOverPrunedBlocks(
+	ctx context.Context,
+	nd ipld.Node,
+	field string,
+	priority uint64,
+) error {
+	fieldID := core.COMPOSITE_NAMESPACE
+	if field != "" {
+		fd, ok := bp.col.Schema().GetField(field)
+		if !ok {
+			return client.NewErrFieldNotExist(field)
+		}
+		fieldID = fd.ID.String()
+	}
+
+	for _, link := range nd.Links() {
+		if link.Name != core.HEAD {
+			continue
+		}
+		isPruned, err := base.IsBlockPruned(ctx, bp.txn.Systemstore(), bp.dsKey.DocID, link.Cid)
+		if err != nil {
+			return err
+		}
+		if isPruned {
+			headset := clock.NewHeadSet(bp.txn.Headstore(), bp.dsKey.WithFieldId(fieldID).ToHeadStoreKey())
+			return headset.Write(ctx, nd.Cid(), priority)
+		}
+	}
+	return nil
+}
+
+// reportInvalidFieldValue reports the value carried by the given field delta if it may not be
+// held by the field, as documents written locally are checked by [client.Document.Set]:
+// a null value for a non-null field, a value that is not one of the values of the enum of the
+// field, or a value that does not satisfy the constraint of the field.
+//
+// Merged blocks cannot be rejected without causing peers to diverge, so violations are
+// reported rather than enforced, see [blockProcessor.reportViolation].
+func (bp *blockProcessor) reportInvalidFieldValue(
+	ctx context.Context,
+	nd ipld.Node,
+	field string,
+	delta core.Delta,
+) {
+	fd, ok := bp.col.Schema().GetField(field)
+	if !ok {
+		return
+	}
+	lwwDelta, ok := delta.(*crdt.LWWRegDelta)
+	if !ok {
+		return
+	}
+
+	var value any
+	err := cbor.Unmarshal(lwwDelta.Data, &value)
+	if err == nil {
+		value, err = core.DecodeFieldValue(fd, value)
+	}
+	if err == nil {
+		err = client.ValidateFieldValue(value, fd, bp.col.Schema())
+	}
+	if err != nil {
+		bp.reportViolation(ctx, nd, field, err)
+	}
+}
+
+// reportMissingNonNullFields reports the non-null fields that are not set by the given composite
+// delta, if it creates the document.
+//
+// Later composite deltas only link the fields that they update, the fields that they do not link
+// keep their value.
+func (bp *blockProcessor) reportMissingNonNullFields(ctx context.Context, nd ipld.Node, delta core.Delta) {
+	if delta.GetPriority() != 1 {
+		return
+	}
+
+	linkedFields := map[string]struct{}{}
+	for _, link := range nd.Links() {
+		linkedFields[link.Name] = struct{}{}
+	}
+	for _, fd := range bp.col.Schema().Fields {
+		if !fd.IsNonNull {
+			continue
+		}
+		if _, ok := linkedFields[fd.Name]; !ok {
+			bp.reportViolation(ctx, nd, fd.Name, client.NewErrNonNullFieldNotSet(fd.Name))
+		}
+	}
+}
+
+// reportMissingRelatedDoc reports the relation ID carried by the given field delta if it
+// references a document that does not exist and the relation requires it to.
+//
+// As with field values, merged blocks cannot be rejected so missing documents are
+// reported rather than enforced.
+//
+// The on-delete options of relations are likewise not applied to merged blocks.  They are
+// applied by the node that deleted the document, which then shares the resulting blocks.
+func (bp *blockProcessor) reportMissingRelatedDoc(
+	ctx context.Context,
+	nd ipld.Node,
+	field string,
+	delta core.Delta,
+) {
+	objField, ok := bp.col.Schema().GetField(strings.TrimSuffix(field, request.RelatedObjectID))
+	if !ok || !objField.CheckExists || objField.Name == field {
+		return
+	}
+	lwwDelta, ok := delta.(*crdt.LWWRegDelta)
+	if !ok {
+		return
+	}
+
+	var value any
+	err := cbor.Unmarshal(lwwDelta.Data, &value)
+	if err != nil {
+		return
+	}
+	relatedDocID, ok := value.(string)
+	if !ok {
+		return
+	}
+
+	err = base.CheckRelatedDocExists(ctx, bp.db.WithTxn(bp.txn), bp.txn, objField, relatedDocID)
+	if err != nil {
+		bp.reportViolation(ctx, nd, field, err)
+	}
+}
+
+// reportViolation logs the given violation of the schema of the document by the given merged
+// block, and publishes it to the violation events channel if the database has one so that
+// callers can act upon it.
+func (bp *blockProcessor) reportViolation(ctx context.Context, nd ipld.Node, field string, err error) {
+	log.ErrorE(
+		ctx,
+		"Merged block violates the schema of the document",
+		err,
+		logging.NewKV("DocID", bp.dsKey.DocID),
+		logging.NewKV("CID", nd.Cid()),
+		logging.NewKV("Field", field),
+	)
+
+	if bp.db.Events().Violations.HasValue() {
+		bp.db.Events().Violations.Value().Publish(events.Violation{
+			DocID:      bp.dsKey.DocID,
+			Cid:        nd.Cid(),
+			SchemaRoot: bp.col.SchemaRoot(),
+			Field:      field,
+			Err:        err,
+		})
+	}
+}
+
+// documentStatus returns the document status of the given composite delta.
+func documentStatus(delta core.Delta) client.DocumentStatus {
+	compositeDelta, ok := delta.(*crdt.CompositeDAGDelta)
+	if !ok {
+		return client.Active
+	}
+	return compositeDelta.Status
+}
+
+// verifyBlock verifies that the signature of the given block is accepted by the given policy.
+func verifyBlock(
+	ctx context.Context,
+	txn datastore.Txn,
+	col client.Collection,
+	dsKey core.DataStoreKey,
+	nd ipld.Node,
+	field string,
+	policy core.SignaturePolicy,
+) error {
+	crdt, err := initCRDTForType(ctx, txn, col, dsKey, field)
+	if err != nil {
+		return err
+	}
+	delta, err := crdt.DeltaDecode(nd)
+	if err != nil {
+		return errors.Wrap("failed to decode delta object", err)
+	}
+	return clock.VerifyNode(nd, delta, policy)
+}
+
 func initCRDTForType(
 	ctx context.Context,
 	txn datastore.Txn,
@@ -176,6 +401,281 @@ func decodeBlockBuffer(buf []byte, cid cid.Cid) (ipld.Node, error) {
 	return ipld.Decode(blk, dag.DecodeProtobufBlock)
 }
 
+// loadSnapshot loads the given snapshot of the document, taken by a peer at the version of the
+// given CID bytes when it pruned the history preceding it, if this node has none of the history
+// of the document.
+//
+// The pruned blocks cannot be fetched from peers, so the state of the document at this version
+// is loaded instead, along with the blocks of its heads, and the parents of these blocks are
+// marked as pruned so that they are not fetched either. Nothing is written unless the snapshot
+// is consistent with the blocks of its heads, see [blockProcessor.verifySnapshot].
+//
+// Counter values cannot be verified without the pruned history, so snapshots are not loaded if
+// the signature policy restricts the accepted signers.
+//
+// It returns the CID of the loaded version, which is undefined if the snapshot was not loaded.
+func (bp *blockProcessor) loadSnapshot(
+	ctx context.Context,
+	versionBytes []byte,
+	buf []byte,
+) (cid.Cid, error) {
+	version, err := cid.Cast(versionBytes)
+	if err != nil {
+		return cid.Undef, err
+	}
+	if bp.signaturePolicy.RequireSignature || len(bp.signaturePolicy.TrustedSigners) > 0 {
+		log.Debug(ctx, "Signature policy restricts signers, skipping snapshot.", logging.NewKV("CID", version))
+		return cid.Undef, nil
+	}
+
+	headKey := bp.dsKey.WithFieldId(core.COMPOSITE_NAMESPACE).ToHeadStoreKey()
+	heads, _, err := clock.NewHeadSet(bp.txn.Headstore(), headKey).List(ctx)
+	if err != nil {
+		return cid.Undef, err
+	}
+	if len(heads) > 0 {
+		return cid.Undef, nil
+	}
+
+	var snapshot base.DocumentSnapshot
+	if err := cbor.Unmarshal(buf, &snapshot); err != nil {
+		return cid.Undef, err
+	}
+
+	getCtx, cancel := context.WithTimeout(ctx, DAGSyncTimeout)
+	defer cancel()
+
+	nodes, err := bp.verifySnapshot(getCtx, version, snapshot)
+	if err != nil {
+		return cid.Undef, err
+	}
+
+	// collection IDs are local to each node, so the keys of the snapshot are rewritten with
+	// the one of this node
+	collectionID := base.MakeDataStoreKeyWithCollectionDescription(bp.col.Description()).CollectionID
+	data := make(map[string][]byte, len(snapshot.Data))
+	for key, value := range snapshot.Data {
+		dsKey, err := core.NewDataStoreKey(key)
+		if err != nil {
+			return cid.Undef, err
+		}
+		dsKey.CollectionID = collectionID
+		if err := bp.txn.Datastore().Put(ctx, dsKey.ToDS(), value); err != nil {
+			return cid.Undef, err
+		}
+		data[dsKey.ToString()] = value
+	}
+	snapshot.Data = data
+
+	for key, value := range snapshot.Heads {
+		headKey, err := core.NewHeadStoreKey(key)
+		if err != nil {
+			return cid.Undef, err
+		}
+		if err := bp.txn.Headstore().Put(ctx, headKey.ToDS(), value); err != nil {
+			return cid.Undef, err
+		}
+	}
+
+	for _, nd := range nodes {
+		if err := bp.txn.DAGstore().Put(ctx, nd); err != nil {
+			return cid.Undef, err
+		}
+	}
+	for _, nd := range nodes {
+		for _, link := range nd.Links() {
+			if link.Name != core.HEAD {
+				continue
+			}
+			exists, err := bp.txn.DAGstore().Has(ctx, link.Cid)
+			if err != nil {
+				return cid.Undef, err
+			}
+			if !exists {
+				err = base.MarkBlockPruned(ctx, bp.txn.Systemstore(), bp.dsKey.DocID, link.Cid)
+				if err != nil {
+					return cid.Undef, err
+				}
+			}
+		}
+	}
+
+	delta, err := crdt.CompositeDAG{}.DeltaDecode(nodes[version])
+	if err != nil {
+		return cid.Undef, err
+	}
+	err = base.RecordCommit(
+		ctx,
+		bp.txn,
+		bp.col.ID(),
+		bp.dsKey.DocID,
+		version,
+		delta.GetPriority(),
+		documentStatus(delta),
+	)
+	if err != nil {
+		return cid.Undef, err
+	}
+
+	err = base.PutDocumentSnapshot(ctx, bp.txn.Systemstore(), bp.dsKey.DocID, version, snapshot)
+	if err != nil {
+		return cid.Undef, err
+	}
+	return version, nil
+}
+
+// verifySnapshot verifies that the given snapshot of the document is the state at the given
+// version, as far as the blocks of its heads allow, and returns these blocks mapped by CID.
+//
+// The version must be the only composite head, each field block linked by the version must be
+// the head of its field, the heights of the heads must be the priorities of their blocks, and
+// the values and priorities of the fields must be those set by their head block. The values of
+// counters are the sum of all their blocks and are not verified.
+func (bp *blockProcessor) verifySnapshot(
+	ctx context.Context,
+	version cid.Cid,
+	snapshot base.DocumentSnapshot,
+) (map[cid.Cid]ipld.Node, error) {
+	docID := bp.dsKey.DocID
+	nodes := map[cid.Cid]ipld.Node{}
+	fieldHeads := map[string]core.Delta{}
+	fieldTypes := map[string]client.CType{}
+	hasVersionHead := false
+	for key, value := range snapshot.Heads {
+		headKey, err := core.NewHeadStoreKey(key)
+		if err != nil {
+			return nil, err
+		}
+		if headKey.DocID != docID {
+			return nil, NewErrSnapshotOtherDocument(docID, key)
+		}
+
+		field := ""
+		if headKey.FieldId == core.COMPOSITE_NAMESPACE {
+			if headKey.Cid != version {
+				return nil, NewErrSnapshotHeadMismatch(docID, key)
+			}
+			hasVersionHead = true
+		} else {
+			fd, ok := getFieldByID(bp.col.Schema(), headKey.FieldId)
+			if !ok {
+				return nil, NewErrSnapshotHeadMismatch(docID, key)
+			}
+			if _, ok := fieldHeads[headKey.FieldId]; ok {
+				// concurrent heads of a field cannot be told apart from stale ones
+				return nil, NewErrSnapshotHeadMismatch(docID, key)
+			}
+			field = fd.Name
+			fieldTypes[headKey.FieldId] = fd.Typ
+		}
+
+		nd, err := bp.getSnapshotBlock(ctx, headKey.Cid)
+		if err != nil {
+			return nil, err
+		}
+		crdt, err := initCRDTForType(ctx, bp.txn, bp.col, bp.dsKey, field)
+		if err != nil {
+			return nil, err
+		}
+		delta, err := crdt.DeltaDecode(nd)
+		if err != nil {
+			return nil, errors.Wrap("failed to decode delta object", err)
+		}
+		if err := clock.VerifyNode(nd, delta, bp.signaturePolicy); err != nil {
+			return nil, err
+		}
+		height, n := binary.Uvarint(value)
+		if n <= 0 || height != delta.GetPriority() {
+			return nil, NewErrSnapshotHeadMismatch(docID, key)
+		}
+
+		nodes[headKey.Cid] = nd
+		if field != "" {
+			fieldHeads[headKey.FieldId] = delta
+		}
+	}
+	if !hasVersionHead {
+		return nil, NewErrSnapshotHeadMismatch(docID, version.String())
+	}
+
+	for _, link := range nodes[version].Links() {
+		if link.Name == core.HEAD {
+			continue
+		}
+		fd, ok := bp.col.Schema().GetField(link.Name)
+		if !ok {
+			return nil, NewErrSnapshotHeadMismatch(docID, link.Name)
+		}
+		if _, ok := nodes[link.Cid]; !ok {
+			return nil, NewErrSnapshotHeadMismatch(docID, fd.ID.String())
+		}
+	}
+
+	for key, value := range snapshot.Data {
+		dsKey, err := core.NewDataStoreKey(key)
+		if err != nil {
+			return nil, err
+		}
+		if dsKey.DocID != docID {
+			return nil, NewErrSnapshotOtherDocument(docID, key)
+		}
+		if _, ok := getFieldByID(bp.col.Schema(), dsKey.FieldId); !ok {
+			// document level entries, such as the primary key marker and the schema version,
+			// are not set by field blocks
+			continue
+		}
+
+		delta, ok := fieldHeads[dsKey.FieldId]
+		if !ok {
+			return nil, NewErrSnapshotBlockMismatch(docID, key)
+		}
+		switch dsKey.InstanceType {
+		case core.PriorityKey:
+			priority, n := binary.Uvarint(value)
+			if n <= 0 || priority != delta.GetPriority() {
+				return nil, NewErrSnapshotBlockMismatch(docID, key)
+			}
+		case core.ValueKey, core.DeletedKey:
+			if fieldTypes[dsKey.FieldId] != client.LWW_REGISTER {
+				continue
+			}
+			lwwDelta, ok := delta.(*crdt.LWWRegDelta)
+			if !ok || !bytes.Equal(lwwDelta.Data, value) {
+				return nil, NewErrSnapshotBlockMismatch(docID, key)
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+// getFieldByID returns the field of the given schema with the given ID string.
+func getFieldByID(schema client.SchemaDescription, id string) (client.FieldDescription, bool) {
+	for _, field := range schema.Fields {
+		if field.ID.String() == id {
+			return field, true
+		}
+	}
+	return client.FieldDescription{}, false
+}
+
+// getSnapshotBlock returns the given block of the snapshot, from the transaction if it has
+// already been stored, like the pushed block, or from the network otherwise.
+func (bp *blockProcessor) getSnapshotBlock(ctx context.Context, c cid.Cid) (ipld.Node, error) {
+	exists, err := bp.txn.DAGstore().Has(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return bp.getter.Get(ctx, c)
+	}
+	block, err := bp.txn.DAGstore().Get(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	return dag.DecodeProtobufBlock(block)
+}
+
 // processRemoteBlock stores the block in the DAG store and initiates a sync of the block's children.
 func (bp *blockProcessor) processRemoteBlock(
 	ctx context.Context,
@@ -230,6 +730,20 @@ func (bp *blockProcessor) handleChildBlocks(
 			continue
 		}
 
+		isPruned, err := base.IsBlockPruned(ctx, bp.txn.Systemstore(), bp.dsKey.DocID, link.Cid)
+		if err != nil {
+			log.Error(
+				ctx,
+				"Failed to check for pruned block",
+				logging.NewKV("CID", link.Cid),
+				logging.NewKV("ERROR", err),
+			)
+		}
+		if isPruned {
+			log.Debug(ctx, "Block has been pruned locally, skipping.", logging.NewKV("CID", link.Cid))
+			continue
+		}
+
 		session.Add(1)
 		job := &dagJob{
 			session:     session,
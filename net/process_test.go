@@ -0,0 +1,113 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package net
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dag "github.com/ipfs/boxo/ipld/merkledag"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/config"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/datastore/memory"
+	"github.com/sourcenetwork/defradb/db"
+	"github.com/sourcenetwork/defradb/events"
+)
+
+func TestProcessBlock_WithBlocksViolatingSchema_PublishesViolations(t *testing.T) {
+	ctx := context.Background()
+
+	// the blocks are created by a node that does not know of the constraints of the fields
+	sourceDB, err := db.NewDB(ctx, memory.NewDatastore(ctx))
+	require.NoError(t, err)
+	defer sourceDB.Close()
+	_, err = sourceDB.AddSchema(ctx, `type User {
+		name: String
+		age: Int
+	}`)
+	require.NoError(t, err)
+	sourceCol, err := sourceDB.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+	doc, err := client.NewDocFromJSON([]byte(`{"age": -1}`), sourceCol.Schema())
+	require.NoError(t, err)
+	err = sourceCol.Create(ctx, doc)
+	require.NoError(t, err)
+
+	targetDB, err := db.NewDB(ctx, memory.NewDatastore(ctx), db.WithUpdateEvents(), db.WithViolationEvents())
+	require.NoError(t, err)
+	_, err = targetDB.AddSchema(ctx, `type User {
+		name: String!
+		age: Int @constraint(min: 0)
+	}`)
+	require.NoError(t, err)
+	cfg := config.DefaultConfig()
+	cfg.Net.P2PAddress = randomMultiaddr
+	n, err := NewNode(ctx, targetDB, WithConfig(cfg))
+	require.NoError(t, err)
+	defer n.Close()
+	violations, err := targetDB.Events().Violations.Value().Subscribe()
+	require.NoError(t, err)
+
+	keys, err := sourceDB.Blockstore().AllKeysChan(ctx)
+	require.NoError(t, err)
+	for key := range keys {
+		block, err := sourceDB.Blockstore().Get(ctx, key)
+		require.NoError(t, err)
+		err = targetDB.Blockstore().Put(ctx, block)
+		require.NoError(t, err)
+	}
+
+	txn, err := targetDB.NewTxn(ctx, false)
+	require.NoError(t, err)
+	defer txn.Discard(ctx)
+	targetCol, err := targetDB.WithTxn(txn).GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+	block, err := txn.DAGstore().Get(ctx, doc.Head())
+	require.NoError(t, err)
+	nd, err := dag.DecodeProtobufBlock(block)
+	require.NoError(t, err)
+
+	bp := newBlockProcessor(n.Peer, txn, targetCol, core.DataStoreKeyFromDocID(doc.ID()), n.Peer.newDAGSyncerTxn(txn))
+	err = bp.processBlock(ctx, nd, "")
+	require.NoError(t, err)
+
+	expected := []struct {
+		field string
+		err   string
+	}{
+		{field: "name", err: "non-null field must be given a value"},
+		{field: "age", err: "value is less than the minimum permitted by the field"},
+	}
+	for _, e := range expected {
+		select {
+		case violation := <-violations:
+			require.Equal(t, doc.ID().String(), violation.DocID)
+			require.Equal(t, targetCol.SchemaRoot(), violation.SchemaRoot)
+			require.Equal(t, e.field, violation.Field)
+			require.ErrorContains(t, violation.Err, e.err)
+		case <-time.After(time.Second):
+			t.Fatalf("expected a violation of field %s", e.field)
+		}
+	}
+	requireNoViolation(t, violations)
+}
+
+func requireNoViolation(t *testing.T, violations events.Subscription[events.Violation]) {
+	select {
+	case violation := <-violations:
+		t.Fatalf("unexpected violation of field %s: %v", violation.Field, violation.Err)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -17,11 +17,13 @@ import (
 	"fmt"
 	"sync"
 
+	dag "github.com/ipfs/boxo/ipld/merkledag"
 	"github.com/ipfs/go-cid"
 	format "github.com/ipfs/go-ipld-format"
 	"github.com/libp2p/go-libp2p/core/event"
 	libpeer "github.com/libp2p/go-libp2p/core/peer"
 	rpc "github.com/sourcenetwork/go-libp2p-pubsub-rpc"
+	"golang.org/x/exp/slices"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	grpcpeer "google.golang.org/grpc/peer"
@@ -29,9 +31,12 @@ import (
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/datastore"
 	"github.com/sourcenetwork/defradb/datastore/badger/v4"
+	"github.com/sourcenetwork/defradb/db/base"
 	"github.com/sourcenetwork/defradb/errors"
 	"github.com/sourcenetwork/defradb/logging"
+	"github.com/sourcenetwork/defradb/merkle/clock"
 	pb "github.com/sourcenetwork/defradb/net/pb"
 )
 
@@ -100,6 +105,10 @@ func newServer(p *Peer, db client.DB, opts ...grpc.DialOption) (*server, error)
 		if err != nil {
 			return nil, err
 		}
+		cols, err = withLinkCollections(s.peer.ctx, s.db, cols)
+		if err != nil {
+			return nil, err
+		}
 
 		i := 0
 		for _, col := range cols {
@@ -201,10 +210,6 @@ func (s *server) PushLog(ctx context.Context, req *pb.PushLogRequest) (*pb.PushL
 	}
 	log.Debug(ctx, "Received a PushLog request", logging.NewKV("PeerID", pid))
 
-	cid, err := cid.Cast(req.Body.Cid)
-	if err != nil {
-		return nil, err
-	}
 	docID, err := client.NewDocIDFromString(string(req.Body.DocID))
 	if err != nil {
 		return nil, err
@@ -230,6 +235,19 @@ func (s *server) PushLog(ctx context.Context, req *pb.PushLogRequest) (*pb.PushL
 		}
 	}()
 
+	if req.Body.Purged {
+		signature := core.CommitSignature{
+			Signature: req.Body.PurgeSignature,
+			PublicKey: req.Body.PurgePublicKey,
+		}
+		return &pb.PushLogReply{}, s.purgeDocument(ctx, docID, string(req.Body.SchemaRoot), signature)
+	}
+
+	cid, err := cid.Cast(req.Body.Cid)
+	if err != nil {
+		return nil, err
+	}
+
 	// make sure were not processing twice
 	if canVisit := s.peer.queuedChildren.Visit(cid); !canVisit {
 		return &pb.PushLogReply{}, nil
@@ -260,6 +278,16 @@ func (s *server) PushLog(ctx context.Context, req *pb.PushLogRequest) (*pb.PushL
 		defer txn.Discard(ctx)
 		store := s.db.WithTxn(txn)
 
+		// purged documents must not be recreated from the commits of peers
+		isPurged, err := base.IsDocumentPurged(ctx, txn.Systemstore(), docID.String())
+		if err != nil {
+			return nil, err
+		}
+		if isPurged {
+			log.Debug(ctx, fmt.Sprintf("Document %s has been purged, skipping.", docID))
+			return &pb.PushLogReply{}, nil
+		}
+
 		// Currently a schema is the best way we have to link a push log request to a collection,
 		// this will change with https://github.com/sourcenetwork/defradb/issues/1085
 		cols, err := store.GetCollectionsBySchemaRoot(ctx, schemaRoot)
@@ -284,20 +312,46 @@ func (s *server) PushLog(ctx context.Context, req *pb.PushLogRequest) (*pb.PushL
 			return nil, errors.Wrap("failed to decode block to ipld.Node", err)
 		}
 
+		// If the policy restricts the accepted signers, the pushed block is rejected before
+		// anything is stored. Signatures are otherwise verified when each block is merged.
+		policy := s.peer.signaturePolicy
+		if policy.RequireSignature || len(policy.TrustedSigners) > 0 {
+			err = verifyBlock(ctx, txn, col, dsKey, nd, "", policy)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		var session sync.WaitGroup
 		bp := newBlockProcessor(s.peer, txn, col, dsKey, getter)
-		err = bp.processRemoteBlock(ctx, &session, nd, true)
-		if err != nil {
-			log.ErrorE(
-				ctx,
-				"Failed to process remote block",
-				err,
-				logging.NewKV("DocID", dsKey.DocID),
-				logging.NewKV("CID", cid),
-			)
+		// the state of the pushed block is already loaded if it is the version of the snapshot
+		isSnapshotVersion := false
+		if len(req.Body.SnapshotCid) > 0 {
+			// the pushed block is stored first so that it is not fetched if it is the version of
+			// the snapshot
+			if err := txn.DAGstore().Put(ctx, nd); err != nil {
+				return nil, err
+			}
+			version, err := bp.loadSnapshot(ctx, req.Body.SnapshotCid, req.Body.Snapshot)
+			if err != nil {
+				return nil, err
+			}
+			isSnapshotVersion = version == cid
+		}
+		if !isSnapshotVersion {
+			err = bp.processRemoteBlock(ctx, &session, nd, true)
+			if err != nil {
+				log.ErrorE(
+					ctx,
+					"Failed to process remote block",
+					err,
+					logging.NewKV("DocID", dsKey.DocID),
+					logging.NewKV("CID", cid),
+				)
+			}
+			session.Wait()
+			bp.mergeBlocks(ctx)
 		}
-		session.Wait()
-		bp.mergeBlocks(ctx)
 
 		// dagWorkers specific to the DocID will have been spawned within handleChildBlocks.
 		// Once we are done with the dag syncing process, we can get rid of those workers.
@@ -326,6 +380,124 @@ func (s *server) PushLog(ctx context.Context, req *pb.PushLogRequest) (*pb.PushL
 	return &pb.PushLogReply{}, client.NewErrMaxTxnRetries(txnErr)
 }
 
+// purgeDocument purges the given document from this node, as its purge has been advertised by
+// a peer with the given signature.
+//
+// The purge must be signed by the author of the document, the signer of its oldest commit known
+// to this node, or by a signer trusted by the signature policy. Purges of documents that do not
+// exist on this node are ignored, so that peers cannot prevent documents from being synced. As on
+// the peer, only deleted documents can be purged.
+func (s *server) purgeDocument(
+	ctx context.Context,
+	docID client.DocID,
+	schemaRoot string,
+	signature core.CommitSignature,
+) error {
+	signer, err := clock.VerifyPurge(docID.String(), signature)
+	if err != nil {
+		return err
+	}
+	policy := s.peer.signaturePolicy
+	isTrusted := slices.Contains(policy.TrustedSigners, signer.String())
+	if len(policy.TrustedSigners) > 0 && !isTrusted {
+		return NewErrUnauthorizedPurge(docID.String(), signer)
+	}
+
+	cols, err := s.db.GetCollectionsBySchemaRoot(ctx, schemaRoot)
+	if err != nil {
+		return errors.Wrap(fmt.Sprintf("Failed to get collection from schemaRoot %s", schemaRoot), err)
+	}
+	if len(cols) == 0 {
+		return client.NewErrCollectionNotFoundForSchema(schemaRoot)
+	}
+	col := cols[0]
+
+	txn, err := s.db.NewTxn(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer txn.Discard(ctx)
+
+	isPurged, err := base.IsDocumentPurged(ctx, txn.Systemstore(), docID.String())
+	if err != nil {
+		return err
+	}
+	if isPurged {
+		return nil
+	}
+
+	dsKey := core.DataStoreKeyFromDocID(docID)
+	author, hasCommits, err := documentAuthor(ctx, txn, col, dsKey)
+	if err != nil {
+		return err
+	}
+	if !hasCommits {
+		log.Debug(ctx, fmt.Sprintf("Document %s is unknown, ignoring purge.", docID))
+		return nil
+	}
+	if !isTrusted && (author == "" || author != signer) {
+		return NewErrUnauthorizedPurge(docID.String(), signer)
+	}
+
+	err = col.WithTxn(txn).Purge(ctx, docID)
+	if errors.Is(err, client.ErrDocumentNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return txn.Commit(ctx)
+}
+
+// documentAuthor returns the signer of the oldest commit of the given document known to this node,
+// which is empty if the commit is not signed, and whether any commit of the document is known.
+func documentAuthor(
+	ctx context.Context,
+	txn datastore.Txn,
+	col client.Collection,
+	dsKey core.DataStoreKey,
+) (libpeer.ID, bool, error) {
+	history, err := base.GetDocumentCommitHistory(ctx, txn.Systemstore(), dsKey.DocID)
+	if err != nil {
+		return "", false, err
+	}
+
+	var oldest format.Node
+	var oldestDelta core.Delta
+	for commit := range history {
+		block, err := txn.DAGstore().Get(ctx, commit)
+		if format.IsNotFound(err) {
+			// pruned commits are no longer part of the history
+			continue
+		}
+		if err != nil {
+			return "", false, err
+		}
+		nd, err := dag.DecodeProtobufBlock(block)
+		if err != nil {
+			return "", false, err
+		}
+		crdt, err := initCRDTForType(ctx, txn, col, dsKey, "")
+		if err != nil {
+			return "", false, err
+		}
+		delta, err := crdt.DeltaDecode(nd)
+		if err != nil {
+			return "", false, errors.Wrap("failed to decode delta object", err)
+		}
+		if oldestDelta == nil || delta.GetPriority() < oldestDelta.GetPriority() {
+			oldest = nd
+			oldestDelta = delta
+		}
+	}
+	if oldest == nil {
+		return "", false, nil
+	}
+
+	author, err := clock.NodeSigner(oldest, oldestDelta)
+	return author, true, err
+}
+
 // GetHeadLog receives a get head log request
 func (s *server) GetHeadLog(
 	ctx context.Context,
@@ -432,6 +604,11 @@ func (s *server) publishLog(ctx context.Context, topic string, req *pb.PushLogRe
 		return errors.Wrap(fmt.Sprintf("failed publishing to thread %s", topic), err)
 	}
 
+	if req.Body.Purged {
+		log.Debug(ctx, "Published purge", logging.NewKV("DocID", string(req.Body.DocID)))
+		return nil
+	}
+
 	cid, err := cid.Cast(req.Body.Cid)
 	if err != nil {
 		return err
@@ -12,18 +12,27 @@ package net
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/fxamacker/cbor/v2"
+	"github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/event"
 	"github.com/libp2p/go-libp2p/core/host"
+	libpeer "github.com/libp2p/go-libp2p/core/peer"
 	rpc "github.com/sourcenetwork/go-libp2p-pubsub-rpc"
 	"github.com/stretchr/testify/require"
 	grpcpeer "google.golang.org/grpc/peer"
 
 	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
 	"github.com/sourcenetwork/defradb/datastore/memory"
+	"github.com/sourcenetwork/defradb/db"
+	"github.com/sourcenetwork/defradb/db/base"
 	"github.com/sourcenetwork/defradb/errors"
+	"github.com/sourcenetwork/defradb/merkle/clock"
 	net_pb "github.com/sourcenetwork/defradb/net/pb"
 )
 
@@ -95,6 +104,12 @@ type mockCollection struct {
 	client.Collection
 }
 
+func (mCol *mockCollection) Name() string {
+	return "mockCol"
+}
+func (mCol *mockCollection) Schema() client.SchemaDescription {
+	return client.SchemaDescription{}
+}
 func (mCol *mockCollection) SchemaRoot() string {
 	return "mockColID"
 }
@@ -285,3 +300,487 @@ func TestPushLog(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+const pushLogTestSchema = `type User {
+	name: String
+	age: Int
+}`
+
+const pushLogTestDoc = `{"name": "John", "age": 30}`
+
+// pushLogFromSourceDB creates a document on a separate database and pushes its composite
+// block to the given node.
+func pushLogFromSourceDB(ctx context.Context, t *testing.T, n *Node, options ...db.Option) error {
+	sourceDB := newPushLogSourceDB(ctx, t, n, pushLogTestSchema, options...)
+	defer sourceDB.Close()
+
+	doc := createPushLogTestDoc(ctx, t, sourceDB)
+	return pushDocumentLog(ctx, t, n, sourceDB, doc.ID())
+}
+
+// newPushLogSourceDB creates a separate database and adds the given schema to it and to the
+// given node.
+func newPushLogSourceDB(
+	ctx context.Context,
+	t *testing.T,
+	n *Node,
+	schema string,
+	options ...db.Option,
+) client.DB {
+	sourceDB, err := db.NewDB(ctx, memory.NewDatastore(ctx), options...)
+	require.NoError(t, err)
+
+	_, err = sourceDB.AddSchema(ctx, schema)
+	require.NoError(t, err)
+	_, err = n.db.AddSchema(ctx, schema)
+	require.NoError(t, err)
+	return sourceDB
+}
+
+func createPushLogTestDoc(ctx context.Context, t *testing.T, sourceDB client.DB) *client.Document {
+	col, err := sourceDB.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+	doc, err := client.NewDocFromJSON([]byte(pushLogTestDoc), col.Schema())
+	require.NoError(t, err)
+	err = col.Create(ctx, doc)
+	require.NoError(t, err)
+	return doc
+}
+
+// pushDocumentLog pushes the composite head block of the given document of the source database
+// to the given node, along with the snapshot of the document if its history has been pruned.
+func pushDocumentLog(
+	ctx context.Context,
+	t *testing.T,
+	n *Node,
+	sourceDB client.DB,
+	docID client.DocID,
+) error {
+	return pushModifiedDocumentLog(ctx, t, n, sourceDB, docID, nil)
+}
+
+// pushModifiedDocumentLog pushes the log of the given document like [pushDocumentLog], after
+// modifying the pushed snapshot with the given function if any.
+func pushModifiedDocumentLog(
+	ctx context.Context,
+	t *testing.T,
+	n *Node,
+	sourceDB client.DB,
+	docID client.DocID,
+	modify func(*base.DocumentSnapshot),
+) error {
+	col, err := sourceDB.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+
+	result := sourceDB.ExecRequest(ctx, fmt.Sprintf(`query {
+		latestCommits(docID: "%s", fieldId: "C") {
+			cid
+		}
+	}`, docID))
+	require.Empty(t, result.GQL.Errors)
+	commits := result.GQL.Data.([]map[string]any)
+	require.Len(t, commits, 1)
+	headCid, err := cid.Decode(commits[0]["cid"].(string))
+	require.NoError(t, err)
+	block, err := sourceDB.Blockstore().Get(ctx, headCid)
+	require.NoError(t, err)
+
+	// the field blocks are stored on the node so that the pushed block can be merged without
+	// fetching them from the network
+	nd, err := decodeBlockBuffer(block.RawData(), headCid)
+	require.NoError(t, err)
+	for _, link := range nd.Links() {
+		if link.Name == core.HEAD {
+			continue
+		}
+		fieldBlock, err := sourceDB.Blockstore().Get(ctx, link.Cid)
+		require.NoError(t, err)
+		err = n.db.Blockstore().Put(ctx, fieldBlock)
+		require.NoError(t, err)
+	}
+
+	body := &net_pb.PushLogRequest_Body{
+		DocID:      []byte(docID.String()),
+		Cid:        headCid.Bytes(),
+		SchemaRoot: []byte(col.SchemaRoot()),
+		Creator:    n.PeerID().String(),
+		Log: &net_pb.Document_Log{
+			Block: block.RawData(),
+		},
+	}
+	source := &Peer{db: sourceDB}
+	err = source.setDocumentSnapshot(ctx, body)
+	require.NoError(t, err)
+	if len(body.Snapshot) > 0 {
+		// the other head blocks of the snapshot are stored on the node as well
+		var snapshot base.DocumentSnapshot
+		err = cbor.Unmarshal(body.Snapshot, &snapshot)
+		require.NoError(t, err)
+		for key := range snapshot.Heads {
+			headKey, err := core.NewHeadStoreKey(key)
+			require.NoError(t, err)
+			if headKey.Cid == headCid {
+				continue
+			}
+			headBlock, err := sourceDB.Blockstore().Get(ctx, headKey.Cid)
+			require.NoError(t, err)
+			err = n.db.Blockstore().Put(ctx, headBlock)
+			require.NoError(t, err)
+		}
+		if modify != nil {
+			modify(&snapshot)
+			body.Snapshot, err = cbor.Marshal(snapshot)
+			require.NoError(t, err)
+		}
+	}
+
+	ctx = grpcpeer.NewContext(ctx, &grpcpeer.Peer{
+		Addr: addr{n.PeerID()},
+	})
+	_, err = n.server.PushLog(ctx, &net_pb.PushLogRequest{Body: body})
+	if err != nil {
+		// a rejected block must not be stored
+		exists, hasErr := n.db.Blockstore().Has(ctx, headCid)
+		require.NoError(t, hasErr)
+		require.False(t, exists)
+	}
+	return err
+}
+
+func TestPushLog_WithRequiredSignatureAndUnsignedBlock_Error(t *testing.T) {
+	ctx := context.Background()
+	_, n := newTestNode(ctx, t, WithSignaturePolicy(core.SignaturePolicy{RequireSignature: true}))
+
+	err := pushLogFromSourceDB(ctx, t, n)
+	require.ErrorIs(t, err, clock.ErrUnsignedCommit)
+}
+
+func TestPushLog_WithUntrustedSigner_Error(t *testing.T) {
+	ctx := context.Background()
+	trustedKey, _, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+	require.NoError(t, err)
+	trustedSigner, err := libpeer.IDFromPrivateKey(trustedKey)
+	require.NoError(t, err)
+	_, n := newTestNode(
+		ctx,
+		t,
+		WithSignaturePolicy(core.SignaturePolicy{TrustedSigners: []string{trustedSigner.String()}}),
+	)
+
+	key, _, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+	require.NoError(t, err)
+	err = pushLogFromSourceDB(ctx, t, n, db.WithCommitSigningKey(key))
+	require.ErrorIs(t, err, clock.ErrUntrustedCommitSigner)
+}
+
+func TestPushLog_WithPurgedDocument_IsIgnored(t *testing.T) {
+	ctx := context.Background()
+	_, n := newTestNode(ctx, t)
+	err := n.Start()
+	require.NoError(t, err)
+
+	sourceDB := newPushLogSourceDB(ctx, t, n, pushLogTestSchema)
+	defer sourceDB.Close()
+	doc := createPushLogTestDoc(ctx, t, sourceDB)
+	err = pushDocumentLog(ctx, t, n, sourceDB, doc.ID())
+	require.NoError(t, err)
+
+	col, err := n.db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+	_, err = col.Delete(ctx, doc.ID())
+	require.NoError(t, err)
+	err = col.Purge(ctx, doc.ID())
+	require.NoError(t, err)
+
+	err = pushDocumentLog(ctx, t, n, sourceDB, doc.ID())
+	require.NoError(t, err)
+
+	_, err = col.Get(ctx, doc.ID(), true)
+	require.ErrorIs(t, err, client.ErrDocumentNotFound)
+}
+
+// pushPurge pushes the purge of the given document to the given node, signed with the given key
+// if any.
+func pushPurge(
+	ctx context.Context,
+	t *testing.T,
+	n *Node,
+	docID client.DocID,
+	schemaRoot string,
+	key crypto.PrivKey,
+) error {
+	body := &net_pb.PushLogRequest_Body{
+		DocID:      []byte(docID.String()),
+		SchemaRoot: []byte(schemaRoot),
+		Creator:    n.PeerID().String(),
+		Purged:     true,
+	}
+	if key != nil {
+		signature, err := clock.SignPurge(key, docID.String())
+		require.NoError(t, err)
+		body.PurgeSignature = signature.Signature
+		body.PurgePublicKey = signature.PublicKey
+	}
+
+	ctx = grpcpeer.NewContext(ctx, &grpcpeer.Peer{
+		Addr: addr{n.PeerID()},
+	})
+	_, err := n.server.PushLog(ctx, &net_pb.PushLogRequest{Body: body})
+	return err
+}
+
+func TestPushLog_WithPurgeOfDeletedDocument_PurgesDocument(t *testing.T) {
+	ctx := context.Background()
+	_, n := newTestNode(ctx, t)
+	err := n.Start()
+	require.NoError(t, err)
+
+	key, _, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+	require.NoError(t, err)
+	sourceDB := newPushLogSourceDB(ctx, t, n, pushLogTestSchema, db.WithCommitSigningKey(key))
+	defer sourceDB.Close()
+	doc := createPushLogTestDoc(ctx, t, sourceDB)
+	err = pushDocumentLog(ctx, t, n, sourceDB, doc.ID())
+	require.NoError(t, err)
+
+	col, err := n.db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+	_, err = col.Delete(ctx, doc.ID())
+	require.NoError(t, err)
+
+	err = pushPurge(ctx, t, n, doc.ID(), col.SchemaRoot(), key)
+	require.NoError(t, err)
+
+	_, err = col.Get(ctx, doc.ID(), true)
+	require.ErrorIs(t, err, client.ErrDocumentNotFound)
+
+	// the document is not restored by the blocks of peers that have not purged it yet
+	err = pushDocumentLog(ctx, t, n, sourceDB, doc.ID())
+	require.NoError(t, err)
+	_, err = col.Get(ctx, doc.ID(), true)
+	require.ErrorIs(t, err, client.ErrDocumentNotFound)
+}
+
+func TestPushLog_WithUnsignedPurge_Error(t *testing.T) {
+	ctx := context.Background()
+	_, n := newTestNode(ctx, t)
+	err := n.Start()
+	require.NoError(t, err)
+
+	sourceDB := newPushLogSourceDB(ctx, t, n, pushLogTestSchema)
+	defer sourceDB.Close()
+	doc := createPushLogTestDoc(ctx, t, sourceDB)
+	err = pushDocumentLog(ctx, t, n, sourceDB, doc.ID())
+	require.NoError(t, err)
+
+	col, err := n.db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+	_, err = col.Delete(ctx, doc.ID())
+	require.NoError(t, err)
+
+	err = pushPurge(ctx, t, n, doc.ID(), col.SchemaRoot(), nil)
+	require.ErrorIs(t, err, clock.ErrUnsignedPurge)
+
+	_, err = col.Get(ctx, doc.ID(), true)
+	require.NoError(t, err)
+}
+
+func TestPushLog_WithPurgeSignedByOtherThanAuthor_Error(t *testing.T) {
+	ctx := context.Background()
+	_, n := newTestNode(ctx, t)
+	err := n.Start()
+	require.NoError(t, err)
+
+	authorKey, _, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+	require.NoError(t, err)
+	sourceDB := newPushLogSourceDB(ctx, t, n, pushLogTestSchema, db.WithCommitSigningKey(authorKey))
+	defer sourceDB.Close()
+	doc := createPushLogTestDoc(ctx, t, sourceDB)
+	err = pushDocumentLog(ctx, t, n, sourceDB, doc.ID())
+	require.NoError(t, err)
+
+	col, err := n.db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+	_, err = col.Delete(ctx, doc.ID())
+	require.NoError(t, err)
+
+	key, _, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+	require.NoError(t, err)
+	err = pushPurge(ctx, t, n, doc.ID(), col.SchemaRoot(), key)
+	require.ErrorIs(t, err, ErrUnauthorizedPurge)
+
+	_, err = col.Get(ctx, doc.ID(), true)
+	require.NoError(t, err)
+}
+
+func TestPushLog_WithPurgeSignedByTrustedSigner_PurgesDocument(t *testing.T) {
+	ctx := context.Background()
+	key, _, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+	require.NoError(t, err)
+	signer, err := libpeer.IDFromPrivateKey(key)
+	require.NoError(t, err)
+	_, n := newTestNode(
+		ctx,
+		t,
+		WithSignaturePolicy(core.SignaturePolicy{TrustedSigners: []string{signer.String()}}),
+	)
+	err = n.Start()
+	require.NoError(t, err)
+
+	sourceDB := newPushLogSourceDB(ctx, t, n, pushLogTestSchema, db.WithCommitSigningKey(key))
+	defer sourceDB.Close()
+	doc := createPushLogTestDoc(ctx, t, sourceDB)
+	err = pushDocumentLog(ctx, t, n, sourceDB, doc.ID())
+	require.NoError(t, err)
+
+	col, err := n.db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+	_, err = col.Delete(ctx, doc.ID())
+	require.NoError(t, err)
+
+	err = pushPurge(ctx, t, n, doc.ID(), col.SchemaRoot(), key)
+	require.NoError(t, err)
+
+	_, err = col.Get(ctx, doc.ID(), true)
+	require.ErrorIs(t, err, client.ErrDocumentNotFound)
+}
+
+func TestPushLog_WithPurgeOfUnknownDocument_IsIgnored(t *testing.T) {
+	ctx := context.Background()
+	_, n := newTestNode(ctx, t)
+	err := n.Start()
+	require.NoError(t, err)
+
+	key, _, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+	require.NoError(t, err)
+	sourceDB := newPushLogSourceDB(ctx, t, n, pushLogTestSchema, db.WithCommitSigningKey(key))
+	defer sourceDB.Close()
+	doc := createPushLogTestDoc(ctx, t, sourceDB)
+	col, err := n.db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+
+	err = pushPurge(ctx, t, n, doc.ID(), col.SchemaRoot(), key)
+	require.NoError(t, err)
+
+	// the document is not marked as purged, so it is synced once its blocks are received
+	err = pushDocumentLog(ctx, t, n, sourceDB, doc.ID())
+	require.NoError(t, err)
+	_, err = col.Get(ctx, doc.ID(), false)
+	require.NoError(t, err)
+}
+
+func TestPushLog_WithPrunedHistory_LoadsSnapshot(t *testing.T) {
+	ctx := context.Background()
+	_, n := newTestNode(ctx, t)
+	err := n.Start()
+	require.NoError(t, err)
+
+	sourceDB := newPushLogSourceDB(ctx, t, n, `type User @retention(versions: 1) {
+		name: String
+		age: Int
+	}`)
+	defer sourceDB.Close()
+	doc := createPushLogTestDoc(ctx, t, sourceDB)
+	sourceCol, err := sourceDB.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+	err = doc.Set("age", 31)
+	require.NoError(t, err)
+	err = sourceCol.Update(ctx, doc)
+	require.NoError(t, err)
+	err = sourceCol.CompactHistory(ctx)
+	require.NoError(t, err)
+
+	err = pushDocumentLog(ctx, t, n, sourceDB, doc.ID())
+	require.NoError(t, err)
+
+	col, err := n.db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+	pushedDoc, err := col.Get(ctx, doc.ID(), false)
+	require.NoError(t, err)
+	// the name has been set by the create commit, which has been pruned by the source
+	name, err := pushedDoc.Get("name")
+	require.NoError(t, err)
+	require.Equal(t, "John", name)
+	age, err := pushedDoc.Get("age")
+	require.NoError(t, err)
+	require.Equal(t, int64(31), age)
+
+	// later blocks are merged on top of the snapshot
+	err = doc.Set("name", "Johnny")
+	require.NoError(t, err)
+	err = sourceCol.Update(ctx, doc)
+	require.NoError(t, err)
+	err = pushDocumentLog(ctx, t, n, sourceDB, doc.ID())
+	require.NoError(t, err)
+
+	pushedDoc, err = col.Get(ctx, doc.ID(), false)
+	require.NoError(t, err)
+	name, err = pushedDoc.Get("name")
+	require.NoError(t, err)
+	require.Equal(t, "Johnny", name)
+	age, err = pushedDoc.Get("age")
+	require.NoError(t, err)
+	require.Equal(t, int64(31), age)
+}
+
+func TestPushLog_WithSnapshotNotMatchingHeads_Error(t *testing.T) {
+	ctx := context.Background()
+	_, n := newTestNode(ctx, t)
+	err := n.Start()
+	require.NoError(t, err)
+
+	sourceDB := newPushLogSourceDB(ctx, t, n, `type User @retention(versions: 1) {
+		name: String
+		age: Int
+	}`)
+	defer sourceDB.Close()
+	doc := createPushLogTestDoc(ctx, t, sourceDB)
+	sourceCol, err := sourceDB.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+	err = doc.Set("age", 31)
+	require.NoError(t, err)
+	err = sourceCol.Update(ctx, doc)
+	require.NoError(t, err)
+	err = sourceCol.CompactHistory(ctx)
+	require.NoError(t, err)
+
+	nameField, ok := sourceCol.Schema().GetField("name")
+	require.True(t, ok)
+	err = pushModifiedDocumentLog(ctx, t, n, sourceDB, doc.ID(), func(snapshot *base.DocumentSnapshot) {
+		for key := range snapshot.Data {
+			dsKey, err := core.NewDataStoreKey(key)
+			require.NoError(t, err)
+			if dsKey.InstanceType == core.ValueKey && dsKey.FieldId == nameField.ID.String() {
+				snapshot.Data[key], err = cbor.Marshal("Mallory")
+				require.NoError(t, err)
+			}
+		}
+	})
+	require.ErrorIs(t, err, ErrSnapshotBlockMismatch)
+
+	col, err := n.db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+	_, err = col.Get(ctx, doc.ID(), true)
+	require.ErrorIs(t, err, client.ErrDocumentNotFound)
+}
+
+func TestPushLog_WithNewDocument_RecordsCreateChange(t *testing.T) {
+	ctx := context.Background()
+	_, n := newTestNode(ctx, t)
+	err := n.Start()
+	require.NoError(t, err)
+
+	err = pushLogFromSourceDB(ctx, t, n)
+	require.NoError(t, err)
+
+	col, err := n.db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+	doc, err := client.NewDocFromJSON([]byte(pushLogTestDoc), col.Schema())
+	require.NoError(t, err)
+
+	changes, err := col.Changes(ctx, "", 0)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.Equal(t, doc.ID().String(), changes[0].DocID)
+	require.Equal(t, client.ChangeTypeCreate, changes[0].Type)
+}
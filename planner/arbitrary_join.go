@@ -112,7 +112,7 @@ func (n *dataSource) Source() planNode {
 }
 
 func (source *dataSource) mergeParent(
-	keyFields []mapper.Field,
+	keyFields []mapper.GroupByField,
 	destination *orderedMap,
 	childIndexes []int,
 ) (bool, error) {
@@ -141,7 +141,7 @@ func (source *dataSource) mergeParent(
 }
 
 func (source *dataSource) appendChild(
-	keyFields []mapper.Field,
+	keyFields []mapper.GroupByField,
 	valuesByKey *orderedMap,
 	mapping *core.DocumentMapping,
 ) (bool, error) {
@@ -175,7 +175,7 @@ func (source *dataSource) appendChild(
 
 func join(
 	sources []*dataSource,
-	keyFields []mapper.Field,
+	keyFields []mapper.GroupByField,
 	mapping *core.DocumentMapping,
 ) (*orderedMap, error) {
 	result := orderedMap{
@@ -213,11 +213,11 @@ func join(
 	return &result, nil
 }
 
-func generateKey(doc core.Doc, keyFields []mapper.Field) string {
+func generateKey(doc core.Doc, keyFields []mapper.GroupByField) string {
 	keyBuilder := strings.Builder{}
 	for _, keyField := range keyFields {
 		keyBuilder.WriteString(fmt.Sprint(keyField.Index))
-		keyBuilder.WriteString(fmt.Sprintf("_%v_", doc.Fields[keyField.Index]))
+		keyBuilder.WriteString(fmt.Sprintf("_%v_", groupKeyValue(doc, keyField)))
 	}
 	return keyBuilder.String()
 }
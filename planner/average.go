@@ -11,6 +11,10 @@
 package planner
 
 import (
+	"math/big"
+
+	"github.com/shopspring/decimal"
+
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
 	"github.com/sourcenetwork/defradb/core"
@@ -98,6 +102,10 @@ func (n *averageNode) Next() (bool, error) {
 		n.currentValue.Fields[n.virtualFieldIndex] = sum / float64(count)
 	case int64:
 		n.currentValue.Fields[n.virtualFieldIndex] = float64(sum) / float64(count)
+	case *big.Int:
+		n.currentValue.Fields[n.virtualFieldIndex] = decimal.NewFromBigInt(sum, 0).Div(decimal.NewFromInt(int64(count)))
+	case decimal.Decimal:
+		n.currentValue.Fields[n.virtualFieldIndex] = sum.Div(decimal.NewFromInt(int64(count)))
 	default:
 		return false, client.NewErrUnhandledType("sum", sumProp)
 	}
@@ -11,11 +11,16 @@
 package planner
 
 import (
+	"encoding/hex"
+	"time"
+
 	"github.com/fxamacker/cbor/v2"
 	dag "github.com/ipfs/boxo/ipld/merkledag"
 	blocks "github.com/ipfs/go-block-format"
 	cid "github.com/ipfs/go-cid"
 	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
@@ -212,6 +217,11 @@ func (n *dagScanNode) Next() (bool, error) {
 	// use the stored cid to scan through the blockstore
 	// clear the cid after
 	block, err := store.Get(n.planner.ctx, *currentCid)
+	if ipld.IsNotFound(err) {
+		// the history preceding this point has been pruned by history compaction
+		n.visitedNodes[currentCid.String()] = true
+		return n.Next()
+	}
 	if err != nil {
 		return false, err
 	}
@@ -372,6 +382,35 @@ func (n *dagScanNode) dagBlockToNodeDoc(block blocks.Block) (core.Doc, []*ipld.L
 	n.commitSelect.DocumentMapping.SetFirstOfName(&commit,
 		request.CollectionIDFieldName, int64(cols[0].ID()))
 
+	// the timestamp and author are optional, and only present if they were recorded
+	var timestamp any
+	switch t := delta[request.DeltaArgTimestamp].(type) {
+	case uint64:
+		timestamp = time.Unix(0, int64(t)).UTC()
+	case int64:
+		timestamp = time.Unix(0, t).UTC()
+	}
+	n.commitSelect.DocumentMapping.SetFirstOfName(&commit, request.TimestampFieldName, timestamp)
+
+	var author any
+	if a, ok := delta[request.DeltaArgAuthor].(string); ok {
+		author = a
+	}
+	n.commitSelect.DocumentMapping.SetFirstOfName(&commit, request.AuthorFieldName, author)
+
+	// the signature is optional, and only present if the commit was signed
+	var signature any
+	var signer any
+	if sig, ok := delta[request.DeltaArgSignature].([]byte); ok && len(sig) > 0 {
+		signature = hex.EncodeToString(sig)
+		signer, err = signerFromPublicKey(delta[request.DeltaArgPublicKey])
+		if err != nil {
+			return core.Doc{}, nil, err
+		}
+	}
+	n.commitSelect.DocumentMapping.SetFirstOfName(&commit, request.SignatureFieldName, signature)
+	n.commitSelect.DocumentMapping.SetFirstOfName(&commit, request.SignerFieldName, signer)
+
 	heads := make([]*ipld.Link, 0)
 
 	// links
@@ -402,3 +441,20 @@ func (n *dagScanNode) dagBlockToNodeDoc(block blocks.Block) (core.Doc, []*ipld.L
 }
 
 func (n *dagScanNode) Append() bool { return true }
+
+// signerFromPublicKey returns the peer ID of the given marshalled public key.
+func signerFromPublicKey(publicKey any) (string, error) {
+	keyBytes, ok := publicKey.([]byte)
+	if !ok {
+		return "", client.NewErrUnexpectedType[[]byte](request.DeltaArgPublicKey, publicKey)
+	}
+	key, err := crypto.UnmarshalPublicKey(keyBytes)
+	if err != nil {
+		return "", err
+	}
+	signer, err := peer.IDFromPublicKey(key)
+	if err != nil {
+		return "", err
+	}
+	return signer.String(), nil
+}
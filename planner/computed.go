@@ -0,0 +1,430 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"fmt"
+	"math/big"
+
+	gocid "github.com/ipfs/go-cid"
+	"github.com/shopspring/decimal"
+	"github.com/sourcenetwork/immutable"
+	"golang.org/x/exp/slices"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/connor/numbers"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/core/computed"
+	"github.com/sourcenetwork/defradb/db/base"
+	"github.com/sourcenetwork/defradb/db/fetcher"
+	"github.com/sourcenetwork/defradb/planner/filter"
+	"github.com/sourcenetwork/defradb/planner/mapper"
+)
+
+// computedField is a field whose value is computed from the other fields of the document,
+// or of its related documents, after the document has been fetched.
+type computedField struct {
+	index      int
+	desc       client.FieldDescription
+	expression *computed.Expression
+}
+
+// tryAddComputedField ensures that the value of the given computed field will be set on the
+// documents yielded by this scan, and that the fields that it is computed from are fetched.
+func (n *scanNode) tryAddComputedField(fd client.FieldDescription) error {
+	for _, existing := range n.computedFields {
+		if existing.desc.Name == fd.Name {
+			return nil
+		}
+	}
+
+	expression, err := computed.Parse(fd.Computed)
+	if err != nil {
+		return err
+	}
+
+	n.computedFields = append(n.computedFields, computedField{
+		index:      n.documentMapping.FirstIndexOfName(fd.Name),
+		desc:       fd,
+		expression: expression,
+	})
+
+	for _, path := range expression.Paths() {
+		fieldName := path[0]
+		if len(path) > 1 {
+			fieldName += request.RelatedObjectID
+		}
+		_, err := n.tryAddField(fieldName)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitComputedFilter moves any conditions that reference computed fields out of the filter
+// given to the fetcher, so that they may be evaluated once the fields have been computed.
+func (n *scanNode) splitComputedFilter() error {
+	computedFields := []mapper.Field{}
+	for _, fd := range n.col.Schema().Fields {
+		if fd.IsComputed() {
+			computedFields = append(computedFields, mapper.Field{
+				Index: n.documentMapping.FirstIndexOfName(fd.Name),
+				Name:  fd.Name,
+			})
+		}
+	}
+
+	n.filter, n.computedFilter = filter.SplitByFields(n.filter, computedFields...)
+	if n.computedFilter == nil {
+		return nil
+	}
+
+	for _, fd := range n.col.Schema().Fields {
+		if fd.IsComputed() {
+			err := n.tryAddComputedField(fd)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// computedBatchSize is the number of documents fetched ahead by a scan whose computed fields
+// are computed from related documents, so that their related documents may be loaded together.
+const computedBatchSize = 100
+
+// computedRelation is a relation whose related documents computed fields are computed from.
+type computedRelation struct {
+	// plan selects the related documents, with the fields that computed fields reference.
+	plan planNode
+	// col is the collection of the related documents.
+	col client.Collection
+	// relatedIDIndex is the index of the related document id in the documents of the scan.
+	relatedIDIndex int
+	// docs holds the related documents of the current batch, by document ID.
+	docs map[string]core.Doc
+}
+
+// initComputedRelations creates the plans selecting the related documents that the computed
+// fields of the scan are computed from.
+//
+// The related documents are selected as they were at the given time, or if a version of the
+// document is requested, at the time at which this node applied that version. Otherwise, as
+// for joined documents, their current state is selected.
+func (n *scanNode) initComputedRelations(
+	cid immutable.Option[string],
+	asOf immutable.Option[request.AsOfTime],
+) error {
+	if n.computedRelations != nil {
+		return nil
+	}
+
+	relatedFields := map[string][]string{}
+	relationNames := []string{}
+	for _, field := range n.computedFields {
+		for _, path := range field.expression.Paths() {
+			if len(path) == 1 {
+				continue
+			}
+			if _, ok := relatedFields[path[0]]; !ok {
+				relationNames = append(relationNames, path[0])
+			}
+			if !slices.Contains(relatedFields[path[0]], path[1]) {
+				relatedFields[path[0]] = append(relatedFields[path[0]], path[1])
+			}
+		}
+	}
+	n.computedRelations = map[string]*computedRelation{}
+	if len(relationNames) == 0 {
+		return nil
+	}
+
+	if cid.HasValue() && n.slct.DocIDs.HasValue() && len(n.slct.DocIDs.Value()) > 0 {
+		version, err := gocid.Decode(cid.Value())
+		if err != nil {
+			return err
+		}
+		appliedAt, ok, err := base.GetCommitTime(n.p.ctx, n.p.txn.Systemstore(), n.slct.DocIDs.Value()[0], version)
+		if err != nil {
+			return err
+		}
+		if ok {
+			asOf = immutable.Some(request.AsOfTime{Time: appliedAt, Clock: request.AppliedClock})
+		}
+	}
+
+	for _, relationName := range relationNames {
+		relationDesc, ok := n.col.Schema().GetField(relationName)
+		if !ok {
+			return client.NewErrFieldNotExist(relationName)
+		}
+		relatedCol, err := n.p.getRelatedCollection(relationDesc)
+		if err != nil {
+			return err
+		}
+
+		fields := []request.Selection{}
+		for _, fieldName := range relatedFields[relationName] {
+			fd, ok := relatedCol.Schema().GetField(fieldName)
+			if !ok || fd.IsComputed() {
+				// The related document holds no value for the field.
+				continue
+			}
+			fields = append(fields, &request.Field{Name: fieldName})
+		}
+
+		relatedSelect, err := mapper.ToSelect(n.p.ctx, n.p.db, &request.Select{
+			Field: request.Field{
+				Name: relatedCol.Name(),
+			},
+			Fields: fields,
+			AsOf:   asOf,
+		})
+		if err != nil {
+			return err
+		}
+		plan, err := n.p.Select(relatedSelect)
+		if err != nil {
+			return err
+		}
+		err = n.p.expandPlan(plan, nil)
+		if err != nil {
+			return err
+		}
+
+		n.computedRelations[relationName] = &computedRelation{
+			plan:           plan,
+			col:            relatedCol,
+			relatedIDIndex: n.documentMapping.FirstIndexOfName(relationName + request.RelatedObjectID),
+			docs:           map[string]core.Doc{},
+		}
+	}
+	return nil
+}
+
+// fetchNext sets the next fetched document, with its computed fields set, as the current value.
+//
+// Returns false if there are no more documents.
+func (n *scanNode) fetchNext() (bool, error) {
+	if len(n.computedBatch) == 0 {
+		err := n.fetchComputedBatch()
+		if err != nil {
+			return false, err
+		}
+		if len(n.computedBatch) == 0 {
+			return false, nil
+		}
+	}
+
+	n.currentValue = n.computedBatch[0]
+	n.computedBatch = n.computedBatch[1:]
+	return true, nil
+}
+
+// fetchComputedBatch fetches the next documents and sets their computed fields.
+//
+// If computed fields are computed from related documents, up to [computedBatchSize] documents
+// are fetched, and their related documents are loaded together, once per relation.
+func (n *scanNode) fetchComputedBatch() error {
+	batchSize := 1
+	if len(n.computedRelations) > 0 {
+		batchSize = computedBatchSize
+	}
+
+	n.computedBatch = n.computedBatch[:0]
+	for len(n.computedBatch) < batchSize {
+		encodedDoc, execInfo, err := n.fetcher.FetchNext(n.p.ctx)
+		if err != nil {
+			return err
+		}
+		n.execInfo.fetches.Add(execInfo)
+
+		if encodedDoc == nil {
+			break
+		}
+
+		doc, err := fetcher.DecodeToDoc(encodedDoc, n.documentMapping, false)
+		if err != nil {
+			return err
+		}
+		n.computedBatch = append(n.computedBatch, doc)
+	}
+
+	for _, relation := range n.computedRelations {
+		err := relation.load(n.computedBatch)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i := range n.computedBatch {
+		err := n.setComputedFields(&n.computedBatch[i])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// load loads the related documents of the given documents.
+func (r *computedRelation) load(docs []core.Doc) error {
+	r.docs = map[string]core.Doc{}
+
+	relatedIDs := []string{}
+	for _, doc := range docs {
+		relatedID, ok := doc.Fields[r.relatedIDIndex].(string)
+		if ok && relatedID != "" && !slices.Contains(relatedIDs, relatedID) {
+			relatedIDs = append(relatedIDs, relatedID)
+		}
+	}
+	if len(relatedIDs) == 0 {
+		return nil
+	}
+
+	// The spans must be in ascending order to be fetched in a single pass.
+	slices.Sort(relatedIDs)
+	spans := make([]core.Span, len(relatedIDs))
+	for i, relatedID := range relatedIDs {
+		dsKey := base.MakeDataStoreKeyWithCollectionAndDocID(r.col.Description(), relatedID)
+		spans[i] = core.NewSpan(dsKey, dsKey.PrefixEnd())
+	}
+
+	r.plan.Spans(core.NewSpans(spans...))
+	if err := r.plan.Init(); err != nil {
+		return NewErrSubTypeInit(err)
+	}
+	for {
+		hasNext, err := r.plan.Next()
+		if err != nil {
+			return err
+		}
+		if !hasNext {
+			return nil
+		}
+		relatedDoc := r.plan.Value()
+		r.docs[relatedDoc.GetID()] = relatedDoc
+	}
+}
+
+// setComputedFields sets the values of the computed fields on the given document.
+func (n *scanNode) setComputedFields(doc *core.Doc) error {
+	for _, field := range n.computedFields {
+		value, err := field.expression.Eval(func(path []string) (any, error) {
+			return n.resolveComputedPath(*doc, path), nil
+		})
+		if err != nil {
+			return err
+		}
+		doc.Fields[field.index] = toComputedFieldKind(value, field.desc.Kind)
+	}
+	return nil
+}
+
+// resolveComputedPath returns the value at the given path of the given document.
+//
+// Paths to related fields are resolved from the related documents loaded for the current batch,
+// and are nil if the related document does not exist, for example if it has been deleted or not
+// yet synced.
+func (n *scanNode) resolveComputedPath(doc core.Doc, path []string) any {
+	if len(path) == 1 {
+		return doc.Fields[n.documentMapping.FirstIndexOfName(path[0])]
+	}
+
+	relation, ok := n.computedRelations[path[0]]
+	if !ok {
+		return nil
+	}
+	relatedID, ok := doc.Fields[relation.relatedIDIndex].(string)
+	if !ok {
+		return nil
+	}
+	relatedDoc, ok := relation.docs[relatedID]
+	if !ok {
+		return nil
+	}
+	indexes, ok := relation.plan.DocumentMap().IndexesByName[path[1]]
+	if !ok || len(indexes) == 0 {
+		// The related document holds no value for the field.
+		return nil
+	}
+	return relatedDoc.Fields[indexes[0]]
+}
+
+// toComputedFieldKind converts the given computed value to the kind of its field.
+//
+// Values that cannot be converted are returned as nil.
+func toComputedFieldKind(value any, kind client.FieldKind) any {
+	if value == nil {
+		return nil
+	}
+
+	switch kind {
+	case client.FieldKind_INT:
+		switch v := value.(type) {
+		case int64:
+			return v
+		case float64:
+			return int64(v)
+		case *big.Int:
+			if v.IsInt64() {
+				return v.Int64()
+			}
+		case decimal.Decimal:
+			if v.IsInteger() && v.BigInt().IsInt64() {
+				return v.IntPart()
+			}
+		}
+		return nil
+
+	case client.FieldKind_FLOAT:
+		switch v := value.(type) {
+		case int64:
+			return float64(v)
+		case float64:
+			return v
+		case *big.Int, decimal.Decimal:
+			d, _ := numbers.ToDecimal(v)
+			return d.InexactFloat64()
+		}
+		return nil
+
+	case client.FieldKind_BIGINT:
+		switch v := value.(type) {
+		case int64:
+			return big.NewInt(v)
+		case *big.Int:
+			return v
+		case float64, decimal.Decimal:
+			// Fractional values are truncated, as they are when converted to Int.
+			d, _ := numbers.ToDecimal(v)
+			return d.BigInt()
+		}
+		return nil
+
+	case client.FieldKind_DECIMAL:
+		if d, ok := numbers.ToDecimal(value); ok {
+			return d
+		}
+		return nil
+
+	case client.FieldKind_STRING:
+		if v, ok := value.(string); ok {
+			return v
+		}
+		return fmt.Sprint(value)
+
+	default:
+		return value
+	}
+}
@@ -96,6 +96,11 @@ func (n *countNode) simpleExplain() (map[string]any, error) {
 		// Add the main field name.
 		simpleExplainMap[fieldNameLabel] = source.Field.Name
 
+		// Add the distinct field name if it exists.
+		if source.DistinctTarget.HasValue {
+			simpleExplainMap[distinctLabel] = source.DistinctTarget.Name
+		}
+
 		sourceExplanations[i] = simpleExplainMap
 	}
 
@@ -138,7 +143,9 @@ func (n *countNode) Next() (bool, error) {
 		switch v.Kind() {
 		// v.Len will panic if v is not one of these types, we don't want it to panic
 		case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice, reflect.String:
-			if source.Filter == nil && source.Limit == nil {
+			if docs, isDocs := property.([]core.Doc); isDocs && source.DistinctTarget.HasValue {
+				count += countDistinctDocs(docs, source.DistinctTarget.Index)
+			} else if source.Filter == nil && source.Limit == nil {
 				count = count + v.Len()
 			} else {
 				var arrayCount int
@@ -197,6 +204,21 @@ func countDocs(docs []core.Doc) int {
 	return count
 }
 
+// countDistinctDocs counts the number of distinct, non-nil, values of the given field within
+// a slice of documents, skipping over hidden items.
+func countDistinctDocs(docs []core.Doc, fieldIndex int) int {
+	seen := map[string]struct{}{}
+	for _, doc := range docs {
+		value := doc.Fields[fieldIndex]
+		if doc.Hidden || value == nil {
+			continue
+		}
+		seen[distinctValueKey(value)] = struct{}{}
+	}
+
+	return len(seen)
+}
+
 func countItems[T any](source []T, filter *mapper.Filter, limit *mapper.Limit) (int, error) {
 	items := enumerable.New(source)
 	if filter != nil {
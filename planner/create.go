@@ -39,8 +39,6 @@ type createNode struct {
 	input map[string]any
 	doc   *client.Document
 
-	err error
-
 	returned bool
 	results  planNode
 
@@ -56,31 +54,23 @@ func (n *createNode) Kind() string { return "createNode" }
 
 func (n *createNode) Init() error { return nil }
 
-func (n *createNode) Start() error {
-	doc, err := client.NewDocFromMap(n.input, n.collection.Schema())
-	if err != nil {
-		n.err = err
-		return err
-	}
-	n.doc = doc
-	return nil
-}
+func (n *createNode) Start() error { return nil }
 
 // Next only returns once.
 func (n *createNode) Next() (bool, error) {
 	n.execInfo.iterations++
 
-	if n.err != nil {
-		return false, n.err
-	}
-
 	if n.returned {
 		return false, nil
 	}
 
-	if err := n.collection.WithTxn(n.p.txn).Create(n.p.ctx, n.doc); err != nil {
+	// Any nested relation operations given in the input are executed in the same
+	// transaction as the creation of the document itself.
+	doc, err := n.p.createWithRelations(n.collection, n.input)
+	if err != nil {
 		return false, err
 	}
+	n.doc = doc
 
 	currentValue := n.documentMapping.NewDoc()
 
@@ -102,7 +92,7 @@ func (n *createNode) Next() (bool, error) {
 	docID := base.MakeDataStoreKeyWithCollectionAndDocID(desc, currentValue.GetID())
 	n.results.Spans(core.NewSpans(core.NewSpan(docID, docID.PrefixEnd())))
 
-	err := n.results.Init()
+	err = n.results.Init()
 	if err != nil {
 		return false, err
 	}
@@ -0,0 +1,161 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"encoding/json"
+
+	dag "github.com/ipfs/boxo/ipld/merkledag"
+	"github.com/ipfs/go-cid"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/core/crdt"
+	"github.com/sourcenetwork/defradb/planner/mapper"
+)
+
+// diffNode yields the field-level difference between two versions of a document.
+type diffNode struct {
+	documentIterator
+	docMapper
+
+	planner    *Planner
+	diffSelect *mapper.DiffSelect
+
+	returned bool
+}
+
+func (p *Planner) DiffSelect(diffSelect *mapper.DiffSelect) (planNode, error) {
+	diff := &diffNode{
+		planner:    p,
+		diffSelect: diffSelect,
+		docMapper:  docMapper{diffSelect.DocumentMapping},
+	}
+	return p.SelectFromSource(&diffSelect.Select, diff, false, nil)
+}
+
+func (n *diffNode) Kind() string {
+	return "diffNode"
+}
+
+func (n *diffNode) Init() error {
+	return nil
+}
+
+func (n *diffNode) Start() error {
+	return nil
+}
+
+func (n *diffNode) Spans(spans core.Spans) {}
+
+func (n *diffNode) Close() error {
+	return nil
+}
+
+func (n *diffNode) Source() planNode {
+	return nil
+}
+
+func (n *diffNode) Next() (bool, error) {
+	if n.returned {
+		return false, nil
+	}
+	n.returned = true
+
+	from, err := cid.Decode(n.diffSelect.From)
+	if err != nil {
+		return false, err
+	}
+	to, err := cid.Decode(n.diffSelect.To)
+	if err != nil {
+		return false, err
+	}
+	col, err := n.getCollection(to)
+	if err != nil {
+		return false, err
+	}
+	diff, err := col.WithTxn(n.planner.txn).Diff(n.planner.ctx, from, to)
+	if err != nil {
+		return false, err
+	}
+
+	mapping := n.diffSelect.DocumentMapping
+	doc := mapping.NewDoc()
+	mapping.SetFirstOfName(&doc, request.DocIDArgName, diff.DocID)
+	mapping.SetFirstOfName(&doc, request.DiffFromArgName, diff.From)
+	mapping.SetFirstOfName(&doc, request.DiffToArgName, diff.To)
+	mapping.SetFirstOfName(&doc, request.DiffCommitsFieldName, diff.Commits)
+
+	for _, fieldsIndex := range mapping.IndexesByName[request.DiffFieldsFieldName] {
+		fieldsMapping := mapping.ChildMappings[fieldsIndex]
+		fields := make([]core.Doc, len(diff.Fields))
+		for i, fieldDiff := range diff.Fields {
+			field := fieldsMapping.NewDoc()
+			fieldsMapping.SetFirstOfName(&field, request.DiffFieldNameFieldName, fieldDiff.Name)
+			if fieldDiff.Relation != "" {
+				fieldsMapping.SetFirstOfName(&field, request.DiffFieldRelationFieldName, fieldDiff.Relation)
+			}
+			before, err := encodeDiffValue(fieldDiff.Before)
+			if err != nil {
+				return false, err
+			}
+			fieldsMapping.SetFirstOfName(&field, request.DiffFieldBeforeFieldName, before)
+			after, err := encodeDiffValue(fieldDiff.After)
+			if err != nil {
+				return false, err
+			}
+			fieldsMapping.SetFirstOfName(&field, request.DiffFieldAfterFieldName, after)
+			fields[i] = field
+		}
+		doc.Fields[fieldsIndex] = fields
+	}
+
+	n.currentValue = doc
+	return true, nil
+}
+
+// getCollection returns the collection that the given document commit was made to.
+func (n *diffNode) getCollection(commit cid.Cid) (client.Collection, error) {
+	blk, err := n.planner.txn.DAGstore().Get(n.planner.ctx, commit)
+	if err != nil {
+		return nil, err
+	}
+	nd, err := dag.DecodeProtobuf(blk.RawData())
+	if err != nil {
+		return nil, err
+	}
+	delta := &crdt.CompositeDAGDelta{}
+	if err := delta.Unmarshal(nd.Data()); err != nil {
+		return nil, err
+	}
+
+	cols, err := n.planner.db.GetCollectionsByVersionID(n.planner.ctx, delta.SchemaVersionID)
+	if err != nil {
+		return nil, err
+	}
+	if len(cols) == 0 {
+		return nil, client.NewErrCollectionNotFoundForSchemaVersion(delta.SchemaVersionID)
+	}
+	return cols[0], nil
+}
+
+// encodeDiffValue returns the JSON encoding of the given field value, or nil if the value is nil.
+func encodeDiffValue(value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return string(encoded), nil
+}
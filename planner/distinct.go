@@ -0,0 +1,163 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/planner/mapper"
+)
+
+// distinctNode yields only the first document of each distinct combination of the
+// values of the given fields.
+type distinctNode struct {
+	docMapper
+
+	plan planNode
+
+	distinctFields []mapper.Field
+
+	// The keys of the combinations of values yielded so far.
+	seen map[string]struct{}
+
+	execInfo distinctExecInfo
+}
+
+type distinctExecInfo struct {
+	// Total number of times distinctNode was executed.
+	iterations uint64
+
+	// Total number of documents skipped as their values had already been yielded.
+	duplicates uint64
+}
+
+// Distinct creates a new distinctNode initalized from the parser.Distinct object.
+func (p *Planner) Distinct(parsed *mapper.Select, n *mapper.Distinct) (*distinctNode, error) {
+	if n == nil {
+		return nil, nil // nothing to do
+	}
+
+	return &distinctNode{
+		distinctFields: n.Fields,
+		seen:           map[string]struct{}{},
+		docMapper:      docMapper{parsed.DocumentMapping},
+	}, nil
+}
+
+func (n *distinctNode) Kind() string {
+	return "distinctNode"
+}
+
+func (n *distinctNode) Init() error {
+	n.seen = map[string]struct{}{}
+	return n.plan.Init()
+}
+
+func (n *distinctNode) Start() error           { return n.plan.Start() }
+func (n *distinctNode) Spans(spans core.Spans) { n.plan.Spans(spans) }
+func (n *distinctNode) Close() error           { return n.plan.Close() }
+func (n *distinctNode) Value() core.Doc        { return n.plan.Value() }
+func (n *distinctNode) Source() planNode       { return n.plan }
+
+func (n *distinctNode) Next() (bool, error) {
+	for {
+		n.execInfo.iterations++
+
+		if next, err := n.plan.Next(); !next {
+			return false, err
+		}
+
+		key := distinctKey(n.plan.Value(), n.distinctFields)
+		if _, isDuplicate := n.seen[key]; isDuplicate {
+			n.execInfo.duplicates++
+			continue
+		}
+		n.seen[key] = struct{}{}
+
+		return true, nil
+	}
+}
+
+// distinctKey returns a key representing the combination of the values of the given fields
+// within the given document.
+func distinctKey(doc core.Doc, fields []mapper.Field) string {
+	values := make([]string, len(fields))
+	for i, field := range fields {
+		values[i] = distinctValueKey(doc.Fields[field.Index])
+	}
+	return fmt.Sprintf("%#v", values)
+}
+
+// distinctValueKey returns a key representing the given field value, equal for equal values.
+//
+// Arbitrary precision numbers and times hold pointers, so they are keyed by their canonical
+// string form instead of their Go representation.
+func distinctValueKey(value any) string {
+	switch v := value.(type) {
+	case *big.Int:
+		return "big.Int(" + v.String() + ")"
+	case decimal.Decimal:
+		return "decimal.Decimal(" + v.String() + ")"
+	case time.Time:
+		return "time.Time(" + v.UTC().Format(time.RFC3339Nano) + ")"
+	default:
+		return fmt.Sprintf("%#v", v)
+	}
+}
+
+// distinctDocs returns the first of the given documents for each distinct combination of the
+// values of the given fields.
+func distinctDocs(docs []core.Doc, fields []mapper.Field) []core.Doc {
+	seen := map[string]struct{}{}
+	result := make([]core.Doc, 0, len(docs))
+	for _, doc := range docs {
+		key := distinctKey(doc, fields)
+		if _, isDuplicate := seen[key]; isDuplicate {
+			continue
+		}
+		seen[key] = struct{}{}
+		result = append(result, doc)
+	}
+	return result
+}
+
+func (n *distinctNode) simpleExplain() (map[string]any, error) {
+	distinctFields := make([]string, len(n.distinctFields))
+	for i, field := range n.distinctFields {
+		distinctFields[i] = field.Name
+	}
+
+	return map[string]any{
+		"distinctFields": distinctFields,
+	}, nil
+}
+
+func (n *distinctNode) Explain(explainType request.ExplainType) (map[string]any, error) {
+	switch explainType {
+	case request.SimpleExplain:
+		return n.simpleExplain()
+
+	case request.ExecuteExplain:
+		return map[string]any{
+			"iterations": n.execInfo.iterations,
+			"duplicates": n.execInfo.duplicates,
+		}, nil
+
+	default:
+		return nil, ErrUnknownExplainRequestType
+	}
+}
@@ -17,6 +17,9 @@ const (
 	errFailedToClosePlan              string = "failed to close the plan"
 	errFailedToCollectExecExplainInfo string = "failed to collect execution explain information"
 	errSubTypeInit                    string = "sub-type initialization error at scan node reset"
+	errUnknownRelationOperation       string = "unknown relation operation"
+	errMultipleRelationOperations     string = "only one of create, connect or disconnect may be given for a one-sided relation"
+	errDocumentNotRelated             string = "the document is not related to the given document"
 )
 
 var (
@@ -34,6 +37,8 @@ var (
 	ErrMissingChildValue                   = errors.New("expected child value, however none was yielded")
 	ErrUnknownRelationType                 = errors.New("failed sub selection, unknown relation type")
 	ErrUnknownExplainRequestType           = errors.New("can not explain request of unknown type")
+	ErrCursorWithinGroup                   = errors.New("cursors cannot be used within _group")
+	ErrMissingRevertArgs                   = errors.New("revert requires a docID and a cid")
 )
 
 func NewErrUnknownDependency(name string) error {
@@ -51,3 +56,23 @@ func NewErrFailedToCollectExecExplainInfo(inner error) error {
 func NewErrSubTypeInit(inner error) error {
 	return errors.Wrap(errSubTypeInit, inner)
 }
+
+func NewErrUnknownRelationOperation(field string, operation string) error {
+	return errors.New(
+		errUnknownRelationOperation,
+		errors.NewKV("Field", field),
+		errors.NewKV("Operation", operation),
+	)
+}
+
+func NewErrMultipleRelationOperations(field string) error {
+	return errors.New(errMultipleRelationOperations, errors.NewKV("Field", field))
+}
+
+func NewErrDocumentNotRelated(docID string, relatedDocID string) error {
+	return errors.New(
+		errDocumentNotRelated,
+		errors.NewKV("DocID", docID),
+		errors.NewKV("RelatedDocID", relatedDocID),
+	)
+}
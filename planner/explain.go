@@ -37,9 +37,12 @@ var (
 	_ explainablePlanNode = (*createNode)(nil)
 	_ explainablePlanNode = (*dagScanNode)(nil)
 	_ explainablePlanNode = (*deleteNode)(nil)
+	_ explainablePlanNode = (*distinctNode)(nil)
 	_ explainablePlanNode = (*groupNode)(nil)
+	_ explainablePlanNode = (*havingNode)(nil)
 	_ explainablePlanNode = (*limitNode)(nil)
 	_ explainablePlanNode = (*orderNode)(nil)
+	_ explainablePlanNode = (*revertNode)(nil)
 	_ explainablePlanNode = (*scanNode)(nil)
 	_ explainablePlanNode = (*selectNode)(nil)
 	_ explainablePlanNode = (*selectTopNode)(nil)
@@ -53,6 +56,7 @@ const (
 	childFieldNameLabel = "childFieldName"
 	collectionIDLabel   = "collectionID"
 	collectionNameLabel = "collectionName"
+	distinctLabel       = "distinct"
 	inputLabel          = "input"
 	fieldNameLabel      = "fieldName"
 	filterLabel         = "filter"
@@ -113,6 +117,31 @@ func buildDebugExplainGraph(source planNode) (map[string]any, error) {
 		nodeLabelTitle := strcase.ToLowerCamel(node.Kind())
 		explainGraph[nodeLabelTitle] = explainGraphBuilder
 
+	case *typeJoinManyToMany:
+		var explainGraphBuilder = map[string]any{}
+
+		// If root is not the last child then keep walking and explaining the root graph.
+		if node.root != nil {
+			indexJoinRootExplainGraph, err := buildDebugExplainGraph(node.root)
+			if err != nil {
+				return nil, err
+			}
+			// Add the explaination of the rest of the explain graph under the "root" graph.
+			explainGraphBuilder[joinRootLabel] = indexJoinRootExplainGraph
+		}
+
+		if node.subType != nil {
+			indexJoinSubTypeExplainGraph, err := buildDebugExplainGraph(node.subType)
+			if err != nil {
+				return nil, err
+			}
+			// Add the explaination of the rest of the explain graph under the "subType" graph.
+			explainGraphBuilder[joinSubTypeLabel] = indexJoinSubTypeExplainGraph
+		}
+
+		nodeLabelTitle := strcase.ToLowerCamel(node.Kind())
+		explainGraph[nodeLabelTitle] = explainGraphBuilder
+
 	case *typeJoinOne:
 		var explainGraphBuilder = map[string]any{}
 
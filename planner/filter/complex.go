@@ -45,6 +45,9 @@ func isComplex(conditions any, seekRelation bool) bool {
 					if _, isRelation := objK.(*mapper.PropertyIndex); isRelation {
 						return true
 					}
+					if op, isOp := objK.(*mapper.Operator); isOp && op.Operation == request.FilterOpSome {
+						return true
+					}
 				}
 			}
 			if isComplex(v, seekRelation) {
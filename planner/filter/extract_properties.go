@@ -62,7 +62,7 @@ func ExtractProperties(conditions map[connor.FilterKey]any) map[int]Property {
 						properties[prop.Index] = mergeProps(existingProp, prop)
 					}
 				}
-			} else if typedKey.Operation == request.FilterOpNot {
+			} else if typedKey.Operation == request.FilterOpNot || typedKey.Operation == request.FilterOpSome {
 				props := ExtractProperties(v.(map[connor.FilterKey]any))
 				for _, prop := range props {
 					existingProp := properties[prop.Index]
@@ -10,6 +10,8 @@
 package filter
 
 import (
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/connor"
 	"github.com/sourcenetwork/defradb/planner/mapper"
 )
 
@@ -36,3 +38,108 @@ func SplitByField(filter *mapper.Filter, field mapper.Field) (*mapper.Filter, *m
 
 	return filter, splitF
 }
+
+// SplitByFields splits the provided filter into 2 filters based on the given fields.
+// It can be used for extracting the conditions that must be evaluated after the given
+// fields have been calculated, such as aggregates.
+// Eg. (filter: {name: "bob", _count: {_gt: 1}, _or: [{age: 10}, {_sum: {_lt: 5}}]})
+//
+// In this case the first filter is the conditions that do not reference the given fields
+// ie: {name: "bob"}.
+//
+// And the second filter is the conditions that do
+// ie: {_count: {_gt: 1}, _or: [{age: 10}, {_sum: {_lt: 5}}]}.
+//
+// Unlike SplitByField, only the top level conditions (including those within a top
+// level _and) are split, any other operator is moved whole.
+func SplitByFields(filter *mapper.Filter, fields ...mapper.Field) (*mapper.Filter, *mapper.Filter) {
+	if filter == nil || len(fields) == 0 {
+		return filter, nil
+	}
+
+	indexes := make(map[int]struct{}, len(fields))
+	for _, field := range fields {
+		indexes[field.Index] = struct{}{}
+	}
+
+	remaining, split := splitConditionsByIndexes(filter.Conditions, indexes)
+	if len(split) == 0 {
+		return filter, nil
+	}
+
+	splitF := mapper.NewFilter()
+	splitF.Conditions = split
+	if len(remaining) == 0 {
+		return nil, splitF
+	}
+
+	filter.Conditions = remaining
+	return filter, splitF
+}
+
+func splitConditionsByIndexes(
+	conditions map[connor.FilterKey]any,
+	indexes map[int]struct{},
+) (map[connor.FilterKey]any, map[connor.FilterKey]any) {
+	remaining := map[connor.FilterKey]any{}
+	split := map[connor.FilterKey]any{}
+
+	for key, value := range conditions {
+		if op, isOp := key.(*mapper.Operator); isOp && op.Operation == request.FilterOpAnd {
+			var remainingItems, splitItems []any
+			for _, item := range value.([]any) {
+				itemRemaining, itemSplit := splitConditionsByIndexes(item.(map[connor.FilterKey]any), indexes)
+				if len(itemRemaining) > 0 {
+					remainingItems = append(remainingItems, itemRemaining)
+				}
+				if len(itemSplit) > 0 {
+					splitItems = append(splitItems, itemSplit)
+				}
+			}
+			if len(remainingItems) > 0 {
+				remaining[key] = remainingItems
+			}
+			if len(splitItems) > 0 {
+				split[key] = splitItems
+			}
+			continue
+		}
+
+		if referencesIndexes(map[connor.FilterKey]any{key: value}, indexes) {
+			split[key] = value
+		} else {
+			remaining[key] = value
+		}
+	}
+
+	return remaining, split
+}
+
+// referencesIndexes returns true if the given conditions reference any of the given
+// property indexes at their own level.
+func referencesIndexes(conditions any, indexes map[int]struct{}) bool {
+	switch typedConditions := conditions.(type) {
+	case map[connor.FilterKey]any:
+		for key, value := range typedConditions {
+			switch typedKey := key.(type) {
+			case *mapper.PropertyIndex:
+				// The values of properties are either operators or, if the property is a
+				// relation, conditions on the related object and so may be skipped.
+				if _, ok := indexes[typedKey.Index]; ok {
+					return true
+				}
+			case *mapper.Operator:
+				if referencesIndexes(value, indexes) {
+					return true
+				}
+			}
+		}
+	case []any:
+		for _, item := range typedConditions {
+			if referencesIndexes(item, indexes) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -78,3 +78,79 @@ func TestSplitNullFilter(t *testing.T) {
 	assert.Nil(t, actualFilter1)
 	assert.Nil(t, actualFilter2)
 }
+
+func TestSplitFilterByFields(t *testing.T) {
+	tests := []struct {
+		name            string
+		inputFields     []mapper.Field
+		inputFilter     map[string]any
+		expectedFilter1 map[string]any
+		expectedFilter2 map[string]any
+	}{
+		{
+			name: "flat structure",
+			inputFilter: map[string]any{
+				"name": m("_eq", "John"),
+				"age":  m("_gt", 55),
+			},
+			inputFields:     []mapper.Field{{Index: authorAgeInd}},
+			expectedFilter1: m("name", m("_eq", "John")),
+			expectedFilter2: m("age", m("_gt", 55)),
+		},
+		{
+			name: "within top level _and",
+			inputFilter: r("_and",
+				m("name", m("_eq", "John")),
+				m("age", m("_gt", 55)),
+			),
+			inputFields: []mapper.Field{{Index: authorAgeInd}},
+			expectedFilter1: r("_and",
+				m("name", m("_eq", "John")),
+			),
+			expectedFilter2: r("_and",
+				m("age", m("_gt", 55)),
+			),
+		},
+		{
+			name: "_or is moved whole",
+			inputFilter: map[string]any{
+				"verified": m("_eq", true),
+				"_or": []any{
+					m("name", m("_eq", "John")),
+					m("age", m("_gt", 55)),
+				},
+			},
+			inputFields:     []mapper.Field{{Index: authorAgeInd}},
+			expectedFilter1: m("verified", m("_eq", true)),
+			expectedFilter2: r("_or",
+				m("name", m("_eq", "John")),
+				m("age", m("_gt", 55)),
+			),
+		},
+		{
+			name: "no field to split",
+			inputFilter: map[string]any{
+				"name": m("_eq", "John"),
+			},
+			inputFields:     []mapper.Field{{Index: authorAgeInd}},
+			expectedFilter1: m("name", m("_eq", "John")),
+			expectedFilter2: nil,
+		},
+	}
+
+	mapping := getDocMapping()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			inputFilter := mapper.ToFilter(request.Filter{Conditions: test.inputFilter}, mapping)
+			actualFilter1, actualFilter2 := SplitByFields(inputFilter, test.inputFields...)
+			expectedFilter1 := mapper.ToFilter(request.Filter{Conditions: test.expectedFilter1}, mapping)
+			expectedFilter2 := mapper.ToFilter(request.Filter{Conditions: test.expectedFilter2}, mapping)
+			if expectedFilter1 != nil || actualFilter1 != nil {
+				AssertEqualFilterMap(t, expectedFilter1.Conditions, actualFilter1.Conditions)
+			}
+			if expectedFilter2 != nil || actualFilter2 != nil {
+				AssertEqualFilterMap(t, expectedFilter2.Conditions, actualFilter2.Conditions)
+			}
+		})
+	}
+}
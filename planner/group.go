@@ -11,6 +11,10 @@
 package planner
 
 import (
+	"time"
+
+	"golang.org/x/exp/slices"
+
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
 	"github.com/sourcenetwork/defradb/core"
@@ -29,7 +33,7 @@ type groupNode struct {
 
 	// The fields to group by - this must be an ordered collection and
 	// will include any parent group-by fields (if any)
-	groupByFields []mapper.Field
+	groupByFields []mapper.GroupByField
 
 	// The data sources that this node will draw data from.
 	dataSources []*dataSource
@@ -156,9 +160,12 @@ func (n *groupNode) Next() (bool, error) {
 
 		n.values = values.values
 
-		for _, group := range n.values {
+		for i, group := range n.values {
 			n.execInfo.groups++
 
+			group = setTruncatedGroupKeys(group, n.groupByFields)
+			n.values[i] = group
+
 			for _, childSelect := range n.childSelects {
 				n.execInfo.childSelections++
 
@@ -170,6 +177,11 @@ func (n *groupNode) Next() (bool, error) {
 				}
 
 				childDocs := subSelect.([]core.Doc)
+				if childSelect.Distinct != nil {
+					childDocs = distinctDocs(childDocs, childSelect.Distinct.Fields)
+					group.Fields[childSelect.Index] = childDocs
+				}
+
 				if childSelect.Limit != nil {
 					l := uint64(len(childDocs))
 
@@ -326,3 +338,77 @@ func (n *groupNode) Explain(explainType request.ExplainType) (map[string]any, er
 		return nil, ErrUnknownExplainRequestType
 	}
 }
+
+// groupKeyValue returns the value of the given group key for the given document.
+func groupKeyValue(doc core.Doc, keyField mapper.GroupByField) any {
+	value := doc.Fields[keyField.Index]
+
+	if keyField.RelatedField.HasValue() {
+		relatedDoc, ok := value.(core.Doc)
+		if !ok {
+			return nil
+		}
+		value = relatedDoc.Fields[keyField.RelatedField.Value().Index]
+	}
+
+	if keyField.Truncation.HasValue() {
+		return truncateDateTime(value, keyField.Truncation.Value())
+	}
+
+	return value
+}
+
+// setTruncatedGroupKeys sets the values of any truncated group keys on the given group, so
+// that the truncated values are rendered instead of those of the first document in the group.
+//
+// The fields are copied before being set as they may be shared with the documents in the group.
+func setTruncatedGroupKeys(group core.Doc, keyFields []mapper.GroupByField) core.Doc {
+	for _, keyField := range keyFields {
+		if !keyField.Truncation.HasValue() || keyField.Index >= len(group.Fields) {
+			continue
+		}
+		value := groupKeyValue(group, keyField)
+
+		if keyField.RelatedField.HasValue() {
+			relatedDoc, ok := group.Fields[keyField.Index].(core.Doc)
+			if !ok {
+				continue
+			}
+			relatedDoc.Fields = slices.Clone(relatedDoc.Fields)
+			relatedDoc.Fields[keyField.RelatedField.Value().Index] = value
+			value = relatedDoc
+		}
+
+		group.Fields = slices.Clone(group.Fields)
+		group.Fields[keyField.Index] = value
+	}
+	return group
+}
+
+// truncateDateTime truncates the given DateTime value to the start of the (UTC) day, week or
+// month that it falls within.  Weeks start on Mondays.
+//
+// Values that are not DateTimes are returned as-is.
+func truncateDateTime(value any, truncation request.GroupByTruncation) any {
+	dateTime, ok := value.(time.Time)
+	if !ok {
+		return value
+	}
+
+	year, month, day := dateTime.UTC().Date()
+	switch truncation {
+	case request.GroupByDay:
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+
+	case request.GroupByWeek:
+		startOfDay := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		daysSinceMonday := (int(startOfDay.Weekday()) + 6) % 7
+		return startOfDay.AddDate(0, 0, -daysSinceMonday)
+
+	case request.GroupByMonth:
+		return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+
+	default:
+		return value
+	}
+}
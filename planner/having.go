@@ -0,0 +1,115 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/planner/filter"
+	"github.com/sourcenetwork/defradb/planner/mapper"
+)
+
+// havingNode filters the results of its source by the conditions that reference
+// aggregates, once those aggregates have been calculated.
+type havingNode struct {
+	docMapper
+
+	plan   planNode
+	filter *mapper.Filter
+
+	execInfo havingExecInfo
+}
+
+type havingExecInfo struct {
+	// Total number of times havingNode was executed.
+	iterations uint64
+
+	// Total number of times the filter passed / matched.
+	filterMatches uint64
+}
+
+// splitHavingFilter splits the filter of the given select into the conditions that may be
+// evaluated before aggregation, and those that reference aggregates and so may only be
+// evaluated afterwards.
+//
+// The filter of the given select is replaced by the former, and the latter is returned.
+func splitHavingFilter(selectReq *mapper.Select) *mapper.Filter {
+	aggregates := []mapper.Field{}
+	for _, field := range selectReq.Fields {
+		if aggregate, ok := field.(*mapper.Aggregate); ok {
+			aggregates = append(aggregates, aggregate.Field)
+		}
+	}
+
+	var having *mapper.Filter
+	selectReq.Filter, having = filter.SplitByFields(selectReq.Filter, aggregates...)
+	return having
+}
+
+// Having creates a new havingNode for the given filter.
+func (p *Planner) Having(parsed *mapper.Select, having *mapper.Filter) *havingNode {
+	if having == nil {
+		return nil // nothing to do
+	}
+
+	return &havingNode{
+		filter:    having,
+		docMapper: docMapper{parsed.DocumentMapping},
+	}
+}
+
+func (n *havingNode) Kind() string {
+	return "havingNode"
+}
+
+func (n *havingNode) Init() error            { return n.plan.Init() }
+func (n *havingNode) Start() error           { return n.plan.Start() }
+func (n *havingNode) Spans(spans core.Spans) { n.plan.Spans(spans) }
+func (n *havingNode) Close() error           { return n.plan.Close() }
+func (n *havingNode) Value() core.Doc        { return n.plan.Value() }
+func (n *havingNode) Source() planNode       { return n.plan }
+
+func (n *havingNode) Next() (bool, error) {
+	for {
+		n.execInfo.iterations++
+
+		if next, err := n.plan.Next(); !next {
+			return false, err
+		}
+
+		passes, err := mapper.RunFilter(n.plan.Value(), n.filter)
+		if err != nil {
+			return false, err
+		}
+		if passes {
+			n.execInfo.filterMatches++
+			return true, nil
+		}
+	}
+}
+
+func (n *havingNode) Explain(explainType request.ExplainType) (map[string]any, error) {
+	switch explainType {
+	case request.SimpleExplain:
+		return map[string]any{
+			filterLabel: n.filter.ToMap(n.documentMapping),
+		}, nil
+
+	case request.ExecuteExplain:
+		return map[string]any{
+			"iterations":    n.execInfo.iterations,
+			"filterMatches": n.execInfo.filterMatches,
+		}, nil
+
+	default:
+		return nil, ErrUnknownExplainRequestType
+	}
+}
@@ -11,13 +11,24 @@
 package planner
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/sourcenetwork/immutable"
+	"golang.org/x/exp/slices"
+
 	"github.com/sourcenetwork/defradb/client/request"
 	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/db/base"
 	"github.com/sourcenetwork/defradb/planner/mapper"
 )
 
-// Limit the results, yielding only what the limit/offset permits
-// @todo: Handle cursor
+// Limit the results, yielding only what the limit/offset and cursors permit
 type limitNode struct {
 	docMapper
 
@@ -28,6 +39,28 @@ type limitNode struct {
 	offset   uint64
 	rowIndex uint64
 
+	// paginated is true if the results are positioned by cursors, in which case the source
+	// plan must be ordered by the given ordering, ending with the docID.
+	paginated bool
+	ordering  []mapper.OrderCondition
+	after     immutable.Option[mapper.Cursor]
+	before    immutable.Option[mapper.Cursor]
+	pageInfo  *mapper.PageInfo
+
+	// reversed is true if the source plan is ordered in the reverse of the requested order,
+	// in which case the ordering is also reversed.
+	reversed bool
+
+	// page contains the documents yielded when paginated, it is populated on the first call
+	// to Next.
+	page      []core.Doc
+	pageIndex int
+	pageRead  bool
+
+	// renderedPageInfo contains the requested page info properties, it is populated along
+	// with the page.
+	renderedPageInfo map[string]any
+
 	execInfo limitExecInfo
 }
 
@@ -41,11 +74,33 @@ func (p *Planner) Limit(parsed *mapper.Select, n *mapper.Limit) (*limitNode, err
 	if n == nil {
 		return nil, nil // nothing to do
 	}
+	var ordering []mapper.OrderCondition
+	if parsed.OrderBy != nil {
+		ordering = parsed.OrderBy.Conditions
+		if n.Reversed {
+			ordering = parsed.OrderBy.Reverse().Conditions
+		}
+	}
+
+	var pageInfo *mapper.PageInfo
+	for _, field := range parsed.Fields {
+		if f, ok := field.(*mapper.PageInfo); ok {
+			pageInfo = f
+			break
+		}
+	}
+
 	return &limitNode{
 		p:         p,
 		limit:     n.Limit,
 		offset:    n.Offset,
 		rowIndex:  0,
+		paginated: n.Paginated,
+		ordering:  ordering,
+		after:     n.After,
+		before:    n.Before,
+		pageInfo:  pageInfo,
+		reversed:  n.Reversed,
 		docMapper: docMapper{parsed.DocumentMapping},
 	}, nil
 }
@@ -56,17 +111,42 @@ func (n *limitNode) Kind() string {
 
 func (n *limitNode) Init() error {
 	n.rowIndex = 0
+	n.page = nil
+	n.pageIndex = 0
+	n.pageRead = false
+	n.renderedPageInfo = nil
 	return n.plan.Init()
 }
 
 func (n *limitNode) Start() error           { return n.plan.Start() }
 func (n *limitNode) Spans(spans core.Spans) { n.plan.Spans(spans) }
 func (n *limitNode) Close() error           { return n.plan.Close() }
-func (n *limitNode) Value() core.Doc        { return n.plan.Value() }
+
+func (n *limitNode) Value() core.Doc {
+	if n.paginated {
+		return n.page[n.pageIndex-1]
+	}
+	return n.plan.Value()
+}
 
 func (n *limitNode) Next() (bool, error) {
 	n.execInfo.iterations++
 
+	if n.paginated {
+		if !n.pageRead {
+			err := n.readPage()
+			if err != nil {
+				return false, err
+			}
+			n.pageRead = true
+		}
+		if n.pageIndex >= len(n.page) {
+			return false, nil
+		}
+		n.pageIndex++
+		return true, nil
+	}
+
 	// check if we're passed the limit
 	if n.limit != 0 && n.rowIndex >= n.limit+n.offset {
 		return false, nil
@@ -90,6 +170,277 @@ func (n *limitNode) Next() (bool, error) {
 
 func (n *limitNode) Source() planNode { return n.plan }
 
+// readPage reads the documents positioned between the cursors from the source plan, and
+// populates the page with those permitted by the limit/offset.
+//
+// If only a before cursor is given the source plan is read in reverse, so that the
+// limit/offset are applied backwards from it, yielding the documents immediately preceding it.
+func (n *limitNode) readPage() error {
+	// When reversed, the documents preceding the before cursor follow it in the reversed
+	// ordering, so it is read past in the same way as the after cursor.
+	from, to := n.after, n.before
+	if n.reversed {
+		from, to = n.before, immutable.None[mapper.Cursor]()
+	}
+
+	// The documents following the from cursor and preceding the to cursor.
+	window := []core.Doc{}
+	skippedFrom := false
+	reachedTo := false
+	for {
+		if n.limit != 0 && uint64(len(window)) > n.offset+n.limit {
+			// A single document beyond the page is read so we know whether there is a next page.
+			break
+		}
+
+		next, err := n.plan.Next()
+		if err != nil {
+			return err
+		}
+		if !next {
+			break
+		}
+		doc := n.plan.Value()
+
+		if from.HasValue() {
+			compare, err := n.compareToCursor(doc, from.Value())
+			if err != nil {
+				return err
+			}
+			if compare <= 0 {
+				skippedFrom = true
+				continue
+			}
+		}
+		if to.HasValue() {
+			compare, err := n.compareToCursor(doc, to.Value())
+			if err != nil {
+				return err
+			}
+			if compare >= 0 {
+				reachedTo = true
+				break
+			}
+		}
+		window = append(window, doc)
+	}
+
+	length := uint64(len(window))
+	start := length
+	if n.offset < length {
+		start = n.offset
+	}
+	end := length
+	if n.limit != 0 && start+n.limit < length {
+		end = start + n.limit
+	}
+	n.page = window[start:end]
+
+	hasPreviousPage := skippedFrom || start > 0
+	hasNextPage := reachedTo || end < length
+	if n.reversed {
+		slices.Reverse(n.page)
+		hasPreviousPage, hasNextPage = hasNextPage, hasPreviousPage
+	}
+
+	return n.renderPage(hasPreviousPage, hasNextPage)
+}
+
+// renderPage sets the cursor property of each document in the page, and renders the page info.
+func (n *limitNode) renderPage(hasPreviousPage bool, hasNextPage bool) error {
+	cursorIndex := n.documentMapping.FirstIndexOfName(request.CursorFieldName)
+	cursors := make([]string, len(n.page))
+	for i, doc := range n.page {
+		cursor, err := n.cursorOf(doc).String()
+		if err != nil {
+			return err
+		}
+		cursors[i] = cursor
+		doc.Fields[cursorIndex] = cursor
+	}
+
+	if n.pageInfo == nil {
+		return nil
+	}
+
+	var startCursor, endCursor any
+	if len(cursors) > 0 {
+		startCursor = cursors[0]
+		endCursor = cursors[len(cursors)-1]
+	}
+
+	n.renderedPageInfo = make(map[string]any, len(n.pageInfo.Properties))
+	for _, property := range n.pageInfo.Properties {
+		switch property.Name {
+		case request.HasPreviousPageFieldName:
+			n.renderedPageInfo[property.Key] = hasPreviousPage
+		case request.HasNextPageFieldName:
+			n.renderedPageInfo[property.Key] = hasNextPage
+		case request.StartCursorFieldName:
+			n.renderedPageInfo[property.Key] = startCursor
+		case request.EndCursorFieldName:
+			n.renderedPageInfo[property.Key] = endCursor
+		case request.TypeNameFieldName:
+			n.renderedPageInfo[property.Key] = request.PageInfoTypeName
+		}
+	}
+	return nil
+}
+
+// renderedPageInfoOf returns the key and properties of the page info rendered by the
+// top-level limit node of the given plan, if it has one.
+func renderedPageInfoOf(plan planNode) (string, map[string]any, bool) {
+	for node := plan; node != nil; node = node.Source() {
+		switch n := node.(type) {
+		case *limitNode:
+			if n.pageInfo == nil || n.renderedPageInfo == nil {
+				return "", nil, false
+			}
+			return n.pageInfo.Key, n.renderedPageInfo, true
+		case *selectNode:
+			// Any limit nodes beyond the select belong to child selects.
+			return "", nil, false
+		}
+	}
+	return "", nil, false
+}
+
+// cursorOf returns the cursor of the given document.
+func (n *limitNode) cursorOf(doc core.Doc) mapper.Cursor {
+	// The last condition orders by docID, which is held separately.
+	values := make([]any, len(n.ordering)-1)
+	for i, condition := range n.ordering[:len(n.ordering)-1] {
+		values[i] = getDocProp(doc, condition.FieldIndexes)
+	}
+	return mapper.Cursor{
+		Values: values,
+		DocID:  doc.GetID(),
+	}
+}
+
+// compareToCursor returns -1 if the given document precedes the position of the given cursor,
+// 0 if it is at the position of the cursor, and 1 if it follows it.
+func (n *limitNode) compareToCursor(doc core.Doc, cursor mapper.Cursor) (int, error) {
+	for i, condition := range n.ordering[:len(n.ordering)-1] {
+		docValue := getDocProp(doc, condition.FieldIndexes)
+		cursorValue, ok := cursorValueAs(cursor.Values[i], docValue)
+		if !ok {
+			return 0, mapper.NewErrInvalidCursor(cursorString(cursor))
+		}
+
+		compare := base.Compare(docValue, cursorValue)
+		if condition.Direction == mapper.DESC {
+			compare = -compare
+		}
+		if compare != 0 {
+			return compare, nil
+		}
+	}
+	compare := base.Compare(doc.GetID(), cursor.DocID)
+	if n.ordering[len(n.ordering)-1].Direction == mapper.DESC {
+		compare = -compare
+	}
+	return compare, nil
+}
+
+// cursorValueAs returns the given value parsed from a cursor as the type of the given document value.
+//
+// It returns false if the cursor value cannot be represented as that type.
+func cursorValueAs(cursorValue any, docValue any) (any, bool) {
+	if cursorValue == nil || docValue == nil {
+		return cursorValue, true
+	}
+
+	switch docValue.(type) {
+	case int, int64:
+		number, ok := cursorValue.(json.Number)
+		if !ok {
+			return nil, false
+		}
+		v, err := number.Int64()
+		return v, err == nil
+
+	case uint64:
+		number, ok := cursorValue.(json.Number)
+		if !ok {
+			return nil, false
+		}
+		v, err := strconv.ParseUint(number.String(), 10, 64)
+		return v, err == nil
+
+	case float64:
+		number, ok := cursorValue.(json.Number)
+		if !ok {
+			return nil, false
+		}
+		v, err := number.Float64()
+		return v, err == nil
+
+	case bool:
+		v, ok := cursorValue.(bool)
+		return v, ok
+
+	case string:
+		v, ok := cursorValue.(string)
+		return v, ok
+
+	case time.Time:
+		s, ok := cursorValue.(string)
+		if !ok {
+			return nil, false
+		}
+		v, err := time.Parse(time.RFC3339Nano, s)
+		return v, err == nil
+
+	case []byte:
+		s, ok := cursorValue.(string)
+		if !ok {
+			return nil, false
+		}
+		v, err := base64.StdEncoding.DecodeString(s)
+		return v, err == nil
+
+	case *big.Int:
+		// Big numbers are held as numbers or strings depending on how they are marshalled,
+		// both are parsed from their string form.
+		s, ok := bigNumberCursorString(cursorValue)
+		if !ok {
+			return nil, false
+		}
+		return new(big.Int).SetString(s, 10)
+
+	case decimal.Decimal:
+		s, ok := bigNumberCursorString(cursorValue)
+		if !ok {
+			return nil, false
+		}
+		v, err := decimal.NewFromString(s)
+		return v, err == nil
+
+	default:
+		// Values of other types are not compared.
+		return cursorValue, true
+	}
+}
+
+// bigNumberCursorString returns the string form of the given big number value parsed from
+// a cursor.
+func bigNumberCursorString(cursorValue any) (string, bool) {
+	switch v := cursorValue.(type) {
+	case json.Number:
+		return v.String(), true
+	case string:
+		return v, true
+	default:
+		return fmt.Sprint(v), false
+	}
+}
+
+func cursorString(cursor mapper.Cursor) string {
+	s, _ := cursor.String()
+	return s
+}
+
 func (n *limitNode) simpleExplain() (map[string]any, error) {
 	simpleExplainMap := map[string]any{
 		limitLabel:  n.limit,
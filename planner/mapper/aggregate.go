@@ -36,6 +36,10 @@ type AggregateTarget struct {
 	// This may be empty if the aggregate targets a whole collection (e.g. Count),
 	// or if `HostIndex` is an inline array.
 	ChildTarget OptionalChildTarget
+
+	// The property on the `HostIndex` by which the targeted items should be de-duplicated
+	// before being aggregated. Optional.
+	DistinctTarget OptionalChildTarget
 }
 
 // Aggregate represents an aggregate operation definition.
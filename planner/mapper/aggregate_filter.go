@@ -0,0 +1,205 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package mapper
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/core"
+)
+
+// relationAggregateName returns the name by which the hidden aggregate of the given name, targeting
+// the given relation, is mapped.
+//
+// The name cannot clash with any consumer defined field.
+func relationAggregateName(aggregateName string, relation string) string {
+	return fmt.Sprintf("%s(%s)", aggregateName, relation)
+}
+
+// isAggregateFilterKey returns true if the given filter key references an aggregate field
+// within the given mapping.
+func isAggregateFilterKey(key string, mapping *core.DocumentMapping) bool {
+	if mapping == nil {
+		return false
+	}
+	name, _, _ := strings.Cut(key, "(")
+	_, isAggregate := request.Aggregates[name]
+	return isAggregate && len(mapping.IndexesByName[key]) != 0
+}
+
+// resolveFilterAggregates maps the aggregates referenced by the filter of the given select, so
+// that the filter may be evaluated against their values once they have been calculated.
+//
+// Aggregates directly within the filter, e.g. `{_count: {_gt: 1}}`, reference the first
+// requested aggregate of the same name. If `_count` is not requested on a grouped select, the
+// count of the group is used.
+//
+// Counts of one-to-many relations, e.g. `{books: {_count: {_gt: 1}}}`, are moved out of the
+// relation's conditions and mapped to a hidden aggregate on the select, as the relation conditions
+// are otherwise evaluated against each related object.
+func resolveFilterAggregates(
+	selectRequest *request.Select,
+	schema client.SchemaDescription,
+	mapping *core.DocumentMapping,
+	aggregates []*aggregateRequest,
+) ([]*aggregateRequest, error) {
+	if !selectRequest.Filter.HasValue() {
+		return aggregates, nil
+	}
+
+	relations := map[string]struct{}{}
+	referenced := map[string]struct{}{}
+	conditions := rewriteRelationAggregates(
+		selectRequest.Filter.Value().Conditions,
+		schema,
+		relations,
+		referenced,
+	)
+	selectRequest.Filter = immutable.Some(request.Filter{
+		Conditions: conditions,
+	})
+
+	for name := range referenced {
+		if len(mapping.IndexesByName[name]) != 0 {
+			continue
+		}
+		if name != request.CountFieldName || !selectRequest.GroupBy.HasValue() {
+			return nil, NewErrAggregateFilterTargetNotRequested(name)
+		}
+		aggregates = appendHiddenAggregate(
+			aggregates,
+			mapping,
+			request.CountFieldName,
+			request.CountFieldName,
+			request.GroupFieldName,
+		)
+	}
+
+	relationNames := make([]string, 0, len(relations))
+	for relation := range relations {
+		relationNames = append(relationNames, relation)
+	}
+	// Sort the relations so that the mapped indexes are deterministic.
+	sort.Strings(relationNames)
+
+	for _, relation := range relationNames {
+		aggregates = appendHiddenAggregate(
+			aggregates,
+			mapping,
+			request.CountFieldName,
+			relationAggregateName(request.CountFieldName, relation),
+			relation,
+		)
+	}
+
+	return aggregates, nil
+}
+
+// appendHiddenAggregate maps a new, non-rendered, aggregate of the given name under the given
+// mapped name, targeting the given host.
+func appendHiddenAggregate(
+	aggregates []*aggregateRequest,
+	mapping *core.DocumentMapping,
+	name string,
+	mappedName string,
+	hostName string,
+) []*aggregateRequest {
+	index := mapping.GetNextIndex()
+	mapping.Add(index, mappedName)
+
+	return append(aggregates, &aggregateRequest{
+		field: Field{
+			Index: index,
+			Name:  name,
+		},
+		targets: []*aggregateRequestTarget{
+			{
+				hostExternalName: hostName,
+			},
+		},
+	})
+}
+
+// rewriteRelationAggregates returns a copy of the given conditions with any one-to-many relation
+// counts moved to the parent level, keyed by their relationAggregateName.
+//
+// The names of the rewritten relations, and of the aggregates referenced directly by the conditions,
+// are added to the given sets.
+func rewriteRelationAggregates(
+	conditions map[string]any,
+	schema client.SchemaDescription,
+	relations map[string]struct{},
+	referenced map[string]struct{},
+) map[string]any {
+	result := make(map[string]any, len(conditions))
+	for key, value := range conditions {
+		switch key {
+		case request.FilterOpAnd, request.FilterOpOr:
+			items, isList := value.([]any)
+			if !isList {
+				result[key] = value
+				continue
+			}
+			rewrittenItems := make([]any, len(items))
+			for i, item := range items {
+				itemConditions, isMap := item.(map[string]any)
+				if !isMap {
+					rewrittenItems[i] = item
+					continue
+				}
+				rewrittenItems[i] = rewriteRelationAggregates(itemConditions, schema, relations, referenced)
+			}
+			result[key] = rewrittenItems
+
+		case request.FilterOpNot:
+			innerConditions, isMap := value.(map[string]any)
+			if !isMap {
+				result[key] = value
+				continue
+			}
+			result[key] = rewriteRelationAggregates(innerConditions, schema, relations, referenced)
+
+		default:
+			if _, isAggregate := request.Aggregates[key]; isAggregate {
+				referenced[key] = struct{}{}
+				result[key] = value
+				continue
+			}
+
+			innerConditions, isMap := value.(map[string]any)
+			countCondition, hasCount := innerConditions[request.CountFieldName]
+			fieldDesc, isField := schema.GetField(key)
+			if !isMap || !hasCount || !isField || !fieldDesc.IsObjectArray() {
+				result[key] = value
+				continue
+			}
+
+			remaining := make(map[string]any, len(innerConditions)-1)
+			for innerKey, innerValue := range innerConditions {
+				if innerKey != request.CountFieldName {
+					remaining[innerKey] = innerValue
+				}
+			}
+			if len(remaining) > 0 {
+				result[key] = remaining
+			}
+			result[relationAggregateName(request.CountFieldName, key)] = countCondition
+			relations[key] = struct{}{}
+		}
+	}
+	return result
+}
@@ -0,0 +1,238 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package mapper
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/core"
+)
+
+// Cursor identifies the position of a document within the ordered results of a Select.
+//
+// It is presented to consumers as an opaque string.
+type Cursor struct {
+	// Values contains the values of the properties the results are ordered by, in the
+	// order of the conditions of the order clause.
+	Values []any `json:"v"`
+
+	// DocID is the ID of the document, used to order documents with equal values.
+	DocID string `json:"id"`
+}
+
+// String returns the opaque string representation of the cursor.
+func (c Cursor) String() (string, error) {
+	buf, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// ParseCursor parses the given opaque cursor string.
+//
+// Numeric values are returned as [json.Number] as their original type is not known.
+func ParseCursor(cursor string) (Cursor, error) {
+	buf, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return Cursor{}, NewErrInvalidCursor(cursor)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(buf))
+	dec.UseNumber()
+
+	var result Cursor
+	err = dec.Decode(&result)
+	if err != nil || result.DocID == "" {
+		return Cursor{}, NewErrInvalidCursor(cursor)
+	}
+	return result, nil
+}
+
+// isPaginated returns true if the given select uses cursors, either by providing them or by
+// requesting them.
+func isPaginated(selectRequest *request.Select) bool {
+	if selectRequest.After.HasValue() || selectRequest.Before.HasValue() {
+		return true
+	}
+	for _, field := range selectRequest.Fields {
+		switch f := field.(type) {
+		case *request.Field:
+			if f.Name == request.CursorFieldName {
+				return true
+			}
+		case *request.PageInfo:
+			return true
+		}
+	}
+	return false
+}
+
+// hasPageInfo returns true if the given select requests the page info.
+func hasPageInfo(selectRequest *request.Select) bool {
+	for _, field := range selectRequest.Fields {
+		if _, ok := field.(*request.PageInfo); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// toPaginatedLimit returns the limit of the given paginated select, parsing any cursors provided.
+//
+// The given order must already contain the docID condition used to order documents with equal
+// values.
+func toPaginatedLimit(selectRequest *request.Select, orderBy *OrderBy) (*Limit, error) {
+	limit := toLimit(selectRequest.Limit, selectRequest.Offset)
+	if limit == nil {
+		limit = &Limit{}
+	}
+	limit.Paginated = true
+
+	var err error
+	limit.After, err = toCursor(selectRequest.After, orderBy)
+	if err != nil {
+		return nil, err
+	}
+	limit.Before, err = toCursor(selectRequest.Before, orderBy)
+	if err != nil {
+		return nil, err
+	}
+	limit.Reversed = limit.Before.HasValue() && !limit.After.HasValue()
+	return limit, nil
+}
+
+func toCursor(source immutable.Option[string], orderBy *OrderBy) (immutable.Option[Cursor], error) {
+	if !source.HasValue() {
+		return immutable.None[Cursor](), nil
+	}
+	cursor, err := ParseCursor(source.Value())
+	if err != nil {
+		return immutable.None[Cursor](), err
+	}
+	// The cursor must have been produced by a select with the same order, excluding the
+	// docID condition which is held separately.
+	if len(cursor.Values) != len(orderBy.Conditions)-1 {
+		return immutable.None[Cursor](), NewErrInvalidCursor(source.Value())
+	}
+	return immutable.Some(cursor), nil
+}
+
+// appendDocIDOrder returns the given order with an ascending docID condition appended, so that
+// documents with equal values have a stable, known, order.
+func appendDocIDOrder(orderBy *OrderBy) *OrderBy {
+	conditions := []OrderCondition{}
+	if orderBy != nil {
+		conditions = append(conditions, orderBy.Conditions...)
+	}
+	conditions = append(conditions, OrderCondition{
+		FieldIndexes: []int{core.DocIDFieldIndex},
+		Direction:    ASC,
+	})
+	return &OrderBy{
+		Conditions: conditions,
+	}
+}
+
+// withCursorSeekFilter returns the given filter with an added condition that excludes the
+// documents preceding the given cursor, when ordered by the first order condition of the
+// given select.
+//
+// The condition is redundant with the cursor itself, but allows the documents to be
+// fetched using an index on the ordered field, if there is one, seeking directly to the
+// position of the cursor.
+//
+// The given filter is not modified, so that the request remains as it was provided.
+func withCursorSeekFilter(
+	filter immutable.Option[request.Filter],
+	selectRequest *request.Select,
+	schema client.SchemaDescription,
+	cursor immutable.Option[Cursor],
+	direction request.OrderDirection,
+) immutable.Option[request.Filter] {
+	if !cursor.HasValue() || !selectRequest.OrderBy.HasValue() {
+		return filter
+	}
+	firstCondition := selectRequest.OrderBy.Value().Conditions[0]
+	// Nil values sort before all others, so the documents following the cursor are only
+	// guaranteed to be greater than or equal to it in this direction.
+	if firstCondition.Direction != direction || len(firstCondition.Fields) != 1 {
+		return filter
+	}
+	fieldDesc, ok := schema.GetField(firstCondition.Fields[0])
+	if !ok {
+		return filter
+	}
+	value, ok := seekValue(fieldDesc.Kind, cursor.Value().Values[0])
+	if !ok {
+		return filter
+	}
+
+	condition := map[string]any{
+		fieldDesc.Name: map[string]any{
+			"_ge": value,
+		},
+	}
+	if filter.HasValue() {
+		condition = map[string]any{
+			request.FilterOpAnd: []any{
+				filter.Value().Conditions,
+				condition,
+			},
+		}
+	}
+	return immutable.Some(request.Filter{
+		Conditions: condition,
+	})
+}
+
+// seekValue returns the given cursor value as a filter value of the given field kind.
+//
+// Only the kinds whose index encoding preserves the order of their values may be sought to,
+// for the others it returns false.
+func seekValue(kind client.FieldKind, value any) (any, bool) {
+	switch kind {
+	case client.FieldKind_BIGINT:
+		switch v := value.(type) {
+		case json.Number:
+			return new(big.Int).SetString(v.String(), 10)
+		case string:
+			return new(big.Int).SetString(v, 10)
+		}
+	case client.FieldKind_DECIMAL:
+		switch v := value.(type) {
+		case json.Number:
+			d, err := decimal.NewFromString(v.String())
+			return d, err == nil
+		case string:
+			d, err := decimal.NewFromString(v)
+			return d, err == nil
+		}
+	case client.FieldKind_DATETIME:
+		if v, ok := value.(string); ok {
+			t, err := time.Parse(time.RFC3339Nano, v)
+			return t, err == nil
+		}
+	case client.FieldKind_STRING:
+		v, ok := value.(string)
+		return v, ok
+	}
+	return nil, false
+}
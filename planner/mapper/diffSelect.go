@@ -0,0 +1,36 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package mapper
+
+// DiffSelect represents a request for the field-level difference between two versions
+// of a document.
+type DiffSelect struct {
+	// The underlying Select, defining the information requested.
+	Select
+
+	// The CID of the document commit of the version to diff from.
+	From string
+
+	// The CID of the document commit of the version to diff to.
+	To string
+}
+
+func (s *DiffSelect) CloneTo(index int) Requestable {
+	return s.cloneTo(index)
+}
+
+func (s *DiffSelect) cloneTo(index int) *DiffSelect {
+	return &DiffSelect{
+		Select: *s.Select.cloneTo(index),
+		From:   s.From,
+		To:     s.To,
+	}
+}
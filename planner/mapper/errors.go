@@ -13,22 +13,39 @@ package mapper
 import "github.com/sourcenetwork/defradb/errors"
 
 const (
-	errInvalidFieldToGroupBy string = "invalid field value to groupBy"
-	errTypeNotFound          string = "type not found"
+	errInvalidFieldToGroupBy  string = "invalid field value to groupBy"
+	errInvalidFieldToDistinct string = "invalid field value to distinct"
+	errTypeNotFound           string = "type not found"
+	errInvalidCursor          string = "invalid cursor"
+	errAggregateNotRequested  string = "aggregates must be requested in order to be filtered upon"
 )
 
 var (
-	ErrUnableToIdAggregateChild = errors.New("unable to identify aggregate child")
-	ErrAggregateTargetMissing   = errors.New("aggregate must be provided with a property to aggregate")
-	ErrFailedToFindHostField    = errors.New("failed to find host field")
-	ErrInvalidFieldIndex        = errors.New("given field doesn't have any indexes")
-	ErrMissingSelect            = errors.New("missing target select field")
+	ErrUnableToIdAggregateChild  = errors.New("unable to identify aggregate child")
+	ErrAggregateTargetMissing    = errors.New("aggregate must be provided with a property to aggregate")
+	ErrFailedToFindHostField     = errors.New("failed to find host field")
+	ErrInvalidFieldIndex         = errors.New("given field doesn't have any indexes")
+	ErrMissingSelect             = errors.New("missing target select field")
+	ErrCursorWithGroupBy         = errors.New("cursors cannot be used with groupBy")
+	ErrPageInfoWithinChildSelect = errors.New("_pageInfo may only be requested on top-level selects")
 )
 
 func NewErrInvalidFieldToGroupBy(field string) error {
 	return errors.New(errInvalidFieldToGroupBy, errors.NewKV("Field", field))
 }
 
+func NewErrInvalidFieldToDistinct(field string) error {
+	return errors.New(errInvalidFieldToDistinct, errors.NewKV("Field", field))
+}
+
 func NewErrTypeNotFound(name string) error {
 	return errors.New(errTypeNotFound, errors.NewKV("Type", name))
 }
+
+func NewErrInvalidCursor(cursor string) error {
+	return errors.New(errInvalidCursor, errors.NewKV("Cursor", cursor))
+}
+
+func NewErrAggregateFilterTargetNotRequested(name string) error {
+	return errors.New(errAggregateNotRequested, errors.NewKV("Aggregate", name))
+}
@@ -16,6 +16,7 @@ import (
 	"strings"
 
 	"github.com/sourcenetwork/immutable"
+	"golang.org/x/exp/slices"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
@@ -68,6 +69,11 @@ func toSelect(
 		return nil, err
 	}
 
+	aggregates, err = resolveFilterAggregates(selectRequest, schema, mapping, aggregates)
+	if err != nil {
+		return nil, err
+	}
+
 	// Needs to be done before resolving aggregates, else filter conversion may fail there
 	filterDependencies, err := resolveFilterDependencies(
 		ctx, store, collectionName, selectRequest.Filter, mapping, fields)
@@ -83,6 +89,12 @@ func toSelect(
 		return nil, err
 	}
 
+	// Resolve distinct dependencies that may have been missed due to not being rendered.
+	err = resolveDistinctDependencies(selectRequest, schema, mapping, &fields)
+	if err != nil {
+		return nil, err
+	}
+
 	aggregates = appendUnderlyingAggregates(aggregates, mapping)
 	fields, err = resolveAggregates(
 		ctx,
@@ -106,25 +118,15 @@ func toSelect(
 		}
 	}
 
-	// Resolve groupBy mappings i.e. alias remapping and handle missed inner group.
+	// Resolve groupBy mappings i.e. alias remapping, derived keys and handle missed inner group.
+	var groupBy *GroupBy
 	if selectRequest.GroupBy.HasValue() {
-		groupByFields := selectRequest.GroupBy.Value().Fields
-		// Remap all alias field names to use their internal field name mappings.
-		for index, groupByField := range groupByFields {
-			fieldDesc, ok := schema.GetField(groupByField)
-			if ok && fieldDesc.IsObject() && !fieldDesc.IsObjectArray() {
-				groupByFields[index] = groupByField + request.RelatedObjectID
-			} else if ok && fieldDesc.IsObjectArray() {
-				return nil, NewErrInvalidFieldToGroupBy(groupByField)
-			}
+		groupBy, err = resolveGroupByDependencies(
+			ctx, store, collectionName, selectRequest.GroupBy.Value(), schema, mapping, &fields)
+		if err != nil {
+			return nil, err
 		}
 
-		selectRequest.GroupBy = immutable.Some(
-			request.GroupBy{
-				Fields: groupByFields,
-			},
-		)
-
 		// If there is a groupBy, and no inner group has been requested, we need to map the property here
 		if _, isGroupFieldMapped := mapping.IndexesByName[request.GroupFieldName]; !isGroupFieldMapped {
 			index := mapping.GetNextIndex()
@@ -132,10 +134,16 @@ func toSelect(
 		}
 	}
 
+	targetable, err := toTargetable(thisIndex, selectRequest, schema, mapping, groupBy)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Select{
-		Targetable:      toTargetable(thisIndex, selectRequest, mapping),
+		Targetable:      targetable,
 		DocumentMapping: mapping,
 		Cid:             selectRequest.CID,
+		AsOf:            selectRequest.AsOf,
 		CollectionName:  collectionName,
 		Fields:          fields,
 	}, nil
@@ -210,6 +218,220 @@ outer:
 	return nil
 }
 
+// resolveGroupByDependencies converts the given groupBy request into a [GroupBy].
+//
+// Related object fields are remapped to their id fields, and any related objects that group
+// keys are derived from are joined, even if they have not been requested.
+func resolveGroupByDependencies(
+	ctx context.Context,
+	store client.Store,
+	descName string,
+	source request.GroupBy,
+	schema client.SchemaDescription,
+	mapping *core.DocumentMapping,
+	existingFields *[]Requestable,
+) (*GroupBy, error) {
+	fields := make([]GroupByField, 0, len(source.Fields)+len(source.Keys))
+	for _, fieldName := range source.Fields {
+		field, err := toGroupByField(fieldName, schema, mapping)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, field)
+	}
+	for _, key := range source.Keys {
+		field, err := toDerivedGroupByField(ctx, store, descName, key, schema, mapping, existingFields)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, field)
+	}
+
+	return &GroupBy{
+		Fields: fields,
+	}, nil
+}
+
+// toGroupByField converts the given groupBy field name into a [GroupByField].
+func toGroupByField(
+	fieldName string,
+	schema client.SchemaDescription,
+	mapping *core.DocumentMapping,
+) (GroupByField, error) {
+	if fieldDesc, ok := schema.GetField(fieldName); ok {
+		if fieldDesc.IsObjectArray() {
+			return GroupByField{}, NewErrInvalidFieldToGroupBy(fieldName)
+		}
+		if fieldDesc.IsObject() {
+			fieldName = fieldName + request.RelatedObjectID
+		}
+	}
+	// Fields that are not described by the schema, such as those of commits, are grouped by as-is.
+	return GroupByField{
+		Field: Field{
+			Index: mapping.FirstIndexOfName(fieldName),
+			Name:  fieldName,
+		},
+	}, nil
+}
+
+// toDerivedGroupByField converts the given group key derived from a field into a [GroupByField].
+//
+// The field may belong to a single related object, and DateTime values may be truncated.
+func toDerivedGroupByField(
+	ctx context.Context,
+	store client.Store,
+	descName string,
+	key request.GroupByKey,
+	schema client.SchemaDescription,
+	mapping *core.DocumentMapping,
+	existingFields *[]Requestable,
+) (GroupByField, error) {
+	name := strings.Join(append(slices.Clone(key.RelationPath), key.Field), ".")
+
+	switch len(key.RelationPath) {
+	case 0:
+		field, err := toGroupByField(key.Field, schema, mapping)
+		if err != nil {
+			return GroupByField{}, err
+		}
+		if key.Truncation.HasValue() {
+			fieldDesc, ok := schema.GetField(key.Field)
+			if !ok || fieldDesc.Kind != client.FieldKind_DATETIME {
+				return GroupByField{}, NewErrInvalidFieldToGroupBy(name)
+			}
+			field.Truncation = key.Truncation
+		}
+		return field, nil
+
+	case 1:
+		relationName := key.RelationPath[0]
+		fieldDesc, ok := schema.GetField(relationName)
+		if !ok || !fieldDesc.IsObject() || fieldDesc.IsObjectArray() {
+			return GroupByField{}, NewErrInvalidFieldToGroupBy(name)
+		}
+
+		relatedCollection, err := store.GetCollectionByName(ctx, fieldDesc.Schema)
+		if err != nil {
+			return GroupByField{}, err
+		}
+		relatedFieldDesc, ok := relatedCollection.Schema().GetField(key.Field)
+		if !ok || relatedFieldDesc.IsObject() {
+			return GroupByField{}, NewErrInvalidFieldToGroupBy(name)
+		}
+		if key.Truncation.HasValue() && relatedFieldDesc.Kind != client.FieldKind_DATETIME {
+			return GroupByField{}, NewErrInvalidFieldToGroupBy(name)
+		}
+
+		// ensure the child select is resolved for this group key
+		innerSelect, err := resolveChildOrder(ctx, store, descName, relationName, mapping, existingFields)
+		if err != nil {
+			return GroupByField{}, err
+		}
+
+		relatedFieldIndexes := innerSelect.IndexesByName[key.Field]
+		if len(relatedFieldIndexes) == 0 {
+			return GroupByField{}, NewErrInvalidFieldToGroupBy(name)
+		}
+		relatedField := Field{
+			Index: relatedFieldIndexes[0],
+			Name:  key.Field,
+		}
+		innerSelect.Fields = append(innerSelect.Fields, &relatedField)
+		appendRelatedFieldToGroupSelects(relationName, key.Field, *existingFields)
+
+		return GroupByField{
+			Field: Field{
+				Index: mapping.FirstIndexOfName(relationName),
+				Name:  name,
+			},
+			Truncation:   key.Truncation,
+			RelatedField: immutable.Some(relatedField),
+		}, nil
+
+	default:
+		// Only the fields of directly related objects may be grouped by.
+		return GroupByField{}, NewErrInvalidFieldToGroupBy(name)
+	}
+}
+
+// appendRelatedFieldToGroupSelects ensures that any `_group` selects that join the given relation
+// also select the given related field.
+//
+// The `_group` selects yield the same documents as their host, and their joins may replace those
+// of the host before the group keys are generated.
+func appendRelatedFieldToGroupSelects(relationName string, relatedFieldName string, fields []Requestable) {
+	for _, field := range fields {
+		groupSelect, ok := field.(*Select)
+		if !ok || groupSelect.Name != request.GroupFieldName {
+			continue
+		}
+		for _, groupField := range groupSelect.Fields {
+			relationSelect, ok := groupField.(*Select)
+			if !ok || relationSelect.Name != relationName {
+				continue
+			}
+			relatedFieldIndexes := relationSelect.IndexesByName[relatedFieldName]
+			if len(relatedFieldIndexes) == 0 {
+				continue
+			}
+			relationSelect.Fields = append(relationSelect.Fields, &Field{
+				Index: relatedFieldIndexes[0],
+				Name:  relatedFieldName,
+			})
+		}
+	}
+}
+
+// resolveDistinctDependencies remaps the distinct fields of the given select to their internal
+// names and ensures that they are included in the select, so that their values are available
+// to de-duplicate by even if they have not been requested.
+func resolveDistinctDependencies(
+	selectRequest *request.Select,
+	schema client.SchemaDescription,
+	mapping *core.DocumentMapping,
+	existingFields *[]Requestable,
+) error {
+	if !selectRequest.Distinct.HasValue() {
+		return nil
+	}
+
+	distinctFields := make([]string, len(selectRequest.Distinct.Value().Fields))
+	for i, fieldName := range selectRequest.Distinct.Value().Fields {
+		distinctName, err := toDistinctFieldName(fieldName, schema)
+		if err != nil {
+			return err
+		}
+		distinctFields[i] = distinctName
+
+		*existingFields = append(*existingFields, &Field{
+			Index: mapping.FirstIndexOfName(distinctName),
+			Name:  distinctName,
+		})
+	}
+
+	selectRequest.Distinct = immutable.Some(
+		request.Distinct{
+			Fields: distinctFields,
+		},
+	)
+	return nil
+}
+
+// toDistinctFieldName returns the internal name of the field by which to de-duplicate, given
+// the name known by the consumer.
+//
+// Related objects are de-duplicated by their ids, related object arrays may not be used.
+func toDistinctFieldName(fieldName string, schema client.SchemaDescription) (string, error) {
+	fieldDesc, ok := schema.GetField(fieldName)
+	if ok && fieldDesc.IsObjectArray() {
+		return "", NewErrInvalidFieldToDistinct(fieldName)
+	} else if ok && fieldDesc.IsObject() {
+		return fieldName + request.RelatedObjectID, nil
+	}
+	return fieldName, nil
+}
+
 // given a type join field, ensure its mapping exists
 // and add a coorsponding select field(s)
 func resolveChildOrder(
@@ -439,9 +661,45 @@ func resolveAggregates(
 				}
 			}
 
+			var distinctTarget OptionalChildTarget
+			if target.distinct.HasValue() {
+				hostSelect, isHostSelectable := host.AsSelect()
+				if !isHostSelectable {
+					return nil, client.NewErrUnhandledType("host", host)
+				}
+
+				hostSchema := schema
+				if target.hostExternalName != request.GroupFieldName {
+					hostCollection, err := store.GetCollectionByName(ctx, hostSelect.CollectionName)
+					if err != nil {
+						return nil, err
+					}
+					hostSchema = hostCollection.Schema()
+				}
+
+				distinctName, err := toDistinctFieldName(target.distinct.Value(), hostSchema)
+				if err != nil {
+					return nil, err
+				}
+
+				distinctField := &Field{
+					Index: hostSelect.DocumentMapping.FirstIndexOfName(distinctName),
+					Name:  distinctName,
+				}
+				// ensure the distinct field is included in the type join
+				hostSelect.Fields = append(hostSelect.Fields, distinctField)
+
+				distinctTarget = OptionalChildTarget{
+					Index:    distinctField.Index,
+					Name:     distinctField.Name,
+					HasValue: true,
+				}
+			}
+
 			aggregateTargets[i] = AggregateTarget{
-				Targetable:  *hostTarget,
-				ChildTarget: childTarget,
+				Targetable:     *hostTarget,
+				ChildTarget:    childTarget,
+				DistinctTarget: distinctTarget,
 			}
 		}
 
@@ -628,7 +886,31 @@ func getRequestables(
 				Index: index,
 				Key:   getRenderKey(f),
 			})
+		case *request.PageInfo:
+			// The page info has already been mapped by getTopLevelInfo
+			index := mapping.FirstIndexOfName(f.Name)
+
+			properties := make([]PageInfoProperty, len(f.Fields))
+			for i, property := range f.Fields {
+				properties[i] = PageInfoProperty{
+					Name: property.Name,
+					Key:  getRenderKey(&property),
+				}
+			}
+			// The page info is not rendered as a property of the documents, so it has no
+			// render key.
+			fields = append(fields, &PageInfo{
+				Field: Field{
+					Index: index,
+					Name:  f.Name,
+				},
+				Key:        getRenderKey(&f.Field),
+				Properties: properties,
+			})
 		case *request.Select:
+			if hasPageInfo(f) {
+				return nil, nil, ErrPageInfoWithinChildSelect
+			}
 			index := mapping.GetNextIndex()
 
 			innerSelect, err := toSelect(ctx, store, index, f, collectionName)
@@ -706,7 +988,7 @@ func getCollectionName(
 
 	if selectRequest.Name == request.GroupFieldName {
 		return parentCollectionName, nil
-	} else if selectRequest.Root == request.CommitSelection {
+	} else if selectRequest.Root == request.CommitSelection || selectRequest.Root == request.DiffSelection {
 		return parentCollectionName, nil
 	}
 
@@ -781,10 +1063,37 @@ func getTopLevelInfo(
 		mapping.SetTypeName(collectionName)
 
 		mapping.Add(mapping.GetNextIndex(), request.DeletedFieldName)
+		mapping.Add(mapping.GetNextIndex(), request.CursorFieldName)
+		mapping.Add(mapping.GetNextIndex(), request.PageInfoFieldName)
+		// The subscription event fields are only resolved on subscriptions, and are null otherwise.
+		mapping.Add(mapping.GetNextIndex(), request.OperationFieldName)
+		mapping.Add(mapping.GetNextIndex(), request.ChangedFieldsFieldName)
 
 		return mapping, schema, nil
 	}
 
+	if selectRequest.Root == request.DiffSelection {
+		if selectRequest.Name == request.DiffFieldsFieldName {
+			for i, f := range request.DiffFieldFields {
+				mapping.Add(i, f)
+			}
+
+			// Setting the type name must be done after adding the fields, as
+			// the typeName index is dynamic, but the field indexes are not
+			mapping.SetTypeName(request.DiffFieldTypeName)
+		} else {
+			for i, f := range request.DiffFields {
+				mapping.Add(i, f)
+			}
+
+			// Setting the type name must be done after adding the fields, as
+			// the typeName index is dynamic, but the field indexes are not
+			mapping.SetTypeName(request.DiffTypeName)
+		}
+
+		return mapping, client.SchemaDescription{}, nil
+	}
+
 	if selectRequest.Name == request.LinksFieldName {
 		for i, f := range request.LinksFields {
 			mapping.Add(i, f)
@@ -861,7 +1170,7 @@ func resolveInnerFilterDependencies(
 				newFields = append(newFields, innerFields...)
 			}
 			continue
-		} else if key == request.FilterOpNot {
+		} else if key == request.FilterOpNot || key == request.FilterOpSome {
 			notFilter := source[key].(map[string]any)
 			innerFields, err := resolveInnerFilterDependencies(
 				ctx,
@@ -879,6 +1188,9 @@ func resolveInnerFilterDependencies(
 			resolvedFields = append(resolvedFields, innerFields...)
 			newFields = append(newFields, innerFields...)
 			continue
+		} else if isAggregateFilterKey(key, mapping) {
+			// Aggregates are resolved separately, along with their dependencies.
+			continue
 		}
 
 		propertyMapped := len(mapping.IndexesByName[key]) != 0
@@ -1076,6 +1388,23 @@ func ToCommitSelect(
 	}, nil
 }
 
+// ToDiffSelect converts the given [request.DiffSelect] into a [DiffSelect].
+func ToDiffSelect(
+	ctx context.Context,
+	store client.Store,
+	selectRequest *request.DiffSelect,
+) (*DiffSelect, error) {
+	underlyingSelect, err := ToSelect(ctx, store, selectRequest.ToSelect())
+	if err != nil {
+		return nil, err
+	}
+	return &DiffSelect{
+		Select: *underlyingSelect,
+		From:   selectRequest.From,
+		To:     selectRequest.To,
+	}, nil
+}
+
 // ToMutation converts the given [request.Mutation] into a [Mutation].
 //
 // In the process of doing so it will construct the document map required to access the data
@@ -1090,19 +1419,47 @@ func ToMutation(ctx context.Context, store client.Store, mutationRequest *reques
 		Select: *underlyingSelect,
 		Type:   MutationType(mutationRequest.Type),
 		Input:  mutationRequest.Input,
+		CID:    mutationRequest.CID,
 	}, nil
 }
 
-func toTargetable(index int, selectRequest *request.Select, docMap *core.DocumentMapping) Targetable {
+func toTargetable(
+	index int,
+	selectRequest *request.Select,
+	schema client.SchemaDescription,
+	docMap *core.DocumentMapping,
+	groupBy *GroupBy,
+) (Targetable, error) {
+	limit := toLimit(selectRequest.Limit, selectRequest.Offset)
+	orderBy := toOrderBy(selectRequest.OrderBy, docMap)
+	filter := selectRequest.Filter
+
+	if isPaginated(selectRequest) {
+		if selectRequest.GroupBy.HasValue() {
+			return Targetable{}, ErrCursorWithGroupBy
+		}
+
+		orderBy = appendDocIDOrder(orderBy)
+		var err error
+		limit, err = toPaginatedLimit(selectRequest, orderBy)
+		if err != nil {
+			return Targetable{}, err
+		}
+
+		filter = withCursorSeekFilter(filter, selectRequest, schema, limit.After, request.ASC)
+		filter = withCursorSeekFilter(filter, selectRequest, schema, limit.Before, request.DESC)
+	}
+
 	return Targetable{
 		Field:       toField(index, selectRequest),
 		DocIDs:      selectRequest.DocIDs,
-		Filter:      ToFilter(selectRequest.Filter.Value(), docMap),
-		Limit:       toLimit(selectRequest.Limit, selectRequest.Offset),
-		GroupBy:     toGroupBy(selectRequest.GroupBy, docMap),
-		OrderBy:     toOrderBy(selectRequest.OrderBy, docMap),
+		Filter:      ToFilter(filter.Value(), docMap),
+		Limit:       limit,
+		GroupBy:     groupBy,
+		Distinct:    toDistinct(selectRequest.Distinct, docMap),
+		OrderBy:     orderBy,
 		ShowDeleted: selectRequest.ShowDeleted,
-	}
+	}, nil
 }
 
 func toField(index int, selectRequest *request.Select) Field {
@@ -1141,7 +1498,8 @@ func toFilterMap(
 	sourceClause any,
 	mapping *core.DocumentMapping,
 ) (connor.FilterKey, any) {
-	if strings.HasPrefix(sourceKey, "_") && sourceKey != request.DocIDFieldName {
+	if strings.HasPrefix(sourceKey, "_") && sourceKey != request.DocIDFieldName &&
+		!isAggregateFilterKey(sourceKey, mapping) {
 		key := &Operator{
 			Operation: sourceKey,
 		}
@@ -1194,7 +1552,14 @@ func toFilterMap(
 					// If the innerSourceValue is also a map, then we should parse the nested clause
 					// using the child mapping, as this key must refer to a host property in a join
 					// and deeper keys must refer to properties on the child items.
-					innerMapping = mapping.ChildMappings[index]
+					//
+					// Inline array operators also contain maps, but their keys are all operators
+					// that have no need for a child mapping.
+					var hasChildMapping bool
+					innerMapping, hasChildMapping = tryGetChildMapping(mapping, index)
+					if !hasChildMapping || innerMapping == nil {
+						innerMapping = mapping
+					}
 				default:
 					innerMapping = mapping
 				}
@@ -1229,26 +1594,20 @@ func toLimit(limit immutable.Option[uint64], offset immutable.Option[uint64]) *L
 	}
 }
 
-func toGroupBy(source immutable.Option[request.GroupBy], mapping *core.DocumentMapping) *GroupBy {
+func toDistinct(source immutable.Option[request.Distinct], mapping *core.DocumentMapping) *Distinct {
 	if !source.HasValue() {
 		return nil
 	}
 
 	fields := make([]Field, len(source.Value().Fields))
 	for i, fieldName := range source.Value().Fields {
-		// If there are multiple properties of the same name we can just take the first as
-		// we have no other reasonable way of identifying which property they mean if multiple
-		// consumer specified requestables are available.  Aggregate dependencies should not
-		// impact this as they are added after selects.
-		key := mapping.FirstIndexOfName(fieldName)
-
 		fields[i] = Field{
-			Index: key,
+			Index: mapping.FirstIndexOfName(fieldName),
 			Name:  fieldName,
 		}
 	}
 
-	return &GroupBy{
+	return &Distinct{
 		Fields: fields,
 	}
 }
@@ -1327,7 +1686,18 @@ func (l *Limit) equal(other *Limit) bool {
 		return l == nil
 	}
 
-	return l.Limit == other.Limit && l.Offset == other.Offset
+	return l.Limit == other.Limit &&
+		l.Offset == other.Offset &&
+		l.Paginated == other.Paginated &&
+		cursorEqual(l.After, other.After) &&
+		cursorEqual(l.Before, other.Before)
+}
+
+func cursorEqual(c immutable.Option[Cursor], other immutable.Option[Cursor]) bool {
+	if !c.HasValue() || !other.HasValue() {
+		return c.HasValue() == other.HasValue()
+	}
+	return reflect.DeepEqual(c.Value(), other.Value())
 }
 
 func (f *Filter) equal(other *Filter) bool {
@@ -1456,6 +1826,10 @@ type aggregateRequestTarget struct {
 	// The order in which items should be aggregated. Affects results when used with
 	// limit. Optional.
 	order immutable.Option[request.OrderBy]
+
+	// The name of the child property by which items should be de-duplicated before
+	// being aggregated, as known by the consumer. Optional.
+	distinct immutable.Option[string]
 }
 
 // Returns the source of the aggregate as requested by the consumer
@@ -1469,6 +1843,7 @@ func getAggregateSources(field *request.Aggregate) ([]*aggregateRequestTarget, e
 			filter:            target.Filter,
 			limit:             toLimit(target.Limit, target.Offset),
 			order:             target.OrderBy,
+			distinct:          target.Distinct,
 		}
 	}
 
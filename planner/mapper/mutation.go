@@ -10,6 +10,8 @@
 
 package mapper
 
+import "github.com/sourcenetwork/immutable"
+
 type MutationType int
 
 const (
@@ -17,6 +19,7 @@ const (
 	CreateObjects
 	UpdateObjects
 	DeleteObjects
+	RevertObjects
 )
 
 // Mutation represents a request to mutate data stored in Defra.
@@ -29,6 +32,9 @@ type Mutation struct {
 
 	// Input is the map of fields and values used for the mutation.
 	Input map[string]any
+
+	// CID is the version to revert the document to, if this is a revert mutation.
+	CID immutable.Option[string]
 }
 
 func (m *Mutation) CloneTo(index int) Requestable {
@@ -40,5 +46,6 @@ func (m *Mutation) cloneTo(index int) *Mutation {
 		Select: *m.Select.cloneTo(index),
 		Type:   m.Type,
 		Input:  m.Input,
+		CID:    m.CID,
 	}
 }
@@ -0,0 +1,44 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package mapper
+
+// PageInfo represents a request for information about the page of documents
+// yielded by the host Select.
+//
+// The page info is rendered once per request, alongside the documents, rather than as
+// a property of each document.
+type PageInfo struct {
+	Field
+
+	// The key with which the page info will be rendered.
+	Key string
+
+	// The page information properties requested, in the order they were requested.
+	Properties []PageInfoProperty
+}
+
+// PageInfoProperty is a page information property, such as 'hasNextPage', requested
+// by the consumer.
+type PageInfoProperty struct {
+	// The name of the property.
+	Name string
+
+	// The key with which the property will be rendered.
+	Key string
+}
+
+func (p *PageInfo) CloneTo(index int) Requestable {
+	return &PageInfo{
+		Field:      *p.Field.cloneTo(index),
+		Key:        p.Key,
+		Properties: p.Properties,
+	}
+}
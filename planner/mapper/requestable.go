@@ -45,5 +45,6 @@ var (
 	_ Requestable = (*CommitSelect)(nil)
 	_ Requestable = (*Field)(nil)
 	_ Requestable = (*Mutation)(nil)
+	_ Requestable = (*PageInfo)(nil)
 	_ Requestable = (*Select)(nil)
 )
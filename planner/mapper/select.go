@@ -13,6 +13,7 @@ package mapper
 import (
 	"github.com/sourcenetwork/immutable"
 
+	"github.com/sourcenetwork/defradb/client/request"
 	"github.com/sourcenetwork/defradb/core"
 )
 
@@ -30,6 +31,9 @@ type Select struct {
 	// A commit identifier that can be specified to request data at a given time.
 	Cid immutable.Option[string]
 
+	// An optional point in time, if specified data will be returned as it was at that time.
+	AsOf immutable.Option[request.AsOfTime]
+
 	// The name of the collection that this Select selects data from.
 	CollectionName string
 
@@ -57,6 +61,7 @@ func (s *Select) cloneTo(index int) *Select {
 		Targetable:      *s.Targetable.cloneTo(index),
 		DocumentMapping: s.DocumentMapping,
 		Cid:             s.Cid,
+		AsOf:            s.AsOf,
 		CollectionName:  s.CollectionName,
 		Fields:          s.Fields,
 	}
@@ -129,7 +129,8 @@ func filterObjectToMap(mapping *core.DocumentMapping, obj map[connor.FilterKey]a
 					logicMapEntries[i] = filterObjectToMap(mapping, itemMap)
 				}
 				outmap[keyType.Operation] = logicMapEntries
-			case request.FilterOpNot:
+			case request.FilterOpNot, request.FilterOpAny, request.FilterOpAll, request.FilterOpNone,
+				request.FilterOpSome:
 				itemMap := v.(map[connor.FilterKey]any)
 				outmap[keyType.Operation] = filterObjectToMap(mapping, itemMap)
 			default:
@@ -156,11 +157,49 @@ type Limit struct {
 	// The offset from which counting towards the Limit will begin.
 	// Before records before the Offset will not be returned.
 	Offset uint64
+
+	// Paginated is true if the request uses cursors, in which case each record will be
+	// given a cursor and the page info may be requested.
+	Paginated bool
+
+	// If provided, only records following the position of this cursor will be returned.
+	After immutable.Option[Cursor]
+
+	// If provided, only records preceding the position of this cursor will be returned.
+	Before immutable.Option[Cursor]
+
+	// Reversed is true if only a before cursor is provided, in which case the records are
+	// read in the reverse of the requested order, so that those immediately preceding the
+	// cursor are read first.
+	Reversed bool
 }
 
 // GroupBy represents a grouping instruction on a request.
 type GroupBy struct {
-	// The indexes of fields by which documents should be grouped. Ordered.
+	// The keys by which documents should be grouped. Ordered.
+	Fields []GroupByField
+}
+
+// GroupByField represents a single key by which documents should be grouped.
+//
+// The key may be the value of a field directly, or it may be derived from it.
+type GroupByField struct {
+	// The name of the key and the index of the field that it is derived from.
+	Field
+
+	// An optional unit that the DateTime value of the field should be truncated to
+	// before grouping.
+	Truncation immutable.Option[request.GroupByTruncation]
+
+	// An optional field of the related object held at the field index, if set the
+	// value of this field will be grouped by instead of the related object.
+	RelatedField immutable.Option[Field]
+}
+
+// Distinct represents a de-duplication instruction on a request.
+type Distinct struct {
+	// The indexes of fields by which documents should be de-duplicated. Only the first
+	// document yielded for each combination of their values will be kept.
 	Fields []Field
 }
 
@@ -187,6 +226,22 @@ type OrderBy struct {
 	Conditions []OrderCondition
 }
 
+// Reverse returns a copy of the order with the direction of each condition reversed.
+func (o *OrderBy) Reverse() *OrderBy {
+	conditions := make([]OrderCondition, len(o.Conditions))
+	for i, condition := range o.Conditions {
+		conditions[i] = condition
+		if condition.Direction == ASC {
+			conditions[i].Direction = DESC
+		} else {
+			conditions[i].Direction = ASC
+		}
+	}
+	return &OrderBy{
+		Conditions: conditions,
+	}
+}
+
 // Targetable represents a targetable property.
 type Targetable struct {
 	// The basic field information of this property.
@@ -208,6 +263,10 @@ type Targetable struct {
 	// value.
 	GroupBy *GroupBy
 
+	// An optional distinct clause, that can be specified to de-duplicate results by
+	// property value.
+	Distinct *Distinct
+
 	// An optional order clause, that can be specified to order results by property
 	// value
 	OrderBy *OrderBy
@@ -222,6 +281,7 @@ func (t *Targetable) cloneTo(index int) *Targetable {
 		Filter:      t.Filter,
 		Limit:       t.Limit,
 		GroupBy:     t.GroupBy,
+		Distinct:    t.Distinct,
 		OrderBy:     t.OrderBy,
 		ShowDeleted: t.ShowDeleted,
 	}
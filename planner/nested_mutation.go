@@ -0,0 +1,483 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"fmt"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+// relationInput holds the nested create, connect and disconnect operations
+// given for a relation field of a mutation input.
+type relationInput struct {
+	field client.FieldDescription
+
+	// create holds the inputs of the related documents to create.
+	create []map[string]any
+
+	// connect holds the IDs of the existing documents to relate to the host document.
+	connect []string
+
+	// disconnect holds the IDs of the related documents to unrelate from the host document.
+	disconnect []string
+
+	// disconnectAll is true if the host document should be unrelated from whatever
+	// document it is currently related to through a one-sided relation.
+	disconnectAll bool
+}
+
+// splitRelationInputs separates the nested relation operations from the rest of the given
+// mutation input, returning a copy of the input without them.
+//
+// Relation fields given a plain ID (the related object id alias) are left in the input.
+func splitRelationInputs(
+	schema client.SchemaDescription,
+	input map[string]any,
+) (map[string]any, []relationInput, error) {
+	fields := make(map[string]any, len(input))
+	relations := []relationInput{}
+
+	for name, value := range input {
+		nested, isNested := value.(map[string]any)
+		fd, exists := schema.GetField(name)
+		if !isNested || !exists || !fd.IsObject() {
+			fields[name] = value
+			continue
+		}
+
+		relation, err := newRelationInput(fd, nested)
+		if err != nil {
+			return nil, nil, err
+		}
+		relations = append(relations, relation)
+	}
+
+	return fields, relations, nil
+}
+
+func newRelationInput(fd client.FieldDescription, nested map[string]any) (relationInput, error) {
+	relation := relationInput{
+		field: fd,
+	}
+
+	for operation, value := range nested {
+		if value == nil {
+			continue
+		}
+		switch operation {
+		case request.RelationCreate:
+			if fd.IsObjectArray() {
+				items, ok := value.([]any)
+				if !ok {
+					return relationInput{}, client.NewErrUnexpectedType[[]any](fd.Name, value)
+				}
+				for _, item := range items {
+					itemInput, ok := item.(map[string]any)
+					if !ok {
+						return relationInput{}, client.NewErrUnexpectedType[map[string]any](fd.Name, item)
+					}
+					relation.create = append(relation.create, itemInput)
+				}
+			} else {
+				itemInput, ok := value.(map[string]any)
+				if !ok {
+					return relationInput{}, client.NewErrUnexpectedType[map[string]any](fd.Name, value)
+				}
+				relation.create = append(relation.create, itemInput)
+			}
+
+		case request.RelationConnect:
+			ids, err := relationInputIDs(fd, value)
+			if err != nil {
+				return relationInput{}, err
+			}
+			relation.connect = ids
+
+		case request.RelationDisconnect:
+			if fd.IsObjectArray() {
+				ids, err := relationInputIDs(fd, value)
+				if err != nil {
+					return relationInput{}, err
+				}
+				relation.disconnect = ids
+			} else {
+				disconnect, ok := value.(bool)
+				if !ok {
+					return relationInput{}, client.NewErrUnexpectedType[bool](fd.Name, value)
+				}
+				relation.disconnectAll = disconnect
+			}
+
+		default:
+			return relationInput{}, NewErrUnknownRelationOperation(fd.Name, operation)
+		}
+	}
+
+	if !fd.IsObjectArray() {
+		operations := len(relation.create) + len(relation.connect)
+		if relation.disconnectAll {
+			operations++
+		}
+		if operations > 1 {
+			return relationInput{}, NewErrMultipleRelationOperations(fd.Name)
+		}
+	}
+
+	return relation, nil
+}
+
+// relationInputIDs returns the document IDs given as the value of a connect or disconnect operation.
+func relationInputIDs(fd client.FieldDescription, value any) ([]string, error) {
+	if !fd.IsObjectArray() {
+		id, ok := value.(string)
+		if !ok {
+			return nil, client.NewErrUnexpectedType[string](fd.Name, value)
+		}
+		return []string{id}, nil
+	}
+
+	items, ok := value.([]any)
+	if !ok {
+		return nil, client.NewErrUnexpectedType[[]any](fd.Name, value)
+	}
+	ids := make([]string, len(items))
+	for i, item := range items {
+		id, ok := item.(string)
+		if !ok {
+			return nil, client.NewErrUnexpectedType[string](fd.Name, item)
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// holdsRelationID returns true if the host document of the given relation has a field for the ID
+// of the related document, in which case the relation is resolved by setting it before the host
+// document is written.
+//
+// Note that the collection applies the ID set on the secondary side of a one-to-one relation to
+// the primary document.
+func (r relationInput) holdsRelationID(schema client.SchemaDescription) bool {
+	if r.field.IsObjectArray() {
+		return false
+	}
+	_, ok := schema.GetField(r.field.Name + request.RelatedObjectID)
+	return ok
+}
+
+// isResolvedAfterWrite returns true if the given relation must be resolved after the host
+// document has been written.
+func (r relationInput) isResolvedAfterWrite(schema client.SchemaDescription) bool {
+	return !r.holdsRelationID(schema) || (r.disconnectAll && !r.field.IsPrimaryRelation())
+}
+
+// createWithRelations creates a document from the given input, executing any nested relation
+// operations that it contains within the planner's transaction.
+func (p *Planner) createWithRelations(
+	col client.Collection,
+	input map[string]any,
+) (*client.Document, error) {
+	fields, relations, err := splitRelationInputs(col.Schema(), input)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.applyRelationIDInputs(col, fields, relations)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := client.NewDocFromMapWithDefaults(fields, col.Schema())
+	if err != nil {
+		return nil, err
+	}
+
+	err = col.WithTxn(p.txn).Create(p.ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.applyRelatedDocInputs(col, doc.ID().String(), relations)
+	if err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// applyRelationIDInputs executes the nested operations of the relations for which the host
+// document holds the related document ID, setting the resulting IDs on the given fields.
+func (p *Planner) applyRelationIDInputs(
+	col client.Collection,
+	fields map[string]any,
+	relations []relationInput,
+) error {
+	for _, relation := range relations {
+		if !relation.holdsRelationID(col.Schema()) {
+			continue
+		}
+		idFieldName := relation.field.Name + request.RelatedObjectID
+
+		switch {
+		case len(relation.create) > 0:
+			relatedCol, err := p.getRelatedCollection(relation.field)
+			if err != nil {
+				return err
+			}
+			relatedDoc, err := p.createWithRelations(relatedCol, relation.create[0])
+			if err != nil {
+				return err
+			}
+			fields[idFieldName] = relatedDoc.ID().String()
+
+		case len(relation.connect) > 0:
+			fields[idFieldName] = relation.connect[0]
+
+		case relation.disconnectAll && relation.field.IsPrimaryRelation():
+			fields[idFieldName] = nil
+		}
+	}
+	return nil
+}
+
+// applyRelatedDocInputs executes the nested operations of the relations for which the related
+// documents hold the host document ID. It must be called after the host document has been written.
+func (p *Planner) applyRelatedDocInputs(
+	col client.Collection,
+	hostDocID string,
+	relations []relationInput,
+) error {
+	for _, relation := range relations {
+		if !relation.isResolvedAfterWrite(col.Schema()) {
+			continue
+		}
+
+		relatedCol, err := p.getRelatedCollection(relation.field)
+		if err != nil {
+			return err
+		}
+		relatedSchema := relatedCol.Schema()
+		relatedField, ok := relatedCol.Description().GetFieldByRelation(
+			relation.field.RelationName,
+			col.Name(),
+			relation.field.Name,
+			&relatedSchema,
+		)
+		if !ok {
+			return client.NewErrFieldNotExist(relation.field.RelationName)
+		}
+
+		if relation.field.IsManyToMany() {
+			err := p.applyManyToManyInputs(hostDocID, relatedCol, relatedField, relation)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		idFieldName := relatedField.Name + request.RelatedObjectID
+
+		if relation.disconnectAll {
+			_, err := relatedCol.UpdateWithFilter(
+				p.ctx,
+				fmt.Sprintf(`{%s: {_eq: "%s"}}`, idFieldName, hostDocID),
+				fmt.Sprintf(`{"%s": null}`, idFieldName),
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, id := range relation.disconnect {
+			err := p.setRelatedDocID(relatedCol, id, idFieldName, hostDocID, nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, id := range relation.connect {
+			err := p.setRelatedDocID(relatedCol, id, idFieldName, "", hostDocID)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, relatedInput := range relation.create {
+			fields := make(map[string]any, len(relatedInput)+1)
+			for name, value := range relatedInput {
+				fields[name] = value
+			}
+			fields[idFieldName] = hostDocID
+
+			_, err := p.createWithRelations(relatedCol, fields)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// applyManyToManyInputs executes the nested operations of a many-to-many relation by linking
+// and unlinking the host document and the related documents.
+func (p *Planner) applyManyToManyInputs(
+	hostDocID string,
+	relatedCol client.Collection,
+	relatedField client.FieldDescription,
+	relation relationInput,
+) error {
+	linkCol, err := p.db.GetCollectionByName(p.ctx, client.LinkCollectionName(relation.field.RelationName))
+	if err != nil {
+		return err
+	}
+	link := documentLink{
+		col:          linkCol.WithTxn(p.txn),
+		hostField:    client.LinkFieldName(relatedField),
+		hostDocID:    hostDocID,
+		relatedField: client.LinkFieldName(relation.field),
+	}
+
+	for _, id := range relation.disconnect {
+		err := p.setLinked(link, id, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, id := range relation.connect {
+		// the related document must exist to be linked to
+		docID, err := client.NewDocIDFromString(id)
+		if err != nil {
+			return err
+		}
+		_, err = relatedCol.Get(p.ctx, docID, false)
+		if err != nil {
+			return err
+		}
+		err = p.setLinked(link, id, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, relatedInput := range relation.create {
+		relatedDoc, err := p.createWithRelations(relatedCol, relatedInput)
+		if err != nil {
+			return err
+		}
+		err = p.setLinked(link, relatedDoc.ID().String(), true)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// documentLink describes the links of a host document held by the link collection of
+// a many-to-many relation.
+type documentLink struct {
+	col client.Collection
+
+	// hostField is the name of the link collection field holding the host document ID.
+	hostField string
+	hostDocID string
+
+	// relatedField is the name of the link collection field holding the related document IDs.
+	relatedField string
+}
+
+// setLinked links, or unlinks, the host document of the given link and the given related document.
+//
+// The link document of a pair of documents has the same ID whichever side it was created from, and
+// is updated rather than deleted when unlinking so that the documents may be linked again.
+func (p *Planner) setLinked(link documentLink, relatedDocID string, linked bool) error {
+	doc, err := client.NewDocFromMap(
+		map[string]any{
+			link.hostField:         link.hostDocID,
+			link.relatedField:      relatedDocID,
+			client.LinkedFieldName: true,
+		},
+		link.col.Schema(),
+	)
+	if err != nil {
+		return err
+	}
+
+	existing, err := link.col.Get(p.ctx, doc.ID(), false)
+	if errors.Is(err, client.ErrDocumentNotFound) {
+		if !linked {
+			return NewErrDocumentNotRelated(relatedDocID, link.hostDocID)
+		}
+		return link.col.Create(p.ctx, doc)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !linked {
+		current, err := existing.Get(client.LinkedFieldName)
+		if err != nil || current != true {
+			return NewErrDocumentNotRelated(relatedDocID, link.hostDocID)
+		}
+	}
+
+	err = existing.Set(client.LinkedFieldName, linked)
+	if err != nil {
+		return err
+	}
+	return link.col.Update(p.ctx, existing)
+}
+
+// setRelatedDocID sets the relation ID field of the given related document to the given value.
+//
+// If expectedDocID is not empty the related document must currently be related to it.
+func (p *Planner) setRelatedDocID(
+	relatedCol client.Collection,
+	relatedDocID string,
+	idFieldName string,
+	expectedDocID string,
+	value any,
+) error {
+	docID, err := client.NewDocIDFromString(relatedDocID)
+	if err != nil {
+		return err
+	}
+	doc, err := relatedCol.Get(p.ctx, docID, false)
+	if err != nil {
+		return err
+	}
+
+	if expectedDocID != "" {
+		current, err := doc.Get(idFieldName)
+		if err != nil || current != expectedDocID {
+			return NewErrDocumentNotRelated(relatedDocID, expectedDocID)
+		}
+	}
+
+	err = doc.Set(idFieldName, value)
+	if err != nil {
+		return err
+	}
+	return relatedCol.Update(p.ctx, doc)
+}
+
+// getRelatedCollection returns the collection on the other side of the given relation field,
+// bound to the planner's transaction.
+func (p *Planner) getRelatedCollection(fd client.FieldDescription) (client.Collection, error) {
+	col, err := p.db.GetCollectionByName(p.ctx, fd.Schema)
+	if err != nil {
+		return nil, err
+	}
+	return col.WithTxn(p.txn), nil
+}
@@ -16,12 +16,15 @@ var (
 	_ planNode = (*createNode)(nil)
 	_ planNode = (*dagScanNode)(nil)
 	_ planNode = (*deleteNode)(nil)
+	_ planNode = (*distinctNode)(nil)
 	_ planNode = (*groupNode)(nil)
+	_ planNode = (*havingNode)(nil)
 	_ planNode = (*limitNode)(nil)
 	_ planNode = (*multiScanNode)(nil)
 	_ planNode = (*orderNode)(nil)
 	_ planNode = (*parallelNode)(nil)
 	_ planNode = (*pipeNode)(nil)
+	_ planNode = (*revertNode)(nil)
 	_ planNode = (*scanNode)(nil)
 	_ planNode = (*selectNode)(nil)
 	_ planNode = (*selectTopNode)(nil)
@@ -29,6 +32,7 @@ var (
 	_ planNode = (*topLevelNode)(nil)
 	_ planNode = (*typeIndexJoin)(nil)
 	_ planNode = (*typeJoinMany)(nil)
+	_ planNode = (*typeJoinManyToMany)(nil)
 	_ planNode = (*typeJoinOne)(nil)
 	_ planNode = (*updateNode)(nil)
 	_ planNode = (*valuesNode)(nil)
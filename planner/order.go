@@ -77,6 +77,9 @@ func (p *Planner) OrderBy(parsed *mapper.Select, n *mapper.OrderBy) (*orderNode,
 	if n == nil { // no orderby info
 		return nil, nil
 	}
+	if parsed.Limit != nil && parsed.Limit.Reversed {
+		n = n.Reverse()
+	}
 
 	return &orderNode{
 		p:         p,
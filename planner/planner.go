@@ -135,6 +135,13 @@ func (p *Planner) newPlan(stmt any) (planNode, error) {
 		}
 		return p.CommitSelect(m)
 
+	case *request.DiffSelect:
+		m, err := mapper.ToDiffSelect(p.ctx, p.db, n)
+		if err != nil {
+			return nil, err
+		}
+		return p.DiffSelect(m)
+
 	case *request.ObjectMutation:
 		m, err := mapper.ToMutation(p.ctx, p.db, n)
 		if err != nil {
@@ -157,6 +164,9 @@ func (p *Planner) newObjectMutationPlan(stmt *mapper.Mutation) (planNode, error)
 	case mapper.DeleteObjects:
 		return p.DeleteDocs(stmt)
 
+	case mapper.RevertObjects:
+		return p.RevertDoc(stmt)
+
 	default:
 		return nil, client.NewErrUnhandledType("mutation", stmt.Type)
 	}
@@ -237,6 +247,9 @@ func (p *Planner) expandPlan(planNode planNode, parentPlan *selectTopNode) error
 	case *deleteNode:
 		return p.expandPlan(n.source, parentPlan)
 
+	case *revertNode:
+		return p.expandPlan(n.results, parentPlan)
+
 	case *viewNode:
 		return p.expandPlan(n.source, parentPlan)
 
@@ -264,14 +277,25 @@ func (p *Planner) expandSelectTopNodePlan(plan *selectTopNode, parentPlan *selec
 
 	p.expandAggregatePlans(plan)
 
+	// if filtered by aggregates
+	if plan.having != nil {
+		plan.having.plan = plan.planNode
+		plan.planNode = plan.having
+	}
+
 	// if order
 	if plan.order != nil {
 		plan.order.plan = plan.planNode
 		plan.planNode = plan.order
 	}
 
+	if plan.distinct != nil {
+		plan.distinct.plan = plan.planNode
+		plan.planNode = plan.distinct
+	}
+
 	if plan.limit != nil {
-		p.expandLimitPlan(plan, parentPlan)
+		return p.expandLimitPlan(plan, parentPlan)
 	}
 
 	return nil
@@ -308,6 +332,11 @@ func (p *Planner) expandTypeIndexJoinPlan(plan *typeIndexJoin, parentPlan *selec
 		return p.expandTypeJoin(&node.invertibleTypeJoin, parentPlan)
 	case *typeJoinMany:
 		return p.expandTypeJoin(&node.invertibleTypeJoin, parentPlan)
+	case *typeJoinManyToMany:
+		if err := p.expandPlan(node.link, parentPlan); err != nil {
+			return err
+		}
+		return p.expandPlan(node.subType, parentPlan)
 	}
 	return client.NewErrUnhandledType("join plan", plan.joinPlan)
 }
@@ -442,20 +471,24 @@ func (p *Planner) expandGroupNodePlan(topNodeSelect *selectTopNode) error {
 	return nil
 }
 
-func (p *Planner) expandLimitPlan(topNodeSelect *selectTopNode, parentPlan *selectTopNode) {
+func (p *Planner) expandLimitPlan(topNodeSelect *selectTopNode, parentPlan *selectTopNode) error {
 	if topNodeSelect.limit == nil {
-		return
+		return nil
 	}
 
 	// Limits get more complicated with groups and have to be handled internally, so we ensure
 	// any limit topNodeSelect is disabled here
 	if parentPlan != nil && parentPlan.group != nil && len(parentPlan.group.childSelects) != 0 {
+		if topNodeSelect.limit.paginated {
+			return ErrCursorWithinGroup
+		}
 		topNodeSelect.limit = nil
-		return
+		return nil
 	}
 
 	topNodeSelect.limit.plan = topNodeSelect.planNode
 	topNodeSelect.planNode = topNodeSelect.limit
+	return nil
 }
 
 // walkAndReplace walks through the provided plan, and searches for an instance
@@ -481,6 +514,8 @@ func (p *Planner) walkAndReplacePlan(planNode, target, replace planNode) error {
 		node.replaceRoot(replace)
 	case *typeJoinMany:
 		node.replaceRoot(replace)
+	case *typeJoinManyToMany:
+		node.root = replace
 	case *pipeNode:
 		/* Do nothing - pipe nodes should not be replaced */
 	// @todo: add more nodes that apply here
@@ -512,14 +547,14 @@ func walkAndFindPlanType[T planNode](planNode planNode) (T, bool) {
 func (p *Planner) executeRequest(
 	ctx context.Context,
 	planNode planNode,
-) ([]map[string]any, error) {
+) ([]map[string]any, map[string]any, error) {
 	if err := planNode.Start(); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	hasNext, err := planNode.Next()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	docs := []map[string]any{}
@@ -531,20 +566,29 @@ func (p *Planner) executeRequest(
 
 		hasNext, err = planNode.Next()
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
-	return docs, err
+
+	// The page info describes the page as a whole, so it is rendered once, alongside the documents.
+	var extensions map[string]any
+	if key, pageInfo, ok := renderedPageInfoOf(planNode); ok {
+		extensions = map[string]any{key: pageInfo}
+	}
+	return docs, extensions, err
 }
 
 // RunRequest classifies the type of request to run, runs it, and then returns the result(s).
+//
+// Any information about the result that is not part of the resultant documents, such as the
+// page info of a paginated request, is returned in the extensions.
 func (p *Planner) RunRequest(
 	ctx context.Context,
 	req *request.Request,
-) (result []map[string]any, err error) {
+) (result []map[string]any, extensions map[string]any, err error) {
 	planNode, err := p.makePlan(req)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	defer func() {
@@ -555,20 +599,22 @@ func (p *Planner) RunRequest(
 
 	err = planNode.Init()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Ensure subscription request doesn't ever end up with an explain directive.
 	if len(req.Subscription) > 0 && req.Subscription[0].Directives.ExplainType.HasValue() {
-		return nil, ErrCantExplainSubscriptionRequest
+		return nil, nil, ErrCantExplainSubscriptionRequest
 	}
 
 	if len(req.Queries) > 0 && req.Queries[0].Directives.ExplainType.HasValue() {
-		return p.explainRequest(ctx, planNode, req.Queries[0].Directives.ExplainType.Value())
+		result, err := p.explainRequest(ctx, planNode, req.Queries[0].Directives.ExplainType.Value())
+		return result, nil, err
 	}
 
 	if len(req.Mutations) > 0 && req.Mutations[0].Directives.ExplainType.HasValue() {
-		return p.explainRequest(ctx, planNode, req.Mutations[0].Directives.ExplainType.Value())
+		result, err := p.explainRequest(ctx, planNode, req.Mutations[0].Directives.ExplainType.Value())
+		return result, nil, err
 	}
 
 	// This won't / should NOT execute if it's any kind of explain request.
@@ -596,7 +642,7 @@ func (p *Planner) RunSubscriptionRequest(
 		return nil, err
 	}
 
-	data, err := p.executeRequest(ctx, planNode)
+	data, _, err := p.executeRequest(ctx, planNode)
 	if err != nil {
 		return nil, err
 	}
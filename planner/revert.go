@@ -0,0 +1,139 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"github.com/ipfs/go-cid"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/planner/mapper"
+)
+
+type revertNode struct {
+	documentIterator
+	docMapper
+
+	p *Planner
+
+	collection client.Collection
+
+	docID   string
+	version string
+
+	isReverting bool
+
+	results planNode
+
+	execInfo revertExecInfo
+}
+
+type revertExecInfo struct {
+	// Total number of times revertNode was executed.
+	iterations uint64
+}
+
+func (n *revertNode) Next() (bool, error) {
+	n.execInfo.iterations++
+
+	if n.isReverting {
+		docID, err := client.NewDocIDFromString(n.docID)
+		if err != nil {
+			return false, err
+		}
+		version, err := cid.Decode(n.version)
+		if err != nil {
+			return false, err
+		}
+		err = n.collection.Revert(n.p.ctx, docID, version)
+		if err != nil {
+			return false, err
+		}
+		n.isReverting = false
+
+		// Re-init the results node, so that the reverted values are yielded
+		err = n.results.Init()
+		if err != nil {
+			return false, err
+		}
+	}
+
+	next, err := n.results.Next()
+	if err != nil {
+		return false, err
+	}
+	if !next {
+		return false, nil
+	}
+
+	n.currentValue = n.results.Value()
+	return true, nil
+}
+
+func (n *revertNode) Kind() string { return "revertNode" }
+
+func (n *revertNode) Spans(spans core.Spans) { n.results.Spans(spans) }
+
+func (n *revertNode) Init() error { return n.results.Init() }
+
+func (n *revertNode) Start() error { return n.results.Start() }
+
+func (n *revertNode) Close() error { return n.results.Close() }
+
+func (n *revertNode) Source() planNode { return n.results }
+
+// Explain method returns a map containing all attributes of this node that
+// are to be explained, subscribes / opts-in this node to be an explainablePlanNode.
+func (n *revertNode) Explain(explainType request.ExplainType) (map[string]any, error) {
+	switch explainType {
+	case request.SimpleExplain:
+		return map[string]any{
+			request.DocIDArgName: n.docID,
+			request.Cid:          n.version,
+		}, nil
+
+	case request.ExecuteExplain:
+		return map[string]any{
+			"iterations": n.execInfo.iterations,
+		}, nil
+
+	default:
+		return nil, ErrUnknownExplainRequestType
+	}
+}
+
+func (p *Planner) RevertDoc(parsed *mapper.Mutation) (planNode, error) {
+	docIDs := parsed.DocIDs.Value()
+	if len(docIDs) != 1 || !parsed.CID.HasValue() {
+		return nil, ErrMissingRevertArgs
+	}
+
+	col, err := p.db.GetCollectionByName(p.ctx, parsed.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	resultsNode, err := p.Select(&parsed.Select)
+	if err != nil {
+		return nil, err
+	}
+
+	return &revertNode{
+		p:           p,
+		collection:  col.WithTxn(p.txn),
+		docID:       docIDs[0],
+		version:     parsed.CID.Value(),
+		isReverting: true,
+		results:     resultsNode,
+		docMapper:   docMapper{parsed.DocumentMapping},
+	}, nil
+}
@@ -51,6 +51,16 @@ type scanNode struct {
 	filter *mapper.Filter
 	slct   *mapper.Select
 
+	// The computed fields to set on each document, and the conditions referencing them,
+	// which may only be evaluated once they have been set.
+	computedFields []computedField
+	computedFilter *mapper.Filter
+	// The relations whose related documents computed fields are computed from, by the name
+	// of the relation field, and the fetched documents whose computed fields have been set,
+	// that are yet to be yielded.
+	computedRelations map[string]*computedRelation
+	computedBatch     []core.Doc
+
 	fetcher fetcher.Fetcher
 
 	execInfo scanExecInfo
@@ -90,11 +100,17 @@ func (n *scanNode) initFields(fields []mapper.Requestable) error {
 		switch requestable := r.(type) {
 		// field is simple as its just a base level field
 		case *mapper.Field:
-			n.tryAddField(requestable.GetName())
+			_, err := n.tryAddField(requestable.GetName())
+			if err != nil {
+				return err
+			}
 		// select might have its own select fields and filters fields
 		case *mapper.Select:
-			n.tryAddField(requestable.Field.Name + request.RelatedObjectID) // foreign key for type joins
-			err := n.initFields(requestable.Fields)
+			_, err := n.tryAddField(requestable.Field.Name + request.RelatedObjectID) // foreign key for type joins
+			if err != nil {
+				return err
+			}
+			err = n.initFields(requestable.Fields)
 			if err != nil {
 				return err
 			}
@@ -110,13 +126,19 @@ func (n *scanNode) initFields(fields []mapper.Requestable) error {
 						return err
 					}
 					for _, fd := range fieldDescs {
-						n.tryAddField(fd.Name)
+						_, err := n.tryAddField(fd.Name)
+						if err != nil {
+							return err
+						}
 					}
 				}
+				fieldName := target.Field.Name
 				if target.ChildTarget.HasValue {
-					n.tryAddField(target.ChildTarget.Name)
-				} else {
-					n.tryAddField(target.Field.Name)
+					fieldName = target.ChildTarget.Name
+				}
+				_, err := n.tryAddField(fieldName)
+				if err != nil {
+					return err
 				}
 			}
 		}
@@ -124,25 +146,45 @@ func (n *scanNode) initFields(fields []mapper.Requestable) error {
 	return nil
 }
 
-func (n *scanNode) tryAddField(fieldName string) bool {
+func (n *scanNode) tryAddField(fieldName string) (bool, error) {
 	fd, ok := n.col.Schema().GetField(fieldName)
 	if !ok {
 		// skip fields that are not part of the
 		// schema description. The scanner (and fetcher)
 		// is only responsible for basic fields
-		return false
+		return false, nil
 	}
 	n.fields = append(n.fields, fd)
-	return true
+	if fd.IsComputed() {
+		err := n.tryAddComputedField(fd)
+		if err != nil {
+			return false, err
+		}
+	}
+	return true, nil
 }
 
 func (scan *scanNode) initFetcher(
 	cid immutable.Option[string],
+	asOf immutable.Option[request.AsOfTime],
 	indexedField immutable.Option[client.FieldDescription],
-) {
+) error {
+	err := scan.splitComputedFilter()
+	if err != nil {
+		return err
+	}
+	err = scan.initComputedRelations(cid, asOf)
+	if err != nil {
+		return err
+	}
+
 	var f fetcher.Fetcher
 	if cid.HasValue() {
 		f = new(fetcher.VersionedFetcher)
+	} else if asOf.HasValue() {
+		// indexes hold the current state of the documents, so cannot be used to find
+		// their past state
+		f = fetcher.NewAsOfFetcher(asOf.Value())
 	} else {
 		f = new(fetcher.DocumentFetcher)
 
@@ -160,12 +202,19 @@ func (scan *scanNode) initFetcher(
 		f = lens.NewFetcher(f, scan.p.db.LensRegistry())
 	}
 	scan.fetcher = f
+	return nil
 }
 
 // Start starts the internal logic of the scanner
 // like the DocumentFetcher, and more.
 func (n *scanNode) Start() error {
-	return nil // no op
+	for _, relation := range n.computedRelations {
+		err := relation.plan.Start()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (n *scanNode) initScan() error {
@@ -173,6 +222,7 @@ func (n *scanNode) initScan() error {
 		start := base.MakeDataStoreKeyWithCollectionDescription(n.col.Description())
 		n.spans = core.NewSpans(core.NewSpan(start, start.PrefixEnd()))
 	}
+	n.computedBatch = nil
 
 	err := n.fetcher.Start(n.p.ctx, n.spans)
 	if err != nil {
@@ -192,19 +242,19 @@ func (n *scanNode) Next() (bool, error) {
 		return false, nil
 	}
 
-	doc, execInfo, err := n.fetcher.FetchNext(n.p.ctx)
-	if err != nil {
-		return false, err
-	}
-	n.execInfo.fetches.Add(execInfo)
-
-	if doc == nil {
-		return false, nil
-	}
+	for {
+		hasNext, err := n.fetchNext()
+		if err != nil || !hasNext {
+			return false, err
+		}
 
-	n.currentValue, err = fetcher.DecodeToDoc(doc, n.documentMapping, false)
-	if err != nil {
-		return false, err
+		passed, err := mapper.RunFilter(n.currentValue, n.computedFilter)
+		if err != nil {
+			return false, err
+		}
+		if passed {
+			break
+		}
 	}
 
 	n.documentMapping.SetFirstOfName(
@@ -221,6 +271,12 @@ func (n *scanNode) Spans(spans core.Spans) {
 }
 
 func (n *scanNode) Close() error {
+	for _, relation := range n.computedRelations {
+		err := relation.plan.Close()
+		if err != nil {
+			return err
+		}
+	}
 	return n.fetcher.Close()
 }
 
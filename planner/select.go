@@ -51,8 +51,10 @@ type selectTopNode struct {
 
 	group      *groupNode
 	order      *orderNode
+	distinct   *distinctNode
 	limit      *limitNode
 	aggregates []aggregateNode
+	having     *havingNode
 
 	// selectNode is used pre-wiring of the plan (before expansion and all).
 	selectNode *selectNode
@@ -290,7 +292,10 @@ func (n *selectNode) initSource() ([]aggregateNode, error) {
 	}
 
 	if isScanNode {
-		origScan.initFetcher(n.selectReq.Cid, findFilteredByIndexedField(origScan))
+		err = origScan.initFetcher(n.selectReq.Cid, n.selectReq.AsOf, findFilteredByIndexedField(origScan))
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return aggregates, nil
@@ -373,6 +378,10 @@ func (n *selectNode) initFields(selectReq *mapper.Select) ([]aggregateNode, erro
 				// commit query link fields are always added and need no special treatment here
 				// WARNING: It is important to check collection name is nil and the parent select name
 				// here else we risk falsely identifying user defined fields with the name `links` as a commit links field
+			} else if f.Name == request.DiffFieldsFieldName && selectReq.Name == request.DiffName &&
+				f.CollectionName == "" {
+				// no-op
+				// diff query fields are always added and need no special treatment here
 			} else if n.collection.Description().BaseQuery == nil {
 				// Views only contain embedded objects and don't require a traditional join here
 				err := n.addTypeIndexJoin(f)
@@ -406,6 +415,7 @@ func (p *Planner) SelectFromSource(
 	fromCollection bool,
 	collection client.Collection,
 ) (planNode, error) {
+	having := splitHavingFilter(selectReq)
 	s := &selectNode{
 		planner:    p,
 		source:     source,
@@ -452,12 +462,19 @@ func (p *Planner) SelectFromSource(
 		return nil, err
 	}
 
+	distinctPlan, err := p.Distinct(selectReq, selectReq.Distinct)
+	if err != nil {
+		return nil, err
+	}
+
 	top := &selectTopNode{
 		selectNode: s,
 		limit:      limitPlan,
 		order:      orderPlan,
+		distinct:   distinctPlan,
 		group:      groupPlan,
 		aggregates: aggregates,
+		having:     p.Having(selectReq, having),
 		docMapper:  docMapper{selectReq.DocumentMapping},
 	}
 	return top, nil
@@ -465,6 +482,7 @@ func (p *Planner) SelectFromSource(
 
 // Select constructs a SelectPlan
 func (p *Planner) Select(selectReq *mapper.Select) (planNode, error) {
+	having := splitHavingFilter(selectReq)
 	s := &selectNode{
 		planner:   p,
 		filter:    selectReq.Filter,
@@ -496,12 +514,19 @@ func (p *Planner) Select(selectReq *mapper.Select) (planNode, error) {
 		return nil, err
 	}
 
+	distinctPlan, err := p.Distinct(selectReq, selectReq.Distinct)
+	if err != nil {
+		return nil, err
+	}
+
 	top := &selectTopNode{
 		selectNode: s,
 		limit:      limitPlan,
 		order:      orderPlan,
+		distinct:   distinctPlan,
 		group:      groupPlan,
 		aggregates: aggregates,
+		having:     p.Having(selectReq, having),
 		docMapper:  docMapper{selectReq.DocumentMapping},
 	}
 	return top, nil
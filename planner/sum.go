@@ -11,6 +11,9 @@
 package planner
 
 import (
+	"math/big"
+
+	"github.com/shopspring/decimal"
 	"github.com/sourcenetwork/immutable"
 	"github.com/sourcenetwork/immutable/enumerable"
 
@@ -224,6 +227,11 @@ func (n *sumNode) Next() (bool, error) {
 	n.currentValue = n.plan.Value()
 
 	sum := float64(0)
+	// Arbitrary precision values are summed separately so that they do not lose
+	// precision by being converted to floats.
+	bigSum := decimal.Zero
+	hasBig := false
+	hasDecimal := false
 
 	for _, source := range n.aggregateMapping {
 		child := n.currentValue.Fields[source.Index]
@@ -242,6 +250,15 @@ func (n *sumNode) Next() (bool, error) {
 					return float64(v)
 				case float64:
 					return v
+				case *big.Int:
+					bigSum = bigSum.Add(decimal.NewFromBigInt(v, 0))
+					hasBig = true
+					return 0
+				case decimal.Decimal:
+					bigSum = bigSum.Add(v)
+					hasBig = true
+					hasDecimal = true
+					return 0
 				default:
 					// return nothing, cannot be summed
 					return 0
@@ -300,9 +317,14 @@ func (n *sumNode) Next() (bool, error) {
 	}
 
 	var typedSum any
-	if n.isFloat {
+	switch {
+	case hasBig && (n.isFloat || hasDecimal):
+		typedSum = bigSum.Add(decimal.NewFromFloat(sum))
+	case hasBig:
+		typedSum = bigSum.Add(decimal.NewFromFloat(sum)).BigInt()
+	case n.isFloat:
 		typedSum = sum
-	} else {
+	default:
 		typedSum = int64(sum)
 	}
 	n.currentValue.Fields[n.virtualFieldIndex] = typedSum
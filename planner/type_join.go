@@ -12,6 +12,7 @@ package planner
 
 import (
 	"github.com/sourcenetwork/immutable"
+	"golang.org/x/exp/slices"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
@@ -91,7 +92,9 @@ func (p *Planner) makeTypeIndexJoin(
 		joinPlan, err = p.makeTypeJoinOne(parent, source, subType)
 	} else if schema.IsOneToMany(meta) { // Many side of One-to-Many
 		joinPlan, err = p.makeTypeJoinMany(parent, source, subType)
-	} else { // more to come, Many-to-Many, Embedded?
+	} else if schema.IsManyToMany(meta) { // Many-to-Many
+		joinPlan, err = p.makeTypeJoinManyToMany(parent, source, subType, typeFieldDesc)
+	} else { // more to come, Embedded?
 		return nil, ErrUnknownRelationType
 	}
 	if err != nil {
@@ -183,6 +186,19 @@ func (n *typeIndexJoin) simpleExplain() (map[string]any, error) {
 		// Add the joined (subType) type's entire explain graph.
 		simpleExplainMap[joinSubTypeLabel] = subTypeExplainGraph
 
+	case *typeJoinManyToMany:
+		// Add the attribute(s).
+		simpleExplainMap[joinRootLabel] = joinType.rootName
+		simpleExplainMap[joinSubTypeNameLabel] = joinType.subTypeName
+
+		subTypeExplainGraph, err := buildSimpleExplainGraph(joinType.subType)
+		if err != nil {
+			return nil, err
+		}
+
+		// Add the joined (subType) type's entire explain graph.
+		simpleExplainMap[joinSubTypeLabel] = subTypeExplainGraph
+
 	default:
 		return simpleExplainMap, client.NewErrUnhandledType("join plan", n.joinPlan)
 	}
@@ -208,6 +224,11 @@ func (n *typeIndexJoin) Explain(explainType request.ExplainType) (map[string]any
 		if joinOne, isJoinOne := n.joinPlan.(*typeJoinOne); isJoinOne {
 			subScan = getScanNode(joinOne.subType)
 		}
+		var linkScan *scanNode
+		if joinManyToMany, isJoinManyToMany := n.joinPlan.(*typeJoinManyToMany); isJoinManyToMany {
+			subScan = getScanNode(joinManyToMany.subType)
+			linkScan = getScanNode(joinManyToMany.link)
+		}
 		if subScan != nil {
 			subScanExplain, err := subScan.Explain(explainType)
 			if err != nil {
@@ -215,6 +236,13 @@ func (n *typeIndexJoin) Explain(explainType request.ExplainType) (map[string]any
 			}
 			result["subTypeScanNode"] = subScanExplain
 		}
+		if linkScan != nil {
+			linkScanExplain, err := linkScan.Explain(explainType)
+			if err != nil {
+				return nil, err
+			}
+			result["linkScanNode"] = linkScanExplain
+		}
 		return result, nil
 
 	default:
@@ -342,6 +370,7 @@ func prepareScanNodeFilterForTypeJoin(
 	subType *mapper.Select,
 ) {
 	subType.ShowDeleted = parent.selectReq.ShowDeleted
+	subType.AsOf = parent.selectReq.AsOf
 
 	scan, ok := source.(*scanNode)
 	if !ok || scan.filter == nil {
@@ -431,6 +460,255 @@ func (n *typeJoinMany) Kind() string {
 	return "typeJoinMany"
 }
 
+// typeJoinManyToMany is the plan node for a type index join on either side of a
+// many-to-many relation.
+//
+// The related documents are found through the documents of the hidden link collection
+// of the relation that reference the root document and are still linked.
+type typeJoinManyToMany struct {
+	documentIterator
+	docMapper
+
+	root    planNode
+	subType planNode
+	// link is the plan selecting the linked documents of the link collection.
+	link planNode
+
+	subSelect   *mapper.Select
+	rootName    string
+	subTypeName string
+
+	// rootLinkField is the name of the link collection field holding the root document IDs.
+	rootLinkField string
+	// subTypeLinkField is the name of the link collection field holding the related document IDs.
+	subTypeLinkField string
+}
+
+func (p *Planner) makeTypeJoinManyToMany(
+	parent *selectNode,
+	source planNode,
+	subType *mapper.Select,
+	subTypeFieldDesc client.FieldDescription,
+) (*typeJoinManyToMany, error) {
+	prepareScanNodeFilterForTypeJoin(parent, source, subType)
+
+	selectPlan, err := p.Select(subType)
+	if err != nil {
+		return nil, err
+	}
+
+	subTypeCol, err := p.db.GetCollectionByName(p.ctx, subType.CollectionName)
+	if err != nil {
+		return nil, err
+	}
+	subTypeSchema := subTypeCol.Schema()
+
+	rootField, rootNameFound := subTypeCol.Description().GetFieldByRelation(
+		subTypeFieldDesc.RelationName,
+		parent.collection.Name(),
+		subTypeFieldDesc.Name,
+		&subTypeSchema,
+	)
+	if !rootNameFound {
+		return nil, client.NewErrFieldNotExist(subTypeFieldDesc.RelationName)
+	}
+
+	rootLinkField := client.LinkFieldName(rootField)
+	subTypeLinkField := client.LinkFieldName(subTypeFieldDesc)
+	linkPlan, err := p.makeLinkSelectPlan(
+		subTypeFieldDesc.RelationName,
+		parent.selectReq.AsOf,
+		rootLinkField,
+		subTypeLinkField,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &typeJoinManyToMany{
+		docMapper:        docMapper{parent.documentMapping},
+		root:             source,
+		subType:          selectPlan,
+		link:             linkPlan,
+		subSelect:        subType,
+		rootName:         rootField.Name,
+		subTypeName:      subType.Name,
+		rootLinkField:    rootLinkField,
+		subTypeLinkField: subTypeLinkField,
+	}, nil
+}
+
+// makeLinkSelectPlan returns a plan selecting the given fields of the linked documents of the
+// link collection of the given many-to-many relation, as they were at the given time if any.
+func (p *Planner) makeLinkSelectPlan(
+	relationName string,
+	asOf immutable.Option[request.AsOfTime],
+	fieldNames ...string,
+) (planNode, error) {
+	fields := make([]request.Selection, len(fieldNames))
+	for i, fieldName := range fieldNames {
+		fields[i] = &request.Field{Name: fieldName}
+	}
+
+	linkSelect, err := mapper.ToSelect(p.ctx, p.db, &request.Select{
+		Field: request.Field{
+			Name: client.LinkCollectionName(relationName),
+		},
+		Fields: fields,
+		AsOf:   asOf,
+		Filter: immutable.Some(request.Filter{
+			Conditions: map[string]any{
+				client.LinkedFieldName: map[string]any{
+					mapper.FilterEqOp.Operation: true,
+				},
+			},
+		}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Select(linkSelect)
+}
+
+func (n *typeJoinManyToMany) Kind() string {
+	return "typeJoinManyToMany"
+}
+
+func (n *typeJoinManyToMany) Init() error {
+	if err := n.subType.Init(); err != nil {
+		return err
+	}
+	if err := n.link.Init(); err != nil {
+		return err
+	}
+	return n.root.Init()
+}
+
+func (n *typeJoinManyToMany) Start() error {
+	if err := n.subType.Start(); err != nil {
+		return err
+	}
+	if err := n.link.Start(); err != nil {
+		return err
+	}
+	return n.root.Start()
+}
+
+func (n *typeJoinManyToMany) Spans(spans core.Spans) {
+	n.root.Spans(spans)
+}
+
+func (n *typeJoinManyToMany) Close() error {
+	if err := n.root.Close(); err != nil {
+		return err
+	}
+	if err := n.link.Close(); err != nil {
+		return err
+	}
+	return n.subType.Close()
+}
+
+func (n *typeJoinManyToMany) Source() planNode { return n.root }
+
+func (n *typeJoinManyToMany) Next() (bool, error) {
+	hasValue, err := n.root.Next()
+	if err != nil || !hasValue {
+		return false, err
+	}
+
+	rootDoc := n.root.Value()
+	links, err := fetchLinkDocs(n.link, n.rootLinkField, rootDoc.GetID())
+	if err != nil {
+		return false, err
+	}
+
+	subDocs := []core.Doc{}
+	if len(links) > 0 {
+		scan := getScanNode(n.subType)
+		if scan == nil {
+			return false, ErrMissingChildSelect
+		}
+		linkFieldIndex := n.link.DocumentMap().FirstIndexOfName(n.subTypeLinkField)
+		docIDs := make([]string, 0, len(links))
+		for _, link := range links {
+			docID, ok := link.Fields[linkFieldIndex].(string)
+			if !ok {
+				continue
+			}
+			docIDs = append(docIDs, docID)
+		}
+		// The links are in the order of the link documents, the spans of the related
+		// documents must be in ascending order to be fetched in a single pass.
+		slices.Sort(docIDs)
+		spans := make([]core.Span, len(docIDs))
+		for i, docID := range docIDs {
+			dsKey := base.MakeDataStoreKeyWithCollectionAndDocID(scan.col.Description(), docID)
+			spans[i] = core.NewSpan(dsKey, dsKey.PrefixEnd())
+		}
+
+		n.subType.Spans(core.NewSpans(spans...))
+		if err := n.subType.Init(); err != nil {
+			return false, NewErrSubTypeInit(err)
+		}
+		for {
+			hasSubDoc, err := n.subType.Next()
+			if err != nil {
+				return false, err
+			}
+			if !hasSubDoc {
+				break
+			}
+			subDocs = append(subDocs, n.subType.Value())
+		}
+	}
+
+	rootDoc.Fields[n.subSelect.Index] = subDocs
+	n.currentValue = rootDoc
+
+	return true, nil
+}
+
+// fetchLinkDocs returns the documents of the given link collection plan that hold the given
+// document ID in the given field.
+//
+// The documents are found through the index of the link collection on the field.
+func fetchLinkDocs(link planNode, fieldName string, docID string) ([]core.Doc, error) {
+	scan := getScanNode(link)
+	if scan == nil {
+		return nil, ErrMissingChildSelect
+	}
+	field, ok := scan.col.Schema().GetField(fieldName)
+	if !ok {
+		return nil, client.NewErrFieldNotExist(fieldName)
+	}
+
+	setSubTypeFilterToScanNode(link, link.DocumentMap().FirstIndexOfName(fieldName), docID)
+	err := scan.fetcher.Close()
+	if err != nil {
+		return nil, err
+	}
+	err = scan.initFetcher(immutable.None[string](), scan.slct.AsOf, immutable.Some(field))
+	if err != nil {
+		return nil, err
+	}
+	if err := link.Init(); err != nil {
+		return nil, NewErrSubTypeInit(err)
+	}
+
+	docs := []core.Doc{}
+	for {
+		next, err := link.Next()
+		if err != nil {
+			return nil, err
+		}
+		if !next {
+			return docs, nil
+		}
+		docs = append(docs, link.Value())
+	}
+}
+
 func fetchPrimaryDoc(node, subNode planNode, parentProp string) (bool, error) {
 	subDoc := subNode.Value()
 	ind := subNode.DocumentMap().FirstIndexOfName(parentProp)
@@ -609,9 +887,15 @@ func (join *invertibleTypeJoin) invertJoinDirectionWithIndex(
 	field client.FieldDescription,
 ) error {
 	subScan := getScanNode(join.subType)
-	subScan.tryAddField(join.rootName + request.RelatedObjectID)
+	_, err := subScan.tryAddField(join.rootName + request.RelatedObjectID)
+	if err != nil {
+		return err
+	}
 	subScan.filter = fieldFilter
-	subScan.initFetcher(immutable.Option[string]{}, immutable.Some(field))
+	err = subScan.initFetcher(immutable.Option[string]{}, subScan.slct.AsOf, immutable.Some(field))
+	if err != nil {
+		return err
+	}
 
 	join.invert()
 
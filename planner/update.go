@@ -68,11 +68,7 @@ func (n *updateNode) Next() (bool, error) {
 			if err != nil {
 				return false, err
 			}
-			patch, err := json.Marshal(n.input)
-			if err != nil {
-				return false, err
-			}
-			_, err = n.collection.UpdateWithDocID(n.p.ctx, docID, string(patch))
+			err = n.updateWithRelations(docID)
 			if err != nil {
 				return false, err
 			}
@@ -101,6 +97,31 @@ func (n *updateNode) Next() (bool, error) {
 	return true, nil
 }
 
+// updateWithRelations updates the given document with the node's input, executing any nested
+// relation operations that it contains within the same transaction.
+func (n *updateNode) updateWithRelations(docID client.DocID) error {
+	fields, relations, err := splitRelationInputs(n.collection.Schema(), n.input)
+	if err != nil {
+		return err
+	}
+
+	err = n.p.applyRelationIDInputs(n.collection, fields, relations)
+	if err != nil {
+		return err
+	}
+
+	patch, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	_, err = n.collection.UpdateWithDocID(n.p.ctx, docID, string(patch))
+	if err != nil {
+		return err
+	}
+
+	return n.p.applyRelatedDocInputs(n.collection, docID.String(), relations)
+}
+
 func (n *updateNode) Kind() string { return "updateNode" }
 
 func (n *updateNode) Spans(spans core.Spans) { n.results.Spans(spans) }
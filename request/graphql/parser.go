@@ -65,9 +65,14 @@ func (p *parser) IsIntrospection(ast *ast.Document) bool {
 	return defrap.IsIntrospectionQuery(*schema, ast)
 }
 
-func (p *parser) ExecuteIntrospection(request string) *client.RequestResult {
+func (p *parser) ExecuteIntrospection(request string, options client.GQLOptions) *client.RequestResult {
 	schema := p.schemaManager.Schema()
-	params := gql.Params{Schema: *schema, RequestString: request}
+	params := gql.Params{
+		Schema:         *schema,
+		RequestString:  request,
+		OperationName:  options.OperationName,
+		VariableValues: options.Variables,
+	}
 	r := gql.Do(params)
 
 	res := &client.RequestResult{
@@ -84,17 +89,28 @@ func (p *parser) ExecuteIntrospection(request string) *client.RequestResult {
 	return res
 }
 
-func (p *parser) Parse(ast *ast.Document) (*request.Request, []error) {
+func (p *parser) Parse(ast *ast.Document, options client.GQLOptions) (*request.Request, []error) {
 	schema := p.schemaManager.Schema()
-	validationResult := gql.ValidateDocument(schema, ast, nil)
-	if !validationResult.IsValid {
-		errors := make([]error, len(validationResult.Errors))
-		for i, err := range validationResult.Errors {
-			errors[i] = err
-		}
-		return nil, errors
+	defrap.ApplyRelationIDAliases(*schema, ast)
+
+	errs := validate(schema, ast)
+	if len(errs) > 0 {
+		return nil, errs
 	}
 
+	ast, err := defrap.ApplyVariables(*schema, ast, options.OperationName, options.Variables)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	// The document is validated again now that the variable values are known, as they
+	// may not be valid where they are used.
+	errs = validate(schema, ast)
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	ast = defrap.InlineFragments(ast)
+
 	query, parsingErrors := defrap.ParseRequest(*schema, ast)
 	if len(parsingErrors) > 0 {
 		return nil, parsingErrors
@@ -103,6 +119,18 @@ func (p *parser) Parse(ast *ast.Document) (*request.Request, []error) {
 	return query, nil
 }
 
+func validate(schema *gql.Schema, ast *ast.Document) []error {
+	validationResult := gql.ValidateDocument(schema, ast, nil)
+	if validationResult.IsValid {
+		return nil
+	}
+	errors := make([]error, len(validationResult.Errors))
+	for i, err := range validationResult.Errors {
+		errors[i] = err
+	}
+	return errors
+}
+
 func (p *parser) ParseSDL(ctx context.Context, schemaString string) (
 	[]client.CollectionDefinition,
 	error,
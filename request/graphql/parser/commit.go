@@ -29,6 +29,8 @@ func parseCommitSelect(schema gql.Schema, parent *gql.Object, field *ast.Field)
 		},
 	}
 
+	fieldDef := gql.GetFieldDef(schema, parent, field.Name.Value)
+
 	for _, argument := range field.Arguments {
 		prop := argument.Name.Value
 		if prop == request.DocIDArgName {
@@ -40,6 +42,17 @@ func parseCommitSelect(schema gql.Schema, parent *gql.Object, field *ast.Field)
 		} else if prop == request.FieldIDName {
 			raw := argument.Value.(*ast.StringValue)
 			commit.FieldID = immutable.Some(raw.Value)
+		} else if prop == request.FilterClause {
+			obj := argument.Value.(*ast.ObjectValue)
+			filterType, ok := getArgumentType(fieldDef, request.FilterClause)
+			if !ok {
+				return nil, ErrFilterMissingArgumentType
+			}
+			filter, err := NewFilter(obj, filterType)
+			if err != nil {
+				return nil, err
+			}
+			commit.Filter = filter
 		} else if prop == request.OrderClause {
 			obj := argument.Value.(*ast.ObjectValue)
 			cond, err := ParseConditionsInOrder(obj)
@@ -105,8 +118,6 @@ func parseCommitSelect(schema gql.Schema, parent *gql.Object, field *ast.Field)
 		return commit, nil
 	}
 
-	fieldDef := gql.GetFieldDef(schema, parent, field.Name.Value)
-
 	fieldObject, err := typeFromFieldDef(fieldDef)
 	if err != nil {
 		return nil, err
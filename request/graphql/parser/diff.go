@@ -0,0 +1,51 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package parser
+
+import (
+	gql "github.com/sourcenetwork/graphql-go"
+	"github.com/sourcenetwork/graphql-go/language/ast"
+
+	"github.com/sourcenetwork/defradb/client/request"
+)
+
+func parseDiffSelect(schema gql.Schema, parent *gql.Object, field *ast.Field) (*request.DiffSelect, error) {
+	diff := &request.DiffSelect{
+		Field: request.Field{
+			Name:  field.Name.Value,
+			Alias: getFieldAlias(field),
+		},
+	}
+
+	for _, argument := range field.Arguments {
+		switch argument.Name.Value {
+		case request.DiffFromArgName:
+			diff.From = argument.Value.(*ast.StringValue).Value
+		case request.DiffToArgName:
+			diff.To = argument.Value.(*ast.StringValue).Value
+		}
+	}
+
+	if field.SelectionSet == nil {
+		return diff, nil
+	}
+
+	fieldDef := gql.GetFieldDef(schema, parent, field.Name.Value)
+
+	fieldObject, err := typeFromFieldDef(fieldDef)
+	if err != nil {
+		return nil, err
+	}
+
+	diff.Fields, err = parseSelectFields(schema, request.DiffSelection, fieldObject, field.SelectionSet)
+
+	return diff, err
+}
@@ -12,7 +12,24 @@ package parser
 
 import "github.com/sourcenetwork/defradb/errors"
 
+const (
+	errUnknownOperationName   string = "unknown operation name"
+	errOperationNameRequired  string = "an operation name must be given for requests with several operations"
+	errMissingVariable        string = "required variable was not provided"
+	errInvalidVariableValue   string = "invalid variable value"
+	errUnknownVariableField   string = "unknown field in variable value"
+	errUnsupportedVariableUse string = "variable type is not an input type"
+	errInvalidAsOf            string = "invalid asOf time, expected an RFC 3339 date time"
+)
+
 var (
+	ErrUnknownOperationName           = errors.New(errUnknownOperationName)
+	ErrOperationNameRequired          = errors.New(errOperationNameRequired)
+	ErrMissingVariable                = errors.New(errMissingVariable)
+	ErrInvalidVariableValue           = errors.New(errInvalidVariableValue)
+	ErrUnknownVariableField           = errors.New(errUnknownVariableField)
+	ErrUnsupportedVariableUse         = errors.New(errUnsupportedVariableUse)
+	ErrInvalidAsOf                    = errors.New(errInvalidAsOf)
 	ErrFilterMissingArgumentType      = errors.New("couldn't find filter argument type")
 	ErrInvalidOrderDirection          = errors.New("invalid order direction string")
 	ErrFailedToParseConditionsFromAST = errors.New("couldn't parse conditions value from AST")
@@ -25,3 +42,39 @@ var (
 	ErrUnknownGQLOperation            = errors.New("unknown GraphQL operation type")
 	ErrInvalidFilterConditions        = errors.New("invalid filter condition type, expected map")
 )
+
+// NewErrUnknownOperationName returns an error indicating that the request does not contain
+// an operation of the given name.
+func NewErrUnknownOperationName(name string) error {
+	return errors.New(errUnknownOperationName, errors.NewKV("Name", name))
+}
+
+// NewErrMissingVariable returns an error indicating that no value was provided for the given
+// non-nullable variable.
+func NewErrMissingVariable(name string) error {
+	return errors.New(errMissingVariable, errors.NewKV("Name", name))
+}
+
+// NewErrInvalidVariableValue returns an error indicating that the value provided for the given
+// variable cannot be used as a value of its declared type.
+func NewErrInvalidVariableValue(name string, value any) error {
+	return errors.New(errInvalidVariableValue, errors.NewKV("Name", name), errors.NewKV("Value", value))
+}
+
+// NewErrUnknownVariableField returns an error indicating that the object value provided for
+// the given variable contains a field not defined by its declared type.
+func NewErrUnknownVariableField(name string, field string) error {
+	return errors.New(errUnknownVariableField, errors.NewKV("Name", name), errors.NewKV("Field", field))
+}
+
+// NewErrUnsupportedVariableUse returns an error indicating that the declared type of the given
+// variable is not a known input type.
+func NewErrUnsupportedVariableUse(name string) error {
+	return errors.New(errUnsupportedVariableUse, errors.NewKV("Name", name))
+}
+
+// NewErrInvalidAsOf returns an error indicating that the given asOf argument value is not a
+// valid date time.
+func NewErrInvalidAsOf(value string, inner error) error {
+	return errors.Wrap(errInvalidAsOf, inner, errors.NewKV("Value", value))
+}
@@ -0,0 +1,107 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package parser
+
+import (
+	"github.com/sourcenetwork/graphql-go/language/ast"
+)
+
+// InlineFragments returns a copy of the given document with every fragment spread and inline
+// fragment replaced by the selections of the fragment.
+//
+// The request parser only handles fields, so fragments are inlined once the document has been
+// validated. The returned document contains no fragment definitions.
+func InlineFragments(doc *ast.Document) *ast.Document {
+	fragments := map[string]*ast.FragmentDefinition{}
+	for _, def := range doc.Definitions {
+		if fragDef, isFragDef := def.(*ast.FragmentDefinition); isFragDef {
+			fragments[fragDef.Name.Value] = fragDef
+		}
+	}
+
+	definitions := make([]ast.Node, 0, len(doc.Definitions))
+	for _, def := range doc.Definitions {
+		switch d := def.(type) {
+		case *ast.OperationDefinition:
+			definitions = append(definitions, &ast.OperationDefinition{
+				Kind:                d.Kind,
+				Loc:                 d.Loc,
+				Operation:           d.Operation,
+				Name:                d.Name,
+				VariableDefinitions: d.VariableDefinitions,
+				Directives:          d.Directives,
+				SelectionSet:        inlineFragmentsInSelectionSet(d.SelectionSet, fragments),
+			})
+
+		case *ast.FragmentDefinition:
+			continue
+
+		default:
+			definitions = append(definitions, def)
+		}
+	}
+
+	return &ast.Document{
+		Kind:        doc.Kind,
+		Loc:         doc.Loc,
+		Definitions: definitions,
+	}
+}
+
+func inlineFragmentsInSelectionSet(
+	selectionSet *ast.SelectionSet,
+	fragments map[string]*ast.FragmentDefinition,
+) *ast.SelectionSet {
+	if selectionSet == nil {
+		return nil
+	}
+
+	selections := make([]ast.Selection, 0, len(selectionSet.Selections))
+	for _, selection := range selectionSet.Selections {
+		switch s := selection.(type) {
+		case *ast.Field:
+			selections = append(selections, &ast.Field{
+				Kind:         s.Kind,
+				Loc:          s.Loc,
+				Alias:        s.Alias,
+				Name:         s.Name,
+				Arguments:    s.Arguments,
+				Directives:   s.Directives,
+				SelectionSet: inlineFragmentsInSelectionSet(s.SelectionSet, fragments),
+			})
+
+		case *ast.FragmentSpread:
+			fragDef, ok := fragments[s.Name.Value]
+			if !ok {
+				continue
+			}
+			inlined := inlineFragmentsInSelectionSet(fragDef.SelectionSet, fragments)
+			if inlined != nil {
+				selections = append(selections, inlined.Selections...)
+			}
+
+		case *ast.InlineFragment:
+			inlined := inlineFragmentsInSelectionSet(s.SelectionSet, fragments)
+			if inlined != nil {
+				selections = append(selections, inlined.Selections...)
+			}
+
+		default:
+			selections = append(selections, selection)
+		}
+	}
+
+	return &ast.SelectionSet{
+		Kind:       selectionSet.Kind,
+		Loc:        selectionSet.Loc,
+		Selections: selections,
+	}
+}
@@ -11,8 +11,10 @@
 package parser
 
 import (
+	"encoding/json"
 	"strings"
 
+	"github.com/shopspring/decimal"
 	gql "github.com/sourcenetwork/graphql-go"
 	"github.com/sourcenetwork/graphql-go/language/ast"
 	"github.com/sourcenetwork/immutable"
@@ -26,6 +28,7 @@ var (
 		"create": request.CreateObjects,
 		"update": request.UpdateObjects,
 		"delete": request.DeleteObjects,
+		"revert": request.RevertObjects,
 	}
 )
 
@@ -128,6 +131,9 @@ func parseMutation(schema gql.Schema, parent *gql.Object, field *ast.Field) (*re
 				ids[i] = id.Value
 			}
 			mut.IDs = immutable.Some(ids)
+		} else if prop == request.Cid {
+			raw := argument.Value.(*ast.StringValue)
+			mut.CID = immutable.Some(raw.Value)
 		}
 	}
 
@@ -150,9 +156,22 @@ func parseMutation(schema gql.Schema, parent *gql.Object, field *ast.Field) (*re
 func parseMutationInput(val ast.Value) any {
 	switch t := val.(type) {
 	case *ast.IntValue:
-		return gql.Int.ParseLiteral(val)
+		value := gql.Int.ParseLiteral(val)
+		if value == nil {
+			// The value is out of the Int range, it is kept as a number literal so that
+			// it may be used by arbitrary precision fields without loss of precision.
+			return json.Number(t.Value)
+		}
+		return value
 	case *ast.FloatValue:
-		return gql.Float.ParseLiteral(val)
+		value := gql.Float.ParseLiteral(val)
+		if !isExactFloat(value, t.Value) {
+			// The value cannot be represented exactly as a float, it is kept as a number
+			// literal so that it may be used by arbitrary precision fields without loss
+			// of precision.
+			return json.Number(t.Value)
+		}
+		return value
 	case *ast.BooleanValue:
 		return t.Value
 	case *ast.StringValue:
@@ -166,6 +185,20 @@ func parseMutationInput(val ast.Value) any {
 	}
 }
 
+// isExactFloat returns true if the given parsed float holds exactly the
+// number represented by the given literal.
+func isExactFloat(value any, literal string) bool {
+	f, ok := value.(float64)
+	if !ok {
+		return false
+	}
+	d, err := decimal.NewFromString(literal)
+	if err != nil {
+		return false
+	}
+	return decimal.NewFromFloat(f).Equal(d)
+}
+
 // parseMutationInputList parses the correct underlying
 // value type for all of the values in the ast.ListValue
 func parseMutationInputList(val *ast.ListValue) []any {
@@ -185,3 +218,120 @@ func parseMutationInputObject(val *ast.ObjectValue) map[string]any {
 	}
 	return obj
 }
+
+// ApplyRelationIDAliases replaces the related document IDs given directly to the one-sided
+// relation fields of mutation inputs, i.e. `author: "bae-..."`, with the equivalent nested
+// connect operation, i.e. `author: {connect: "bae-..."}`.
+//
+// ID variables given to relation fields, i.e. `author: $authorID`, are replaced in the same way.
+//
+// It must be called before the request is validated, so that the relation field name may
+// continue to be used as an alias of its related object ID field.
+func ApplyRelationIDAliases(schema gql.Schema, doc *ast.Document) {
+	mutationType := schema.MutationType()
+	if mutationType == nil {
+		return
+	}
+
+	for _, def := range doc.Definitions {
+		opDef, isOpDef := def.(*ast.OperationDefinition)
+		if !isOpDef || opDef.Operation != ast.OperationTypeMutation || opDef.SelectionSet == nil {
+			continue
+		}
+		idVariables := map[string]struct{}{}
+		for _, varDef := range opDef.VariableDefinitions {
+			if isIDType(varDef.Type) {
+				idVariables[varDef.Variable.Name.Value] = struct{}{}
+			}
+		}
+		for _, selection := range opDef.SelectionSet.Selections {
+			field, isField := selection.(*ast.Field)
+			if !isField {
+				continue
+			}
+			fieldDef, ok := mutationType.Fields()[field.Name.Value]
+			if !ok {
+				continue
+			}
+			for _, argument := range field.Arguments {
+				for _, argDef := range fieldDef.Args {
+					if argDef.Name() == argument.Name.Value && argDef.Name() == request.Input {
+						applyRelationIDAliases(argument.Value, argDef.Type, idVariables)
+					}
+				}
+			}
+		}
+	}
+}
+
+// isIDType returns true if the given variable type is that of a single ID.
+func isIDType(ttype ast.Type) bool {
+	if nonNull, ok := ttype.(*ast.NonNull); ok {
+		ttype = nonNull.Type
+	}
+	named, ok := ttype.(*ast.Named)
+	return ok && named.Name.Value == gql.ID.Name()
+}
+
+func applyRelationIDAliases(value ast.Value, ttype gql.Input, idVariables map[string]struct{}) {
+	if nonNull, ok := ttype.(*gql.NonNull); ok {
+		ttype = nonNull.OfType
+	}
+
+	switch t := ttype.(type) {
+	case *gql.List:
+		list, ok := value.(*ast.ListValue)
+		if !ok {
+			return
+		}
+		for _, item := range list.Values {
+			applyRelationIDAliases(item, t.OfType, idVariables)
+		}
+
+	case *gql.InputObject:
+		obj, ok := value.(*ast.ObjectValue)
+		if !ok {
+			return
+		}
+		for _, field := range obj.Fields {
+			fieldDef, ok := t.Fields()[field.Name.Value]
+			if !ok {
+				continue
+			}
+			relationType, isInputObject := fieldDef.Type.(*gql.InputObject)
+			if isInputObject && isRelationInputArg(relationType) && isRelationID(field.Value, idVariables) {
+				field.Value = ast.NewObjectValue(&ast.ObjectValue{
+					Loc: field.Value.GetLoc(),
+					Fields: []*ast.ObjectField{
+						ast.NewObjectField(&ast.ObjectField{
+							Loc:   field.Value.GetLoc(),
+							Name:  ast.NewName(&ast.Name{Value: request.RelationConnect}),
+							Value: field.Value,
+						}),
+					},
+				})
+				continue
+			}
+			applyRelationIDAliases(field.Value, fieldDef.Type, idVariables)
+		}
+	}
+}
+
+// isRelationInputArg returns true if the given type is the input of a one-sided relation field.
+func isRelationInputArg(ttype *gql.InputObject) bool {
+	return strings.HasSuffix(ttype.Name(), "RelationInputArg")
+}
+
+// isRelationID returns true if the given value is a related document ID, given either directly
+// or by an ID variable.
+func isRelationID(value ast.Value, idVariables map[string]struct{}) bool {
+	switch v := value.(type) {
+	case *ast.StringValue:
+		return true
+	case *ast.Variable:
+		_, isID := idVariables[v.Name.Value]
+		return isID
+	default:
+		return false
+	}
+}
@@ -12,6 +12,7 @@ package parser
 
 import (
 	"strconv"
+	"time"
 
 	gql "github.com/sourcenetwork/graphql-go"
 	"github.com/sourcenetwork/graphql-go/language/ast"
@@ -42,6 +43,13 @@ func parseQueryOperationDefinition(
 					return nil, []error{err}
 				}
 
+				parsedSelection = parsed
+			} else if node.Name.Value == request.DiffName {
+				parsed, err := parseDiffSelect(schema, schema.QueryType(), node)
+				if err != nil {
+					return nil, []error{err}
+				}
+
 				parsedSelection = parsed
 			} else if _, isAggregate := request.Aggregates[node.Name.Value]; isAggregate {
 				parsed, err := parseAggregate(schema, schema.QueryType(), node, i)
@@ -106,6 +114,7 @@ func parseSelect(
 	fieldDef := gql.GetFieldDef(schema, parent, slct.Name)
 
 	// parse arguments
+	asOfClock := request.AppliedClock
 	for _, argument := range field.Arguments {
 		prop := argument.Name.Value
 		astValue := argument.Value
@@ -137,6 +146,16 @@ func parseSelect(
 		case request.Cid: // parse single CID query field
 			val := astValue.(*ast.StringValue)
 			slct.CID = immutable.Some(val.Value)
+		case request.AsOf:
+			val := astValue.(*ast.StringValue)
+			asOf, err := time.Parse(time.RFC3339, val.Value)
+			if err != nil {
+				return nil, NewErrInvalidAsOf(val.Value, err)
+			}
+			slct.AsOf = immutable.Some(request.AsOfTime{Time: asOf})
+		case request.AsOfClockArgName:
+			val := astValue.(*ast.EnumValue)
+			asOfClock = request.AsOfClock(val.Value)
 		case request.LimitClause: // parse limit/offset
 			val := astValue.(*ast.IntValue)
 			limit, err := strconv.ParseUint(val.Value, 10, 64)
@@ -151,6 +170,12 @@ func parseSelect(
 				return nil, err
 			}
 			slct.Offset = immutable.Some(offset)
+		case request.AfterClause:
+			val := astValue.(*ast.StringValue)
+			slct.After = immutable.Some(val.Value)
+		case request.BeforeClause:
+			val := astValue.(*ast.StringValue)
+			slct.Before = immutable.Some(val.Value)
 		case request.OrderClause: // parse order by
 			obj := astValue.(*ast.ObjectValue)
 			cond, err := ParseConditionsInOrder(obj)
@@ -169,8 +194,28 @@ func parseSelect(
 				fields = append(fields, v.GetValue().(string))
 			}
 
-			slct.GroupBy = immutable.Some(
-				request.GroupBy{
+			groupBy := slct.GroupBy.Value()
+			groupBy.Fields = fields
+			slct.GroupBy = immutable.Some(groupBy)
+		case request.GroupByKeysClause:
+			obj := astValue.(*ast.ListValue)
+			keys := make([]request.GroupByKey, 0)
+			for _, v := range obj.Values {
+				keys = append(keys, parseGroupByKey(v.(*ast.ObjectValue)))
+			}
+
+			groupBy := slct.GroupBy.Value()
+			groupBy.Keys = keys
+			slct.GroupBy = immutable.Some(groupBy)
+		case request.DistinctClause:
+			obj := astValue.(*ast.ListValue)
+			fields := make([]string, 0)
+			for _, v := range obj.Values {
+				fields = append(fields, v.GetValue().(string))
+			}
+
+			slct.Distinct = immutable.Some(
+				request.Distinct{
 					Fields: fields,
 				},
 			)
@@ -179,6 +224,9 @@ func parseSelect(
 			slct.ShowDeleted = val.Value
 		}
 	}
+	if slct.AsOf.HasValue() {
+		slct.AsOf = immutable.Some(request.AsOfTime{Time: slct.AsOf.Value().Time, Clock: asOfClock})
+	}
 
 	// if theres no field selections, just return
 	if field.SelectionSet == nil {
@@ -215,6 +263,7 @@ func parseAggregate(schema gql.Schema, parent *gql.Object, field *ast.Field, ind
 			var limit immutable.Option[uint64]
 			var offset immutable.Option[uint64]
 			var order immutable.Option[request.OrderBy]
+			var distinct immutable.Option[string]
 
 			fieldArg, hasFieldArg := tryGet(argumentValue, request.FieldName)
 			if hasFieldArg {
@@ -303,6 +352,13 @@ func parseAggregate(schema gql.Schema, parent *gql.Object, field *ast.Field, ind
 				}
 			}
 
+			distinctArg, hasDistinctArg := tryGet(argumentValue, request.DistinctClause)
+			if hasDistinctArg {
+				if distinctValue, isString := distinctArg.Value.GetValue().(string); isString {
+					distinct = immutable.Some(distinctValue)
+				}
+			}
+
 			targets[i] = &request.AggregateTarget{
 				HostName:  hostName,
 				ChildName: immutable.Some(childName),
@@ -310,6 +366,7 @@ func parseAggregate(schema gql.Schema, parent *gql.Object, field *ast.Field, ind
 				Limit:     limit,
 				Offset:    offset,
 				OrderBy:   order,
+				Distinct:  distinct,
 			}
 		}
 	}
@@ -322,3 +379,27 @@ func parseAggregate(schema gql.Schema, parent *gql.Object, field *ast.Field, ind
 		Targets: targets,
 	}, nil
 }
+
+// parseGroupByKey parses the given groupByKeys argument value into a [request.GroupByKey].
+func parseGroupByKey(obj *ast.ObjectValue) request.GroupByKey {
+	key := request.GroupByKey{}
+	for _, field := range obj.Fields {
+		switch field.Name.Value {
+		case request.GroupByKeyField:
+			key.Field = field.Value.GetValue().(string)
+		case request.GroupByKeyRelation:
+			switch v := field.Value.(type) {
+			case *ast.ListValue:
+				for _, name := range v.Values {
+					key.RelationPath = append(key.RelationPath, name.GetValue().(string))
+				}
+			default:
+				// A single value is accepted in place of a list with a single item
+				key.RelationPath = []string{v.GetValue().(string)}
+			}
+		case request.GroupByKeyTruncate:
+			key.Truncation = immutable.Some(request.GroupByTruncation(field.Value.GetValue().(string)))
+		}
+	}
+	return key
+}
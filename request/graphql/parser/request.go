@@ -176,6 +176,8 @@ func parseSelectFields(
 					return nil, err
 				}
 				selections[i] = s
+			} else if node.Name.Value == request.PageInfoFieldName {
+				selections[i] = parsePageInfo(node)
 			} else if node.SelectionSet == nil { // regular field
 				selections[i] = parseField(node)
 			} else { // sub type with extra fields
@@ -206,6 +208,23 @@ func parseField(field *ast.Field) *request.Field {
 	}
 }
 
+// parsePageInfo parses the page information properties requested
+// into a PageInfo type
+func parsePageInfo(field *ast.Field) *request.PageInfo {
+	pageInfo := &request.PageInfo{
+		Field: *parseField(field),
+	}
+	if field.SelectionSet == nil {
+		return pageInfo
+	}
+	for _, selection := range field.SelectionSet.Selections {
+		if node, ok := selection.(*ast.Field); ok {
+			pageInfo.Fields = append(pageInfo.Fields, *parseField(node))
+		}
+	}
+	return pageInfo
+}
+
 func tryGet(fields []*ast.ObjectField, name string) (*ast.ObjectField, bool) {
 	for _, field := range fields {
 		if field.Name.Value == name {
@@ -11,6 +11,8 @@
 package parser
 
 import (
+	"fmt"
+
 	gql "github.com/sourcenetwork/graphql-go"
 	"github.com/sourcenetwork/graphql-go/language/ast"
 
@@ -54,7 +56,7 @@ func parseSubscription(schema gql.Schema, field *ast.Field) (*request.ObjectSubs
 
 	sub.Collection = sub.Name
 
-	fieldDef := gql.GetFieldDef(schema, schema.QueryType(), field.Name.Value)
+	fieldDef := gql.GetFieldDef(schema, schema.SubscriptionType(), field.Name.Value)
 
 	for _, argument := range field.Arguments {
 		prop := argument.Name.Value
@@ -70,6 +72,8 @@ func parseSubscription(schema gql.Schema, field *ast.Field) (*request.ObjectSubs
 			}
 
 			sub.Filter = filter
+		} else if prop == request.OperationsArgName {
+			sub.Operations = parseSubscriptionOperations(argument.Value)
 		}
 	}
 
@@ -79,6 +83,39 @@ func parseSubscription(schema gql.Schema, field *ast.Field) (*request.ObjectSubs
 		return nil, err
 	}
 
-	sub.Fields, err = parseSelectFields(schema, request.ObjectSelection, fieldObject, field.SelectionSet)
-	return sub, err
+	fields, err := parseSelectFields(schema, request.ObjectSelection, fieldObject, field.SelectionSet)
+	if err != nil {
+		return nil, err
+	}
+
+	// the event fields are resolved from the change, so they are not selected from the document
+	for _, selection := range fields {
+		if eventField, ok := selection.(*request.Field); ok && isEventField(eventField.Name) {
+			sub.EventFields = append(sub.EventFields, *eventField)
+		} else {
+			sub.Fields = append(sub.Fields, selection)
+		}
+	}
+	return sub, nil
+}
+
+// parseSubscriptionOperations parses the operations argument of a subscription, which may be
+// a list of operations or a single operation.
+//
+// The operations are values of the `SubscriptionOperation` enum, as validated against the schema.
+func parseSubscriptionOperations(value ast.Value) []request.SubscriptionOperation {
+	values := []ast.Value{value}
+	if list, ok := value.(*ast.ListValue); ok {
+		values = list.Values
+	}
+
+	operations := make([]request.SubscriptionOperation, len(values))
+	for i, value := range values {
+		operations[i] = request.SubscriptionOperation(fmt.Sprint(value.GetValue()))
+	}
+	return operations
+}
+
+func isEventField(name string) bool {
+	return name == request.OperationFieldName || name == request.ChangedFieldsFieldName
 }
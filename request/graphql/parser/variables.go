@@ -0,0 +1,434 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package parser
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+
+	gql "github.com/sourcenetwork/graphql-go"
+	"github.com/sourcenetwork/graphql-go/language/ast"
+
+	"github.com/sourcenetwork/defradb/client/request"
+)
+
+// ApplyVariables returns a copy of the given document containing only the operation of the given
+// name, with every variable reference replaced by the literal value of the variable.
+//
+// The name may only be omitted if the document contains a single operation, an error is returned
+// otherwise.
+//
+// Variables without a provided value take the default value declared by the operation, references
+// to nullable variables with neither are removed as if the argument or field had not been given.
+//
+// Variable references within fragment definitions are replaced by the values of the variables
+// of the returned operations, where several operations declare the same variable the value
+// of the first is used.
+func ApplyVariables(
+	schema gql.Schema,
+	doc *ast.Document,
+	operationName string,
+	variables map[string]any,
+) (*ast.Document, error) {
+	if operationName == "" && countOperations(doc) > 1 {
+		return nil, ErrOperationNameRequired
+	}
+
+	operations := make([]*ast.OperationDefinition, 0, len(doc.Definitions))
+	operationValues := make([]map[string]ast.Value, 0, len(doc.Definitions))
+	fragmentValues := map[string]ast.Value{}
+	for _, def := range doc.Definitions {
+		opDef, isOpDef := def.(*ast.OperationDefinition)
+		if !isOpDef {
+			continue
+		}
+		if operationName != "" && (opDef.Name == nil || opDef.Name.Value != operationName) {
+			continue
+		}
+
+		values, err := resolveVariables(schema, opDef.VariableDefinitions, variables)
+		if err != nil {
+			return nil, err
+		}
+		for name, value := range values {
+			if _, ok := fragmentValues[name]; !ok {
+				fragmentValues[name] = value
+			}
+		}
+		operations = append(operations, opDef)
+		operationValues = append(operationValues, values)
+	}
+	if operationName != "" && len(operations) == 0 {
+		return nil, NewErrUnknownOperationName(operationName)
+	}
+
+	definitions := make([]ast.Node, 0, len(doc.Definitions))
+	for _, def := range doc.Definitions {
+		switch d := def.(type) {
+		case *ast.OperationDefinition:
+			for i, opDef := range operations {
+				if opDef != d {
+					continue
+				}
+				definitions = append(definitions, &ast.OperationDefinition{
+					Kind:         opDef.Kind,
+					Loc:          opDef.Loc,
+					Operation:    opDef.Operation,
+					Name:         opDef.Name,
+					Directives:   applyToDirectives(opDef.Directives, operationValues[i]),
+					SelectionSet: applyToSelectionSet(opDef.SelectionSet, operationValues[i]),
+				})
+			}
+
+		case *ast.FragmentDefinition:
+			definitions = append(definitions, &ast.FragmentDefinition{
+				Kind:          d.Kind,
+				Loc:           d.Loc,
+				Operation:     d.Operation,
+				Name:          d.Name,
+				TypeCondition: d.TypeCondition,
+				Directives:    applyToDirectives(d.Directives, fragmentValues),
+				SelectionSet:  applyToSelectionSet(d.SelectionSet, fragmentValues),
+			})
+
+		default:
+			definitions = append(definitions, def)
+		}
+	}
+
+	return &ast.Document{
+		Kind:        doc.Kind,
+		Loc:         doc.Loc,
+		Definitions: definitions,
+	}, nil
+}
+
+// countOperations returns the number of operations defined by the given document.
+func countOperations(doc *ast.Document) int {
+	count := 0
+	for _, def := range doc.Definitions {
+		if _, isOpDef := def.(*ast.OperationDefinition); isOpDef {
+			count++
+		}
+	}
+	return count
+}
+
+// resolveVariables returns the literal values of the given variable definitions.
+//
+// Variables without a value are not included in the result.
+func resolveVariables(
+	schema gql.Schema,
+	varDefs []*ast.VariableDefinition,
+	variables map[string]any,
+) (map[string]ast.Value, error) {
+	values := make(map[string]ast.Value, len(varDefs))
+	for _, varDef := range varDefs {
+		name := varDef.Variable.Name.Value
+		ttype, err := inputTypeFromAST(schema, varDef.Type)
+		if err != nil {
+			return nil, NewErrUnsupportedVariableUse(name)
+		}
+
+		value, ok := variables[name]
+		switch {
+		case ok:
+			astValue, err := valueToAST(name, value, ttype)
+			if err != nil {
+				return nil, err
+			}
+			values[name] = astValue
+
+		case varDef.DefaultValue != nil:
+			values[name] = varDef.DefaultValue
+
+		default:
+			if _, isNonNull := ttype.(*gql.NonNull); isNonNull {
+				return nil, NewErrMissingVariable(name)
+			}
+		}
+	}
+	return values, nil
+}
+
+// inputTypeFromAST returns the input type of the given variable type declaration.
+func inputTypeFromAST(schema gql.Schema, astType ast.Type) (gql.Input, error) {
+	switch t := astType.(type) {
+	case *ast.NonNull:
+		inner, err := inputTypeFromAST(schema, t.Type)
+		if err != nil {
+			return nil, err
+		}
+		return gql.NewNonNull(inner), nil
+
+	case *ast.List:
+		inner, err := inputTypeFromAST(schema, t.Type)
+		if err != nil {
+			return nil, err
+		}
+		return gql.NewList(inner), nil
+
+	case *ast.Named:
+		ttype := schema.Type(t.Name.Value)
+		if input, isInput := ttype.(gql.Input); isInput && gql.IsInputType(ttype) {
+			return input, nil
+		}
+	}
+	return nil, ErrUnsupportedVariableUse
+}
+
+// valueToAST returns the literal value of the given variable value for the given type.
+func valueToAST(name string, value any, ttype gql.Input) (ast.Value, error) {
+	if nonNull, isNonNull := ttype.(*gql.NonNull); isNonNull {
+		if value == nil {
+			return nil, NewErrMissingVariable(name)
+		}
+		return valueToAST(name, value, nonNull.OfType.(gql.Input))
+	}
+	if value == nil {
+		return ast.NewNullValue(&ast.NullValue{}), nil
+	}
+
+	switch t := ttype.(type) {
+	case *gql.List:
+		itemType := t.OfType.(gql.Input)
+		items, isList := value.([]any)
+		if !isList {
+			// A single value may be provided for a list, as per the GraphQL input coercion rules.
+			return valueToAST(name, value, itemType)
+		}
+		values := make([]ast.Value, len(items))
+		for i, item := range items {
+			itemValue, err := valueToAST(name, item, itemType)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = itemValue
+		}
+		return ast.NewListValue(&ast.ListValue{Values: values}), nil
+
+	case *gql.InputObject:
+		if id, isID := value.(string); isID && isRelationInputArg(t) {
+			// As in literal mutation inputs, the related document ID is shorthand for connecting it.
+			value = map[string]any{request.RelationConnect: id}
+		}
+		fields, isObject := value.(map[string]any)
+		if !isObject {
+			return nil, NewErrInvalidVariableValue(name, value)
+		}
+		fieldDefs := t.Fields()
+		fieldNames := make([]string, 0, len(fields))
+		for fieldName := range fields {
+			if _, ok := fieldDefs[fieldName]; !ok {
+				return nil, NewErrUnknownVariableField(name, fieldName)
+			}
+			fieldNames = append(fieldNames, fieldName)
+		}
+		sort.Strings(fieldNames)
+
+		objectFields := make([]*ast.ObjectField, len(fieldNames))
+		for i, fieldName := range fieldNames {
+			fieldValue, err := valueToAST(name, fields[fieldName], fieldDefs[fieldName].Type)
+			if err != nil {
+				return nil, err
+			}
+			objectFields[i] = ast.NewObjectField(&ast.ObjectField{
+				Name:  ast.NewName(&ast.Name{Value: fieldName}),
+				Value: fieldValue,
+			})
+		}
+		return ast.NewObjectValue(&ast.ObjectValue{Fields: objectFields}), nil
+
+	case *gql.Enum:
+		enumValue, isString := value.(string)
+		if !isString {
+			return nil, NewErrInvalidVariableValue(name, value)
+		}
+		return ast.NewEnumValue(&ast.EnumValue{Value: enumValue}), nil
+
+	default:
+		return scalarToAST(name, value, ttype)
+	}
+}
+
+// scalarToAST returns the literal value of the given scalar variable value.
+func scalarToAST(name string, value any, ttype gql.Input) (ast.Value, error) {
+	switch v := value.(type) {
+	case string:
+		return ast.NewStringValue(&ast.StringValue{Value: v}), nil
+	case bool:
+		return ast.NewBooleanValue(&ast.BooleanValue{Value: v}), nil
+	case int:
+		return ast.NewIntValue(&ast.IntValue{Value: strconv.FormatInt(int64(v), 10)}), nil
+	case int32:
+		return ast.NewIntValue(&ast.IntValue{Value: strconv.FormatInt(int64(v), 10)}), nil
+	case int64:
+		return ast.NewIntValue(&ast.IntValue{Value: strconv.FormatInt(v, 10)}), nil
+	case uint64:
+		return ast.NewIntValue(&ast.IntValue{Value: strconv.FormatUint(v, 10)}), nil
+	case float32:
+		return floatToAST(float64(v), ttype), nil
+	case float64:
+		return floatToAST(v, ttype), nil
+	case json.Number:
+		// The literal value is used as given, so that arbitrary precision numbers keep
+		// their precision.
+		if _, isInt := new(big.Int).SetString(v.String(), 10); isInt {
+			return ast.NewIntValue(&ast.IntValue{Value: v.String()}), nil
+		}
+		if _, isFloat := new(big.Float).SetString(v.String()); !isFloat {
+			return nil, NewErrInvalidVariableValue(name, value)
+		}
+		if ttype == gql.Int {
+			f, err := v.Float64()
+			if err != nil {
+				return nil, NewErrInvalidVariableValue(name, value)
+			}
+			return floatToAST(f, ttype), nil
+		}
+		return ast.NewFloatValue(&ast.FloatValue{Value: v.String()}), nil
+	default:
+		return nil, NewErrInvalidVariableValue(name, value)
+	}
+}
+
+// floatToAST returns the literal value of the given float variable value.
+//
+// JSON decoding yields all numbers as floats, so integral values of Int variables are
+// returned as int literals.
+func floatToAST(value float64, ttype gql.Input) ast.Value {
+	if ttype == gql.Int && value == math.Trunc(value) {
+		return ast.NewIntValue(&ast.IntValue{Value: strconv.FormatInt(int64(value), 10)})
+	}
+	return ast.NewFloatValue(&ast.FloatValue{Value: strconv.FormatFloat(value, 'g', -1, 64)})
+}
+
+func applyToSelectionSet(selectionSet *ast.SelectionSet, values map[string]ast.Value) *ast.SelectionSet {
+	if selectionSet == nil {
+		return nil
+	}
+
+	selections := make([]ast.Selection, len(selectionSet.Selections))
+	for i, selection := range selectionSet.Selections {
+		switch s := selection.(type) {
+		case *ast.Field:
+			selections[i] = &ast.Field{
+				Kind:         s.Kind,
+				Loc:          s.Loc,
+				Alias:        s.Alias,
+				Name:         s.Name,
+				Arguments:    applyToArguments(s.Arguments, values),
+				Directives:   applyToDirectives(s.Directives, values),
+				SelectionSet: applyToSelectionSet(s.SelectionSet, values),
+			}
+
+		case *ast.InlineFragment:
+			selections[i] = &ast.InlineFragment{
+				Kind:          s.Kind,
+				Loc:           s.Loc,
+				TypeCondition: s.TypeCondition,
+				Directives:    applyToDirectives(s.Directives, values),
+				SelectionSet:  applyToSelectionSet(s.SelectionSet, values),
+			}
+
+		case *ast.FragmentSpread:
+			selections[i] = &ast.FragmentSpread{
+				Kind:       s.Kind,
+				Loc:        s.Loc,
+				Name:       s.Name,
+				Directives: applyToDirectives(s.Directives, values),
+			}
+
+		default:
+			selections[i] = selection
+		}
+	}
+
+	return &ast.SelectionSet{
+		Kind:       selectionSet.Kind,
+		Loc:        selectionSet.Loc,
+		Selections: selections,
+	}
+}
+
+func applyToDirectives(directives []*ast.Directive, values map[string]ast.Value) []*ast.Directive {
+	result := make([]*ast.Directive, len(directives))
+	for i, directive := range directives {
+		result[i] = &ast.Directive{
+			Kind:      directive.Kind,
+			Loc:       directive.Loc,
+			Name:      directive.Name,
+			Arguments: applyToArguments(directive.Arguments, values),
+		}
+	}
+	return result
+}
+
+func applyToArguments(arguments []*ast.Argument, values map[string]ast.Value) []*ast.Argument {
+	result := make([]*ast.Argument, 0, len(arguments))
+	for _, argument := range arguments {
+		value, ok := applyToValue(argument.Value, values)
+		if !ok {
+			continue
+		}
+		result = append(result, &ast.Argument{
+			Kind:  argument.Kind,
+			Loc:   argument.Loc,
+			Name:  argument.Name,
+			Value: value,
+		})
+	}
+	return result
+}
+
+// applyToValue returns the given value with any variable references replaced.
+//
+// It returns false if the value is a reference to a variable without a value.
+func applyToValue(value ast.Value, values map[string]ast.Value) (ast.Value, bool) {
+	switch v := value.(type) {
+	case *ast.Variable:
+		variableValue, ok := values[v.Name.Value]
+		return variableValue, ok
+
+	case *ast.ObjectValue:
+		fields := make([]*ast.ObjectField, 0, len(v.Fields))
+		for _, field := range v.Fields {
+			fieldValue, ok := applyToValue(field.Value, values)
+			if !ok {
+				continue
+			}
+			fields = append(fields, &ast.ObjectField{
+				Kind:  field.Kind,
+				Loc:   field.Loc,
+				Name:  field.Name,
+				Value: fieldValue,
+			})
+		}
+		return &ast.ObjectValue{Kind: v.Kind, Loc: v.Loc, Fields: fields}, true
+
+	case *ast.ListValue:
+		items := make([]ast.Value, len(v.Values))
+		for i, item := range v.Values {
+			itemValue, ok := applyToValue(item, values)
+			if !ok {
+				itemValue = ast.NewNullValue(&ast.NullValue{})
+			}
+			items[i] = itemValue
+		}
+		return &ast.ListValue{Kind: v.Kind, Loc: v.Loc, Values: items}, true
+
+	default:
+		return value, true
+	}
+}
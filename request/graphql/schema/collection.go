@@ -12,8 +12,14 @@ package schema
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"sort"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/sourcenetwork/immutable"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
@@ -53,10 +59,17 @@ func fromAst(ctx context.Context, doc *ast.Document) (
 	relationManager := NewRelationManager()
 	definitions := []client.CollectionDefinition{}
 
+	// Enums must be gathered before any object is processed, as fields may reference
+	// enums declared later on in the document.
+	enums, err := enumsFromAst(doc)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, def := range doc.Definitions {
 		switch defType := def.(type) {
 		case *ast.ObjectDefinition:
-			description, err := collectionFromAstDefinition(ctx, relationManager, defType)
+			description, err := collectionFromAstDefinition(ctx, relationManager, enums, defType)
 			if err != nil {
 				return nil, err
 			}
@@ -64,7 +77,7 @@ func fromAst(ctx context.Context, doc *ast.Document) (
 			definitions = append(definitions, description)
 
 		case *ast.InterfaceDefinition:
-			description, err := schemaFromAstDefinition(ctx, relationManager, defType)
+			description, err := schemaFromAstDefinition(ctx, relationManager, enums, defType)
 			if err != nil {
 				return nil, err
 			}
@@ -86,18 +99,49 @@ func fromAst(ctx context.Context, doc *ast.Document) (
 	// The details on the relations between objects depend on both sides
 	// of the relationship.  The relation manager handles this, and must be applied
 	// after all the collections have been processed.
-	err := finalizeRelations(relationManager, definitions)
+	err = finalizeRelations(relationManager, definitions)
 	if err != nil {
 		return nil, err
 	}
 
-	return definitions, nil
+	return appendLinkCollections(definitions)
+}
+
+// enumsFromAst parses all the enum definitions within the given GQL AST.
+func enumsFromAst(doc *ast.Document) (map[string]client.EnumDescription, error) {
+	enums := map[string]client.EnumDescription{}
+	for _, def := range doc.Definitions {
+		enumDef, ok := def.(*ast.EnumDefinition)
+		if !ok {
+			continue
+		}
+
+		name := enumDef.Name.Value
+		if _, exists := enums[name]; exists {
+			return nil, NewErrDuplicateEnum(name)
+		}
+
+		values := make([]string, 0, len(enumDef.Values))
+		for _, value := range enumDef.Values {
+			values = append(values, value.Name.Value)
+		}
+		if len(values) == 0 {
+			return nil, NewErrEnumHasNoValues(name)
+		}
+
+		enums[name] = client.EnumDescription{
+			Name:   name,
+			Values: values,
+		}
+	}
+	return enums, nil
 }
 
 // collectionFromAstDefinition parses a AST object definition into a set of collection descriptions.
 func collectionFromAstDefinition(
 	ctx context.Context,
 	relationManager *RelationManager,
+	enums map[string]client.EnumDescription,
 	def *ast.ObjectDefinition,
 ) (client.CollectionDefinition, error) {
 	fieldDescriptions := []client.FieldDescription{
@@ -110,7 +154,7 @@ func collectionFromAstDefinition(
 
 	indexDescriptions := []client.IndexDescription{}
 	for _, field := range def.Fields {
-		tmpFieldsDescriptions, err := fieldsFromAST(field, relationManager, def.Name.Value)
+		tmpFieldsDescriptions, err := fieldsFromAST(field, relationManager, enums, def.Name.Value)
 		if err != nil {
 			return client.CollectionDefinition{}, err
 		}
@@ -139,24 +183,70 @@ func collectionFromAstDefinition(
 		return fieldDescriptions[i].Name < fieldDescriptions[j].Name
 	})
 
+	var retentionPolicy *client.RetentionPolicy
+	var ttl *client.TTLDescription
 	for _, directive := range def.Directives {
-		if directive.Name.Value == types.IndexDirectiveLabel {
+		switch directive.Name.Value {
+		case types.IndexDirectiveLabel:
 			index, err := indexFromAST(directive)
 			if err != nil {
 				return client.CollectionDefinition{}, err
 			}
 			indexDescriptions = append(indexDescriptions, index)
+		case types.RetentionDirectiveLabel:
+			policy, err := retentionPolicyFromAST(def.Name.Value, directive)
+			if err != nil {
+				return client.CollectionDefinition{}, err
+			}
+			retentionPolicy = &policy
+		case types.TTLDirectiveLabel:
+			desc, err := ttlFromAST(def.Name.Value, directive, fieldDescriptions)
+			if err != nil {
+				return client.CollectionDefinition{}, err
+			}
+			ttl = &desc
+		}
+	}
+
+	if ttl != nil && !hasAscendingIndexOnField(indexDescriptions, ttl.Field) {
+		// expired documents are found through an ascending index on the expiry field
+		indexDescriptions = append(indexDescriptions, client.IndexDescription{
+			Fields: []client.IndexedFieldDescription{
+				{
+					Name:      ttl.Field,
+					Direction: client.Ascending,
+				},
+			},
+		})
+	}
+
+	for _, field := range fieldDescriptions {
+		relatedIDField := field.Name + request.RelatedObjectID
+		if field.OnDelete != "" && !hasIndexOnField(indexDescriptions, relatedIDField) {
+			// documents referencing a deleted document are found through an index on the
+			// related id field
+			indexDescriptions = append(indexDescriptions, client.IndexDescription{
+				Fields: []client.IndexedFieldDescription{
+					{
+						Name:      relatedIDField,
+						Direction: client.Ascending,
+					},
+				},
+			})
 		}
 	}
 
 	return client.CollectionDefinition{
 		Description: client.CollectionDescription{
-			Name:    def.Name.Value,
-			Indexes: indexDescriptions,
+			Name:            def.Name.Value,
+			Indexes:         indexDescriptions,
+			RetentionPolicy: retentionPolicy,
+			TTL:             ttl,
 		},
 		Schema: client.SchemaDescription{
 			Name:   def.Name.Value,
 			Fields: fieldDescriptions,
+			Enums:  enumsForFields(enums, fieldDescriptions),
 		},
 	}, nil
 }
@@ -164,12 +254,13 @@ func collectionFromAstDefinition(
 func schemaFromAstDefinition(
 	ctx context.Context,
 	relationManager *RelationManager,
+	enums map[string]client.EnumDescription,
 	def *ast.InterfaceDefinition,
 ) (client.SchemaDescription, error) {
 	fieldDescriptions := []client.FieldDescription{}
 
 	for _, field := range def.Fields {
-		tmpFieldsDescriptions, err := fieldsFromAST(field, relationManager, def.Name.Value)
+		tmpFieldsDescriptions, err := fieldsFromAST(field, relationManager, enums, def.Name.Value)
 		if err != nil {
 			return client.SchemaDescription{}, err
 		}
@@ -185,9 +276,37 @@ func schemaFromAstDefinition(
 	return client.SchemaDescription{
 		Name:   def.Name.Value,
 		Fields: fieldDescriptions,
+		Enums:  enumsForFields(enums, fieldDescriptions),
 	}, nil
 }
 
+// enumsForFields returns the enums referenced by the given fields, sorted by name.
+//
+// Each schema holds its own copy of the enums that it uses.
+func enumsForFields(
+	enums map[string]client.EnumDescription,
+	fields []client.FieldDescription,
+) []client.EnumDescription {
+	var result []client.EnumDescription
+	added := map[string]struct{}{}
+	for _, field := range fields {
+		if !field.IsEnum() {
+			continue
+		}
+		if _, ok := added[field.Schema]; ok {
+			continue
+		}
+		added[field.Schema] = struct{}{}
+		result = append(result, enums[field.Schema])
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
+
 // IsValidIndexName returns true if the name is a valid index name.
 // Valid index names must start with a letter or underscore, and can
 // contain letters, numbers, and underscores.
@@ -312,9 +431,40 @@ func indexFromAST(directive *ast.Directive) (client.IndexDescription, error) {
 
 func fieldsFromAST(field *ast.FieldDefinition,
 	relationManager *RelationManager,
+	enums map[string]client.EnumDescription,
 	hostObjectName string,
 ) ([]client.FieldDescription, error) {
-	kind, err := astTypeToKind(field.Type)
+	fieldType := field.Type
+	nonNullType, isNonNull := fieldType.(*ast.NonNull)
+	if isNonNull {
+		fieldType = nonNullType.Type
+	}
+
+	kind, err := astTypeToKind(fieldType, enums)
+	if err != nil {
+		return nil, err
+	}
+
+	if isNonNull && (kind == client.FieldKind_FOREIGN_OBJECT || kind == client.FieldKind_FOREIGN_OBJECT_ARRAY) {
+		return nil, ErrNonNullNotSupported
+	}
+
+	defaultValue, err := defaultValueFromAST(field)
+	if err != nil {
+		return nil, err
+	}
+
+	constraint, err := constraintFromAST(field)
+	if err != nil {
+		return nil, err
+	}
+
+	computed, err := computedFromAST(field)
+	if err != nil {
+		return nil, err
+	}
+
+	onDelete, checkExists, err := relationOptionsFromAST(field)
 	if err != nil {
 		return nil, err
 	}
@@ -325,6 +475,10 @@ func fieldsFromAST(field *ast.FieldDefinition,
 
 	fieldDescriptions := []client.FieldDescription{}
 
+	if kind == client.FieldKind_ENUM {
+		schema = fieldType.(*ast.Named).Name.Value
+	}
+
 	if kind == client.FieldKind_FOREIGN_OBJECT || kind == client.FieldKind_FOREIGN_OBJECT_ARRAY {
 		if kind == client.FieldKind_FOREIGN_OBJECT {
 			schema = field.Type.(*ast.Named).Name.Value
@@ -375,12 +529,249 @@ func fieldsFromAST(field *ast.FieldDefinition,
 		Schema:       schema,
 		RelationName: relationName,
 		RelationType: relationType,
+		IsNonNull:    isNonNull,
+		DefaultValue: defaultValue,
+		Constraint:   constraint,
+		Computed:     computed,
+		OnDelete:     onDelete,
+		CheckExists:  checkExists,
 	}
 
 	fieldDescriptions = append(fieldDescriptions, fieldDescription)
 	return fieldDescriptions, nil
 }
 
+// defaultValueFromAST returns the JSON encoded value of the @default directive on the given
+// field, or an empty string if the field has no such directive.
+func defaultValueFromAST(field *ast.FieldDefinition) (string, error) {
+	directive, exists := findDirective(field, types.DefaultDirectiveLabel)
+	if !exists {
+		return "", nil
+	}
+
+	var value ast.Value
+	for _, arg := range directive.Arguments {
+		switch arg.Name.Value {
+		case types.DefaultDirectivePropValue:
+			value = arg.Value
+		default:
+			return "", NewErrDefaultWithUnknownArg(field.Name.Value, arg.Name.Value)
+		}
+	}
+	if value == nil {
+		return "", NewErrDefaultMissingValue(field.Name.Value)
+	}
+
+	defaultValue, err := astValueToJSON(value)
+	if err != nil {
+		return "", err
+	}
+	encoded, err := json.Marshal(defaultValue)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
+
+// astValueToJSON converts the given literal value into a value that may be encoded as JSON.
+//
+// Enum values are converted to their string names.
+func astValueToJSON(value ast.Value) (any, error) {
+	switch v := value.(type) {
+	case *ast.IntValue:
+		return json.Number(v.Value), nil
+	case *ast.FloatValue:
+		return json.Number(v.Value), nil
+	case *ast.StringValue:
+		return v.Value, nil
+	case *ast.BooleanValue:
+		return v.Value, nil
+	case *ast.EnumValue:
+		return v.Value, nil
+	case *ast.ListValue:
+		values := make([]any, len(v.Values))
+		for i, item := range v.Values {
+			itemValue, err := astValueToJSON(item)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = itemValue
+		}
+		return values, nil
+	default:
+		return nil, NewErrDefaultWithInvalidValue(value.GetKind())
+	}
+}
+
+// constraintFromAST returns the constraint declared by the @constraint directive on the given
+// field, or nil if the field has no such directive.
+func constraintFromAST(field *ast.FieldDefinition) (*client.FieldConstraint, error) {
+	directive, exists := findDirective(field, types.ConstraintDirectiveLabel)
+	if !exists {
+		return nil, nil
+	}
+
+	constraint := &client.FieldConstraint{}
+	for _, arg := range directive.Arguments {
+		switch arg.Name.Value {
+		case types.ConstraintDirectivePropMin, types.ConstraintDirectivePropMax:
+			// The literal is parsed as a decimal so that bounds of BigInt and Decimal fields
+			// are not rounded.
+			var number decimal.Decimal
+			var err error
+			switch v := arg.Value.(type) {
+			case *ast.IntValue:
+				number, err = decimal.NewFromString(v.Value)
+			case *ast.FloatValue:
+				number, err = decimal.NewFromString(v.Value)
+			default:
+				return nil, NewErrConstraintWithInvalidArg(field.Name.Value, arg.Name.Value)
+			}
+			if err != nil {
+				return nil, NewErrConstraintWithInvalidArg(field.Name.Value, arg.Name.Value)
+			}
+			if arg.Name.Value == types.ConstraintDirectivePropMin {
+				constraint.Min = immutable.Some(number)
+			} else {
+				constraint.Max = immutable.Some(number)
+			}
+		case types.ConstraintDirectivePropPattern:
+			patternVal, ok := arg.Value.(*ast.StringValue)
+			if !ok {
+				return nil, NewErrConstraintWithInvalidArg(field.Name.Value, arg.Name.Value)
+			}
+			constraint.Pattern = patternVal.Value
+		case types.ConstraintDirectivePropMaxLength:
+			lengthVal, ok := arg.Value.(*ast.IntValue)
+			if !ok {
+				return nil, NewErrConstraintWithInvalidArg(field.Name.Value, arg.Name.Value)
+			}
+			maxLength, err := strconv.Atoi(lengthVal.Value)
+			if err != nil {
+				return nil, NewErrConstraintWithInvalidArg(field.Name.Value, arg.Name.Value)
+			}
+			constraint.MaxLength = immutable.Some(maxLength)
+		default:
+			return nil, NewErrConstraintWithUnknownArg(field.Name.Value, arg.Name.Value)
+		}
+	}
+	return constraint, nil
+}
+
+// computedFromAST returns the expression of the @computed directive on the given field, or an
+// empty string if the field has no such directive.
+func computedFromAST(field *ast.FieldDefinition) (string, error) {
+	directive, exists := findDirective(field, types.ComputedDirectiveLabel)
+	if !exists {
+		return "", nil
+	}
+
+	var expression string
+	for _, arg := range directive.Arguments {
+		switch arg.Name.Value {
+		case types.ComputedDirectivePropExpr:
+			exprVal, ok := arg.Value.(*ast.StringValue)
+			if !ok {
+				return "", NewErrComputedMissingExpression(field.Name.Value)
+			}
+			expression = exprVal.Value
+		default:
+			return "", NewErrComputedWithUnknownArg(field.Name.Value, arg.Name.Value)
+		}
+	}
+	if expression == "" {
+		return "", NewErrComputedMissingExpression(field.Name.Value)
+	}
+
+	return expression, nil
+}
+
+// retentionPolicyFromAST returns the retention policy declared by the given @retention directive.
+func retentionPolicyFromAST(collectionName string, directive *ast.Directive) (client.RetentionPolicy, error) {
+	policy := client.RetentionPolicy{}
+	for _, arg := range directive.Arguments {
+		switch arg.Name.Value {
+		case types.RetentionDirectivePropVersions:
+			versionsVal, ok := arg.Value.(*ast.IntValue)
+			if !ok {
+				return client.RetentionPolicy{}, NewErrRetentionWithInvalidArg(collectionName, arg.Name.Value)
+			}
+			versions, err := strconv.Atoi(versionsVal.Value)
+			if err != nil || versions < 1 {
+				return client.RetentionPolicy{}, NewErrRetentionWithInvalidArg(collectionName, arg.Name.Value)
+			}
+			policy.MaxVersions = immutable.Some(versions)
+		case types.RetentionDirectivePropAge:
+			ageVal, ok := arg.Value.(*ast.StringValue)
+			if !ok {
+				return client.RetentionPolicy{}, NewErrRetentionWithInvalidArg(collectionName, arg.Name.Value)
+			}
+			age, err := time.ParseDuration(ageVal.Value)
+			if err != nil || age <= 0 {
+				return client.RetentionPolicy{}, NewErrRetentionWithInvalidArg(collectionName, arg.Name.Value)
+			}
+			policy.MaxAge = immutable.Some(age)
+		default:
+			return client.RetentionPolicy{}, NewErrRetentionWithUnknownArg(collectionName, arg.Name.Value)
+		}
+	}
+	if !policy.MaxVersions.HasValue() && !policy.MaxAge.HasValue() {
+		return client.RetentionPolicy{}, NewErrRetentionMissingLimit(collectionName)
+	}
+	return policy, nil
+}
+
+// ttlFromAST returns the TTL declared by the given @ttl directive.
+func ttlFromAST(
+	collectionName string,
+	directive *ast.Directive,
+	fields []client.FieldDescription,
+) (client.TTLDescription, error) {
+	desc := client.TTLDescription{}
+	for _, arg := range directive.Arguments {
+		switch arg.Name.Value {
+		case types.TTLDirectivePropField:
+			fieldVal, ok := arg.Value.(*ast.StringValue)
+			if !ok {
+				return client.TTLDescription{}, NewErrTTLInvalidField(collectionName, "")
+			}
+			desc.Field = fieldVal.Value
+		default:
+			return client.TTLDescription{}, NewErrTTLWithUnknownArg(collectionName, arg.Name.Value)
+		}
+	}
+
+	for _, field := range fields {
+		if field.Name == desc.Field && field.Kind == client.FieldKind_DATETIME {
+			return desc, nil
+		}
+	}
+	return client.TTLDescription{}, NewErrTTLInvalidField(collectionName, desc.Field)
+}
+
+// hasAscendingIndexOnField returns true if one of the given indexes starts with the given
+// field, in ascending order.
+func hasAscendingIndexOnField(indexes []client.IndexDescription, fieldName string) bool {
+	for _, index := range indexes {
+		if len(index.Fields) > 0 &&
+			index.Fields[0].Name == fieldName &&
+			index.Fields[0].Direction == client.Ascending {
+			return true
+		}
+	}
+	return false
+}
+
+// hasIndexOnField returns true if one of the given indexes starts with the given field.
+func hasIndexOnField(indexes []client.IndexDescription, fieldName string) bool {
+	for _, index := range indexes {
+		if len(index.Fields) > 0 && index.Fields[0].Name == fieldName {
+			return true
+		}
+	}
+	return false
+}
+
 func setCRDTType(field *ast.FieldDefinition, kind client.FieldKind) (client.CType, error) {
 	if directive, exists := findDirective(field, "crdt"); exists {
 		for _, arg := range directive.Arguments {
@@ -404,7 +795,7 @@ func setCRDTType(field *ast.FieldDefinition, kind client.FieldKind) (client.CTyp
 	return defaultCRDTForFieldKind[kind], nil
 }
 
-func astTypeToKind(t ast.Type) (client.FieldKind, error) {
+func astTypeToKind(t ast.Type, enums map[string]client.EnumDescription) (client.FieldKind, error) {
 	const (
 		typeID       string = "ID"
 		typeBoolean  string = "Boolean"
@@ -413,6 +804,8 @@ func astTypeToKind(t ast.Type) (client.FieldKind, error) {
 		typeDateTime string = "DateTime"
 		typeString   string = "String"
 		typeBlob     string = "Blob"
+		typeBigInt   string = "BigInt"
+		typeDecimal  string = "Decimal"
 	)
 
 	switch astTypeVal := t.(type) {
@@ -443,6 +836,9 @@ func astTypeToKind(t ast.Type) (client.FieldKind, error) {
 			case typeString:
 				return client.FieldKind_NILLABLE_STRING_ARRAY, nil
 			default:
+				if _, isEnum := enums[astTypeVal.Type.(*ast.Named).Name.Value]; isEnum {
+					return 0, NewErrEnumArrayNotSupported(astTypeVal.Type.(*ast.Named).Name.Value)
+				}
 				return client.FieldKind_FOREIGN_OBJECT_ARRAY, nil
 			}
 		}
@@ -463,7 +859,14 @@ func astTypeToKind(t ast.Type) (client.FieldKind, error) {
 			return client.FieldKind_STRING, nil
 		case typeBlob:
 			return client.FieldKind_BLOB, nil
+		case typeBigInt:
+			return client.FieldKind_BIGINT, nil
+		case typeDecimal:
+			return client.FieldKind_DECIMAL, nil
 		default:
+			if _, isEnum := enums[astTypeVal.Name.Value]; isEnum {
+				return client.FieldKind_ENUM, nil
+			}
 			return client.FieldKind_FOREIGN_OBJECT, nil
 		}
 
@@ -475,6 +878,45 @@ func astTypeToKind(t ast.Type) (client.FieldKind, error) {
 	}
 }
 
+// relationOptionsFromAST returns the referential integrity options given by the @relation
+// directive on the given field.
+func relationOptionsFromAST(field *ast.FieldDefinition) (client.RelationOnDelete, bool, error) {
+	directive, exists := findDirective(field, types.RelationLabel)
+	if !exists {
+		return "", false, nil
+	}
+
+	var onDelete client.RelationOnDelete
+	var checkExists bool
+	for _, arg := range directive.Arguments {
+		switch arg.Name.Value {
+		case types.RelationDirectivePropOnDelete:
+			var value string
+			switch argValue := arg.Value.(type) {
+			case *ast.EnumValue:
+				value = argValue.Value
+			case *ast.StringValue:
+				value = argValue.Value
+			default:
+				return "", false, NewErrRelationWithInvalidArg(field.Name.Value, arg.Name.Value)
+			}
+			switch client.RelationOnDelete(value) {
+			case client.RelationOnDeleteCascade, client.RelationOnDeleteSetNull, client.RelationOnDeleteRestrict:
+				onDelete = client.RelationOnDelete(value)
+			default:
+				return "", false, NewErrRelationWithInvalidArg(field.Name.Value, arg.Name.Value)
+			}
+		case types.RelationDirectivePropCheckExists:
+			argValue, ok := arg.Value.(*ast.BooleanValue)
+			if !ok {
+				return "", false, NewErrRelationWithInvalidArg(field.Name.Value, arg.Name.Value)
+			}
+			checkExists = argValue.Value
+		}
+	}
+	return onDelete, checkExists, nil
+}
+
 func findDirective(field *ast.FieldDefinition, directiveName string) (*ast.Directive, bool) {
 	for _, directive := range field.Directives {
 		if directive.Name.Value == directiveName {
@@ -493,9 +935,9 @@ func getRelationshipName(
 ) (string, error) {
 	// search for a @relation directive name, and return it if found
 	for _, directive := range field.Directives {
-		if directive.Name.Value == "relation" {
+		if directive.Name.Value == types.RelationLabel {
 			for _, argument := range directive.Arguments {
-				if argument.Name.Value == "name" {
+				if argument.Name.Value == types.RelationDirectivePropName {
 					name, isString := argument.Value.GetValue().(string)
 					if !isString {
 						return "", client.NewErrUnexpectedType[string]("Relationship name", argument.Value.GetValue())
@@ -552,3 +994,102 @@ func finalizeRelations(relationManager *RelationManager, definitions []client.Co
 
 	return nil
 }
+
+// appendLinkCollections appends the definitions of the hidden collections holding the links
+// of the many-to-many relations between the given collections.
+//
+// The link collection of a relation holds one document per pair of related documents, which
+// references the two documents and whether they are currently related. Unlinking two documents
+// sets the linked field to false instead of deleting their link document, as the ID of a link
+// document is derived from the pair it references and a deleted document could never be created
+// again if they were linked later.
+//
+// Link collections are internal, they are not returned by GetAllCollections nor exposed through
+// GraphQL. They are synced by the P2P system along with the collections of their relation, so
+// that the relation can be resolved on the other peers.
+func appendLinkCollections(definitions []client.CollectionDefinition) ([]client.CollectionDefinition, error) {
+	manyToManyFields := map[string][]client.FieldDescription{}
+	relationNames := []string{}
+	for _, def := range definitions {
+		if def.Description.Name == "" {
+			continue
+		}
+		for _, field := range def.Schema.Fields {
+			if !field.IsManyToMany() {
+				continue
+			}
+			if _, exists := manyToManyFields[field.RelationName]; !exists {
+				relationNames = append(relationNames, field.RelationName)
+			}
+			manyToManyFields[field.RelationName] = append(manyToManyFields[field.RelationName], field)
+		}
+	}
+
+	for _, relationName := range relationNames {
+		fields := manyToManyFields[relationName]
+		if len(fields) != 2 {
+			return nil, client.NewErrRelationOneSided(fields[0].Name, fields[0].Schema)
+		}
+
+		firstFieldName := client.LinkFieldName(fields[0])
+		secondFieldName := client.LinkFieldName(fields[1])
+		if firstFieldName == secondFieldName {
+			return nil, NewErrManyToManyFieldNamesMatch(relationName, fields[0].Name)
+		}
+
+		linkFields := []client.FieldDescription{
+			{
+				Name: request.DocIDFieldName,
+				Kind: client.FieldKind_DocID,
+				Typ:  client.NONE_CRDT,
+			},
+			{
+				Name: firstFieldName,
+				Kind: client.FieldKind_DocID,
+				Typ:  client.LWW_REGISTER,
+			},
+			{
+				Name: secondFieldName,
+				Kind: client.FieldKind_DocID,
+				Typ:  client.LWW_REGISTER,
+			},
+			{
+				Name: client.LinkedFieldName,
+				Kind: client.FieldKind_BOOL,
+				Typ:  client.LWW_REGISTER,
+			},
+		}
+		// sort the fields lexicographically, keeping the _docID at the beginning
+		sortedFields := linkFields[1:]
+		sort.Slice(sortedFields, func(i, j int) bool {
+			return sortedFields[i].Name < sortedFields[j].Name
+		})
+
+		name := client.LinkCollectionName(relationName)
+		definitions = append(definitions, client.CollectionDefinition{
+			Description: client.CollectionDescription{
+				Name:     name,
+				Internal: true,
+				// the links of a document are found through the index on its side of the relation
+				Indexes: []client.IndexDescription{
+					{
+						Fields: []client.IndexedFieldDescription{
+							{Name: firstFieldName, Direction: client.Ascending},
+						},
+					},
+					{
+						Fields: []client.IndexedFieldDescription{
+							{Name: secondFieldName, Direction: client.Ascending},
+						},
+					},
+				},
+			},
+			Schema: client.SchemaDescription{
+				Name:   name,
+				Fields: linkFields,
+			},
+		})
+	}
+
+	return definitions, nil
+}
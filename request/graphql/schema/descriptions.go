@@ -33,7 +33,9 @@ var (
 		&gql.Object{}: client.FieldKind_FOREIGN_OBJECT,
 		&gql.List{}:   client.FieldKind_FOREIGN_OBJECT_ARRAY,
 		// Custom scalars
-		schemaTypes.BlobScalarType: client.FieldKind_BLOB,
+		schemaTypes.BlobScalarType:    client.FieldKind_BLOB,
+		schemaTypes.BigIntScalarType:  client.FieldKind_BIGINT,
+		schemaTypes.DecimalScalarType: client.FieldKind_DECIMAL,
 		// More custom ones to come
 		// - JSON
 		// - Counters
@@ -55,6 +57,8 @@ var (
 		client.FieldKind_STRING_ARRAY:          gql.NewList(gql.NewNonNull(gql.String)),
 		client.FieldKind_NILLABLE_STRING_ARRAY: gql.NewList(gql.String),
 		client.FieldKind_BLOB:                  schemaTypes.BlobScalarType,
+		client.FieldKind_BIGINT:                schemaTypes.BigIntScalarType,
+		client.FieldKind_DECIMAL:               schemaTypes.DecimalScalarType,
 	}
 
 	// This map is fine to use
@@ -74,6 +78,9 @@ var (
 		client.FieldKind_STRING_ARRAY:          client.LWW_REGISTER,
 		client.FieldKind_NILLABLE_STRING_ARRAY: client.LWW_REGISTER,
 		client.FieldKind_BLOB:                  client.LWW_REGISTER,
+		client.FieldKind_ENUM:                  client.LWW_REGISTER,
+		client.FieldKind_BIGINT:                client.LWW_REGISTER,
+		client.FieldKind_DECIMAL:               client.LWW_REGISTER,
 		client.FieldKind_FOREIGN_OBJECT:        client.LWW_REGISTER,
 		client.FieldKind_FOREIGN_OBJECT_ARRAY:  client.NONE_CRDT,
 	}
@@ -118,6 +125,25 @@ An optional filter for this select, only documents matching the given criteria
 	aggregateFilterArgDescription string = `
 An optional filter for this aggregate, only documents matching the given criteria
  will be aggregated.
+`
+	asOfArgDescription string = `
+An optional point in time, if specified documents will be returned as they were
+ at that time, as seen by this node. Filters and related documents are also
+ evaluated as they were at that time. Commits are timed by the clock given by
+ asOfClock, by default the time at which this node applied them, whether they were
+ created locally or received from a peer, so the same request may return different
+ results on different nodes, and changes to the clock of this node are reflected
+ in the results. Commits applied before this node recorded its commit history are
+ timed by their commit timestamp if they have one, else they are seen from the
+ earliest time. Documents are recomposed from their history, only the documents
+ requested through docIDs or a _docID filter are recomposed if given, else all the
+ documents of the collection.
+`
+	asOfClockArgDescription string = `
+An optional clock by which the commits are timed for the asOf argument, APPLIED by
+ default. With COMMITTED, commits are timed by the timestamp recorded in them by the
+ node creating them, so the same request returns the same results on every node that
+ applied the same commits. It has no effect without the asOf argument.
 `
 	showDeletedArgDescription string = `
 An optional value that specifies as to whether deleted documents may be
@@ -165,6 +191,17 @@ An optional set of docID values that will limit the delete to documents with
 An optional filter for this delete that will limit the delete to documents
  matching the given criteria. If no matching documents are found, the operation
  will succeed, but no documents will be deleted.
+`
+	revertDocumentDescription string = `
+Reverts the document with the given docID to the given version. The values of
+ that version are written as new commits, the history of the document is left
+ untouched.
+`
+	revertIDArgDescription string = `
+The docID of the document to revert.
+`
+	revertCidArgDescription string = `
+The CID of the document commit to revert the document to.
 `
 	groupFieldDescription string = `
 The group field may be used to return a set of records belonging to the group.
@@ -174,8 +211,43 @@ The group field may be used to return a set of records belonging to the group.
 `
 	deletedFieldDescription string = `
 Indicates as to whether or not this document has been deleted.
+`
+	cursorFieldDescription string = `
+An opaque cursor identifying the position of this document within the results,
+ which may be given to the 'after' and 'before' arguments to page through them.
+`
+	pageInfoFieldDescription string = `
+Information about the page of results returned by the select. It is returned once,
+ in the extensions of the result, rather than with each document, and may only be
+ requested on top-level selects.
+`
+	operationFieldDescription string = `
+The kind of change to this document that the subscription was notified of.
+ Only available on subscriptions.
+`
+	changedFieldsFieldDescription string = `
+The names of the fields set by the change to this document that the subscription
+ was notified of. Only available on subscriptions.
+`
+	operationsArgDescription string = `
+The kinds of changes to the documents that the subscription is notified of.
+ The subscription is notified of all kinds of changes if omitted.
 `
 	versionFieldDescription string = `
 Returns the head commit for this document.
+`
+	relationInputArgDescription string = `
+Nested operations to execute on the related documents of this relation, within the
+ same transaction as the host mutation.
+`
+	relationCreateArgDescription string = `
+Creates the given document(s) and relates them to the host document.
+`
+	relationConnectArgDescription string = `
+Relates the existing document(s) of the given docID(s) to the host document.
+`
+	relationDisconnectArgDescription string = `
+Unrelates the document(s) of the given docID(s) from the host document. On a one-sided
+ relation, setting this to true unrelates whatever document is currently related.
 `
 )
@@ -14,6 +14,8 @@ import (
 	"context"
 	"testing"
 
+	"github.com/shopspring/decimal"
+	"github.com/sourcenetwork/immutable"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/sourcenetwork/defradb/client"
@@ -617,6 +619,73 @@ func TestSingleSimpleType(t *testing.T) {
 	}
 }
 
+func TestEnumType(t *testing.T) {
+	descs, err := FromString(
+		context.Background(),
+		`
+		type User {
+			name: String
+			status: Status
+		}
+
+		enum Status {
+			ACTIVE
+			INACTIVE
+		}
+		`,
+	)
+	assert.NoError(t, err)
+	assert.Len(t, descs, 1)
+
+	assert.Equal(
+		t,
+		client.SchemaDescription{
+			Name: "User",
+			Fields: []client.FieldDescription{
+				{
+					Name: "_docID",
+					Kind: client.FieldKind_DocID,
+					Typ:  client.NONE_CRDT,
+				},
+				{
+					Name: "name",
+					Kind: client.FieldKind_STRING,
+					Typ:  client.LWW_REGISTER,
+				},
+				{
+					Name:   "status",
+					Kind:   client.FieldKind_ENUM,
+					Typ:    client.LWW_REGISTER,
+					Schema: "Status",
+				},
+			},
+			Enums: []client.EnumDescription{
+				{
+					Name:   "Status",
+					Values: []string{"ACTIVE", "INACTIVE"},
+				},
+			},
+		},
+		descs[0].Schema,
+	)
+}
+
+func TestEnumArrayType_Errors(t *testing.T) {
+	_, err := FromString(
+		context.Background(),
+		`
+		type User {
+			statuses: [Status]
+		}
+
+		enum Status {
+			ACTIVE
+		}
+		`,
+	)
+	assert.ErrorIs(t, err, NewErrEnumArrayNotSupported("Status"))
+}
+
 func runCreateDescriptionTest(t *testing.T, testcase descriptionTestCase) {
 	ctx := context.Background()
 
@@ -634,3 +703,59 @@ type descriptionTestCase struct {
 	sdl         string
 	targetDescs []client.CollectionDefinition
 }
+
+func TestFieldNonNullDefaultAndConstraint(t *testing.T) {
+	descs, err := FromString(
+		context.Background(),
+		`
+		type User {
+			name: String! @constraint(maxLength: 10, pattern: "^[A-Z]")
+			age: Int @default(value: 18) @constraint(min: 0, max: 150)
+			tags: [String!] @default(value: ["a", "b"])
+		}
+		`,
+	)
+	assert.NoError(t, err)
+	assert.Len(t, descs, 1)
+
+	assert.Equal(
+		t,
+		client.SchemaDescription{
+			Name: "User",
+			Fields: []client.FieldDescription{
+				{
+					Name: "_docID",
+					Kind: client.FieldKind_DocID,
+					Typ:  client.NONE_CRDT,
+				},
+				{
+					Name:         "age",
+					Kind:         client.FieldKind_INT,
+					Typ:          client.LWW_REGISTER,
+					DefaultValue: "18",
+					Constraint: &client.FieldConstraint{
+						Min: immutable.Some(decimal.RequireFromString("0")),
+						Max: immutable.Some(decimal.RequireFromString("150")),
+					},
+				},
+				{
+					Name:      "name",
+					Kind:      client.FieldKind_STRING,
+					Typ:       client.LWW_REGISTER,
+					IsNonNull: true,
+					Constraint: &client.FieldConstraint{
+						Pattern:   "^[A-Z]",
+						MaxLength: immutable.Some(10),
+					},
+				},
+				{
+					Name:         "tags",
+					Kind:         client.FieldKind_STRING_ARRAY,
+					Typ:          client.LWW_REGISTER,
+					DefaultValue: `["a","b"]`,
+				},
+			},
+		},
+		descs[0].Schema,
+	)
+}
@@ -28,6 +28,23 @@ const (
 	errIndexInvalidArgument          string = "index with invalid argument"
 	errIndexInvalidName              string = "index with invalid name"
 	errViewRelationMustBeOneSided    string = "relations in views must only be defined on one schema"
+	errDuplicateEnum                 string = "duplicate enum"
+	errEnumHasNoValues               string = "enum must define at least one value"
+	errEnumArrayNotSupported         string = "arrays of enums are not supported"
+	errDefaultUnknownArgument        string = "default with unknown argument"
+	errDefaultMissingValue           string = "default missing value"
+	errDefaultInvalidValue           string = "default with invalid value"
+	errConstraintUnknownArgument     string = "constraint with unknown argument"
+	errConstraintInvalidArgument     string = "constraint with invalid argument"
+	errComputedUnknownArgument       string = "computed with unknown argument"
+	errComputedMissingExpression     string = "computed missing expression"
+	errRelationInvalidArgument       string = "relation with invalid argument"
+	errManyToManyFieldNamesMatch     string = "the fields of a many-to-many relation must have different names"
+	errRetentionUnknownArgument      string = "retention with unknown argument"
+	errRetentionInvalidArgument      string = "retention with invalid argument"
+	errRetentionMissingLimit         string = "retention must define a versions or age limit"
+	errTTLUnknownArgument            string = "ttl with unknown argument"
+	errTTLInvalidField               string = "ttl field must be a DateTime field of the type"
 )
 
 var (
@@ -140,3 +157,120 @@ func NewErrViewRelationMustBeOneSided(fieldName string, typeName string) error {
 		errors.NewKV("Type", typeName),
 	)
 }
+
+func NewErrDuplicateEnum(name string) error {
+	return errors.New(
+		errDuplicateEnum,
+		errors.NewKV("Name", name),
+	)
+}
+
+func NewErrEnumHasNoValues(name string) error {
+	return errors.New(
+		errEnumHasNoValues,
+		errors.NewKV("Name", name),
+	)
+}
+
+func NewErrEnumArrayNotSupported(name string) error {
+	return errors.New(
+		errEnumArrayNotSupported,
+		errors.NewKV("Name", name),
+	)
+}
+
+func NewErrDefaultWithUnknownArg(fieldName string, argName string) error {
+	return errors.New(
+		errDefaultUnknownArgument,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Argument", argName),
+	)
+}
+
+func NewErrDefaultMissingValue(fieldName string) error {
+	return errors.New(errDefaultMissingValue, errors.NewKV("Field", fieldName))
+}
+
+func NewErrDefaultWithInvalidValue(valueKind string) error {
+	return errors.New(errDefaultInvalidValue, errors.NewKV("Kind", valueKind))
+}
+
+func NewErrConstraintWithUnknownArg(fieldName string, argName string) error {
+	return errors.New(
+		errConstraintUnknownArgument,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Argument", argName),
+	)
+}
+
+func NewErrConstraintWithInvalidArg(fieldName string, argName string) error {
+	return errors.New(
+		errConstraintInvalidArgument,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Argument", argName),
+	)
+}
+
+func NewErrComputedWithUnknownArg(fieldName string, argName string) error {
+	return errors.New(
+		errComputedUnknownArgument,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Argument", argName),
+	)
+}
+
+func NewErrComputedMissingExpression(fieldName string) error {
+	return errors.New(errComputedMissingExpression, errors.NewKV("Field", fieldName))
+}
+
+func NewErrRetentionWithUnknownArg(collectionName string, argName string) error {
+	return errors.New(
+		errRetentionUnknownArgument,
+		errors.NewKV("Collection", collectionName),
+		errors.NewKV("Argument", argName),
+	)
+}
+
+func NewErrRetentionWithInvalidArg(collectionName string, argName string) error {
+	return errors.New(
+		errRetentionInvalidArgument,
+		errors.NewKV("Collection", collectionName),
+		errors.NewKV("Argument", argName),
+	)
+}
+
+func NewErrRetentionMissingLimit(collectionName string) error {
+	return errors.New(errRetentionMissingLimit, errors.NewKV("Collection", collectionName))
+}
+
+func NewErrTTLWithUnknownArg(collectionName string, argName string) error {
+	return errors.New(
+		errTTLUnknownArgument,
+		errors.NewKV("Collection", collectionName),
+		errors.NewKV("Argument", argName),
+	)
+}
+
+func NewErrTTLInvalidField(collectionName string, fieldName string) error {
+	return errors.New(
+		errTTLInvalidField,
+		errors.NewKV("Collection", collectionName),
+		errors.NewKV("Field", fieldName),
+	)
+}
+
+func NewErrRelationWithInvalidArg(fieldName string, argName string) error {
+	return errors.New(
+		errRelationInvalidArgument,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Argument", argName),
+	)
+}
+
+func NewErrManyToManyFieldNamesMatch(relationName string, fieldName string) error {
+	return errors.New(
+		errManyToManyFieldNamesMatch,
+		errors.NewKV("Relation", relationName),
+		errors.NewKV("Field", fieldName),
+	)
+}
@@ -15,6 +15,8 @@ import (
 	"fmt"
 	"strings"
 
+	"golang.org/x/exp/slices"
+
 	gql "github.com/sourcenetwork/graphql-go"
 
 	"github.com/sourcenetwork/defradb/client"
@@ -78,9 +80,29 @@ func (g *Generator) Generate(ctx context.Context, collections []client.Collectio
 	return result, nil
 }
 
+// withoutInternalCollections returns the given collections, excluding the collections managed
+// by the database itself.
+func withoutInternalCollections(collections []client.CollectionDefinition) []client.CollectionDefinition {
+	result := make([]client.CollectionDefinition, 0, len(collections))
+	for _, c := range collections {
+		if !c.Description.Internal {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
 // generate generates the query-op and mutation-op type definitions from
 // the given CollectionDescriptions.
 func (g *Generator) generate(ctx context.Context, collections []client.CollectionDefinition) ([]*gql.Object, error) {
+	// link collections are hidden, they are only queried through their many-to-many relations
+	collections = withoutInternalCollections(collections)
+
+	// build enum types
+	err := g.buildEnumTypes(collections)
+	if err != nil {
+		return nil, err
+	}
 	// build base types
 	defs, err := g.buildTypes(ctx, collections)
 	if err != nil {
@@ -98,7 +120,10 @@ func (g *Generator) generate(ctx context.Context, collections []client.Collectio
 
 	// for each built type generate query inputs
 	queryType := g.manager.schema.QueryType()
+	subscriptionType := g.manager.schema.SubscriptionType()
 	generatedQueryFields := make([]*gql.Field, 0)
+	// the query fields of the collections that may be paginated and subscribed to, by type name
+	collectionQueryFields := map[string]*gql.Field{}
 	for _, t := range g.typeDefs {
 		f, err := g.GenerateQueryInputForGQLType(ctx, t)
 		if err != nil {
@@ -122,6 +147,19 @@ func (g *Generator) generate(ctx context.Context, collections []client.Collectio
 		}
 
 		queryType.AddFieldConfig(f.Name, f)
+
+		var isView bool
+		for _, definition := range collections {
+			if t.Name() == definition.Description.Name {
+				isView = definition.Description.BaseQuery != nil
+				break
+			}
+		}
+		if isView {
+			subscriptionType.AddFieldConfig(f.Name, g.genTypeSubscriptionField(t, t, f))
+		} else {
+			collectionQueryFields[t.Name()] = f
+		}
 	}
 
 	// resolve types
@@ -174,6 +212,9 @@ func (g *Generator) generate(ctx context.Context, collections []client.Collectio
 						Type:        g.manager.schema.TypeMap()[name+"FilterArg"],
 					}
 					aggregateTarget.Type.(*gql.InputObject).AddFieldConfig(request.FilterClause, expandedField)
+					if def.Name == request.CountFieldName {
+						g.appendCountDistinctField(aggregateTarget.Type.(*gql.InputObject), name)
+					}
 				}
 			}
 		}
@@ -186,6 +227,52 @@ func (g *Generator) generate(ctx context.Context, collections []client.Collectio
 		return nil, err
 	}
 
+	// The pagination fields are only resolved on top-level selects and the event fields only on
+	// subscriptions, so they are only exposed by the types returned by these.  These types copy
+	// the fields of the collection types, so they are generated once the latter are complete.
+	for _, t := range g.typeDefs {
+		f, ok := collectionQueryFields[t.Name()]
+		if !ok {
+			continue
+		}
+
+		pageItemType, err := g.genTypeWithFields(t, "PageItem", gql.Fields{
+			request.CursorFieldName: &gql.Field{
+				Description: cursorFieldDescription,
+				Type:        gql.String,
+			},
+			request.PageInfoFieldName: &gql.Field{
+				Description: pageInfoFieldDescription,
+				Type:        schemaTypes.PageInfoObject,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		f.Type = gql.NewList(pageItemType)
+		queryType.AddFieldConfig(f.Name, f)
+
+		payloadType, err := g.genTypeWithFields(t, "SubscriptionPayload", gql.Fields{
+			request.OperationFieldName: &gql.Field{
+				Description: operationFieldDescription,
+				Type:        schemaTypes.SubscriptionOperationEnum,
+			},
+			request.ChangedFieldsFieldName: &gql.Field{
+				Description: changedFieldsFieldDescription,
+				Type:        gql.NewList(gql.NewNonNull(gql.String)),
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		subscriptionType.AddFieldConfig(f.Name, g.genTypeSubscriptionField(t, payloadType, f))
+	}
+
+	// resolve types
+	if err := g.manager.ResolveTypes(); err != nil {
+		return nil, err
+	}
+
 	// now let's generate the mutation types.
 	mutationType := g.manager.schema.MutationType()
 	for _, t := range g.typeDefs {
@@ -307,8 +394,11 @@ func (g *Generator) createExpandedFieldAggregate(
 	for _, aggregateTarget := range f.Args {
 		target := aggregateTarget.Name()
 		var filterTypeName string
+		// The name of the object type targeted, if the target is a set of objects.
+		var targetTypeName string
 		if target == request.GroupFieldName {
 			filterTypeName = obj.Name() + "FilterArg"
+			targetTypeName = obj.Name()
 		} else {
 			if targeted := obj.Fields()[target]; targeted != nil {
 				if list, isList := targeted.Type.(*gql.List); isList && gql.IsLeafType(list.OfType) {
@@ -323,6 +413,9 @@ func (g *Generator) createExpandedFieldAggregate(
 					}
 				} else {
 					filterTypeName = targeted.Type.Name() + "FilterArg"
+					if list, isList := targeted.Type.(*gql.List); isList {
+						targetTypeName = list.OfType.Name()
+					}
 				}
 			} else {
 				return NewErrAggregateTargetNotFound(obj.Name(), target)
@@ -337,11 +430,28 @@ func (g *Generator) createExpandedFieldAggregate(
 			}
 			aggregateTarget.Type.(*gql.InputObject).AddFieldConfig("filter", expandedField)
 		}
+
+		if f.Name == request.CountFieldName && targetTypeName != "" {
+			g.appendCountDistinctField(aggregateTarget.Type.(*gql.InputObject), targetTypeName)
+		}
 	}
 
 	return nil
 }
 
+// appendCountDistinctField adds the distinct argument to the given count selector, allowing the
+// counted objects to be de-duplicated by a field of the given object type.
+func (g *Generator) appendCountDistinctField(selector *gql.InputObject, typeName string) {
+	fieldsEnum, hasFieldsEnum := g.manager.schema.TypeMap()[typeName+"Fields"]
+	if !hasFieldsEnum {
+		return
+	}
+	selector.AddFieldConfig(request.DistinctClause, &gql.InputObjectFieldConfig{
+		Description: schemaTypes.CountDistinctArgDescription,
+		Type:        fieldsEnum,
+	})
+}
+
 func (g *Generator) createExpandedFieldSingle(
 	f *gql.FieldDefinition,
 	t *gql.Object,
@@ -382,12 +492,22 @@ func (g *Generator) createExpandedFieldList(
 				gql.NewList(gql.NewNonNull(g.manager.schema.TypeMap()[typeName+"Fields"])),
 				schemaTypes.GroupByArgDescription,
 			),
+			request.GroupByKeysClause: schemaTypes.NewArgConfig(
+				gql.NewList(gql.NewNonNull(schemaTypes.GroupByKeyInput)),
+				schemaTypes.GroupByKeysArgDescription,
+			),
+			request.DistinctClause: schemaTypes.NewArgConfig(
+				gql.NewList(gql.NewNonNull(g.manager.schema.TypeMap()[typeName+"Fields"])),
+				schemaTypes.DistinctArgDescription,
+			),
 			"order": schemaTypes.NewArgConfig(
 				g.manager.schema.TypeMap()[typeName+"OrderArg"],
 				schemaTypes.OrderArgDescription,
 			),
 			request.LimitClause:  schemaTypes.NewArgConfig(gql.Int, schemaTypes.LimitArgDescription),
 			request.OffsetClause: schemaTypes.NewArgConfig(gql.Int, schemaTypes.OffsetArgDescription),
+			request.AfterClause:  schemaTypes.NewArgConfig(gql.String, schemaTypes.AfterArgDescription),
+			request.BeforeClause: schemaTypes.NewArgConfig(gql.String, schemaTypes.BeforeArgDescription),
 		},
 	}
 
@@ -472,6 +592,12 @@ func (g *Generator) buildTypes(
 						return nil, NewErrTypeNotFound(field.Schema)
 					}
 					ttype = gql.NewList(t)
+				} else if field.Kind == client.FieldKind_ENUM {
+					var ok bool
+					ttype, ok = g.manager.schema.TypeMap()[field.Schema]
+					if !ok {
+						return nil, NewErrTypeNotFound(field.Schema)
+					}
 				} else {
 					var ok bool
 					ttype, ok = fieldKindToGQLType[field.Kind]
@@ -508,6 +634,7 @@ func (g *Generator) buildTypes(
 					Description: deletedFieldDescription,
 					Type:        gql.Boolean,
 				}
+
 			}
 
 			return fields, nil
@@ -525,6 +652,58 @@ func (g *Generator) buildTypes(
 	return objs, nil
 }
 
+// buildEnumTypes creates the enum types, and their filter operator blocks, for all
+// the enums referenced by the given collections.
+//
+// Each schema holds its own copy of the enums that it references, and these copies
+// may have diverged through schema patches.  The generated type will permit the union
+// of all the values, the values permitted for a given schema are validated on write.
+func (g *Generator) buildEnumTypes(collections []client.CollectionDefinition) error {
+	enumNames := []string{}
+	valuesByEnumName := map[string][]string{}
+	for _, collection := range collections {
+		for _, enum := range collection.Schema.Enums {
+			values, ok := valuesByEnumName[enum.Name]
+			if !ok {
+				enumNames = append(enumNames, enum.Name)
+			}
+			for _, value := range enum.Values {
+				if !slices.Contains(values, value) {
+					values = append(values, value)
+				}
+			}
+			valuesByEnumName[enum.Name] = values
+		}
+	}
+
+	for _, enumName := range enumNames {
+		if _, ok := g.manager.schema.TypeMap()[enumName]; ok {
+			return NewErrSchemaTypeAlreadyExist(enumName)
+		}
+
+		enumCfg := gql.EnumConfig{
+			Name:   enumName,
+			Values: gql.EnumValueConfigMap{},
+		}
+		for _, value := range valuesByEnumName[enumName] {
+			enumCfg.Values[value] = &gql.EnumValueConfig{Value: value}
+		}
+
+		enum := gql.NewEnum(enumCfg)
+		err := g.manager.schema.AppendType(enum)
+		if err != nil {
+			return err
+		}
+
+		err = g.manager.schema.AppendType(schemaTypes.NewEnumOperatorBlock(enum))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // buildMutationInputTypes creates the input object types
 // for collection create and update mutation operations.
 func (g *Generator) buildMutationInputTypes(collections []client.CollectionDefinition) error {
@@ -564,12 +743,30 @@ func (g *Generator) buildMutationInputTypes(collections []client.CollectionDefin
 					// user cannot override their values
 					continue
 				}
+				if field.IsComputed() {
+					// computed fields are read-only
+					continue
+				}
 
 				var ttype gql.Type
 				if field.Kind == client.FieldKind_FOREIGN_OBJECT {
-					ttype = gql.ID
+					var ok bool
+					ttype, ok = g.manager.schema.TypeMap()[field.Schema+"RelationInputArg"]
+					if !ok {
+						return nil, NewErrTypeNotFound(field.Schema + "RelationInputArg")
+					}
 				} else if field.Kind == client.FieldKind_FOREIGN_OBJECT_ARRAY {
-					ttype = gql.NewList(gql.ID)
+					var ok bool
+					ttype, ok = g.manager.schema.TypeMap()[field.Schema+"RelationListInputArg"]
+					if !ok {
+						return nil, NewErrTypeNotFound(field.Schema + "RelationListInputArg")
+					}
+				} else if field.Kind == client.FieldKind_ENUM {
+					var ok bool
+					ttype, ok = g.manager.schema.TypeMap()[field.Schema]
+					if !ok {
+						return nil, NewErrTypeNotFound(field.Schema)
+					}
 				} else {
 					var ok bool
 					ttype, ok = fieldKindToGQLType[field.Kind]
@@ -588,11 +785,60 @@ func (g *Generator) buildMutationInputTypes(collections []client.CollectionDefin
 
 		mutationObj := gql.NewInputObject(mutationObjConf)
 		g.manager.schema.TypeMap()[mutationObj.Name()] = mutationObj
+
+		for _, relationObj := range genRelationInputArgs(collection.Description.Name, mutationObj) {
+			g.manager.schema.TypeMap()[relationObj.Name()] = relationObj
+		}
 	}
 
 	return nil
 }
 
+// genRelationInputArgs creates the input object types used to create, connect or
+// disconnect documents of the given collection through the relation fields of
+// another collection's mutation input.
+func genRelationInputArgs(collectionName string, mutationObj *gql.InputObject) []*gql.InputObject {
+	relationObj := gql.NewInputObject(gql.InputObjectConfig{
+		Name:        collectionName + "RelationInputArg",
+		Description: relationInputArgDescription,
+		Fields: gql.InputObjectConfigFieldMap{
+			request.RelationCreate: &gql.InputObjectFieldConfig{
+				Type:        mutationObj,
+				Description: relationCreateArgDescription,
+			},
+			request.RelationConnect: &gql.InputObjectFieldConfig{
+				Type:        gql.ID,
+				Description: relationConnectArgDescription,
+			},
+			request.RelationDisconnect: &gql.InputObjectFieldConfig{
+				Type:        gql.Boolean,
+				Description: relationDisconnectArgDescription,
+			},
+		},
+	})
+
+	relationListObj := gql.NewInputObject(gql.InputObjectConfig{
+		Name:        collectionName + "RelationListInputArg",
+		Description: relationInputArgDescription,
+		Fields: gql.InputObjectConfigFieldMap{
+			request.RelationCreate: &gql.InputObjectFieldConfig{
+				Type:        gql.NewList(mutationObj),
+				Description: relationCreateArgDescription,
+			},
+			request.RelationConnect: &gql.InputObjectFieldConfig{
+				Type:        gql.NewList(gql.ID),
+				Description: relationConnectArgDescription,
+			},
+			request.RelationDisconnect: &gql.InputObjectFieldConfig{
+				Type:        gql.NewList(gql.ID),
+				Description: relationDisconnectArgDescription,
+			},
+		},
+	})
+
+	return []*gql.InputObject{relationObj, relationListObj}
+}
+
 func (g *Generator) genAggregateFields(ctx context.Context) error {
 	topLevelCountInputs := map[string]*gql.InputObject{}
 	topLevelNumericAggInputs := map[string]*gql.InputObject{}
@@ -931,7 +1177,8 @@ func (g *Generator) genNumericAggregateBaseArgInputs(obj *gql.Object) *gql.Input
 			hasSumableFields := false
 			// generate basic filter operator blocks for all the sumable types
 			for _, field := range obj.Fields() {
-				if field.Type == gql.Float || field.Type == gql.Int {
+				if field.Type == gql.Float || field.Type == gql.Int ||
+					field.Type == schemaTypes.BigIntScalarType || field.Type == schemaTypes.DecimalScalarType {
 					hasSumableFields = true
 					fieldsEnumCfg.Values[field.Name] = &gql.EnumValueConfig{Value: field.Name}
 					continue
@@ -1008,6 +1255,7 @@ func (g *Generator) GenerateQueryInputForGQLType(
 	}
 	types := queryInputTypeConfig{}
 	types.filter = g.genTypeFilterArgInput(obj)
+	types.listFilter = g.genTypeListFilterArgInput(obj, types.filter)
 
 	// @todo: Don't add sub fields to filter/order for object list types
 	types.groupBy = g.genTypeFieldsEnum(obj)
@@ -1070,7 +1318,17 @@ func (g *Generator) GenerateMutationInputForGQLType(obj *gql.Object) ([]*gql.Fie
 		},
 	}
 
-	return []*gql.Field{create, update, delete}, nil
+	revert := &gql.Field{
+		Name:        "revert_" + obj.Name(),
+		Description: revertDocumentDescription,
+		Type:        obj,
+		Args: gql.FieldConfigArgument{
+			request.DocIDArgName: schemaTypes.NewArgConfig(gql.NewNonNull(gql.ID), revertIDArgDescription),
+			request.Cid:          schemaTypes.NewArgConfig(gql.NewNonNull(gql.ID), revertCidArgDescription),
+		},
+	}
+
+	return []*gql.Field{create, update, delete, revert}, nil
 }
 
 func (g *Generator) genTypeFieldsEnum(obj *gql.Object) *gql.Enum {
@@ -1109,6 +1367,18 @@ func (g *Generator) genTypeFilterArgInput(obj *gql.Object) *gql.InputObject {
 				Description: schemaTypes.NotOperatorDescription,
 				Type:        selfRefType,
 			}
+			fields[request.CountFieldName] = &gql.InputObjectFieldConfig{
+				Description: schemaTypes.CountFilterDescription,
+				Type:        schemaTypes.IntOperatorBlock,
+			}
+			fields[request.SumFieldName] = &gql.InputObjectFieldConfig{
+				Description: schemaTypes.SumFilterDescription,
+				Type:        schemaTypes.FloatOperatorBlock,
+			}
+			fields[request.AverageFieldName] = &gql.InputObjectFieldConfig{
+				Description: schemaTypes.AverageFilterDescription,
+				Type:        schemaTypes.FloatOperatorBlock,
+			}
 
 			// generate basic filter operator blocks
 			// @todo: Extract object field loop into its own utility func
@@ -1118,11 +1388,17 @@ func (g *Generator) genTypeFilterArgInput(obj *gql.Object) *gql.InputObject {
 				}
 				// scalars (leafs)
 				if gql.IsLeafType(field.Type) {
-					if _, isList := field.Type.(*gql.List); isList {
-						// Filtering by inline array value is currently not supported
-						continue
+					operatorTypeName := field.Type.Name() + "OperatorBlock"
+					if list, isList := field.Type.(*gql.List); isList {
+						if notNull, isNotNull := list.OfType.(*gql.NonNull); isNotNull {
+							// GQL does not support '!' in type names, and so we have to manipulate the
+							// underlying name like this if it is a nullable type.
+							operatorTypeName = fmt.Sprintf("NotNull%sListOperatorBlock", notNull.OfType.Name())
+						} else {
+							operatorTypeName = genTypeName(list.OfType, "ListOperatorBlock")
+						}
 					}
-					operatorType, isFilterable := g.manager.schema.TypeMap()[field.Type.Name()+"OperatorBlock"]
+					operatorType, isFilterable := g.manager.schema.TypeMap()[operatorTypeName]
 					if !isFilterable {
 						continue
 					}
@@ -1130,12 +1406,12 @@ func (g *Generator) genTypeFilterArgInput(obj *gql.Object) *gql.InputObject {
 						Type: operatorType,
 					}
 				} else { // objects (relations)
-					fieldType := field.Type
+					filterTypeName := genTypeName(field.Type, "FilterArg")
 					if l, isList := field.Type.(*gql.List); isList {
-						// We want the FilterArg for the object, not the list of objects.
-						fieldType = l.OfType
+						// We want the ListFilterArg for the object, not the FilterArg of the list of objects.
+						filterTypeName = genTypeName(l.OfType, "ListFilterArg")
 					}
-					filterType, isFilterable := g.manager.schema.TypeMap()[genTypeName(fieldType, "FilterArg")]
+					filterType, isFilterable := g.manager.schema.TypeMap()[filterTypeName]
 					if !isFilterable {
 						filterType = &gql.InputObjectField{}
 					}
@@ -1155,6 +1431,47 @@ func (g *Generator) genTypeFilterArgInput(obj *gql.Object) *gql.InputObject {
 	return selfRefType
 }
 
+// genTypeListFilterArgInput generates the filter input used to filter on one-to-many relations to
+// the given object.
+//
+// It contains all of the fields of the given filter input, which matches if any related object
+// matches, along with the operators that define explicitly how the related objects must match.
+func (g *Generator) genTypeListFilterArgInput(obj *gql.Object, filter *gql.InputObject) *gql.InputObject {
+	inputCfg := gql.InputObjectConfig{
+		Name: genTypeName(obj, "ListFilterArg"),
+	}
+	fieldThunk := (gql.InputObjectConfigFieldMapThunk)(
+		func() (gql.InputObjectConfigFieldMap, error) {
+			fields := gql.InputObjectConfigFieldMap{}
+			for name, field := range filter.Fields() {
+				fields[name] = &gql.InputObjectFieldConfig{
+					Description: field.Description(),
+					Type:        field.Type,
+				}
+			}
+
+			// Aggregates may not be requested on relations within a filter, so only the
+			// number of related objects may be filtered upon.
+			delete(fields, request.SumFieldName)
+			delete(fields, request.AverageFieldName)
+			fields[request.CountFieldName] = &gql.InputObjectFieldConfig{
+				Description: schemaTypes.RelationCountFilterDescription,
+				Type:        schemaTypes.IntOperatorBlock,
+			}
+
+			fields[request.FilterOpSome] = &gql.InputObjectFieldConfig{
+				Description: schemaTypes.SomeOperatorDescription,
+				Type:        filter,
+			}
+
+			return fields, nil
+		},
+	)
+
+	inputCfg.Fields = fieldThunk
+	return gql.NewInputObject(inputCfg)
+}
+
 func (g *Generator) genLeafFilterArgInput(obj gql.Type) *gql.InputObject {
 	var selfRefType *gql.InputObject
 
@@ -1242,9 +1559,10 @@ func (g *Generator) genTypeOrderArgInput(obj *gql.Object) *gql.InputObject {
 }
 
 type queryInputTypeConfig struct {
-	filter  *gql.InputObject
-	groupBy *gql.Enum
-	order   *gql.InputObject
+	filter     *gql.InputObject
+	listFilter *gql.InputObject
+	groupBy    *gql.Enum
+	order      *gql.InputObject
 }
 
 func (g *Generator) genTypeQueryableFieldList(
@@ -1256,6 +1574,7 @@ func (g *Generator) genTypeQueryableFieldList(
 
 	// add the generated types to the type map
 	g.manager.schema.TypeMap()[config.filter.Name()] = config.filter
+	g.manager.schema.TypeMap()[config.listFilter.Name()] = config.listFilter
 	g.manager.schema.TypeMap()[config.groupBy.Name()] = config.groupBy
 	g.manager.schema.TypeMap()[config.order.Name()] = config.order
 
@@ -1264,24 +1583,100 @@ func (g *Generator) genTypeQueryableFieldList(
 		Description: obj.Description(),
 		Type:        gql.NewList(obj),
 		Args: gql.FieldConfigArgument{
-			request.DocIDArgName:  schemaTypes.NewArgConfig(gql.String, docIDArgDescription),
-			request.DocIDsArgName: schemaTypes.NewArgConfig(gql.NewList(gql.NewNonNull(gql.String)), docIDsArgDescription),
-			"cid":                 schemaTypes.NewArgConfig(gql.String, cidArgDescription),
-			"filter":              schemaTypes.NewArgConfig(config.filter, selectFilterArgDescription),
+			request.DocIDArgName:     schemaTypes.NewArgConfig(gql.String, docIDArgDescription),
+			request.DocIDsArgName:    schemaTypes.NewArgConfig(gql.NewList(gql.NewNonNull(gql.String)), docIDsArgDescription),
+			"cid":                    schemaTypes.NewArgConfig(gql.String, cidArgDescription),
+			request.AsOf:             schemaTypes.NewArgConfig(gql.DateTime, asOfArgDescription),
+			request.AsOfClockArgName: schemaTypes.NewArgConfig(schemaTypes.AsOfClockEnum, asOfClockArgDescription),
+			"filter":                 schemaTypes.NewArgConfig(config.filter, selectFilterArgDescription),
 			"groupBy": schemaTypes.NewArgConfig(
 				gql.NewList(gql.NewNonNull(config.groupBy)),
 				schemaTypes.GroupByArgDescription,
 			),
+			request.GroupByKeysClause: schemaTypes.NewArgConfig(
+				gql.NewList(gql.NewNonNull(schemaTypes.GroupByKeyInput)),
+				schemaTypes.GroupByKeysArgDescription,
+			),
+			request.DistinctClause: schemaTypes.NewArgConfig(
+				gql.NewList(gql.NewNonNull(config.groupBy)),
+				schemaTypes.DistinctArgDescription,
+			),
 			"order":              schemaTypes.NewArgConfig(config.order, schemaTypes.OrderArgDescription),
 			request.ShowDeleted:  schemaTypes.NewArgConfig(gql.Boolean, showDeletedArgDescription),
 			request.LimitClause:  schemaTypes.NewArgConfig(gql.Int, schemaTypes.LimitArgDescription),
 			request.OffsetClause: schemaTypes.NewArgConfig(gql.Int, schemaTypes.OffsetArgDescription),
+			request.AfterClause:  schemaTypes.NewArgConfig(gql.String, schemaTypes.AfterArgDescription),
+			request.BeforeClause: schemaTypes.NewArgConfig(gql.String, schemaTypes.BeforeArgDescription),
 		},
 	}
 
 	return field
 }
 
+// genTypeSubscriptionField returns the field subscribing to the changes to the documents of the
+// given type, returned as the given payload type, which shares the filter argument of the given
+// query field.
+func (g *Generator) genTypeSubscriptionField(
+	obj *gql.Object,
+	payloadType *gql.Object,
+	queryField *gql.Field,
+) *gql.Field {
+	return &gql.Field{
+		Name:        obj.Name(),
+		Description: obj.Description(),
+		Type:        gql.NewList(payloadType),
+		Args: gql.FieldConfigArgument{
+			request.FilterClause: queryField.Args[request.FilterClause],
+			request.OperationsArgName: schemaTypes.NewArgConfig(
+				gql.NewList(gql.NewNonNull(schemaTypes.SubscriptionOperationEnum)),
+				operationsArgDescription,
+			),
+		},
+	}
+}
+
+// genTypeWithFields returns a copy of the given object type with the given additional fields,
+// named after the given type with the given suffix.
+func (g *Generator) genTypeWithFields(obj *gql.Object, suffix string, extraFields gql.Fields) (*gql.Object, error) {
+	fields := gql.Fields{}
+	for name, def := range obj.Fields() {
+		args := gql.FieldConfigArgument{}
+		for _, arg := range def.Args {
+			args[arg.Name()] = &gql.ArgumentConfig{
+				Type:         arg.Type,
+				DefaultValue: arg.DefaultValue,
+				Description:  arg.Description(),
+			}
+		}
+		fields[name] = &gql.Field{
+			Name:              name,
+			Description:       def.Description,
+			Type:              def.Type,
+			Args:              args,
+			Resolve:           def.Resolve,
+			DeprecationReason: def.DeprecationReason,
+		}
+	}
+	for name, field := range extraFields {
+		fields[name] = field
+	}
+
+	typeName := genTypeName(obj, suffix)
+	if _, ok := g.manager.schema.TypeMap()[typeName]; ok {
+		return nil, NewErrSchemaTypeAlreadyExist(typeName)
+	}
+	copied := gql.NewObject(gql.ObjectConfig{
+		Name:        typeName,
+		Description: obj.Description(),
+		Fields:      fields,
+	})
+	err := g.manager.schema.AppendType(copied)
+	if err != nil {
+		return nil, err
+	}
+	return copied, nil
+}
+
 func (g *Generator) appendIfNotExists(obj gql.Type) error {
 	if _, typeExists := g.manager.schema.TypeMap()[obj.Name()]; !typeExists {
 		err := g.manager.schema.AppendType(obj)
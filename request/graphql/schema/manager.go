@@ -28,10 +28,11 @@ type SchemaManager struct {
 func NewSchemaManager() (*SchemaManager, error) {
 	sm := &SchemaManager{}
 	schema, err := gql.NewSchema(gql.SchemaConfig{
-		Types:      defaultTypes(),
-		Query:      defaultQueryType(),
-		Mutation:   defaultMutationType(),
-		Directives: defaultDirectivesType(),
+		Types:        defaultTypes(),
+		Query:        defaultQueryType(),
+		Mutation:     defaultMutationType(),
+		Subscription: defaultSubscriptionType(),
+		Directives:   defaultDirectivesType(),
 	})
 	if err != nil {
 		return sm, err
@@ -92,6 +93,7 @@ func defaultQueryType() *gql.Object {
 			// database API queries
 			schemaTypes.QueryCommits.Name:       schemaTypes.QueryCommits,
 			schemaTypes.QueryLatestCommits.Name: schemaTypes.QueryLatestCommits,
+			schemaTypes.QueryDiff.Name:          schemaTypes.QueryDiff,
 		},
 	})
 }
@@ -108,6 +110,18 @@ func defaultMutationType() *gql.Object {
 	})
 }
 
+func defaultSubscriptionType() *gql.Object {
+	return gql.NewObject(gql.ObjectConfig{
+		Name: "Subscription",
+		Fields: gql.Fields{
+			"_": &gql.Field{
+				Name: "_",
+				Type: gql.Boolean,
+			},
+		},
+	})
+}
+
 // default directives type.
 func defaultDirectivesType() []*gql.Directive {
 	return []*gql.Directive{
@@ -143,12 +157,23 @@ func defaultTypes() []gql.Type {
 
 		// Custom Scalar types
 		schemaTypes.BlobScalarType,
+		schemaTypes.BigIntScalarType,
+		schemaTypes.DecimalScalarType,
 
 		// Base Query types
 
 		// Sort/Order enum
 		schemaTypes.OrderingEnum,
 
+		// Subscription operation enum
+		schemaTypes.SubscriptionOperationEnum,
+
+		// As of clock enum
+		schemaTypes.AsOfClockEnum,
+
+		// Relation directive enum
+		schemaTypes.RelationOnDeleteEnum,
+
 		// Filter scalar blocks
 		schemaTypes.BooleanOperatorBlock,
 		schemaTypes.NotNullBooleanOperatorBlock,
@@ -160,11 +185,32 @@ func defaultTypes() []gql.Type {
 		schemaTypes.NotNullIntOperatorBlock,
 		schemaTypes.StringOperatorBlock,
 		schemaTypes.NotNullstringOperatorBlock,
+		schemaTypes.BigIntOperatorBlock,
+		schemaTypes.DecimalOperatorBlock,
+
+		// Filter inline array blocks
+		schemaTypes.BooleanListOperatorBlock,
+		schemaTypes.NotNullBooleanListOperatorBlock,
+		schemaTypes.FloatListOperatorBlock,
+		schemaTypes.NotNullFloatListOperatorBlock,
+		schemaTypes.IntListOperatorBlock,
+		schemaTypes.NotNullIntListOperatorBlock,
+		schemaTypes.StringListOperatorBlock,
+		schemaTypes.NotNullStringListOperatorBlock,
 
 		schemaTypes.CommitsOrderArg,
+		schemaTypes.CommitsFilterArg,
 		schemaTypes.CommitLinkObject,
 		schemaTypes.CommitObject,
 
+		schemaTypes.DiffFieldObject,
+		schemaTypes.DiffObject,
+
+		schemaTypes.PageInfoObject,
+
+		schemaTypes.GroupByTruncationEnum,
+		schemaTypes.GroupByKeyInput,
+
 		schemaTypes.ExplainEnum,
 	}
 }
@@ -262,6 +262,86 @@ var NotNullIntOperatorBlock = gql.NewInputObject(gql.InputObjectConfig{
 	},
 })
 
+// BigIntOperatorBlock filter block for BigInt types.
+var BigIntOperatorBlock = gql.NewInputObject(gql.InputObjectConfig{
+	Name:        "BigIntOperatorBlock",
+	Description: bigIntOperatorBlockDescription,
+	Fields: gql.InputObjectConfigFieldMap{
+		"_eq": &gql.InputObjectFieldConfig{
+			Description: eqOperatorDescription,
+			Type:        BigIntScalarType,
+		},
+		"_ne": &gql.InputObjectFieldConfig{
+			Description: neOperatorDescription,
+			Type:        BigIntScalarType,
+		},
+		"_gt": &gql.InputObjectFieldConfig{
+			Description: gtOperatorDescription,
+			Type:        BigIntScalarType,
+		},
+		"_ge": &gql.InputObjectFieldConfig{
+			Description: geOperatorDescription,
+			Type:        BigIntScalarType,
+		},
+		"_lt": &gql.InputObjectFieldConfig{
+			Description: ltOperatorDescription,
+			Type:        BigIntScalarType,
+		},
+		"_le": &gql.InputObjectFieldConfig{
+			Description: leOperatorDescription,
+			Type:        BigIntScalarType,
+		},
+		"_in": &gql.InputObjectFieldConfig{
+			Description: inOperatorDescription,
+			Type:        gql.NewList(BigIntScalarType),
+		},
+		"_nin": &gql.InputObjectFieldConfig{
+			Description: ninOperatorDescription,
+			Type:        gql.NewList(BigIntScalarType),
+		},
+	},
+})
+
+// DecimalOperatorBlock filter block for Decimal types.
+var DecimalOperatorBlock = gql.NewInputObject(gql.InputObjectConfig{
+	Name:        "DecimalOperatorBlock",
+	Description: decimalOperatorBlockDescription,
+	Fields: gql.InputObjectConfigFieldMap{
+		"_eq": &gql.InputObjectFieldConfig{
+			Description: eqOperatorDescription,
+			Type:        DecimalScalarType,
+		},
+		"_ne": &gql.InputObjectFieldConfig{
+			Description: neOperatorDescription,
+			Type:        DecimalScalarType,
+		},
+		"_gt": &gql.InputObjectFieldConfig{
+			Description: gtOperatorDescription,
+			Type:        DecimalScalarType,
+		},
+		"_ge": &gql.InputObjectFieldConfig{
+			Description: geOperatorDescription,
+			Type:        DecimalScalarType,
+		},
+		"_lt": &gql.InputObjectFieldConfig{
+			Description: ltOperatorDescription,
+			Type:        DecimalScalarType,
+		},
+		"_le": &gql.InputObjectFieldConfig{
+			Description: leOperatorDescription,
+			Type:        DecimalScalarType,
+		},
+		"_in": &gql.InputObjectFieldConfig{
+			Description: inOperatorDescription,
+			Type:        gql.NewList(DecimalScalarType),
+		},
+		"_nin": &gql.InputObjectFieldConfig{
+			Description: ninOperatorDescription,
+			Type:        gql.NewList(DecimalScalarType),
+		},
+	},
+})
+
 // StringOperatorBlock filter block for string types.
 var StringOperatorBlock = gql.NewInputObject(gql.InputObjectConfig{
 	Name:        "StringOperatorBlock",
@@ -291,6 +371,22 @@ var StringOperatorBlock = gql.NewInputObject(gql.InputObjectConfig{
 			Description: nlikeStringOperatorDescription,
 			Type:        gql.String,
 		},
+		"_ilike": &gql.InputObjectFieldConfig{
+			Description: ilikeStringOperatorDescription,
+			Type:        gql.String,
+		},
+		"_nilike": &gql.InputObjectFieldConfig{
+			Description: nilikeStringOperatorDescription,
+			Type:        gql.String,
+		},
+		"_regex": &gql.InputObjectFieldConfig{
+			Description: regexStringOperatorDescription,
+			Type:        gql.String,
+		},
+		"_nregex": &gql.InputObjectFieldConfig{
+			Description: nregexStringOperatorDescription,
+			Type:        gql.String,
+		},
 	},
 })
 
@@ -323,6 +419,22 @@ var NotNullstringOperatorBlock = gql.NewInputObject(gql.InputObjectConfig{
 			Description: nlikeStringOperatorDescription,
 			Type:        gql.String,
 		},
+		"_ilike": &gql.InputObjectFieldConfig{
+			Description: ilikeStringOperatorDescription,
+			Type:        gql.String,
+		},
+		"_nilike": &gql.InputObjectFieldConfig{
+			Description: nilikeStringOperatorDescription,
+			Type:        gql.String,
+		},
+		"_regex": &gql.InputObjectFieldConfig{
+			Description: regexStringOperatorDescription,
+			Type:        gql.String,
+		},
+		"_nregex": &gql.InputObjectFieldConfig{
+			Description: nregexStringOperatorDescription,
+			Type:        gql.String,
+		},
 	},
 })
 
@@ -349,3 +461,108 @@ var IdOperatorBlock = gql.NewInputObject(gql.InputObjectConfig{
 		},
 	},
 })
+
+// BooleanListOperatorBlock filter block for [Boolean] types.
+var BooleanListOperatorBlock = newListOperatorBlock(
+	"BooleanListOperatorBlock",
+	booleanListOperatorBlockDescription,
+	BooleanOperatorBlock,
+)
+
+// NotNullBooleanListOperatorBlock filter block for [Boolean!] types.
+var NotNullBooleanListOperatorBlock = newListOperatorBlock(
+	"NotNullBooleanListOperatorBlock",
+	notNullBooleanListOperatorBlockDescription,
+	NotNullBooleanOperatorBlock,
+)
+
+// FloatListOperatorBlock filter block for [Float] types.
+var FloatListOperatorBlock = newListOperatorBlock(
+	"FloatListOperatorBlock",
+	floatListOperatorBlockDescription,
+	FloatOperatorBlock,
+)
+
+// NotNullFloatListOperatorBlock filter block for [Float!] types.
+var NotNullFloatListOperatorBlock = newListOperatorBlock(
+	"NotNullFloatListOperatorBlock",
+	notNullFloatListOperatorBlockDescription,
+	NotNullFloatOperatorBlock,
+)
+
+// IntListOperatorBlock filter block for [Int] types.
+var IntListOperatorBlock = newListOperatorBlock(
+	"IntListOperatorBlock",
+	intListOperatorBlockDescription,
+	IntOperatorBlock,
+)
+
+// NotNullIntListOperatorBlock filter block for [Int!] types.
+var NotNullIntListOperatorBlock = newListOperatorBlock(
+	"NotNullIntListOperatorBlock",
+	notNullIntListOperatorBlockDescription,
+	NotNullIntOperatorBlock,
+)
+
+// StringListOperatorBlock filter block for [String] types.
+var StringListOperatorBlock = newListOperatorBlock(
+	"StringListOperatorBlock",
+	stringListOperatorBlockDescription,
+	StringOperatorBlock,
+)
+
+// NotNullStringListOperatorBlock filter block for [String!] types.
+var NotNullStringListOperatorBlock = newListOperatorBlock(
+	"NotNullStringListOperatorBlock",
+	notNullStringListOperatorBlockDescription,
+	NotNullstringOperatorBlock,
+)
+
+// newListOperatorBlock returns a new filter block for inline arrays of the type filtered by the
+// given element block.
+func newListOperatorBlock(name string, description string, elementBlock *gql.InputObject) *gql.InputObject {
+	return gql.NewInputObject(gql.InputObjectConfig{
+		Name:        name,
+		Description: description,
+		Fields: gql.InputObjectConfigFieldMap{
+			"_any": &gql.InputObjectFieldConfig{
+				Description: anyOperatorDescription,
+				Type:        elementBlock,
+			},
+			"_all": &gql.InputObjectFieldConfig{
+				Description: allOperatorDescription,
+				Type:        elementBlock,
+			},
+			"_none": &gql.InputObjectFieldConfig{
+				Description: noneOperatorDescription,
+				Type:        elementBlock,
+			},
+		},
+	})
+}
+
+// NewEnumOperatorBlock returns a new filter block for the given enum type.
+func NewEnumOperatorBlock(enum *gql.Enum) *gql.InputObject {
+	return gql.NewInputObject(gql.InputObjectConfig{
+		Name:        enum.Name() + "OperatorBlock",
+		Description: enumOperatorBlockDescription,
+		Fields: gql.InputObjectConfigFieldMap{
+			"_eq": &gql.InputObjectFieldConfig{
+				Description: eqOperatorDescription,
+				Type:        enum,
+			},
+			"_ne": &gql.InputObjectFieldConfig{
+				Description: neOperatorDescription,
+				Type:        enum,
+			},
+			"_in": &gql.InputObjectFieldConfig{
+				Description: inOperatorDescription,
+				Type:        gql.NewList(enum),
+			},
+			"_nin": &gql.InputObjectFieldConfig{
+				Description: ninOperatorDescription,
+				Type:        gql.NewList(enum),
+			},
+		},
+	})
+}
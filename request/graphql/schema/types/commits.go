@@ -37,6 +37,10 @@ var (
 	// 	CollectionID: Int
 	// 	SchemaVersionID: String
 	// 	Delta: String
+	// 	Timestamp: DateTime
+	// 	Author: String
+	// 	Signature: String
+	// 	Signer: String
 	// 	Previous: [Commit]
 	//  Links: [Commit]
 	// }
@@ -79,6 +83,22 @@ var (
 				Description: commitDeltaFieldDescription,
 				Type:        gql.String,
 			},
+			request.TimestampFieldName: &gql.Field{
+				Description: commitTimestampFieldDescription,
+				Type:        gql.DateTime,
+			},
+			request.AuthorFieldName: &gql.Field{
+				Description: commitAuthorFieldDescription,
+				Type:        gql.String,
+			},
+			request.SignatureFieldName: &gql.Field{
+				Description: commitSignatureFieldDescription,
+				Type:        gql.String,
+			},
+			request.SignerFieldName: &gql.Field{
+				Description: commitSignerFieldDescription,
+				Type:        gql.String,
+			},
 			"links": &gql.Field{
 				Description: commitLinksDescription,
 				Type:        gql.NewList(CommitLinkObject),
@@ -133,10 +153,17 @@ var (
 					Description: commitCollectionIDFieldDescription,
 					Type:        OrderingEnum,
 				},
+				request.TimestampFieldName: &gql.InputObjectFieldConfig{
+					Description: commitTimestampFieldDescription,
+					Type:        OrderingEnum,
+				},
 			},
 		},
 	)
 
+	// CommitsFilterArg is the filter input of the commits query.
+	CommitsFilterArg = newCommitsFilterArg()
+
 	commitFields = gql.NewEnum(
 		gql.EnumConfig{
 			Name:        "commitFields",
@@ -177,6 +204,7 @@ var (
 		Args: gql.FieldConfigArgument{
 			request.DocIDArgName: NewArgConfig(gql.ID, commitDocIDArgDescription),
 			request.FieldIDName:  NewArgConfig(gql.String, commitFieldIDArgDescription),
+			"filter":             NewArgConfig(CommitsFilterArg, commitFilterArgDescription),
 			"order":              NewArgConfig(CommitsOrderArg, OrderArgDescription),
 			"cid":                NewArgConfig(gql.ID, commitCIDArgDescription),
 			"groupBy": NewArgConfig(
@@ -203,3 +231,46 @@ var (
 		},
 	}
 )
+
+func newCommitsFilterArg() *gql.InputObject {
+	var selfRefType *gql.InputObject
+
+	fieldThunk := (gql.InputObjectConfigFieldMapThunk)(
+		func() (gql.InputObjectConfigFieldMap, error) {
+			return gql.InputObjectConfigFieldMap{
+				"_and": &gql.InputObjectFieldConfig{
+					Description: AndOperatorDescription,
+					Type:        gql.NewList(selfRefType),
+				},
+				"_or": &gql.InputObjectFieldConfig{
+					Description: OrOperatorDescription,
+					Type:        gql.NewList(selfRefType),
+				},
+				"_not": &gql.InputObjectFieldConfig{
+					Description: NotOperatorDescription,
+					Type:        selfRefType,
+				},
+				request.TimestampFieldName: &gql.InputObjectFieldConfig{
+					Description: commitTimestampFieldDescription,
+					Type:        DateTimeOperatorBlock,
+				},
+				request.AuthorFieldName: &gql.InputObjectFieldConfig{
+					Description: commitAuthorFieldDescription,
+					Type:        StringOperatorBlock,
+				},
+				request.SignerFieldName: &gql.InputObjectFieldConfig{
+					Description: commitSignerFieldDescription,
+					Type:        StringOperatorBlock,
+				},
+			}, nil
+		},
+	)
+
+	selfRefType = gql.NewInputObject(gql.InputObjectConfig{
+		Name:        "commitsFilterArg",
+		Description: commitFilterArgDescription,
+		Fields:      fieldThunk,
+	})
+
+	return selfRefType
+}
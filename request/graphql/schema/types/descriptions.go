@@ -21,7 +21,50 @@ An optional set of fields for which to group the contents of this field by.
  the immediate child selector.  Additional fields may be selected by using
  the '_group' selector within the immediate child selector. If an empty set
  is provided, the restrictions mentioned still apply, although all results
- will appear within the same group.
+ will appear within the same group. Keys derived from fields may be given
+ using the 'groupByKeys' argument.
+`
+	GroupByKeysArgDescription string = `
+An optional set of keys derived from fields for which to group the contents of
+ this field by, in addition to any fields given by the 'groupBy' argument. The
+ same restrictions as those of the 'groupBy' argument apply.
+`
+	groupByKeyDescription string = `
+GroupByKey is a group key derived from a field, such as a DateTime truncated to a
+ unit of time, or the field of a related object.
+`
+	groupByKeyFieldDescription string = `
+The name of the field the key is derived from.
+`
+	groupByKeyRelationDescription string = `
+The names of the related objects the field belongs to, in the order in which they
+ are traversed. If not provided the field belongs to the host object. Only single
+ related objects may be traversed.
+`
+	groupByKeyTruncateDescription string = `
+An optional unit of time that the DateTime value of the field is truncated to
+ (in UTC) before grouping.
+`
+	groupByTruncationDescription string = `
+GroupByTruncation is a unit of time that a DateTime value may be truncated to.
+`
+	groupByDayDescription string = `
+Truncates the DateTime to the start of its day.
+`
+	groupByWeekDescription string = `
+Truncates the DateTime to the start of its week, weeks start on Mondays.
+`
+	groupByMonthDescription string = `
+Truncates the DateTime to the start of its month.
+`
+	DistinctArgDescription string = `
+An optional set of fields by which to de-duplicate the results. Only the first
+ result for each distinct combination of the values of these fields will be
+ returned, respecting any requested order.
+`
+	CountDistinctArgDescription string = `
+An optional field by which to de-duplicate the items counted. Only the number
+ of distinct values of this field will be counted.
 `
 	LimitArgDescription string = `
 An optional value that caps the number of results to the number provided.
@@ -31,6 +74,34 @@ An optional value that caps the number of results to the number provided.
 An optional value that skips the given number of results that would have
  otherwise been returned.  Commonly used alongside the 'limit' argument,
  this argument will still work on its own.
+`
+	AfterArgDescription string = `
+An optional cursor, as returned by the '_cursor' field of a document, only
+ documents following the document of the cursor in the requested order will be
+ returned.  Commonly used alongside the 'limit' argument to page through results.
+`
+	BeforeArgDescription string = `
+An optional cursor, as returned by the '_cursor' field of a document, only
+ documents preceding the document of the cursor in the requested order will be
+ returned.
+`
+	pageInfoDescription string = `
+PageInfo describes the page of documents returned by a select, allowing further
+ pages to be requested using the 'after' and 'before' arguments.
+`
+	pageInfoHasNextPageDescription string = `
+Indicates as to whether there are documents following the last document of the
+ page.
+`
+	pageInfoHasPreviousPageDescription string = `
+Indicates as to whether there are documents preceding the first document of the
+ page.  This may be false if the preceding documents were not scanned.
+`
+	pageInfoStartCursorDescription string = `
+The cursor of the first document of the page.
+`
+	pageInfoEndCursorDescription string = `
+The cursor of the last document of the page.
 `
 	commitDescription string = `
 Commit represents an individual commit to a MerkleCRDT, every mutation to a
@@ -91,12 +162,72 @@ The id of the field that this commit was committed against. If this is a composi
 `
 	commitDeltaFieldDescription string = `
 The CBOR encoded representation of the value that is saved as part of this commit.
+`
+	commitTimestampFieldDescription string = `
+The wall-clock time at which this commit was created. Only recorded if the node
+ that created the commit has commit timestamps enabled, null otherwise.
+`
+	commitAuthorFieldDescription string = `
+The ID of the node, or peer, that created this commit. Only recorded if the node
+ that created the commit has a commit author configured, null otherwise.
+`
+	commitSignatureFieldDescription string = `
+The hex encoded signature of this commit. Only recorded if the node that created
+ the commit has commit signing enabled, null otherwise.
+`
+	commitSignerFieldDescription string = `
+The peer ID derived from the public key that signed this commit, null if the
+ commit is not signed.
+`
+	commitFilterArgDescription string = `
+An optional filter for this commits query that will limit the results to
+ commits matching the given criteria.
 `
 	commitLinkNameFieldDescription string = `
 The Name of the field that this linked commit mutated.
 `
 	commitLinkCIDFieldDescription string = `
 The CID of this linked commit.
+`
+	diffQueryDescription string = `
+Returns the field-level difference between two versions of a document. The versions
+ do not need to be ancestors of one another, but must belong to the same document.
+`
+	diffDescription string = `
+Diff represents the field-level difference between two versions of a document.
+`
+	diffFromArgDescription string = `
+The CID of the document (composite) commit of the version to diff from.
+`
+	diffToArgDescription string = `
+The CID of the document (composite) commit of the version to diff to.
+`
+	diffDocIDFieldDescription string = `
+The ID of the document the versions belong to.
+`
+	diffCommitsFieldDescription string = `
+The CIDs of the document commits that are part of the 'to' version, but not of the
+ 'from' version, in the order they were made.
+`
+	diffFieldsFieldDescription string = `
+The fields whose value differs between the two versions. A change of the deleted status
+ of the document is returned as a change of the '_deleted' field.
+`
+	diffFieldDescription string = `
+DiffField represents the difference between the values of a field in two versions
+ of a document.
+`
+	diffFieldNameFieldDescription string = `
+The name of the field.
+`
+	diffFieldRelationFieldDescription string = `
+The name of the relation field that this field holds the related document ID of, if any.
+`
+	diffFieldBeforeFieldDescription string = `
+The JSON encoded value of the field in the 'from' version.
+`
+	diffFieldAfterFieldDescription string = `
+The JSON encoded value of the field in the 'to' version.
 `
 	commitFieldsEnumDescription string = `
 These are the set of fields supported for grouping by in a commits query.
@@ -152,6 +283,14 @@ These are the set of filter operators available for use when filtering on Int
 	notNullIntOperatorBlockDescription string = `
 These are the set of filter operators available for use when filtering on Int!
  values.
+`
+	bigIntOperatorBlockDescription string = `
+These are the set of filter operators available for use when filtering on BigInt
+ values.
+`
+	decimalOperatorBlockDescription string = `
+These are the set of filter operators available for use when filtering on Decimal
+ values.
 `
 	stringOperatorBlockDescription string = `
 These are the set of filter operators available for use when filtering on String
@@ -160,10 +299,46 @@ These are the set of filter operators available for use when filtering on String
 	notNullStringOperatorBlockDescription string = `
 These are the set of filter operators available for use when filtering on String!
  values.
+`
+	enumOperatorBlockDescription string = `
+These are the set of filter operators available for use when filtering on enum
+ values.
 `
 	idOperatorBlockDescription string = `
 These are the set of filter operators available for use when filtering on ID
  values.
+`
+	booleanListOperatorBlockDescription string = `
+These are the set of filter operators available for use when filtering on [Boolean]
+ values.
+`
+	notNullBooleanListOperatorBlockDescription string = `
+These are the set of filter operators available for use when filtering on [Boolean!]
+ values.
+`
+	floatListOperatorBlockDescription string = `
+These are the set of filter operators available for use when filtering on [Float]
+ values.
+`
+	notNullFloatListOperatorBlockDescription string = `
+These are the set of filter operators available for use when filtering on [Float!]
+ values.
+`
+	intListOperatorBlockDescription string = `
+These are the set of filter operators available for use when filtering on [Int]
+ values.
+`
+	notNullIntListOperatorBlockDescription string = `
+These are the set of filter operators available for use when filtering on [Int!]
+ values.
+`
+	stringListOperatorBlockDescription string = `
+These are the set of filter operators available for use when filtering on [String]
+ values.
+`
+	notNullStringListOperatorBlockDescription string = `
+These are the set of filter operators available for use when filtering on [String!]
+ values.
 `
 	eqOperatorDescription string = `
 The equality operator - if the target matches the value the check will pass.
@@ -202,6 +377,51 @@ The like operator - if the target value contains the given sub-string the check
 The not-like operator - if the target value does not contain the given sub-string the check will
  pass. '%' characters may be used as wildcards, for example '_nlike: "%Ritchie"' would match on
  the string 'Quentin Tarantino'.
+`
+	ilikeStringOperatorDescription string = `
+The case-insensitive like operator - as the like operator, but ignoring the case of both the
+ target value and the given sub-string, for example '_ilike: "%ritchie"' would match on strings
+ ending in 'RITCHIE'.
+`
+	nilikeStringOperatorDescription string = `
+The case-insensitive not-like operator - as the not-like operator, but ignoring the case of both
+ the target value and the given sub-string.
+`
+	regexStringOperatorDescription string = `
+The regex operator - if the target value matches the given regular expression the check will pass.
+ The expression is unanchored unless '^' or '$' are used, for example '_regex: "^(?i)dennis"'
+ would match on strings starting with 'Dennis' in any case.
+`
+	nregexStringOperatorDescription string = `
+The not-regex operator - if the target value does not match the given regular expression the check
+ will pass.
+`
+	anyOperatorDescription string = `
+The any operator - if any element of the target array passes the given checks the check will pass.
+`
+	allOperatorDescription string = `
+The all operator - if all elements of the target array pass the given checks the check will pass.
+ Empty arrays will always pass this check.
+`
+	noneOperatorDescription string = `
+The none operator - if no element of the target array passes the given checks the check will pass.
+ Empty arrays will always pass this check.
+`
+	SomeOperatorDescription string = `
+The some operator - if any related object passes the given checks the check will pass.
+`
+	CountFilterDescription string = `
+Filters by the value of the _count aggregate requested on this object, or the number of items
+ within the group if _count is not requested on a grouped object.
+`
+	RelationCountFilterDescription string = `
+Filters by the number of related objects.
+`
+	SumFilterDescription string = `
+Filters by the value of the _sum aggregate requested on this object.
+`
+	AverageFilterDescription string = `
+Filters by the value of the _avg aggregate requested on this object.
 `
 	AndOperatorDescription string = `
 The and operator - all checks within this clause must pass in order for this check to pass.
@@ -211,6 +431,33 @@ The or operator - only one check within this clause must pass in order for this
 `
 	NotOperatorDescription string = `
 The negative operator - this check will only pass if all checks within it fail.
+`
+	subscriptionOperationDescription string = `
+SubscriptionOperation is a kind of change to a document that a subscription can be
+ notified of.
+`
+	subscriptionCreateOperationDescription string = `
+The creation of a document.
+`
+	subscriptionUpdateOperationDescription string = `
+The update of the fields of a document.
+`
+	subscriptionDeleteOperationDescription string = `
+The deletion of a document.
+`
+	asOfClockDescription string = `
+AsOfClock is the clock by which the commits of documents are timed when selecting
+ them as they were at a point in time.
+`
+	asOfAppliedClockDescription string = `
+The local time at which this node applied the commit, whether it was created
+ locally or received from a peer. It differs between nodes, and reflects changes
+ to the clock of this node.
+`
+	asOfCommittedClockDescription string = `
+The timestamp recorded in the commit by the node creating it, which is the same on
+ every node. Commits without a timestamp are only included if a later commit that
+ is included follows them.
 `
 	ascOrderDescription string = `
 Sort the results in ascending order, e.g. null,1,2,3,a,b,c.
@@ -227,5 +474,24 @@ Allows the explicit definition of relationship attributes instead of using the s
 `
 	relationDirectiveNameArgDescription string = `
 Explicitly define the name of the relationship instead of using the system generated defaults.
+`
+	relationDirectiveOnDeleteArgDescription string = `
+What happens to the documents referencing a related document through this field when that
+ document is deleted. Applied by the node on which the document is deleted, with the resulting
+ changes shared with peers. May only be given on the primary side of the relationship.
+`
+	relationDirectiveCheckExistsArgDescription string = `
+If true, the related document must exist when a document references it through this field.
+ May only be given on the primary side of the relationship.
+`
+	relationOnDeleteCascadeDescription string = `
+Delete the referencing documents.
+`
+	relationOnDeleteSetNullDescription string = `
+Clear the reference held by the referencing documents.
+`
+	relationOnDeleteRestrictDescription string = `
+Prevent the deletion of documents that are still referenced. Only enforced on the node
+ on which the document is deleted, not on deletions received from peers.
 `
 )
@@ -0,0 +1,95 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package types
+
+import (
+	gql "github.com/sourcenetwork/graphql-go"
+
+	"github.com/sourcenetwork/defradb/client/request"
+)
+
+var (
+	// DiffField represents the difference between the values of a field in two versions
+	// of a document.
+	// type DiffField {
+	// 	name: String
+	// 	relation: String
+	// 	before: String
+	// 	after: String
+	// }
+	DiffFieldObject = gql.NewObject(gql.ObjectConfig{
+		Name:        request.DiffFieldTypeName,
+		Description: diffFieldDescription,
+		Fields: gql.Fields{
+			request.DiffFieldNameFieldName: &gql.Field{
+				Description: diffFieldNameFieldDescription,
+				Type:        gql.String,
+			},
+			request.DiffFieldRelationFieldName: &gql.Field{
+				Description: diffFieldRelationFieldDescription,
+				Type:        gql.String,
+			},
+			request.DiffFieldBeforeFieldName: &gql.Field{
+				Description: diffFieldBeforeFieldDescription,
+				Type:        gql.String,
+			},
+			request.DiffFieldAfterFieldName: &gql.Field{
+				Description: diffFieldAfterFieldDescription,
+				Type:        gql.String,
+			},
+		},
+	})
+
+	// Diff represents the field-level difference between two versions of a document.
+	// type Diff {
+	// 	docID: String
+	// 	from: String
+	// 	to: String
+	// 	commits: [String]
+	// 	fields: [DiffField]
+	// }
+	DiffObject = gql.NewObject(gql.ObjectConfig{
+		Name:        request.DiffTypeName,
+		Description: diffDescription,
+		Fields: gql.Fields{
+			request.DocIDArgName: &gql.Field{
+				Description: diffDocIDFieldDescription,
+				Type:        gql.String,
+			},
+			request.DiffFromArgName: &gql.Field{
+				Description: diffFromArgDescription,
+				Type:        gql.String,
+			},
+			request.DiffToArgName: &gql.Field{
+				Description: diffToArgDescription,
+				Type:        gql.String,
+			},
+			request.DiffCommitsFieldName: &gql.Field{
+				Description: diffCommitsFieldDescription,
+				Type:        gql.NewList(gql.String),
+			},
+			request.DiffFieldsFieldName: &gql.Field{
+				Description: diffFieldsFieldDescription,
+				Type:        gql.NewList(DiffFieldObject),
+			},
+		},
+	})
+
+	QueryDiff = &gql.Field{
+		Name:        request.DiffName,
+		Description: diffQueryDescription,
+		Type:        gql.NewList(DiffObject),
+		Args: gql.FieldConfigArgument{
+			request.DiffFromArgName: NewArgConfig(gql.NewNonNull(gql.ID), diffFromArgDescription),
+			request.DiffToArgName:   NewArgConfig(gql.NewNonNull(gql.ID), diffToArgDescription),
+		},
+	}
+)
@@ -12,10 +12,14 @@ package types
 
 import (
 	"encoding/hex"
+	"math/big"
 	"regexp"
 
+	"github.com/shopspring/decimal"
 	"github.com/sourcenetwork/graphql-go"
 	"github.com/sourcenetwork/graphql-go/language/ast"
+
+	"github.com/sourcenetwork/defradb/client"
 )
 
 // BlobPattern is a regex for validating blob hex strings
@@ -63,3 +67,138 @@ var BlobScalarType = graphql.NewScalar(graphql.ScalarConfig{
 		}
 	},
 })
+
+// coerceBigInt converts the given value into an arbitrary precision integer.
+// If the value cannot be converted nil is returned.
+func coerceBigInt(value any) any {
+	switch value := value.(type) {
+	case *big.Int:
+		return value
+	case int:
+		return big.NewInt(int64(value))
+	case int32:
+		return big.NewInt(int64(value))
+	case int64:
+		return big.NewInt(value)
+	case uint64:
+		return new(big.Int).SetUint64(value)
+	case float64:
+		d := decimal.NewFromFloat(value)
+		if !d.IsInteger() {
+			return nil
+		}
+		return d.BigInt()
+	case decimal.Decimal:
+		if !value.IsInteger() || client.CheckBigNumberDigits(value) != nil {
+			return nil
+		}
+		return value.BigInt()
+	case string:
+		// Exponent forms are not valid BigInt literals.
+		i, ok := new(big.Int).SetString(value, 10)
+		if !ok || client.CheckBigNumberDigits(decimal.NewFromBigInt(i, 0)) != nil {
+			return nil
+		}
+		return i
+	case *string:
+		return coerceBigInt(*value)
+	default:
+		return nil
+	}
+}
+
+// serializeBigInt converts the given value into a base 10 string.
+// If the value cannot be converted nil is returned.
+func serializeBigInt(value any) any {
+	i, ok := coerceBigInt(value).(*big.Int)
+	if !ok {
+		return nil
+	}
+	return i.String()
+}
+
+var BigIntScalarType = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "BigInt",
+	Description: "The `BigInt` scalar type represents an arbitrary precision integer.",
+	// Serialize converts the value to a base 10 string
+	Serialize: serializeBigInt,
+	// ParseValue converts the value to a *big.Int
+	ParseValue: coerceBigInt,
+	// ParseLiteral converts the ast value to a *big.Int
+	ParseLiteral: func(valueAST ast.Value) any {
+		switch valueAST := valueAST.(type) {
+		case *ast.IntValue:
+			return coerceBigInt(valueAST.Value)
+		case *ast.StringValue:
+			return coerceBigInt(valueAST.Value)
+		default:
+			// return nil if the value cannot be parsed
+			return nil
+		}
+	},
+})
+
+// coerceDecimal converts the given value into an arbitrary precision decimal.
+// If the value cannot be converted nil is returned.
+func coerceDecimal(value any) any {
+	switch value := value.(type) {
+	case decimal.Decimal:
+		if client.CheckBigNumberDigits(value) != nil {
+			return nil
+		}
+		return value
+	case *big.Int:
+		return decimal.NewFromBigInt(value, 0)
+	case int:
+		return decimal.NewFromInt(int64(value))
+	case int32:
+		return decimal.NewFromInt32(value)
+	case int64:
+		return decimal.NewFromInt(value)
+	case float64:
+		return decimal.NewFromFloat(value)
+	case string:
+		d, err := client.ParseBigNumber(value)
+		if err != nil {
+			return nil
+		}
+		return d
+	case *string:
+		return coerceDecimal(*value)
+	default:
+		return nil
+	}
+}
+
+// serializeDecimal converts the given value into a decimal string.
+// If the value cannot be converted nil is returned.
+func serializeDecimal(value any) any {
+	d, ok := coerceDecimal(value).(decimal.Decimal)
+	if !ok {
+		return nil
+	}
+	return d.String()
+}
+
+var DecimalScalarType = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "Decimal",
+	Description: "The `Decimal` scalar type represents an arbitrary precision decimal number.",
+	// Serialize converts the value to a decimal string
+	Serialize: serializeDecimal,
+	// ParseValue converts the value to a decimal.Decimal
+	ParseValue: coerceDecimal,
+	// ParseLiteral converts the ast value to a decimal.Decimal
+	ParseLiteral: func(valueAST ast.Value) any {
+		switch valueAST := valueAST.(type) {
+		case *ast.IntValue:
+			return coerceDecimal(valueAST.Value)
+		case *ast.FloatValue:
+			return coerceDecimal(valueAST.Value)
+		case *ast.StringValue:
+			return coerceDecimal(valueAST.Value)
+		default:
+			// return nil if the value cannot be parsed
+			return nil
+		}
+	},
+})
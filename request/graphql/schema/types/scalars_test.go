@@ -11,8 +11,10 @@
 package types
 
 import (
+	"math/big"
 	"testing"
 
+	"github.com/shopspring/decimal"
 	"github.com/sourcenetwork/graphql-go/language/ast"
 	"github.com/stretchr/testify/assert"
 )
@@ -86,3 +88,103 @@ func TestBlobScalarTypeParseLiteral(t *testing.T) {
 		assert.Equal(t, c.expect, result)
 	}
 }
+
+func TestBigIntScalarTypeSerialize(t *testing.T) {
+	stringInput := "123456789012345678901234567890"
+
+	cases := []struct {
+		input  any
+		expect any
+	}{
+		{stringInput, "123456789012345678901234567890"},
+		{&stringInput, "123456789012345678901234567890"},
+		{big.NewInt(-42), "-42"},
+		{int64(42), "42"},
+		{42, "42"},
+		{float64(42), "42"},
+		{float64(4.2), nil},
+		{"4.2", nil},
+		{nil, nil},
+		{false, nil},
+	}
+	for _, c := range cases {
+		result := BigIntScalarType.Serialize(c.input)
+		assert.Equal(t, c.expect, result)
+	}
+}
+
+func TestBigIntScalarTypeParseLiteral(t *testing.T) {
+	expected, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+
+	cases := []struct {
+		input  ast.Value
+		expect any
+	}{
+		{&ast.IntValue{Value: "123456789012345678901234567890"}, expected},
+		{&ast.StringValue{Value: "123456789012345678901234567890"}, expected},
+		{&ast.StringValue{Value: "1.5"}, nil},
+		{&ast.StringValue{Value: "1e1000000000"}, nil},
+		{&ast.FloatValue{Value: "1.5"}, nil},
+		{&ast.BooleanValue{}, nil},
+		{&ast.NullValue{}, nil},
+		{&ast.ListValue{}, nil},
+		{&ast.ObjectValue{}, nil},
+	}
+	for _, c := range cases {
+		result := BigIntScalarType.ParseLiteral(c.input)
+		assert.Equal(t, c.expect, result)
+	}
+}
+
+func TestDecimalScalarTypeSerialize(t *testing.T) {
+	stringInput := "1234567890.0123456789"
+
+	cases := []struct {
+		input  any
+		expect any
+	}{
+		{stringInput, "1234567890.0123456789"},
+		{&stringInput, "1234567890.0123456789"},
+		{decimal.RequireFromString("-0.10"), "-0.1"},
+		{big.NewInt(42), "42"},
+		{int64(42), "42"},
+		{float64(0.1), "0.1"},
+		{decimal.New(1, 1_000_000_000), nil},
+		{"abc", nil},
+		{nil, nil},
+		{false, nil},
+	}
+	for _, c := range cases {
+		result := DecimalScalarType.Serialize(c.input)
+		assert.Equal(t, c.expect, result)
+	}
+}
+
+func TestDecimalScalarTypeParseLiteral(t *testing.T) {
+	cases := []struct {
+		input  ast.Value
+		expect string
+	}{
+		{&ast.IntValue{Value: "123456789012345678901234567890"}, "123456789012345678901234567890"},
+		{&ast.FloatValue{Value: "0.1"}, "0.1"},
+		{&ast.StringValue{Value: "1234567890.0123456789"}, "1234567890.0123456789"},
+	}
+	for _, c := range cases {
+		result, ok := DecimalScalarType.ParseLiteral(c.input).(decimal.Decimal)
+		assert.True(t, ok)
+		assert.Equal(t, c.expect, result.String())
+	}
+
+	invalidCases := []ast.Value{
+		&ast.StringValue{Value: "abc"},
+		&ast.StringValue{Value: "1e1000000000"},
+		&ast.FloatValue{Value: "1e-1000000000"},
+		&ast.BooleanValue{},
+		&ast.NullValue{},
+		&ast.ListValue{},
+		&ast.ObjectValue{},
+	}
+	for _, c := range invalidCases {
+		assert.Nil(t, DecimalScalarType.ParseLiteral(c))
+	}
+}
@@ -12,6 +12,9 @@ package types
 
 import (
 	gql "github.com/sourcenetwork/graphql-go"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
 )
 
 const (
@@ -29,6 +32,29 @@ const (
 	IndexDirectivePropUnique     = "unique"
 	IndexDirectivePropFields     = "fields"
 	IndexDirectivePropDirections = "directions"
+
+	DefaultDirectiveLabel     = "default"
+	DefaultDirectivePropValue = "value"
+
+	ConstraintDirectiveLabel         = "constraint"
+	ConstraintDirectivePropMin       = "min"
+	ConstraintDirectivePropMax       = "max"
+	ConstraintDirectivePropPattern   = "pattern"
+	ConstraintDirectivePropMaxLength = "maxLength"
+
+	ComputedDirectiveLabel    = "computed"
+	ComputedDirectivePropExpr = "expr"
+
+	RetentionDirectiveLabel        = "retention"
+	RetentionDirectivePropVersions = "versions"
+	RetentionDirectivePropAge      = "age"
+
+	TTLDirectiveLabel     = "ttl"
+	TTLDirectivePropField = "field"
+
+	RelationDirectivePropName        = "name"
+	RelationDirectivePropOnDelete    = "onDelete"
+	RelationDirectivePropCheckExists = "checkExists"
 )
 
 var (
@@ -47,6 +73,109 @@ var (
 		},
 	})
 
+	// SubscriptionOperationEnum is an enum for the kinds of changes a subscription can be
+	// notified of.
+	SubscriptionOperationEnum = gql.NewEnum(gql.EnumConfig{
+		Name:        request.SubscriptionOperationTypeName,
+		Description: subscriptionOperationDescription,
+		Values: gql.EnumValueConfigMap{
+			string(request.CreateOperation): &gql.EnumValueConfig{
+				Description: subscriptionCreateOperationDescription,
+				Value:       string(request.CreateOperation),
+			},
+			string(request.UpdateOperation): &gql.EnumValueConfig{
+				Description: subscriptionUpdateOperationDescription,
+				Value:       string(request.UpdateOperation),
+			},
+			string(request.DeleteOperation): &gql.EnumValueConfig{
+				Description: subscriptionDeleteOperationDescription,
+				Value:       string(request.DeleteOperation),
+			},
+		},
+	})
+
+	// AsOfClockEnum is an enum for the clock by which the asOf argument times commits.
+	AsOfClockEnum = gql.NewEnum(gql.EnumConfig{
+		Name:        request.AsOfClockTypeName,
+		Description: asOfClockDescription,
+		Values: gql.EnumValueConfigMap{
+			string(request.AppliedClock): &gql.EnumValueConfig{
+				Description: asOfAppliedClockDescription,
+				Value:       string(request.AppliedClock),
+			},
+			string(request.CommittedClock): &gql.EnumValueConfig{
+				Description: asOfCommittedClockDescription,
+				Value:       string(request.CommittedClock),
+			},
+		},
+	})
+
+	// PageInfoObject describes the page of documents returned by a select.
+	PageInfoObject = gql.NewObject(gql.ObjectConfig{
+		Name:        request.PageInfoTypeName,
+		Description: pageInfoDescription,
+		Fields: gql.Fields{
+			request.HasNextPageFieldName: &gql.Field{
+				Description: pageInfoHasNextPageDescription,
+				Type:        gql.Boolean,
+			},
+			request.HasPreviousPageFieldName: &gql.Field{
+				Description: pageInfoHasPreviousPageDescription,
+				Type:        gql.Boolean,
+			},
+			request.StartCursorFieldName: &gql.Field{
+				Description: pageInfoStartCursorDescription,
+				Type:        gql.String,
+			},
+			request.EndCursorFieldName: &gql.Field{
+				Description: pageInfoEndCursorDescription,
+				Type:        gql.String,
+			},
+		},
+	})
+
+	// GroupByTruncationEnum is an enum of the units of time that DateTime values may be
+	// truncated to when grouped by.
+	GroupByTruncationEnum = gql.NewEnum(gql.EnumConfig{
+		Name:        "GroupByTruncation",
+		Description: groupByTruncationDescription,
+		Values: gql.EnumValueConfigMap{
+			string(request.GroupByDay): &gql.EnumValueConfig{
+				Description: groupByDayDescription,
+				Value:       string(request.GroupByDay),
+			},
+			string(request.GroupByWeek): &gql.EnumValueConfig{
+				Description: groupByWeekDescription,
+				Value:       string(request.GroupByWeek),
+			},
+			string(request.GroupByMonth): &gql.EnumValueConfig{
+				Description: groupByMonthDescription,
+				Value:       string(request.GroupByMonth),
+			},
+		},
+	})
+
+	// GroupByKeyInput is a group key derived from a field, as given to the groupByKeys
+	// argument.
+	GroupByKeyInput = gql.NewInputObject(gql.InputObjectConfig{
+		Name:        "GroupByKey",
+		Description: groupByKeyDescription,
+		Fields: gql.InputObjectConfigFieldMap{
+			request.GroupByKeyField: &gql.InputObjectFieldConfig{
+				Description: groupByKeyFieldDescription,
+				Type:        gql.NewNonNull(gql.String),
+			},
+			request.GroupByKeyRelation: &gql.InputObjectFieldConfig{
+				Description: groupByKeyRelationDescription,
+				Type:        gql.NewList(gql.NewNonNull(gql.String)),
+			},
+			request.GroupByKeyTruncate: &gql.InputObjectFieldConfig{
+				Description: groupByKeyTruncateDescription,
+				Type:        GroupByTruncationEnum,
+			},
+		},
+	})
+
 	ExplainEnum = gql.NewEnum(gql.EnumConfig{
 		Name:        "ExplainType",
 		Description: "ExplainType is an enum selecting the type of explanation done by the @explain directive.",
@@ -127,18 +256,45 @@ var (
 		},
 	})
 
+	// RelationOnDeleteEnum is an enum for the onDelete argument of the @relation directive.
+	RelationOnDeleteEnum = gql.NewEnum(gql.EnumConfig{
+		Name: "RelationOnDelete",
+		Values: gql.EnumValueConfigMap{
+			string(client.RelationOnDeleteCascade): &gql.EnumValueConfig{
+				Description: relationOnDeleteCascadeDescription,
+				Value:       client.RelationOnDeleteCascade,
+			},
+			string(client.RelationOnDeleteSetNull): &gql.EnumValueConfig{
+				Description: relationOnDeleteSetNullDescription,
+				Value:       client.RelationOnDeleteSetNull,
+			},
+			string(client.RelationOnDeleteRestrict): &gql.EnumValueConfig{
+				Description: relationOnDeleteRestrictDescription,
+				Value:       client.RelationOnDeleteRestrict,
+			},
+		},
+	})
+
 	// RelationDirective @relation is used to explicitly define
-	// the attributes of a relationship, specifically, the name
-	// if you don't want to use the default generated relationship
-	// name.
+	// the attributes of a relationship, such as the name if you
+	// don't want to use the default generated relationship name,
+	// or the referential integrity rules of the relationship.
 	RelationDirective = gql.NewDirective(gql.DirectiveConfig{
 		Name:        RelationLabel,
 		Description: relationDirectiveDescription,
 		Args: gql.FieldConfigArgument{
-			"name": &gql.ArgumentConfig{
+			RelationDirectivePropName: &gql.ArgumentConfig{
 				Description: relationDirectiveNameArgDescription,
 				Type:        gql.String,
 			},
+			RelationDirectivePropOnDelete: &gql.ArgumentConfig{
+				Description: relationDirectiveOnDeleteArgDescription,
+				Type:        RelationOnDeleteEnum,
+			},
+			RelationDirectivePropCheckExists: &gql.ArgumentConfig{
+				Description: relationDirectiveCheckExistsArgDescription,
+				Type:        gql.Boolean,
+			},
 		},
 		Locations: []string{
 			gql.DirectiveLocationFieldDefinition,
@@ -15,6 +15,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/core"
 	"github.com/sourcenetwork/defradb/datastore"
 	"github.com/sourcenetwork/defradb/errors"
@@ -39,7 +40,7 @@ func runQueryParserBench(
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		ast, _ := parser.BuildRequestAST(query)
-		_, errs := parser.Parse(ast)
+		_, errs := parser.Parse(ast, client.GQLOptions{})
 		if errs != nil {
 			return errors.Wrap("failed to parse query string", errors.New(fmt.Sprintf("%v", errs)))
 		}
@@ -67,7 +68,7 @@ func runMakePlanBench(
 	}
 
 	ast, _ := parser.BuildRequestAST(query)
-	q, errs := parser.Parse(ast)
+	q, errs := parser.Parse(ast, client.GQLOptions{})
 	if len(errs) > 0 {
 		return errors.Wrap("failed to parse query string", errors.New(fmt.Sprintf("%v", errs)))
 	}
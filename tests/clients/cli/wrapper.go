@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"io"
 	"net/http/httptest"
+	"strconv"
 	"strings"
 
 	blockstore "github.com/ipfs/boxo/blockstore"
@@ -222,6 +223,45 @@ func (w *Wrapper) SetMigration(ctx context.Context, config client.LensConfig) er
 	return w.LensRegistry().SetMigration(ctx, config)
 }
 
+func (w *Wrapper) MigrateDocuments(
+	ctx context.Context,
+	collectionName string,
+	opts client.MigrateDocumentsOptions,
+) (client.DocumentMigrationStatus, error) {
+	args := []string{"client", "schema", "migration", "apply"}
+	args = append(args, collectionName)
+	args = append(args, "--batch-size", strconv.Itoa(opts.BatchSize))
+	args = append(args, "--batch-limit", strconv.Itoa(opts.BatchLimit))
+
+	data, err := w.cmd.execute(ctx, args)
+	if err != nil {
+		return client.DocumentMigrationStatus{}, err
+	}
+	var status client.DocumentMigrationStatus
+	if err := json.Unmarshal(data, &status); err != nil {
+		return client.DocumentMigrationStatus{}, err
+	}
+	return status, nil
+}
+
+func (w *Wrapper) GetDocumentMigrationStatus(
+	ctx context.Context,
+	collectionName string,
+) (client.DocumentMigrationStatus, error) {
+	args := []string{"client", "schema", "migration", "status"}
+	args = append(args, collectionName)
+
+	data, err := w.cmd.execute(ctx, args)
+	if err != nil {
+		return client.DocumentMigrationStatus{}, err
+	}
+	var status client.DocumentMigrationStatus
+	if err := json.Unmarshal(data, &status); err != nil {
+		return client.DocumentMigrationStatus{}, err
+	}
+	return status, nil
+}
+
 func (w *Wrapper) LensRegistry() client.LensRegistry {
 	return &LensRegistry{w.cmd}
 }
@@ -370,12 +410,29 @@ func (w *Wrapper) GetAllIndexes(ctx context.Context) (map[client.CollectionName]
 	return indexes, nil
 }
 
-func (w *Wrapper) ExecRequest(ctx context.Context, query string) *client.RequestResult {
+func (w *Wrapper) ExecRequest(
+	ctx context.Context,
+	query string,
+	opts ...client.RequestOption,
+) *client.RequestResult {
 	args := []string{"client", "query"}
-	args = append(args, query)
 
 	result := &client.RequestResult{}
 
+	options := client.NewGQLOptions(opts...)
+	if options.OperationName != "" {
+		args = append(args, "--operation", options.OperationName)
+	}
+	if len(options.Variables) > 0 {
+		variables, err := json.Marshal(options.Variables)
+		if err != nil {
+			result.GQL.Errors = []error{err}
+			return result
+		}
+		args = append(args, "--variables", string(variables))
+	}
+	args = append(args, query)
+
 	stdOut, stdErr, err := w.cmd.executeStream(ctx, args)
 	if err != nil {
 		result.GQL.Errors = []error{err}
@@ -413,6 +470,7 @@ func (w *Wrapper) ExecRequest(ctx context.Context, query string) *client.Request
 	}
 	result.GQL.Data = response.Data
 	result.GQL.Errors = response.Errors
+	result.GQL.Extensions = response.Extensions
 	return result
 }
 
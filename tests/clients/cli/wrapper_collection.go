@@ -16,6 +16,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/ipfs/go-cid"
+
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
 	"github.com/sourcenetwork/defradb/datastore"
@@ -307,6 +309,69 @@ func (c *Collection) Get(ctx context.Context, docID client.DocID, showDeleted bo
 	return doc, nil
 }
 
+func (c *Collection) Diff(ctx context.Context, from cid.Cid, to cid.Cid) (*client.DocumentDiff, error) {
+	args := []string{"client", "collection", "diff"}
+	args = append(args, "--name", c.Description().Name)
+	args = append(args, from.String(), to.String())
+
+	data, err := c.cmd.execute(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	var diff client.DocumentDiff
+	if err := json.Unmarshal(data, &diff); err != nil {
+		return nil, err
+	}
+	return &diff, nil
+}
+
+func (c *Collection) Revert(ctx context.Context, docID client.DocID, version cid.Cid) error {
+	args := []string{"client", "collection", "revert"}
+	args = append(args, "--name", c.Description().Name)
+	args = append(args, docID.String(), version.String())
+
+	_, err := c.cmd.execute(ctx, args)
+	return err
+}
+
+func (c *Collection) CompactHistory(ctx context.Context) error {
+	args := []string{"client", "collection", "compact"}
+	args = append(args, "--name", c.Description().Name)
+
+	_, err := c.cmd.execute(ctx, args)
+	return err
+}
+
+func (c *Collection) Purge(ctx context.Context, docID client.DocID) error {
+	args := []string{"client", "collection", "purge"}
+	args = append(args, "--name", c.Description().Name)
+	args = append(args, docID.String())
+
+	_, err := c.cmd.execute(ctx, args)
+	return err
+}
+
+func (c *Collection) Changes(ctx context.Context, since string, limit int) ([]client.Change, error) {
+	args := []string{"client", "collection", "changes"}
+	args = append(args, "--name", c.Description().Name)
+	if since != "" {
+		args = append(args, "--since", since)
+	}
+	if limit > 0 {
+		args = append(args, "--limit", fmt.Sprint(limit))
+	}
+
+	data, err := c.cmd.execute(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	var changes []client.Change
+	if err := json.Unmarshal(data, &changes); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
 func (c *Collection) WithTxn(tx datastore.Txn) client.Collection {
 	return &Collection{
 		cmd: c.cmd.withTxn(tx),
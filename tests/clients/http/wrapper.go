@@ -111,6 +111,21 @@ func (w *Wrapper) SetMigration(ctx context.Context, config client.LensConfig) er
 	return w.client.SetMigration(ctx, config)
 }
 
+func (w *Wrapper) MigrateDocuments(
+	ctx context.Context,
+	collectionName string,
+	opts client.MigrateDocumentsOptions,
+) (client.DocumentMigrationStatus, error) {
+	return w.client.MigrateDocuments(ctx, collectionName, opts)
+}
+
+func (w *Wrapper) GetDocumentMigrationStatus(
+	ctx context.Context,
+	collectionName string,
+) (client.DocumentMigrationStatus, error) {
+	return w.client.GetDocumentMigrationStatus(ctx, collectionName)
+}
+
 func (w *Wrapper) LensRegistry() client.LensRegistry {
 	return w.client.LensRegistry()
 }
@@ -151,8 +166,12 @@ func (w *Wrapper) GetAllIndexes(ctx context.Context) (map[client.CollectionName]
 	return w.client.GetAllIndexes(ctx)
 }
 
-func (w *Wrapper) ExecRequest(ctx context.Context, query string) *client.RequestResult {
-	return w.client.ExecRequest(ctx, query)
+func (w *Wrapper) ExecRequest(
+	ctx context.Context,
+	query string,
+	opts ...client.RequestOption,
+) *client.RequestResult {
+	return w.client.ExecRequest(ctx, query, opts...)
 }
 
 func (w *Wrapper) NewTxn(ctx context.Context, readOnly bool) (datastore.Txn, error) {
@@ -204,3 +204,41 @@ func TestBackupImport_WithMultipleNoKeysAndInvalidField_Errors(t *testing.T) {
 
 	executeTestCase(t, test)
 }
+
+func TestBackupImport_WithFieldDefaultValue_DoesNotSetDefault(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{
+							"op": "add",
+							"path": "/User/Fields/-",
+							"value": {"Name": "role", "Kind": "String", "DefaultValue": "\"member\""}
+						}
+					]
+				`,
+			},
+			testUtils.BackupImport{
+				ImportContent: `{"User":[{"_docID":"bae-e933420a-988a-56f8-8952-6c245aebd519","_docIDNew":"bae-e933420a-988a-56f8-8952-6c245aebd519","age":30,"name":"John"}]}`,
+			},
+			testUtils.Request{
+				Request: `
+					query  {
+						User {
+							name
+							role
+						}
+					}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"role": nil,
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
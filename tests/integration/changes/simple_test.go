@@ -0,0 +1,164 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package changes
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestChanges_WithoutDocuments_ReturnsEmptyList(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Getting changes should return empty list if there are no documents",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.GetChanges{
+				CollectionID:    0,
+				ExpectedChanges: []client.Change{},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestChanges_WithCreateUpdateAndDelete_ReturnsChangesInOrder(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Getting changes should return the changes in the order they were applied",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age": 22
+				}`,
+			},
+			testUtils.DeleteDoc{},
+			testUtils.GetChanges{
+				CollectionID: 0,
+				ExpectedChanges: []client.Change{
+					{
+						Cursor: "00000000000000000001",
+						DocID:  "bae-f54b9689-e06e-5e3a-89b3-f3aee8e64ca7",
+						Cid:    "bafybeibfzk5yltx42sapy7mtipnjttdgo7jkzc6mgrxsmntfju6oqrok3y",
+						Type:   client.ChangeTypeCreate,
+					},
+					{
+						Cursor: "00000000000000000002",
+						DocID:  "bae-f54b9689-e06e-5e3a-89b3-f3aee8e64ca7",
+						Cid:    "bafybeiby4onqzba7ddary4jjzkn53kfo767vqjngqfympqrdsxox2r245e",
+						Type:   client.ChangeTypeUpdate,
+					},
+					{
+						Cursor: "00000000000000000003",
+						DocID:  "bae-f54b9689-e06e-5e3a-89b3-f3aee8e64ca7",
+						Cid:    "bafybeiep33uds5e6qre3n3parlbaktv5dzaf4dbvzp5uuyyvyexiksczia",
+						Type:   client.ChangeTypeDelete,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestChanges_WithCursorAndLimit_ReturnsFollowingChanges(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Getting changes should return the changes following the given cursor up to the limit",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age": 22
+				}`,
+			},
+			testUtils.DeleteDoc{},
+			testUtils.GetChanges{
+				CollectionID: 0,
+				Since:        "00000000000000000001",
+				Limit:        1,
+				ExpectedChanges: []client.Change{
+					{
+						Cursor: "00000000000000000002",
+						DocID:  "bae-f54b9689-e06e-5e3a-89b3-f3aee8e64ca7",
+						Cid:    "bafybeiby4onqzba7ddary4jjzkn53kfo767vqjngqfympqrdsxox2r245e",
+						Type:   client.ChangeTypeUpdate,
+					},
+				},
+			},
+			testUtils.GetChanges{
+				CollectionID:    0,
+				Since:           "00000000000000000003",
+				ExpectedChanges: []client.Change{},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestChanges_WithInvalidCursor_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Getting changes with an invalid cursor should return an error",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.GetChanges{
+				CollectionID:  0,
+				Since:         "invalid",
+				ExpectedError: "invalid change cursor",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
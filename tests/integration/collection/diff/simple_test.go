@@ -0,0 +1,94 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package diff
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+	testUtilsCol "github.com/sourcenetwork/defradb/tests/integration/collection"
+)
+
+var userCollectionGQLSchema = `
+	type Users {
+		name: String
+		age: Int
+	}
+`
+
+var colDefMap = make(map[string]client.CollectionDefinition)
+
+func init() {
+	c, err := testUtils.ParseSDL(userCollectionGQLSchema)
+	if err != nil {
+		panic(err)
+	}
+	colDefMap = c
+}
+
+func TestCollectionDiff(t *testing.T) {
+	doc, err := client.NewDocFromJSON([]byte(`{
+		"name": "John",
+		"age": 21
+	}`), colDefMap["Users"].Schema)
+	require.NoError(t, err)
+
+	test := testUtilsCol.TestCase{
+		Description: "Test diff between two versions of a document",
+		CollectionCalls: map[string][]func(client.Collection) error{
+			"Users": []func(c client.Collection) error{
+				func(c client.Collection) error {
+					ctx := context.Background()
+					err := c.Create(ctx, doc)
+					if err != nil {
+						return err
+					}
+					from := doc.Head()
+
+					err = doc.Set("age", 22)
+					if err != nil {
+						return err
+					}
+					err = c.Update(ctx, doc)
+					if err != nil {
+						return err
+					}
+					to := doc.Head()
+
+					diff, err := c.Diff(ctx, from, to)
+					if err != nil {
+						return err
+					}
+
+					assert.Equal(t, doc.ID().String(), diff.DocID)
+					assert.Equal(t, from.String(), diff.From)
+					assert.Equal(t, to.String(), diff.To)
+					assert.Equal(t, []string{to.String()}, diff.Commits)
+					assert.Equal(t, []client.FieldDiff{
+						{
+							Name:   "age",
+							Before: int64(21),
+							After:  int64(22),
+						},
+					}, diff.Fields)
+					return nil
+				},
+			},
+		},
+	}
+
+	testUtilsCol.ExecuteRequestTestCase(t, userCollectionGQLSchema, test)
+}
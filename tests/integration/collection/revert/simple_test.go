@@ -0,0 +1,91 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package revert
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+	testUtilsCol "github.com/sourcenetwork/defradb/tests/integration/collection"
+)
+
+var userCollectionGQLSchema = `
+	type Users {
+		name: String
+		age: Int
+	}
+`
+
+var colDefMap = make(map[string]client.CollectionDefinition)
+
+func init() {
+	c, err := testUtils.ParseSDL(userCollectionGQLSchema)
+	if err != nil {
+		panic(err)
+	}
+	colDefMap = c
+}
+
+func TestCollectionRevert(t *testing.T) {
+	doc, err := client.NewDocFromJSON([]byte(`{
+		"name": "John",
+		"age": 21
+	}`), colDefMap["Users"].Schema)
+	require.NoError(t, err)
+
+	test := testUtilsCol.TestCase{
+		Description: "Test revert of a document to its first version",
+		CollectionCalls: map[string][]func(client.Collection) error{
+			"Users": []func(c client.Collection) error{
+				func(c client.Collection) error {
+					ctx := context.Background()
+					err := c.Create(ctx, doc)
+					if err != nil {
+						return err
+					}
+					version := doc.Head()
+
+					err = doc.Set("age", 22)
+					if err != nil {
+						return err
+					}
+					err = c.Update(ctx, doc)
+					if err != nil {
+						return err
+					}
+
+					err = c.Revert(ctx, doc.ID(), version)
+					if err != nil {
+						return err
+					}
+
+					reverted, err := c.Get(ctx, doc.ID(), false)
+					if err != nil {
+						return err
+					}
+					age, err := reverted.Get("age")
+					if err != nil {
+						return err
+					}
+					assert.Equal(t, int64(21), age)
+					return nil
+				},
+			},
+		},
+	}
+
+	testUtilsCol.ExecuteRequestTestCase(t, userCollectionGQLSchema, test)
+}
@@ -129,6 +129,7 @@ func setupDatabase(s *state) (impl client.DB, path string, err error) {
 		db.WithUpdateEvents(),
 		db.WithLensPoolSize(lensPoolSize),
 	}
+	dbopts = append(dbopts, s.testCase.DatabaseOptions...)
 
 	switch s.dbt {
 	case badgerIMType:
@@ -39,7 +39,9 @@ var (
 		"createNode":    {},
 		"dagScanNode":   {},
 		"deleteNode":    {},
+		"distinctNode":  {},
 		"groupNode":     {},
+		"havingNode":    {},
 		"limitNode":     {},
 		"multiScanNode": {},
 		"orderNode":     {},
@@ -72,7 +72,14 @@ func (a *ExplainResultAsserter) Assert(t *testing.T, result []dataMap) {
 	}
 	selectTopNode, ok := explainNode["selectTopNode"].(dataMap)
 	require.True(t, ok, "Expected selectTopNode")
-	selectNode, ok := selectTopNode["selectNode"].(dataMap)
+	// The select node may be wrapped by limit and order nodes.
+	selectParentNode := selectTopNode
+	for _, nodeName := range []string{"limitNode", "orderNode"} {
+		if node, isNode := selectParentNode[nodeName].(dataMap); isNode {
+			selectParentNode = node
+		}
+	}
+	selectNode, ok := selectParentNode["selectNode"].(dataMap)
 	require.True(t, ok, "Expected selectNode")
 
 	if a.filterMatches.HasValue() {
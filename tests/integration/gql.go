@@ -19,7 +19,11 @@ import (
 // jsonToGql transforms a json doc string to a gql string.
 func jsonToGQL(val string) (string, error) {
 	var doc map[string]any
-	if err := json.Unmarshal([]byte(val), &doc); err != nil {
+	// Numbers are decoded as json.Number so that they are written to the
+	// request exactly as given, without loss of precision.
+	decoder := json.NewDecoder(strings.NewReader(val))
+	decoder.UseNumber()
+	if err := decoder.Decode(&doc); err != nil {
 		return "", err
 	}
 	return mapToGQL(doc)
@@ -0,0 +1,292 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package history
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestCompactHistory_WithMaxVersions_PrunesOlderCommits(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Compacting the history keeps only the given number of versions",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users @retention(versions: 2) {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age": 22
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age": 23
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age": 24
+				}`,
+			},
+			testUtils.CompactHistory{},
+			testUtils.Request{
+				Request: `query {
+					commits(fieldId: "C", order: {height: ASC}) {
+						cid
+						height
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"cid":    "bafybeicgwfkh4rbr7yflmyzwihmszkn6vaia56ckrlioblqyprfvv3rvwm",
+						"height": int64(3),
+					},
+					{
+						"cid":    "bafybeidapki63isrcrph34j42ef2rc5nea4on2xwyw63ll7vip35u7ceru",
+						"height": int64(4),
+					},
+				},
+			},
+			// the name was set by a pruned commit, and must be restored from the snapshot
+			testUtils.Request{
+				Request: `query {
+					Users(
+						docID: "bae-f54b9689-e06e-5e3a-89b3-f3aee8e64ca7",
+						cid: "bafybeicgwfkh4rbr7yflmyzwihmszkn6vaia56ckrlioblqyprfvv3rvwm"
+					) {
+						name
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"age":  int64(23),
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(
+						docID: "bae-f54b9689-e06e-5e3a-89b3-f3aee8e64ca7",
+						cid: "bafybeibfzk5yltx42sapy7mtipnjttdgo7jkzc6mgrxsmntfju6oqrok3y"
+					) {
+						name
+						age
+					}
+				}`,
+				ExpectedError: "the version has been pruned from the document history",
+			},
+			// the document can still be updated, and compacted again from the previous snapshot
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age": 25
+				}`,
+			},
+			testUtils.CompactHistory{},
+			testUtils.Request{
+				Request: `query {
+					commits(fieldId: "C", order: {height: ASC}) {
+						height
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"height": int64(4),
+					},
+					{
+						"height": int64(5),
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(
+						docID: "bae-f54b9689-e06e-5e3a-89b3-f3aee8e64ca7",
+						cid: "bafybeidapki63isrcrph34j42ef2rc5nea4on2xwyw63ll7vip35u7ceru"
+					) {
+						name
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"age":  int64(24),
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"age":  int64(25),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCompactHistory_WithRecentVersions_KeepsHistory(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Compacting the history keeps the versions younger than the given age",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users @retention(versions: 1, age: "1h") {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age": 22
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age": 23
+				}`,
+			},
+			testUtils.CompactHistory{},
+			testUtils.Request{
+				Request: `query {
+					commits(fieldId: "C", order: {height: ASC}) {
+						height
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"height": int64(1),
+					},
+					{
+						"height": int64(2),
+					},
+					{
+						"height": int64(3),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCompactHistory_WithoutRetentionPolicy_KeepsHistory(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Compacting the history of a collection without retention policy keeps it",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age": 22
+				}`,
+			},
+			testUtils.CompactHistory{},
+			testUtils.Request{
+				Request: `query {
+					commits(fieldId: "C", order: {height: ASC}) {
+						height
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"height": int64(1),
+					},
+					{
+						"height": int64(2),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCompactHistory_WithInvalidVersionsLimit_Error(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Retention policy with an invalid versions limit",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users @retention(versions: 0) {
+						name: String
+					}
+				`,
+				ExpectedError: "retention with invalid argument",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestCompactHistory_WithoutLimit_Error(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Retention policy without versions or age limit",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users @retention {
+						name: String
+					}
+				`,
+				ExpectedError: "retention must define a versions or age limit",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
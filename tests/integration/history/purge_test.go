@@ -0,0 +1,132 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package history
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestPurge_WithDeletedDocument_RemovesDocumentAndHistory(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Purging a deleted document removes it along with its history",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age": 22
+				}`,
+			},
+			testUtils.PurgeDoc{
+				ExpectedError: "only deleted documents can be purged",
+			},
+			testUtils.DeleteDoc{},
+			testUtils.PurgeDoc{},
+			testUtils.Request{
+				Request: `query {
+					commits {
+						cid
+					}
+				}`,
+				Results: []map[string]any{},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(showDeleted: true) {
+						_docID
+					}
+				}`,
+				Results: []map[string]any{},
+			},
+			testUtils.PurgeDoc{
+				ExpectedError: "no document for the given ID exists",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestPurge_WithOtherDocument_KeepsItsHistoryAndChanges(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Purging a deleted document keeps the history and changes of the other documents",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age": 22
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Islam",
+					"age": 33
+				}`,
+			},
+			testUtils.DeleteDoc{},
+			testUtils.PurgeDoc{},
+			testUtils.Request{
+				Request: `query {
+					commits(fieldId: "C") {
+						docID
+						height
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"docID":  "bae-b80d6a1d-dc74-5953-b5a9-54fbbd94f61f",
+						"height": int64(1),
+					},
+				},
+			},
+			testUtils.GetChanges{
+				ExpectedChanges: []client.Change{
+					{
+						Cursor: "00000000000000000003",
+						DocID:  "bae-b80d6a1d-dc74-5953-b5a9-54fbbd94f61f",
+						Cid:    "bafybeicskaex2noaflngbtoqdgz26s3zcw7a7a4ualvf7y5nbjquaykf5y",
+						Type:   client.ChangeTypeCreate,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
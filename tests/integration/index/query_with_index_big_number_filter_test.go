@@ -0,0 +1,211 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package index
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryWithIndex_WithBigIntEqualFilter_ShouldFetch(t *testing.T) {
+	req := `query {
+		User(filter: {balance: {_eq: 123456789012345678901234567890}}) {
+			name
+			balance
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test index filtering with _eq filter on BigInt field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						balance: BigInt @index
+					}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"balance": 123456789012345678901234567890
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Bob",
+					"balance": 5
+				}`,
+			},
+			testUtils.Request{
+				Request: req,
+				Results: []map[string]any{
+					{
+						"name":    "John",
+						"balance": "123456789012345678901234567890",
+					},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithDocFetches(1).WithFieldFetches(2).WithIndexFetches(1),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithIndex_WithDecimalRangeFilters_ShouldCompareNumerically(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test index filtering with range filters on Decimal field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						balance: Decimal @index
+					}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"balance": 100.5
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Bob",
+					"balance": 9.99
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Alice",
+					"balance": -20
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					User(filter: {balance: {_gt: 9.99}}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{"name": "John"},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					User(filter: {balance: {_le: 9.99}}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{"name": "Alice"},
+					{"name": "Bob"},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					User(filter: {balance: {_in: [-20, 100.50]}}) {
+						name
+						balance
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":    "Alice",
+						"balance": "-20",
+					},
+					{
+						"name":    "John",
+						"balance": "100.5",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithIndex_WithBigIntRangeFilters_ShouldOnlyFetchValuesInRange(t *testing.T) {
+	greaterReq := `query {
+		User(filter: {balance: {_gt: 3}}) {
+			name
+		}
+	}`
+	lesserReq := `query {
+		User(filter: {balance: {_le: -5}}) {
+			name
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test index filtering with range filters on BigInt field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						balance: BigInt @index
+					}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"balance": 123456789012345678901234567890
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Bob",
+					"balance": 3
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Alice",
+					"balance": -5
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Fred",
+					"balance": -123456789012345678901234567890
+				}`,
+			},
+			testUtils.Request{
+				Request: greaterReq,
+				Results: []map[string]any{
+					{"name": "John"},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(greaterReq),
+				Asserter: testUtils.NewExplainAsserter().WithDocFetches(1).WithFieldFetches(2).WithIndexFetches(2),
+			},
+			testUtils.Request{
+				Request: lesserReq,
+				Results: []map[string]any{
+					{"name": "Fred"},
+					{"name": "Alice"},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(lesserReq),
+				Asserter: testUtils.NewExplainAsserter().WithDocFetches(2).WithFieldFetches(4).WithIndexFetches(2),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,262 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package index
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/sourcenetwork/defradb/planner/mapper"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryWithIndex_WithAfterCursorOnNegativeIndexedValue_ShouldReturnFollowingDocs(t *testing.T) {
+	cursor, err := mapper.Cursor{
+		Values: []any{-3},
+		DocID:  "bae-436c684e-8303-5e1b-b559-bc57922cff61",
+	}.String()
+	if err != nil {
+		t.Fatal(err)
+	}
+	test := testUtils.TestCase{
+		Description: "Test after cursor with a negative value of an indexed order field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int @index
+					}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": -3
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Islam",
+					"age": 5
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Shahzad",
+					"age": 7
+				}`,
+			},
+			testUtils.Request{
+				Request: fmt.Sprintf(`query {
+					User(order: {age: ASC}, after: %q) {
+						name
+					}
+				}`, cursor),
+				Results: []map[string]any{
+					{"name": "Islam"},
+					{"name": "Shahzad"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithIndex_WithAfterCursorOnIndexedBigIntOrder_ShouldSeekToCursor(t *testing.T) {
+	cursor, err := mapper.Cursor{
+		Values: []any{"18446744073709551616"},
+		DocID:  "bae-cee45994-473f-5a0c-84d5-94321b2b59a6",
+	}.String()
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := fmt.Sprintf(`query {
+		Product(order: {price: ASC}, after: %q) {
+			code
+		}
+	}`, cursor)
+	test := testUtils.TestCase{
+		Description: "Test index seeking to the position of an after cursor on a BigInt field",
+		Actions: append(
+			cursorSeekTestActions(),
+			testUtils.Request{
+				Request: req,
+				Results: []map[string]any{
+					{"code": "D1"},
+					{"code": "E1"},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithDocFetches(3).WithIndexFetches(3),
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithIndex_WithAfterCursorOnIndexedDateTimeOrder_ShouldSeekToCursor(t *testing.T) {
+	cursor, err := mapper.Cursor{
+		Values: []any{"2019-07-23T03:46:56Z"},
+		DocID:  "bae-cee45994-473f-5a0c-84d5-94321b2b59a6",
+	}.String()
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := fmt.Sprintf(`query {
+		Product(order: {released: ASC}, after: %q) {
+			code
+		}
+	}`, cursor)
+	test := testUtils.TestCase{
+		Description: "Test index seeking to the position of an after cursor on a DateTime field",
+		Actions: append(
+			cursorSeekTestActions(),
+			testUtils.Request{
+				Request: req,
+				Results: []map[string]any{
+					{"code": "D1"},
+					{"code": "E1"},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithDocFetches(3).WithIndexFetches(3),
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithIndex_WithAfterCursorOnIndexedStringOrder_ShouldSeekToCursor(t *testing.T) {
+	cursor, err := mapper.Cursor{
+		Values: []any{"C1"},
+		DocID:  "bae-cee45994-473f-5a0c-84d5-94321b2b59a6",
+	}.String()
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := fmt.Sprintf(`query {
+		Product(order: {code: ASC}, after: %q) {
+			code
+		}
+	}`, cursor)
+	test := testUtils.TestCase{
+		Description: "Test index seeking to the position of an after cursor on a String field",
+		Actions: append(
+			cursorSeekTestActions(),
+			testUtils.Request{
+				Request: req,
+				Results: []map[string]any{
+					{"code": "D1"},
+					{"code": "E1"},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithDocFetches(3).WithIndexFetches(3),
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithIndex_WithAfterCursorOnIndexedStringOrder_ShouldReturnShorterGreaterStrings(t *testing.T) {
+	cursor, err := mapper.Cursor{
+		Values: []any{"Islam"},
+		DocID:  "bae-d6ea3e9d-44ae-5b94-b0ab-86e2e1ae0f5d",
+	}.String()
+	if err != nil {
+		t.Fatal(err)
+	}
+	test := testUtils.TestCase{
+		Description: "Test after cursor on an indexed string field with greater strings shorter than the cursor",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String @index
+						age: Int
+					}`,
+			},
+			testUtils.CreatePredefinedDocs{
+				Docs: getUserDocs(),
+			},
+			testUtils.Request{
+				Request: fmt.Sprintf(`query {
+					User(order: {name: ASC}, after: %q) {
+						name
+					}
+				}`, cursor),
+				Results: []map[string]any{
+					{"name": "John"},
+					{"name": "Keenan"},
+					{"name": "Roy"},
+					{"name": "Shahzad"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func cursorSeekTestActions() []any {
+	return []any{
+		testUtils.SchemaUpdate{
+			Schema: `
+				type Product {
+					code: String @index
+					price: BigInt @index
+					released: DateTime @index
+				}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"code": "A1",
+				"price": -18446744073709551616,
+				"released": "2017-07-23T03:46:56Z"
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"code": "B1",
+				"price": 1,
+				"released": "2018-07-23T03:46:56Z"
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"code": "C1",
+				"price": 18446744073709551616,
+				"released": "2019-07-23T03:46:56Z"
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"code": "D1",
+				"price": 36893488147419103232,
+				"released": "2020-07-23T03:46:56Z"
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"code": "E1",
+				"price": 73786976294838206464,
+				"released": "2021-07-23T03:46:56Z"
+			}`,
+		},
+	}
+}
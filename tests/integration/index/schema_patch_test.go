@@ -0,0 +1,195 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package index
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestIndexSchemaPatch_RemoveIndexedField_ShouldDropIndex(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Removing an indexed field should drop the index",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String @index(name: "name_index")
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "remove", "path": "/User/Fields/2" }
+					]
+				`,
+			},
+			testUtils.GetIndexes{
+				ExpectedIndexes: []client.IndexDescription{},
+			},
+			testUtils.Request{
+				Request: `query {
+					User {
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"age": int64(21),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestIndexSchemaPatch_RenameIndexedField_ShouldUpdateIndex(t *testing.T) {
+	req := `query {
+		User(filter: {fullName: {_eq: "John"}}) {
+			fullName
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Renaming an indexed field should update the index",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String @index(name: "name_index")
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/User/Fields/name/Name", "value": "fullName" }
+					]
+				`,
+			},
+			testUtils.GetIndexes{
+				ExpectedIndexes: []client.IndexDescription{
+					{
+						Name: "name_index",
+						ID:   1,
+						Fields: []client.IndexedFieldDescription{
+							{
+								Name:      "fullName",
+								Direction: client.Ascending,
+							},
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: req,
+				Results: []map[string]any{
+					{
+						"fullName": "John",
+					},
+				},
+			},
+			testUtils.Request{
+				// All fields are fetched as there is a migration registered for the collection.
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithDocFetches(1).WithFieldFetches(2).WithIndexFetches(1),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestIndexSchemaPatch_ReplaceIndexedFieldKind_ShouldRebuildIndex(t *testing.T) {
+	req := `query {
+		User(filter: {age: {_eq: 21}}) {
+			name
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Changing the kind of an indexed field should rebuild the index",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: String @index(name: "age_index")
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": "21"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Islam",
+					"age": "32"
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/User/Fields/age/Kind", "value": "Int" }
+					]
+				`,
+			},
+			testUtils.GetIndexes{
+				ExpectedIndexes: []client.IndexDescription{
+					{
+						Name: "age_index",
+						ID:   2,
+						Fields: []client.IndexedFieldDescription{
+							{
+								Name:      "age",
+								Direction: client.Ascending,
+							},
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: req,
+				Results: []map[string]any{
+					{
+						"name": "John",
+					},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithDocFetches(1).WithFieldFetches(2).WithIndexFetches(1),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
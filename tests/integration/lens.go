@@ -52,6 +52,47 @@ type GetMigrations struct {
 	ExpectedResults []client.LensConfig
 }
 
+// MigrateDocuments is a test action which will eagerly migrate the documents of the given
+// collection to its default schema version.
+type MigrateDocuments struct {
+	// NodeID is the node ID (index) of the node in which to migrate the documents.
+	NodeID immutable.Option[int]
+
+	// Used to identify the transaction for this to run against. Optional.
+	TransactionID immutable.Option[int]
+
+	// The name of the collection whose documents are to be migrated.
+	CollectionName string
+
+	// The batch options of the migration.
+	client.MigrateDocumentsOptions
+
+	// The expected status of the collection's documents after the migration.
+	ExpectedStatus client.DocumentMigrationStatus
+
+	// Any error expected from the action. Optional.
+	//
+	// String can be a partial, and the test will pass if an error is returned that
+	// contains this string.
+	ExpectedError string
+}
+
+// GetDocumentMigrationStatus is a test action which will fetch and assert on the status of the
+// migration of the documents of the given collection.
+type GetDocumentMigrationStatus struct {
+	// NodeID is the node ID (index) of the node from which to get the status.
+	NodeID immutable.Option[int]
+
+	// Used to identify the transaction for this to run against. Optional.
+	TransactionID immutable.Option[int]
+
+	// The name of the collection whose document migration status is to be fetched.
+	CollectionName string
+
+	// The expected status.
+	ExpectedStatus client.DocumentMigrationStatus
+}
+
 func configureMigration(
 	s *state,
 	action ConfigureMigration,
@@ -107,3 +148,33 @@ func getMigrations(
 		}
 	}
 }
+
+func migrateDocuments(
+	s *state,
+	action MigrateDocuments,
+) {
+	for _, node := range getNodes(action.NodeID, s.nodes) {
+		db := getStore(s, node, action.TransactionID, action.ExpectedError)
+
+		status, err := db.MigrateDocuments(s.ctx, action.CollectionName, action.MigrateDocumentsOptions)
+		expectedErrorRaised := AssertError(s.t, s.testCase.Description, err, action.ExpectedError)
+
+		assertExpectedErrorRaised(s.t, s.testCase.Description, action.ExpectedError, expectedErrorRaised)
+		if action.ExpectedError == "" {
+			assert.Equal(s.t, action.ExpectedStatus, status)
+		}
+	}
+}
+
+func getDocumentMigrationStatus(
+	s *state,
+	action GetDocumentMigrationStatus,
+) {
+	for _, node := range getNodes(action.NodeID, s.nodes) {
+		db := getStore(s, node, action.TransactionID, "")
+
+		status, err := db.GetDocumentMigrationStatus(s.ctx, action.CollectionName)
+		require.NoError(s.t, err)
+		assert.Equal(s.t, action.ExpectedStatus, status)
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package many_to_many
+
+import (
+	"fmt"
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationCreateManyToMany_WithNestedCreate(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Many to many create mutation with nested create",
+		Actions: []any{
+			testUtils.Request{
+				Request: `mutation {
+					create_Post(input: {name: "Go CRDTs", tags: {create: [{name: "go"}, {name: "crdt"}]}}) {
+						name
+						tags {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Go CRDTs",
+						"tags": []map[string]any{
+							{
+								"name": "crdt",
+							},
+							{
+								"name": "go",
+							},
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Tag {
+						name
+						posts {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "crdt",
+						"posts": []map[string]any{
+							{
+								"name": "Go CRDTs",
+							},
+						},
+					},
+					{
+						"name": "go",
+						"posts": []map[string]any{
+							{
+								"name": "Go CRDTs",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestMutationCreateManyToMany_WithNestedConnectFromEitherSide(t *testing.T) {
+	tagID := "bae-6e81aae9-a754-5c98-90f1-fcfa50f92d85"
+	postID := "bae-9d16738f-5fd0-5f33-99c4-64d04912a738"
+
+	test := testUtils.TestCase{
+		Description: "Many to many create mutation with nested connect from either side",
+		Actions: []any{
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name": "go"
+				}`,
+			},
+			testUtils.Request{
+				Request: fmt.Sprintf(
+					`mutation {
+						create_Post(input: {name: "Go CRDTs", tags: {connect: ["%s"]}}) {
+							_docID
+						}
+					}`,
+					tagID,
+				),
+				Results: []map[string]any{
+					{
+						"_docID": postID,
+					},
+				},
+			},
+			testUtils.Request{
+				Request: fmt.Sprintf(
+					`mutation {
+						create_Tag(input: {name: "crdt", posts: {connect: ["%s"]}}) {
+							name
+						}
+					}`,
+					postID,
+				),
+				Results: []map[string]any{
+					{
+						"name": "crdt",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Post {
+						name
+						tags {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Go CRDTs",
+						"tags": []map[string]any{
+							{
+								"name": "crdt",
+							},
+							{
+								"name": "go",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestMutationCreateManyToMany_WithNestedConnectToMissingDoc_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Many to many create mutation with nested connect to a missing document",
+		Actions: []any{
+			testUtils.Request{
+				Request: `mutation {
+					create_Post(input: {name: "Go CRDTs", tags: {connect: ["bae-6e81aae9-a754-5c98-90f1-fcfa50f92d85"]}}) {
+						name
+					}
+				}`,
+				ExpectedError: "no document for the given ID exists",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
@@ -0,0 +1,126 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package one_to_many
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+const checkExistsSchema = `
+	type Book {
+		name: String
+		author: Author @relation(checkExists: true)
+	}
+	type Author {
+		name: String
+		published: [Book]
+	}
+`
+
+func TestMutationCreateOneToMany_WithCheckExistsAndMissingAuthor_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One to many create mutation referencing a missing document with checkExists.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: checkExistsSchema,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Painted House",
+					"author_id": "bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed"
+				}`,
+				ExpectedError: "related document does not exist",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreateOneToMany_WithCheckExistsAndDeletedAuthor_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One to many create mutation referencing a deleted document with checkExists.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: checkExistsSchema,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				// bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed
+				Doc: `{
+					"name": "John Grisham"
+				}`,
+			},
+			testUtils.DeleteDoc{
+				CollectionID: 1,
+				DocID:        0,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Painted House",
+					"author_id": "bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed"
+				}`,
+				ExpectedError: "related document does not exist",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreateOneToMany_WithCheckExistsAndExistingAuthor_Succeeds(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One to many create mutation referencing an existing document with checkExists.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: checkExistsSchema,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				// bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed
+				Doc: `{
+					"name": "John Grisham"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Painted House",
+					"author_id": "bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Book {
+						name
+						author {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Painted House",
+						"author": map[string]any{
+							"name": "John Grisham",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
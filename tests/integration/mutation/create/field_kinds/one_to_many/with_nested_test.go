@@ -0,0 +1,185 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package one_to_many
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationCreateOneToMany_WithNestedCreateFromSingleSide(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One to many create mutation with nested create from the single side",
+		Actions: []any{
+			testUtils.Request{
+				Request: `mutation {
+					create_Book(input: {name: "Painted House", author: {create: {name: "John Grisham"}}}) {
+						name
+						author {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Painted House",
+						"author": map[string]any{
+							"name": "John Grisham",
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Author {
+						name
+						published {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John Grisham",
+						"published": []map[string]any{
+							{
+								"name": "Painted House",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestMutationCreateOneToMany_WithNestedCreateAndConnectFromManySide(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One to many create mutation with nested create and connect from the many side",
+		Actions: []any{
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "A Time for Mercy"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					create_Author(input: {
+						name: "John Grisham",
+						published: {
+							create: [{name: "Painted House"}, {name: "Theif Lord"}],
+							connect: ["bae-b79e1ebe-d819-5abf-9fd1-9009a532eb03"]
+						}
+					}) {
+						name
+						published {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John Grisham",
+						"published": []map[string]any{
+							{
+								"name": "Painted House",
+							},
+							{
+								"name": "A Time for Mercy",
+							},
+							{
+								"name": "Theif Lord",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestMutationCreateOneToMany_WithNestedCreateAndConnectFromSingleSide_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One to many create mutation with multiple nested operations on the single side",
+		Actions: []any{
+			testUtils.Request{
+				Request: `mutation {
+					create_Book(input: {
+						name: "Painted House",
+						author: {
+							create: {name: "John Grisham"},
+							connect: "bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed"
+						}
+					}) {
+						name
+					}
+				}`,
+				ExpectedError: "only one of create, connect or disconnect may be given for a one-sided relation",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestMutationCreateOneToMany_WithInvalidNestedCreate_CreatesNothing(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One to many create mutation with invalid nested create is atomic",
+		Actions: []any{
+			testUtils.Request{
+				Request: `mutation {
+					create_Author(input: {
+						name: "John Grisham",
+						published: {
+							create: [{name: "Painted House"}, {name: "Theif Lord", rating: "high"}]
+						}
+					}) {
+						name
+					}
+				}`,
+				ExpectedError: "Expected type \"Float\", found \"high\"",
+			},
+			testUtils.Request{
+				Request: `mutation {
+					create_Author(input: {
+						name: "John Grisham",
+						published: {
+							create: [{name: "Painted House"}],
+							connect: ["bae-c6d0a8ab-e0f8-5e2e-b2f8-c8d2d8bbcae2"]
+						}
+					}) {
+						name
+					}
+				}`,
+				ExpectedError: "no document for the given ID exists",
+			},
+			testUtils.Request{
+				Request: `query {
+					Author {
+						name
+					}
+					Book {
+						name
+					}
+				}`,
+				Results: []map[string]any{},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
@@ -0,0 +1,94 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package one_to_many
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationCreateOneToMany_WithRelationIDVariableFromManySide(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One to many create mutation using a relation id variable from the many side",
+		Actions: []any{
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name": "John Grisham"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation($authorID: ID) {
+					create_Book(input: {name: "Painted House", author: $authorID}) {
+						name
+						author {
+							name
+						}
+					}
+				}`,
+				Variables: map[string]any{
+					"authorID": "bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed",
+				},
+				Results: []map[string]any{
+					{
+						"name": "Painted House",
+						"author": map[string]any{
+							"name": "John Grisham",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestMutationCreateOneToMany_WithRelationIDInInputVariableFromManySide(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One to many create mutation using a relation id within an input variable from the many side",
+		Actions: []any{
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name": "John Grisham"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation($input: BookMutationInputArg) {
+					create_Book(input: $input) {
+						name
+						author {
+							name
+						}
+					}
+				}`,
+				Variables: map[string]any{
+					"input": map[string]any{
+						"name":   "Painted House",
+						"author": "bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed",
+					},
+				},
+				Results: []map[string]any{
+					{
+						"name": "Painted House",
+						"author": map[string]any{
+							"name": "John Grisham",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
@@ -0,0 +1,124 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package create
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationCreate_WithBigIntInExponentForm_CreatesDoc(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create mutation with a BigInt written in exponent form",
+		SupportedMutationTypes: immutable.Some([]testUtils.MutationType{
+			// GQL BigInt literals may not be written in exponent form
+			testUtils.CollectionNamedMutationType,
+			testUtils.CollectionSaveMutationType,
+		}),
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						balance: BigInt
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"balance": "1e21"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						balance
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"balance": "1000000000000000000000",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithBigIntExponentBeyondMaxDigits_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create mutation with a BigInt exponent beyond the maximum number of digits",
+		SupportedMutationTypes: immutable.Some([]testUtils.MutationType{
+			// GQL BigInt literals may not be written in exponent form
+			testUtils.CollectionNamedMutationType,
+			testUtils.CollectionSaveMutationType,
+		}),
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						balance: BigInt
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"balance": "1e1000000000"
+				}`,
+				ExpectedError: "number has more digits than permitted",
+			},
+			testUtils.Request{
+				// Ensure that no documents have been written.
+				Request: `query {
+					Users {
+						balance
+					}
+				}`,
+				Results: []map[string]any{},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithDecimalExponentBeyondMaxDigits_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create mutation with a Decimal exponent beyond the maximum number of digits",
+		SupportedMutationTypes: immutable.Some([]testUtils.MutationType{
+			// GQL mutation will return a different error
+			// when the value cannot be coerced to a Decimal
+			testUtils.CollectionNamedMutationType,
+			testUtils.CollectionSaveMutationType,
+		}),
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						rate: Decimal
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"rate": "1e-1000000000"
+				}`,
+				ExpectedError: "number has more digits than permitted",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
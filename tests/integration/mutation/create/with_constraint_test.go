@@ -0,0 +1,343 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package create
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationCreate_WithValuesWithinConstraints(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create mutation with values satisfying field constraints",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String @constraint(maxLength: 10)
+						age: Int @constraint(min: 0, max: 150)
+						email: String @constraint(pattern: "^[^@]+@[^@]+$")
+						tags: [String!] @constraint(maxLength: 2)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 150,
+					"email": "john@example.com",
+					"tags": ["a", "b"]
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						age
+						email
+						tags
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":  "John",
+						"age":   int64(150),
+						"email": "john@example.com",
+						"tags":  []string{"a", "b"},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithValueBelowMin_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create mutation with value below the field minimum",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int @constraint(min: 0)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": -1
+				}`,
+				ExpectedError: "value is less than the minimum permitted by the field. Field: age, Min: 0, Value: -1",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithValueAboveMax_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create mutation with value above the field maximum",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						points: Float @constraint(max: 10.5)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"points": 10.6
+				}`,
+				ExpectedError: "value is greater than the maximum permitted by the field. Field: points",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithBigIntAboveMaxBeyondFloatPrecision_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create mutation with BigInt value just above a maximum beyond float precision",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						balance: BigInt @constraint(max: 123456789012345678901234567890)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"balance": 123456789012345678901234567891
+				}`,
+				ExpectedError: "value is greater than the maximum permitted by the field. Field: balance",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithDecimalAtMinBeyondFloatPrecision(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create mutation with Decimal value equal to a minimum beyond float precision",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						rate: Decimal @constraint(min: 0.12345678901234567890123)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"rate": "0.12345678901234567890123"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						rate
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"rate": "0.12345678901234567890123",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithValueNotMatchingPattern_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create mutation with value not matching the field pattern",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						email: String @constraint(pattern: "^[^@]+@[^@]+$")
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"email": "john"
+				}`,
+				ExpectedError: "value does not match the pattern required by the field. Field: email",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithStringExceedingMaxLength_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create mutation with string longer than the field maximum length",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String @constraint(maxLength: 3)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+				ExpectedError: "value exceeds the maximum length permitted by the field. Field: name, MaxLength: 3, Length: 4",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithArrayExceedingMaxLength_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create mutation with array longer than the field maximum length",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						tags: [Int] @constraint(maxLength: 1)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"tags": [1, null]
+				}`,
+				ExpectedError: "value exceeds the maximum length permitted by the field. Field: tags, MaxLength: 1, Length: 2",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreateGQL_WithValueViolatingConstraint_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create gql mutation with value violating the field constraint",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int @constraint(max: 150)
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					create_Users(input: {name: "John", age: 151}) {
+						name
+					}
+				}`,
+				ExpectedError: "value is greater than the maximum permitted by the field. Field: age",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithMinConstraintOnStringField_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Schema with numeric constraint on string field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String @constraint(min: 1)
+					}
+				`,
+				ExpectedError: "constraint is not supported for the field. Field: name, Constraint: min",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithInvalidPatternConstraint_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Schema with pattern constraint that is not a valid regular expression",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String @constraint(pattern: "[a-z")
+					}
+				`,
+				ExpectedError: "invalid field constraint pattern",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithMinGreaterThanMaxConstraint_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Schema with min constraint greater than max constraint",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						age: Int @constraint(min: 10, max: 1)
+					}
+				`,
+				ExpectedError: "constraint min must not be greater than max. Field: age, Min: 10, Max: 1",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithUnknownConstraintArg_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Schema with unknown constraint argument",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						age: Int @constraint(minimum: 10)
+					}
+				`,
+				ExpectedError: "constraint with unknown argument. Field: age, Argument: minimum",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,176 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package create
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationCreate_WithDefaultValues_SetsDefaults(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create mutation with default values, omitted fields",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Status {
+						ACTIVE
+						INACTIVE
+					}
+
+					type Users {
+						name: String
+						age: Int @default(value: 18)
+						verified: Boolean @default(value: false)
+						role: String @default(value: "member")
+						status: Status @default(value: ACTIVE)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						age
+						verified
+						role
+						status
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":     "John",
+						"age":      int64(18),
+						"verified": false,
+						"role":     "member",
+						"status":   "ACTIVE",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithDefaultValueAndGivenValue_DoesNotUseDefault(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create mutation with default value, given field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int @default(value: 18)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 27
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"age":  int64(27),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithDefaultValueGQL_SetsDefault(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create gql mutation with default value, omitted field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						points: Float @default(value: 1.5)
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					create_Users(input: {name: "John"}) {
+						name
+						points
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":   "John",
+						"points": 1.5,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithDefaultValueOfWrongKind_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Schema with default value that does not match the field kind",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int @default(value: "eighteen")
+					}
+				`,
+				ExpectedError: "invalid default value for field",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithDefaultValueViolatingConstraint_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Schema with default value that does not satisfy the field constraint",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int @default(value: 5) @constraint(min: 18)
+					}
+				`,
+				ExpectedError: "invalid default value for field",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,158 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package create
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationCreate_WithEnumValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create mutation with enum value",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Status {
+						ACTIVE
+						INACTIVE
+					}
+
+					type Users {
+						name: String
+						status: Status
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"status": "ACTIVE"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						status
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":   "John",
+						"status": "ACTIVE",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithInvalidEnumValue_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create mutation with value outside of enum",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Status {
+						ACTIVE
+						INACTIVE
+					}
+
+					type Users {
+						name: String
+						status: Status
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"status": "ARCHIVED"
+				}`,
+				ExpectedError: "value is not a member of the enum. Field: status, Enum: Status, Value: ARCHIVED",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithEnumValueViaGQL(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create mutation with enum value via gql request",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Status {
+						ACTIVE
+						INACTIVE
+					}
+
+					type Users {
+						name: String
+						status: Status
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					create_Users(input: {name: "John", status: INACTIVE}) {
+						name
+						status
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":   "John",
+						"status": "INACTIVE",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithInvalidEnumValueViaGQL_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create mutation with value outside of enum via gql request",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Status {
+						ACTIVE
+						INACTIVE
+					}
+
+					type Users {
+						name: String
+						status: Status
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					create_Users(input: {name: "John", status: ARCHIVED}) {
+						name
+					}
+				}`,
+				ExpectedError: "Argument \"input\" has invalid value {name: \"John\", status: ARCHIVED}",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,161 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package create
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationCreate_WithNonNullFieldGiven(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create mutation with non-null field given a value",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String!
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"age":  nil,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithNonNullFieldOmitted_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create mutation with non-null field omitted",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String!
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"age": 21
+				}`,
+				ExpectedError: "non-null field must be given a value. Field: name",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithNonNullFieldGivenNull_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create mutation with non-null array field given null",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						tags: [String!]!
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"tags": null
+				}`,
+				ExpectedError: "non-null field must be given a value. Field: tags",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithNonNullFieldOmittedWithDefault_SetsDefault(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create mutation with non-null field with default omitted",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String! @default(value: "Anonymous")
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Anonymous",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreateGQL_WithNonNullFieldOmitted_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create gql mutation with non-null field omitted",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String!
+						age: Int
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					create_Users(input: {age: 21}) {
+						age
+					}
+				}`,
+				ExpectedError: "non-null field must be given a value. Field: name",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,90 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package create
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationCreate_WithVariables(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create mutation with variable input",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `mutation($input: UsersMutationInputArg!) {
+					create_Users(input: $input) {
+						name
+						age
+					}
+				}`,
+				Variables: map[string]any{
+					"input": map[string]any{
+						"name": "John",
+						"age":  27,
+					},
+				},
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"age":  int64(27),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithVariablesInInputField(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create mutation with variables in input fields",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `mutation($name: String, $age: Int) {
+					create_Users(input: {name: $name, age: $age}) {
+						name
+						age
+					}
+				}`,
+				Variables: map[string]any{
+					"name": "John",
+				},
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"age":  nil,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,343 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package one_to_many
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestDeletionOfADocument_WithOnDeleteCascade_DeletesRelatedDocuments(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One to many delete document with onDelete cascade.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Book {
+						name: String
+						author: Author @relation(onDelete: CASCADE)
+					}
+					type Author {
+						name: String
+						published: [Book]
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				// bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed
+				Doc: `{
+					"name": "John Grisham"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name": "Cornelia Funke"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Painted House",
+					"author_id": "bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "A Time for Mercy",
+					"author_id": "bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Theif Lord"
+				}`,
+			},
+			testUtils.DeleteDoc{
+				CollectionID: 1,
+				DocID:        0,
+			},
+			testUtils.Request{
+				Request: `query {
+					Book {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Theif Lord",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Author {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Cornelia Funke",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDeletionOfADocument_WithOnDeleteSetNull_ClearsRelationID(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One to many delete document with onDelete set null.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Book {
+						name: String
+						author: Author @relation(onDelete: SET_NULL)
+					}
+					type Author {
+						name: String
+						published: [Book]
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				// bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed
+				Doc: `{
+					"name": "John Grisham"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Painted House",
+					"author_id": "bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed"
+				}`,
+			},
+			testUtils.DeleteDoc{
+				CollectionID: 1,
+				DocID:        0,
+			},
+			testUtils.Request{
+				Request: `query {
+					Book {
+						name
+						author_id
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":      "Painted House",
+						"author_id": nil,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDeletionOfADocument_WithOnDeleteRestrict_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One to many delete referenced document with onDelete restrict.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Book {
+						name: String
+						author: Author @relation(onDelete: RESTRICT)
+					}
+					type Author {
+						name: String
+						published: [Book]
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				// bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed
+				Doc: `{
+					"name": "John Grisham"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Painted House",
+					"author_id": "bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed"
+				}`,
+			},
+			testUtils.DeleteDoc{
+				CollectionID:  1,
+				DocID:         0,
+				ExpectedError: "document is referenced by a relation that restricts its deletion",
+			},
+			testUtils.Request{
+				Request: `query {
+					Author {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John Grisham",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDeletionOfADocument_WithOnDeleteRestrictAndNoReferences_Succeeds(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One to many delete unreferenced document with onDelete restrict.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Book {
+						name: String
+						author: Author @relation(onDelete: RESTRICT)
+					}
+					type Author {
+						name: String
+						published: [Book]
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name": "John Grisham"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Painted House"
+				}`,
+			},
+			testUtils.DeleteDoc{
+				CollectionID: 1,
+				DocID:        0,
+			},
+			testUtils.Request{
+				Request: `query {
+					Author {
+						name
+					}
+				}`,
+				Results: []map[string]any{},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDeletionOfADocument_WithOnDeleteRestrictAndDeletedReference_Succeeds(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One to many delete document referenced by a deleted document with onDelete restrict.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Book {
+						name: String
+						author: Author @relation(onDelete: RESTRICT)
+					}
+					type Author {
+						name: String
+						published: [Book]
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				// bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed
+				Doc: `{
+					"name": "John Grisham"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Painted House",
+					"author_id": "bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed"
+				}`,
+			},
+			testUtils.DeleteDoc{
+				CollectionID: 0,
+				DocID:        0,
+			},
+			testUtils.DeleteDoc{
+				CollectionID: 1,
+				DocID:        0,
+			},
+			testUtils.Request{
+				Request: `query {
+					Author {
+						name
+					}
+				}`,
+				Results: []map[string]any{},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestDeletionOfADocument_WithOnDelete_IndexesRelationID(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One to many relation with onDelete indexes the relation id.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Book {
+						name: String
+						author: Author @relation(onDelete: RESTRICT)
+					}
+					type Author {
+						name: String
+						published: [Book]
+					}
+				`,
+			},
+			testUtils.GetIndexes{
+				CollectionID: 0,
+				ExpectedIndexes: []client.IndexDescription{
+					{
+						Name: "Book_author_id_ASC",
+						ID:   1,
+						Fields: []client.IndexedFieldDescription{
+							{
+								Name:      "author_id",
+								Direction: client.Ascending,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
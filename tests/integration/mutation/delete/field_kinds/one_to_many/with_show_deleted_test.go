@@ -128,3 +128,35 @@ func TestDeletionOfADocumentUsingSingleDocIDWithShowDeletedDocumentQuery(t *test
 
 	testUtils.ExecuteTestCase(t, test)
 }
+
+func TestDeletionOfADocument_DoesNotReturnItFromRelatedCollection(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One to many delete document does not return it when querying the other collection.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: schemas,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name": "John",
+					"age": 30
+				}`,
+			},
+			testUtils.DeleteDoc{
+				CollectionID: 1,
+				DocID:        0,
+			},
+			testUtils.Request{
+				Request: `query {
+					Book {
+						name
+					}
+				}`,
+				Results: []map[string]any{},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
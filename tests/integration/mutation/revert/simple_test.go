@@ -0,0 +1,344 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package revert
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationRevert(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple revert of a document to its first version",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+						verified: Boolean
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"age":	21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age":	22
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"name":	"Johnny"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					revert_Users(
+						docID: "bae-f54b9689-e06e-5e3a-89b3-f3aee8e64ca7",
+						cid: "bafybeiedu23doqe2nagdbmkvfyuouajnfxo7ezy57vbv34dqewhwbfg45u"
+					) {
+						_docID
+						name
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"_docID": "bae-f54b9689-e06e-5e3a-89b3-f3aee8e64ca7",
+						"name":   "John",
+						"age":    int64(21),
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"age":  int64(21),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationRevert_KeepsHistory(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Revert of a document writes new commits on top of its history",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+						verified: Boolean
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"age":	21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age":	22
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					revert_Users(
+						docID: "bae-f54b9689-e06e-5e3a-89b3-f3aee8e64ca7",
+						cid: "bafybeiedu23doqe2nagdbmkvfyuouajnfxo7ezy57vbv34dqewhwbfg45u"
+					) {
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"age": int64(21),
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					commits(fieldId: "C") {
+						height
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"height": int64(3),
+					},
+					{
+						"height": int64(2),
+					},
+					{
+						"height": int64(1),
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					commits(fieldId: "1") {
+						height
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"height": int64(3),
+					},
+					{
+						"height": int64(2),
+					},
+					{
+						"height": int64(1),
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					commits(fieldId: "2") {
+						height
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"height": int64(1),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationRevert_WithCounterField(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Revert of a document with a counter field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						points: Int @crdt(type: "pncounter")
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"points": 10
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"points": 5
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					revert_Users(
+						docID: "bae-a688789e-d8a6-57a7-be09-22e005ab79e0",
+						cid: "bafybeiepi2gpoyshdj2ekdsydhw5itxqmipsh7f6pd6iyoiu6sqsdlj2se"
+					) {
+						points
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"points": int64(10),
+					},
+				},
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"points": 2
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						points
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"points": int64(12),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationRevert_WithBigIntCounterField(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Revert of a document with a BigInt counter field beyond the range of Int",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						points: BigInt @crdt(type: "pncounter")
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"points": 9223372036854775807
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"points": 9223372036854775807
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					revert_Users(
+						docID: "bae-419fed36-da1e-5b03-876d-f307facda1b9",
+						cid: "bafybeiguto54pyfgvxhmgtou6tigc4hvdzf2d2ej62ziardexhlwqej6ci"
+					) {
+						points
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"points": "9223372036854775807",
+					},
+				},
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"points": 1
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						points
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"points": "9223372036854775808",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationRevert_WithCounterFieldWithConstraint_OnlyChecksRevertedValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Revert of a document with a constrained counter field checks the reverted value",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						points: Int @crdt(type: "pncounter") @constraint(min: 0)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"points": 10
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"points": 5
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					revert_Users(
+						docID: "bae-a688789e-d8a6-57a7-be09-22e005ab79e0",
+						cid: "bafybeiecgdmkvfyguc3osfpwl2d5k2rippzvhjdhuheqohunbtjoxo65sa"
+					) {
+						points
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"points": int64(10),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
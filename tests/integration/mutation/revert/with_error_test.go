@@ -0,0 +1,193 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package revert
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationRevert_WithCommitOfOtherDocument_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Revert of a document to a commit of another document",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+						verified: Boolean
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"age":	21
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"Fred",
+					"age":	21
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					revert_Users(
+						docID: "bae-f54b9689-e06e-5e3a-89b3-f3aee8e64ca7",
+						cid: "bafybeifabxkvvytjsxaiuckr77e4qde2lbihsw6qvnjitohs64fk6nrmsy"
+					) {
+						name
+					}
+				}`,
+				ExpectedError: "the given commit does not belong to the document",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationRevert_WithFieldCommit_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Revert of a document to a field commit",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+						verified: Boolean
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"age":	21
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					revert_Users(
+						docID: "bae-f54b9689-e06e-5e3a-89b3-f3aee8e64ca7",
+						cid: "bafybeihfw5lufgs7ygv45to5rqvt3xkecjgikoccjyx6y2i7lnaclmrcjm"
+					) {
+						name
+					}
+				}`,
+				ExpectedError: "the given CID is not a document commit of the collection",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationRevert_WithUnknownCommit_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Revert of a document to a commit that does not exist",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+						verified: Boolean
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"age":	21
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					revert_Users(
+						docID: "bae-f54b9689-e06e-5e3a-89b3-f3aee8e64ca7",
+						cid: "bafybeiccw3monirbnhxo4wrnp2nw3uzx6b64gjhjywcmgwoyybthwhflmu"
+					) {
+						name
+					}
+				}`,
+				ExpectedError: "the given CID is not a document commit of the collection",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationRevert_WithDeletedDocument_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Revert of a deleted document",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+						verified: Boolean
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"age":	21
+				}`,
+			},
+			testUtils.DeleteDoc{},
+			testUtils.Request{
+				Request: `mutation {
+					revert_Users(
+						docID: "bae-f54b9689-e06e-5e3a-89b3-f3aee8e64ca7",
+						cid: "bafybeiedu23doqe2nagdbmkvfyuouajnfxo7ezy57vbv34dqewhwbfg45u"
+					) {
+						name
+					}
+				}`,
+				ExpectedError: "a document with the given ID has been deleted",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationRevert_WithoutCid_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Revert of a document without a cid",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					revert_Users(docID: "bae-f54b9689-e06e-5e3a-89b3-f3aee8e64ca7") {
+						name
+					}
+				}`,
+				ExpectedError: "Field \"revert_Users\" argument \"cid\" of type \"ID!\" is required but not provided.",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,86 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package revert
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationRevert_WithRelationChange(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Revert of a document restores its related document",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Book {
+						name: String
+						author: Author
+					}
+					type Author {
+						name: String
+						books: [Book]
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name":	"A"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name":	"B"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name":	"X",
+					"author_id": "bae-51d27347-eed2-55be-99a4-7dfe569ec193"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				CollectionID: 0,
+				DocID:        0,
+				Doc: `{
+					"author_id": "bae-4f40a380-6312-554f-8b18-db19c73b0e72"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					revert_Book(
+						docID: "bae-2ef3da5f-bb35-5156-9e0a-c6e7e3fdfafc",
+						cid: "bafybeib5ofn2vueuebbxjuvvqubvy5kzdgmejausgcp5yvmfdoj6yhvbaa"
+					) {
+						name
+						author {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "X",
+						"author": map[string]any{
+							"name": "A",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,95 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package revert
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationRevert_WithFieldsAddedAfterVersion_UnsetsFields(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Revert of a document to a version preceding fields added to the schema, counters are reset to zero",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John"
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "email", "Kind": 11} },
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "points", "Kind": 4} },
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "visits", "Kind": 4, "Typ": 4} }
+					]
+				`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"name":	"Johnny",
+					"email":	"john@example.com",
+					"points":	30,
+					"visits":	3
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					revert_Users(
+						docID: "bae-decf6467-4c7c-50d7-b09d-0a7097ef6bad",
+						cid: "bafybeif5l2a5f2lcsmuml2cji6unq4qk2ta4f3uow4wccdjebsu7jcjrj4"
+					) {
+						name
+						email
+						points
+						visits
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":   "John",
+						"email":  nil,
+						"points": nil,
+						"visits": int64(0),
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						email
+						points
+						visits
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":   "John",
+						"email":  nil,
+						"points": nil,
+						"visits": int64(0),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,198 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package many_to_many
+
+import (
+	"fmt"
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationUpdateManyToMany_WithNestedConnect(t *testing.T) {
+	postID := "bae-9d16738f-5fd0-5f33-99c4-64d04912a738"
+	tagID := "bae-6e81aae9-a754-5c98-90f1-fcfa50f92d85"
+
+	test := testUtils.TestCase{
+		Description: "Many to many update mutation with nested connect",
+		Actions: []any{
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Go CRDTs"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name": "go"
+				}`,
+			},
+			testUtils.Request{
+				Request: fmt.Sprintf(
+					`mutation {
+						update_Tag(docID: "%s", input: {posts: {connect: ["%s"]}}) {
+							name
+							posts {
+								name
+							}
+						}
+					}`,
+					tagID,
+					postID,
+				),
+				Results: []map[string]any{
+					{
+						"name": "go",
+						"posts": []map[string]any{
+							{
+								"name": "Go CRDTs",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestMutationUpdateManyToMany_WithNestedDisconnectAndReconnect(t *testing.T) {
+	postID := "bae-9d16738f-5fd0-5f33-99c4-64d04912a738"
+	tagID := "bae-6e81aae9-a754-5c98-90f1-fcfa50f92d85"
+
+	test := testUtils.TestCase{
+		Description: "Many to many update mutation with nested disconnect and reconnect",
+		Actions: []any{
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name": "go"
+				}`,
+			},
+			testUtils.Request{
+				Request: fmt.Sprintf(
+					`mutation {
+						create_Post(input: {name: "Go CRDTs", tags: {connect: ["%s"]}}) {
+							name
+						}
+					}`,
+					tagID,
+				),
+				Results: []map[string]any{
+					{
+						"name": "Go CRDTs",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: fmt.Sprintf(
+					`mutation {
+						update_Post(docID: "%s", input: {tags: {disconnect: ["%s"]}}) {
+							name
+							tags {
+								name
+							}
+						}
+					}`,
+					postID,
+					tagID,
+				),
+				Results: []map[string]any{
+					{
+						"name": "Go CRDTs",
+						"tags": []map[string]any{},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Tag {
+						name
+						posts {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":  "go",
+						"posts": []map[string]any{},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: fmt.Sprintf(
+					`mutation {
+						update_Tag(docID: "%s", input: {posts: {connect: ["%s"]}}) {
+							name
+							posts {
+								name
+							}
+						}
+					}`,
+					tagID,
+					postID,
+				),
+				Results: []map[string]any{
+					{
+						"name": "go",
+						"posts": []map[string]any{
+							{
+								"name": "Go CRDTs",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestMutationUpdateManyToMany_WithNestedDisconnectOfUnrelatedDoc_Errors(t *testing.T) {
+	postID := "bae-9d16738f-5fd0-5f33-99c4-64d04912a738"
+	tagID := "bae-6e81aae9-a754-5c98-90f1-fcfa50f92d85"
+
+	test := testUtils.TestCase{
+		Description: "Many to many update mutation with nested disconnect of an unrelated document",
+		Actions: []any{
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Go CRDTs"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name": "go"
+				}`,
+			},
+			testUtils.Request{
+				Request: fmt.Sprintf(
+					`mutation {
+						update_Post(docID: "%s", input: {tags: {disconnect: ["%s"]}}) {
+							name
+						}
+					}`,
+					postID,
+					tagID,
+				),
+				ExpectedError: "the document is not related to the given document",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
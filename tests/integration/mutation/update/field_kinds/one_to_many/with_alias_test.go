@@ -118,7 +118,7 @@ func TestMutationUpdateOneToMany_AliasRelationNameToLinkFromSingleSide_GQL(t *te
 					}`,
 					bookID,
 				),
-				ExpectedError: "The given field or alias to field does not exist. Name: published",
+				ExpectedError: "In field \"published\": Expected \"BookRelationListInputArg\", found not an object.",
 			},
 		},
 	}
@@ -0,0 +1,241 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package one_to_many
+
+import (
+	"fmt"
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationUpdateOneToMany_WithNestedCreateFromManySide(t *testing.T) {
+	author1ID := "bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed"
+
+	test := testUtils.TestCase{
+		Description: "One to many update mutation with nested create from the many side",
+		Actions: []any{
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name": "John Grisham"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: fmt.Sprintf(
+					`{
+						"name": "Painted House",
+						"author": "%s"
+					}`,
+					author1ID,
+				),
+			},
+			testUtils.Request{
+				Request: fmt.Sprintf(
+					`mutation {
+						update_Author(docID: "%s", input: {age: 65, published: {create: [{name: "A Time for Mercy"}]}}) {
+							name
+							age
+							published {
+								name
+							}
+						}
+					}`,
+					author1ID,
+				),
+				Results: []map[string]any{
+					{
+						"name": "John Grisham",
+						"age":  int64(65),
+						"published": []map[string]any{
+							{
+								"name": "Painted House",
+							},
+							{
+								"name": "A Time for Mercy",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestMutationUpdateOneToMany_WithNestedDisconnectFromManySide(t *testing.T) {
+	author1ID := "bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed"
+	bookID := "bae-22e0a1c2-d12b-5bfd-b039-0cf72f963991"
+
+	test := testUtils.TestCase{
+		Description: "One to many update mutation with nested disconnect from the many side",
+		Actions: []any{
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name": "John Grisham"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: fmt.Sprintf(
+					`{
+						"name": "Painted House",
+						"author": "%s"
+					}`,
+					author1ID,
+				),
+			},
+			testUtils.Request{
+				Request: fmt.Sprintf(
+					`mutation {
+						update_Author(docID: "%s", input: {published: {disconnect: ["%s"]}}) {
+							name
+							published {
+								name
+							}
+						}
+					}`,
+					author1ID,
+					bookID,
+				),
+				Results: []map[string]any{
+					{
+						"name":      "John Grisham",
+						"published": []map[string]any{},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Book {
+						name
+						author {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":   "Painted House",
+						"author": nil,
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestMutationUpdateOneToMany_WithNestedDisconnectOfUnrelatedDoc_Errors(t *testing.T) {
+	author1ID := "bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed"
+	bookID := "bae-22e0a1c2-d12b-5bfd-b039-0cf72f963991"
+
+	test := testUtils.TestCase{
+		Description: "One to many update mutation with nested disconnect of an unrelated document",
+		Actions: []any{
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name": "John Grisham"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name": "New Shahzad"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: fmt.Sprintf(
+					`{
+						"name": "Painted House",
+						"author": "%s"
+					}`,
+					author1ID,
+				),
+			},
+			testUtils.Request{
+				Request: fmt.Sprintf(
+					`mutation {
+						update_Author(filter: {name: {_eq: "New Shahzad"}}, input: {published: {disconnect: ["%s"]}}) {
+							name
+						}
+					}`,
+					bookID,
+				),
+				ExpectedError: "the document is not related to the given document",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestMutationUpdateOneToMany_WithNestedConnectFromSingleSide(t *testing.T) {
+	author1ID := "bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed"
+	author2ID := "bae-35953caf-4898-518d-9e6b-9ce6cd86ebe5"
+
+	test := testUtils.TestCase{
+		Description: "One to many update mutation with nested connect from the single side",
+		Actions: []any{
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name": "John Grisham"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name": "New Shahzad"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: fmt.Sprintf(
+					`{
+						"name": "Painted House",
+						"author": "%s"
+					}`,
+					author1ID,
+				),
+			},
+			testUtils.Request{
+				Request: fmt.Sprintf(
+					`mutation {
+						update_Book(input: {author: {connect: "%s"}}) {
+							name
+							author {
+								name
+							}
+						}
+					}`,
+					author2ID,
+				),
+				Results: []map[string]any{
+					{
+						"name": "Painted House",
+						"author": map[string]any{
+							"name": "New Shahzad",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
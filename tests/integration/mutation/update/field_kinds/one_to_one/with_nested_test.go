@@ -0,0 +1,183 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package one_to_one
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationUpdateOneToOne_WithNestedCreateFromSecondarySide(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One to one update mutation with nested create from the secondary side",
+		Actions: []any{
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Painted House"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Book(input: {author: {create: {name: "John Grisham"}}}) {
+						name
+						author {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Painted House",
+						"author": map[string]any{
+							"name": "John Grisham",
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Author {
+						name
+						published {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John Grisham",
+						"published": map[string]any{
+							"name": "Painted House",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestMutationUpdateOneToOne_WithNestedDisconnectFromSecondarySide(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One to one update mutation with nested disconnect from the secondary side",
+		Actions: []any{
+			testUtils.Request{
+				Request: `mutation {
+					create_Book(input: {name: "Painted House", author: {create: {name: "John Grisham"}}}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Painted House",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Book(input: {author: {disconnect: true}}) {
+						name
+						author {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":   "Painted House",
+						"author": nil,
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Author {
+						name
+						published {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":      "John Grisham",
+						"published": nil,
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestMutationUpdateOneToOne_WithNestedDisconnectFromPrimarySide(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One to one update mutation with nested disconnect from the primary side",
+		Actions: []any{
+			testUtils.Request{
+				Request: `mutation {
+					create_Author(input: {name: "John Grisham", published: {create: {name: "Painted House"}}}) {
+						name
+						published {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John Grisham",
+						"published": map[string]any{
+							"name": "Painted House",
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Author(input: {published: {disconnect: true}}) {
+						name
+						published {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":      "John Grisham",
+						"published": nil,
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Book {
+						name
+						author {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":   "Painted House",
+						"author": nil,
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
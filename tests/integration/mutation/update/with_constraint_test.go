@@ -0,0 +1,121 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package update
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationUpdate_WithValueViolatingConstraint_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple update mutation with value violating the field constraint",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int @constraint(min: 0, max: 150)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 27
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age": 151
+				}`,
+				ExpectedError: "value is greater than the maximum permitted by the field. Field: age",
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"age":  int64(27),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationUpdateGQL_WithValueViolatingConstraint_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple update gql mutation with value violating the field constraint",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String @constraint(pattern: "^[A-Z]")
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Users(input: {name: "john"}) {
+						name
+					}
+				}`,
+				ExpectedError: "value does not match the pattern required by the field. Field: name",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationUpdate_WithNonNullFieldSetToNull_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple update mutation setting a non-null field to null",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						tags: [String!]!
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"tags": ["a"]
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"tags": null
+				}`,
+				ExpectedError: "non-null field must be given a value. Field: tags",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
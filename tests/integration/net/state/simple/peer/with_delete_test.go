@@ -408,3 +408,76 @@ func TestP2PWithMultipleDocumentsWithUpdateAndDeleteBeforeConnectSingleDeleteWit
 
 	testUtils.ExecuteTestCase(t, test)
 }
+
+func TestP2PWithDelete_WithOnDeleteCascade_DeletesRelatedDocuments(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Book {
+						name: String
+						author: Author @relation(onDelete: CASCADE)
+					}
+					type Author {
+						name: String
+						published: [Book]
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				// Create John Grisham on all nodes
+				CollectionID: 1,
+				// bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed
+				Doc: `{
+					"name": "John Grisham"
+				}`,
+			},
+			testUtils.CreateDoc{
+				// Create Painted House on all nodes
+				CollectionID: 0,
+				Doc: `{
+					"name": "Painted House",
+					"author_id": "bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed"
+				}`,
+			},
+			testUtils.ConnectPeers{
+				SourceNodeID: 0,
+				TargetNodeID: 1,
+			},
+			testUtils.DeleteDoc{
+				NodeID:       immutable.Some(0),
+				CollectionID: 1,
+				DocID:        0,
+			},
+			testUtils.WaitForSync{},
+			testUtils.Request{
+				Request: `query {
+					Book {
+						name
+					}
+				}`,
+				Results: []map[string]any{},
+			},
+			testUtils.Request{
+				// The cascaded deletion of the book is only applied by the node that deleted the
+				// author, and shared with the other node, so each document has a single deleting
+				// composite commit on both nodes.
+				Request: `query {
+					commits(fieldId: "C", order: {height: ASC}) {
+						height
+					}
+				}`,
+				Results: []map[string]any{
+					{"height": int64(1)},
+					{"height": int64(1)},
+					{"height": int64(2)},
+					{"height": int64(2)},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,219 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package commits
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/db"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryCommitsWithTimestampFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Commits query with a filter on the commit timestamp",
+		DatabaseOptions: []db.Option{
+			db.WithCommitTimestamps(),
+		},
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"age":	21
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					commits(filter: {timestamp: {_gt: "2000-01-01T00:00:00Z"}}, fieldId: "C") {
+						height
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"height": int64(1),
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					commits(filter: {timestamp: {_lt: "2000-01-01T00:00:00Z"}}) {
+						height
+					}
+				}`,
+				Results: []map[string]any{},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryCommitsWithTimestampFilter_WithRecordedTime(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Commits query with a filter on the commit timestamp, relative to the time of an update",
+		DatabaseOptions: []db.Option{
+			db.WithCommitTimestamps(),
+		},
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"age":	21
+				}`,
+			},
+			testUtils.RecordTime{
+				Name: "beforeUpdate",
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age":	22
+				}`,
+			},
+			testUtils.Request{
+				Request: `query($beforeUpdate: DateTime) {
+					commits(filter: {timestamp: {_gt: $beforeUpdate}}) {
+						height
+						fieldName
+					}
+				}`,
+				Variables: map[string]any{
+					"beforeUpdate": testUtils.RecordedTime("beforeUpdate"),
+				},
+				Results: []map[string]any{
+					{
+						"height":    int64(2),
+						"fieldName": "age",
+					},
+					{
+						"height":    int64(2),
+						"fieldName": nil,
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query($beforeUpdate: DateTime) {
+					commits(filter: {timestamp: {_lt: $beforeUpdate}}) {
+						height
+					}
+				}`,
+				Variables: map[string]any{
+					"beforeUpdate": testUtils.RecordedTime("beforeUpdate"),
+				},
+				Results: []map[string]any{
+					{
+						"height": int64(1),
+					},
+					{
+						"height": int64(1),
+					},
+					{
+						"height": int64(1),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryCommitsWithTimestampFilter_WithoutTimestamps(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Commits query with a filter on the commit timestamp, without recorded timestamps",
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"age":	21
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					commits(filter: {timestamp: {_gt: "2000-01-01T00:00:00Z"}}) {
+						height
+					}
+				}`,
+				Results: []map[string]any{},
+			},
+			testUtils.Request{
+				Request: `query {
+					commits(filter: {timestamp: {_eq: null}}, fieldId: "C") {
+						cid
+						timestamp
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"cid":       "bafybeiedu23doqe2nagdbmkvfyuouajnfxo7ezy57vbv34dqewhwbfg45u",
+						"timestamp": nil,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryCommitsWithAuthorFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Commits query with a filter on the commit author",
+		DatabaseOptions: []db.Option{
+			db.WithCommitTimestamps(),
+			db.WithCommitAuthor("node-a"),
+		},
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"age":	21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age":	22
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					commits(filter: {author: {_eq: "node-a"}}, fieldId: "C", order: {height: ASC}) {
+						height
+						author
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"height": int64(1),
+						"author": "node-a",
+					},
+					{
+						"height": int64(2),
+						"author": "node-a",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					commits(filter: {author: {_eq: "node-b"}}) {
+						height
+					}
+				}`,
+				Results: []map[string]any{},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,259 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package commits
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/db"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+// newCommitSigningKey returns the signing key generated from the given seed, and its signer.
+//
+// The key is generated from a seed so that the CIDs of the commits it signs are deterministic.
+func newCommitSigningKey(seed byte) (crypto.PrivKey, string) {
+	key, _, err := crypto.GenerateEd25519Key(bytes.NewReader(bytes.Repeat([]byte{seed}, 32)))
+	if err != nil {
+		panic(err)
+	}
+	signer, err := peer.IDFromPrivateKey(key)
+	if err != nil {
+		panic(err)
+	}
+	return key, signer.String()
+}
+
+var (
+	commitSigningKey, commitSigner = newCommitSigningKey(1)
+	_, otherCommitSigner           = newCommitSigningKey(2)
+)
+
+func TestQueryCommitsWithSignature_WithoutSigningKey(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Commits query with signature, without a signing key",
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"age":	21
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					commits(fieldId: "C") {
+						signature
+						signer
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"signature": nil,
+						"signer":    nil,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryCommitsWithSignature_WithSigningKey(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Commits query with signature, with a signing key",
+		DatabaseOptions: []db.Option{
+			db.WithCommitSigningKey(commitSigningKey),
+		},
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"age":	21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age":	22
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					commits {
+						signature
+						signer
+					}
+				}`,
+				Asserter: testUtils.ResultAsserterFunc(func(t *testing.T, commits []map[string]any) (bool, string) {
+					assert.Len(t, commits, 5)
+					for _, commit := range commits {
+						assert.NotEmpty(t, commit["signature"])
+						assert.Equal(t, commitSigner, commit["signer"])
+					}
+					return true, ""
+				}),
+			},
+			testUtils.Request{
+				Request: `query($signer: String) {
+					commits(filter: {signer: {_eq: $signer}}, fieldId: "C", order: {height: ASC}) {
+						height
+					}
+				}`,
+				Variables: map[string]any{
+					"signer": commitSigner,
+				},
+				Results: []map[string]any{
+					{
+						"height": int64(1),
+					},
+					{
+						"height": int64(2),
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query($signer: String) {
+					commits(filter: {signer: {_eq: $signer}}) {
+						height
+					}
+				}`,
+				Variables: map[string]any{
+					"signer": otherCommitSigner,
+				},
+				Results: []map[string]any{},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryCommitsWithSignature_WithTrustedSigner_CanFetchVersion(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Query of a version signed by a trusted signer",
+		DatabaseOptions: []db.Option{
+			db.WithCommitSigningKey(commitSigningKey),
+			db.WithSignaturePolicy(core.SignaturePolicy{TrustedSigners: []string{commitSigner}}),
+		},
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"age":	21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age":	22
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(
+						docID: "bae-f54b9689-e06e-5e3a-89b3-f3aee8e64ca7",
+						cid: "bafybeihxtsdmzdask7t645sjpm2qszhrldpzi73s3ngdw6x7tptc2vbnb4"
+					) {
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"age": int64(21),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryCommitsWithSignature_WithUntrustedSigner_CannotFetchVersion(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Query of a version signed by an untrusted signer",
+		DatabaseOptions: []db.Option{
+			db.WithCommitSigningKey(commitSigningKey),
+			db.WithSignaturePolicy(core.SignaturePolicy{TrustedSigners: []string{otherCommitSigner}}),
+		},
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"age":	21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age":	22
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(
+						docID: "bae-f54b9689-e06e-5e3a-89b3-f3aee8e64ca7",
+						cid: "bafybeihxtsdmzdask7t645sjpm2qszhrldpzi73s3ngdw6x7tptc2vbnb4"
+					) {
+						age
+					}
+				}`,
+				ExpectedError: "commit is not signed by a trusted signer",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryCommitsWithSignature_WithRequiredSignatureAndUnsignedCommits_CannotFetchVersion(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Query of an unsigned version, with a policy requiring signatures",
+		DatabaseOptions: []db.Option{
+			db.WithSignaturePolicy(core.SignaturePolicy{RequireSignature: true}),
+		},
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"age":	21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age":	22
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(
+						docID: "bae-f54b9689-e06e-5e3a-89b3-f3aee8e64ca7",
+						cid: "bafybeiedu23doqe2nagdbmkvfyuouajnfxo7ezy57vbv34dqewhwbfg45u"
+					) {
+						age
+					}
+				}`,
+				ExpectedError: "commit is not signed",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,232 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package diff
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryDiff(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple diff query between two versions of a document",
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"age":	21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age":	22
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"name":	"Johnny"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					_diff(
+						from: "bafybeiedu23doqe2nagdbmkvfyuouajnfxo7ezy57vbv34dqewhwbfg45u",
+						to: "bafybeif3p27nghoim7yblquuanf622zltklezmqmhczouvg4zr53rfmoz4"
+					) {
+						docID
+						from
+						to
+						commits
+						fields {
+							name
+							relation
+							before
+							after
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"docID": "bae-f54b9689-e06e-5e3a-89b3-f3aee8e64ca7",
+						"from":  "bafybeiedu23doqe2nagdbmkvfyuouajnfxo7ezy57vbv34dqewhwbfg45u",
+						"to":    "bafybeif3p27nghoim7yblquuanf622zltklezmqmhczouvg4zr53rfmoz4",
+						"commits": []string{
+							"bafybeibfwqf5szatmlyl3alru4nq3gnxaiyyb3ggqung2jwb4qnm6mejyu",
+							"bafybeif3p27nghoim7yblquuanf622zltklezmqmhczouvg4zr53rfmoz4",
+						},
+						"fields": []map[string]any{
+							{
+								"name":     "age",
+								"relation": nil,
+								"before":   "21",
+								"after":    "22",
+							},
+							{
+								"name":     "name",
+								"relation": nil,
+								"before":   `"John"`,
+								"after":    `"Johnny"`,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryDiff_WithReversedVersions(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple diff query from a newer to an older version of a document",
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"age":	21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age":	22
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					_diff(
+						from: "bafybeibfwqf5szatmlyl3alru4nq3gnxaiyyb3ggqung2jwb4qnm6mejyu",
+						to: "bafybeiedu23doqe2nagdbmkvfyuouajnfxo7ezy57vbv34dqewhwbfg45u"
+					) {
+						commits
+						fields {
+							name
+							before
+							after
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"commits": []string{},
+						"fields": []map[string]any{
+							{
+								"name":   "age",
+								"before": "22",
+								"after":  "21",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryDiff_WithSameVersion(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple diff query between a version and itself",
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"age":	21
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					_diff(
+						from: "bafybeiedu23doqe2nagdbmkvfyuouajnfxo7ezy57vbv34dqewhwbfg45u",
+						to: "bafybeiedu23doqe2nagdbmkvfyuouajnfxo7ezy57vbv34dqewhwbfg45u"
+					) {
+						docID
+						commits
+						fields {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"docID":   "bae-f54b9689-e06e-5e3a-89b3-f3aee8e64ca7",
+						"commits": []string{},
+						"fields":  []map[string]any{},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryDiff_WithDelete(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple diff query across the deletion of a document",
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"age":	21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age":	22
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"name":	"Johnny"
+				}`,
+			},
+			testUtils.DeleteDoc{},
+			testUtils.Request{
+				Request: `query {
+					_diff(
+						from: "bafybeif3p27nghoim7yblquuanf622zltklezmqmhczouvg4zr53rfmoz4",
+						to: "bafybeidy5degwea7a7rz36ufjqpoosjsrvt7sxzth62lkolzpl36gnmffu"
+					) {
+						commits
+						fields {
+							name
+							before
+							after
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"commits": []string{
+							"bafybeidy5degwea7a7rz36ufjqpoosjsrvt7sxzth62lkolzpl36gnmffu",
+						},
+						"fields": []map[string]any{
+							{
+								"name":   "_deleted",
+								"before": "false",
+								"after":  "true",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
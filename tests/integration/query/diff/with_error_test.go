@@ -0,0 +1,101 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package diff
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryDiff_WithFieldCommit_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Diff query with a field commit cid",
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"age":	21
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					_diff(
+						from: "bafybeihfw5lufgs7ygv45to5rqvt3xkecjgikoccjyx6y2i7lnaclmrcjm",
+						to: "bafybeiedu23doqe2nagdbmkvfyuouajnfxo7ezy57vbv34dqewhwbfg45u"
+					) {
+						docID
+					}
+				}`,
+				ExpectedError: "the given CID is not a document commit of the collection",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryDiff_WithInvalidCid_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Diff query with an invalid cid",
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.Request{
+				Request: `query {
+					_diff(
+						from: "not-a-cid",
+						to: "bafybeiedu23doqe2nagdbmkvfyuouajnfxo7ezy57vbv34dqewhwbfg45u"
+					) {
+						docID
+					}
+				}`,
+				ExpectedError: "invalid cid",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryDiff_WithCommitsOfDifferentDocuments_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Diff query with commits of two different documents",
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"John",
+					"age":	21
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name":	"Fred",
+					"age":	21
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					_diff(
+						from: "bafybeifabxkvvytjsxaiuckr77e4qde2lbihsw6qvnjitohs64fk6nrmsy",
+						to: "bafybeiedu23doqe2nagdbmkvfyuouajnfxo7ezy57vbv34dqewhwbfg45u"
+					) {
+						docID
+					}
+				}`,
+				ExpectedError: "the given commits do not belong to the same document",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
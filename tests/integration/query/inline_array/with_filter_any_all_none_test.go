@@ -0,0 +1,269 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package inline_array
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryInlineStringArray_WithAnyFilter_ReturnsMatchingDocs(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple inline array, filtered by any element of string array",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: userCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Shahzad",
+					"preferredStrings": ["", "the previous", "the first", "empty string"]
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"preferredStrings": ["the last", "the next"]
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {preferredStrings: {_any: {_eq: "the first"}}}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Shahzad",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryInlineNillableStringArray_WithAnyLikeFilter_ReturnsMatchingDocs(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple inline array, filtered by any element of nillable string array",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: userCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Shahzad",
+					"pageHeaders": ["the first", null]
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"pageHeaders": [null, "the last"]
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {pageHeaders: {_any: {_like: "%last"}}}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryInlineIntArray_WithAllFilter_ReturnsMatchingDocs(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple inline array, filtered by all elements of int array",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: userCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Shahzad",
+					"favouriteIntegers": [51, 64, 100]
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"favouriteIntegers": [51, 30, 100]
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Fred",
+					"favouriteIntegers": []
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {favouriteIntegers: {_all: {_gt: 50}}}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Fred",
+					},
+					{
+						"name": "Shahzad",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryInlineNillableIntArray_WithAllFilter_ExcludesNilElements(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple inline array, filtered by all elements of nillable int array",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: userCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Shahzad",
+					"testScores": [51, 64]
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"testScores": [51, null]
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {testScores: {_all: {_ge: 51}}}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Shahzad",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryInlineFloatArray_WithNoneFilter_ReturnsMatchingDocs(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple inline array, filtered by no elements of float array",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: userCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Shahzad",
+					"favouriteFloats": [3.1425, 0.00000000001, 10]
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"favouriteFloats": [3.1425, 0.5]
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {favouriteFloats: {_none: {_ge: 10}}}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryInlineBoolArray_WithNotAnyFilter_ReturnsMatchingDocs(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple inline array, filtered by negated any element of bool array",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: userCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Shahzad",
+					"likedIndexes": [true, true]
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"likedIndexes": [true, false]
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {_not: {likedIndexes: {_any: {_eq: false}}}}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Shahzad",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryInlineIntArray_WithEqFilter_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple inline array, filtering by whole int array is not supported",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: userCollectionGQLSchema,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {favouriteIntegers: {_eq: 1}}) {
+						name
+					}
+				}`,
+				ExpectedError: "Argument \"filter\" has invalid value {favouriteIntegers: {_eq: 1}}.",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
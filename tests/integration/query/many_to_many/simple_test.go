@@ -0,0 +1,184 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package many_to_many
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryManyToMany_FromEitherSide(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Many-to-many relation query from either side",
+		Actions: []any{
+			testUtils.Request{
+				Request: `query {
+					Post(order: {name: ASC}) {
+						name
+						tags(order: {name: ASC}) {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Go CRDTs",
+						"tags": []map[string]any{
+							{
+								"name": "crdt",
+							},
+							{
+								"name": "go",
+							},
+						},
+					},
+					{
+						"name": "Go Generics",
+						"tags": []map[string]any{
+							{
+								"name": "go",
+							},
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Tag(order: {name: ASC}) {
+						name
+						posts(order: {name: ASC}) {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "crdt",
+						"posts": []map[string]any{
+							{
+								"name": "Go CRDTs",
+							},
+						},
+					},
+					{
+						"name": "go",
+						"posts": []map[string]any{
+							{
+								"name": "Go CRDTs",
+							},
+							{
+								"name": "Go Generics",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryManyToMany_WithLimitAndOffsetOnRelatedDocs(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Many-to-many relation query with limit and offset on the related documents",
+		Actions: []any{
+			testUtils.Request{
+				Request: `query {
+					Tag(filter: {name: {_eq: "go"}}) {
+						name
+						posts(order: {name: DESC}, limit: 1, offset: 1) {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "go",
+						"posts": []map[string]any{
+							{
+								"name": "Go CRDTs",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryManyToMany_LinkCollectionIsNotQueryable_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Many-to-many relation link collection is hidden from queries",
+		Actions: []any{
+			testUtils.Request{
+				Request: `query {
+					_post_tag {
+						linked
+					}
+				}`,
+				ExpectedError: `Cannot query field "_post_tag" on type "Query".`,
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryManyToMany_WithManyRelatedDocs_ReturnsAllOfThem(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Many-to-many relation query with related documents whose IDs are not in link order",
+		Actions: []any{
+			testUtils.Request{
+				Request: `mutation {
+					create_Post(input: {
+						name: "Go Tooling",
+						tags: {create: [{name: "a"}, {name: "b"}, {name: "c"}, {name: "d"}, {name: "e"}]}
+					}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Go Tooling",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Post(filter: {name: {_eq: "Go Tooling"}}) {
+						name
+						tags(order: {name: ASC}) {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Go Tooling",
+						"tags": []map[string]any{
+							{"name": "a"},
+							{"name": "b"},
+							{"name": "c"},
+							{"name": "d"},
+							{"name": "e"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
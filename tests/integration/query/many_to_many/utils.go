@@ -0,0 +1,86 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package many_to_many
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+// goTagID is the docID of the Tag document named "go" created by the test cases.
+const goTagID = "bae-6e81aae9-a754-5c98-90f1-fcfa50f92d85"
+
+func executeTestCase(t *testing.T, test testUtils.TestCase) {
+	testUtils.ExecuteTestCase(
+		t,
+		testUtils.TestCase{
+			Description: test.Description,
+			Actions: append(
+				[]any{
+					testUtils.SchemaUpdate{
+						Schema: `
+							type Post {
+								name: String
+								rating: Int
+								tags: [Tag]
+							}
+
+							type Tag {
+								name: String
+								posts: [Post]
+							}
+						`,
+					},
+					testUtils.CreateDoc{
+						CollectionID: 1,
+						Doc: `{
+							"name": "go"
+						}`,
+					},
+					testUtils.Request{
+						Request: `mutation {
+							create_Post(input: {
+								name: "Go CRDTs",
+								rating: 4,
+								tags: {create: [{name: "crdt"}], connect: ["` + goTagID + `"]}
+							}) {
+								name
+							}
+						}`,
+						Results: []map[string]any{
+							{
+								"name": "Go CRDTs",
+							},
+						},
+					},
+					testUtils.Request{
+						Request: `mutation {
+							create_Post(input: {
+								name: "Go Generics",
+								rating: 5,
+								tags: {connect: ["` + goTagID + `"]}
+							}) {
+								name
+							}
+						}`,
+						Results: []map[string]any{
+							{
+								"name": "Go Generics",
+							},
+						},
+					},
+				},
+				test.Actions...,
+			),
+		},
+	)
+}
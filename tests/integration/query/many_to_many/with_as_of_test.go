@@ -0,0 +1,66 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package many_to_many
+
+import (
+	"fmt"
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryManyToMany_WithAsOfAfterAllCommits(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Many-to-many relation query as of a time after all commits",
+		Actions: []any{
+			testUtils.Request{
+				Request: fmt.Sprintf(
+					`mutation {
+						update_Tag(docID: "%s", input: {name: "golang"}) {
+							name
+						}
+					}`,
+					goTagID,
+				),
+				Results: []map[string]any{
+					{
+						"name": "golang",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Post(asOf: "2200-01-01T00:00:00Z", filter: {tags: {name: {_eq: "crdt"}}}) {
+						name
+						tags(order: {name: ASC}) {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Go CRDTs",
+						"tags": []map[string]any{
+							{
+								"name": "crdt",
+							},
+							{
+								"name": "golang",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
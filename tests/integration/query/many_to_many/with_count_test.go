@@ -0,0 +1,67 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package many_to_many
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryManyToMany_WithCount(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Many-to-many relation query with count of related documents",
+		Actions: []any{
+			testUtils.Request{
+				Request: `query {
+					Tag(order: {name: ASC}) {
+						name
+						_count(posts: {})
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":   "crdt",
+						"_count": 1,
+					},
+					{
+						"name":   "go",
+						"_count": 2,
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryManyToMany_WithFilterOnCount(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Many-to-many relation query filtered on the count of related documents",
+		Actions: []any{
+			testUtils.Request{
+				Request: `query {
+					Post(filter: {tags: {_count: {_gt: 1}}}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Go CRDTs",
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package many_to_many
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryManyToMany_WithFilterOnRelatedDocs(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Many-to-many relation query filtered on the related documents",
+		Actions: []any{
+			testUtils.Request{
+				Request: `query {
+					Post(filter: {tags: {name: {_eq: "crdt"}}}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Go CRDTs",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Tag(filter: {posts: {rating: {_gt: 4}}}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "go",
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryManyToMany_WithFilterOnRelatedSubSelect(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Many-to-many relation query with a filtered related sub-select",
+		Actions: []any{
+			testUtils.Request{
+				Request: `query {
+					Tag(filter: {name: {_eq: "go"}}) {
+						name
+						posts(filter: {rating: {_lt: 5}}) {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "go",
+						"posts": []map[string]any{
+							{
+								"name": "Go CRDTs",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
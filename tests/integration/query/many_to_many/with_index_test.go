@@ -0,0 +1,76 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package many_to_many
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+type dataMap = map[string]any
+
+func TestQueryManyToMany_WithExplain_FetchesLinksThroughIndex(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Many to many query, finding the links of each document through the link collection index",
+		Actions: []any{
+			testUtils.ExplainRequest{
+				Request: `query @explain(type: execute) {
+					Tag {
+						name
+						posts {
+							name
+						}
+					}
+				}`,
+				ExpectedFullGraph: []dataMap{
+					{
+						"explain": dataMap{
+							"executionSuccess": true,
+							"sizeOfResult":     2,
+							"planExecutions":   uint64(3),
+							"selectTopNode": dataMap{
+								"selectNode": dataMap{
+									"iterations":    uint64(3),
+									"filterMatches": uint64(2),
+									"typeIndexJoin": dataMap{
+										"iterations": uint64(3),
+										"scanNode": dataMap{
+											"iterations":   uint64(3),
+											"docFetches":   uint64(2),
+											"fieldFetches": uint64(2),
+											"indexFetches": uint64(0),
+										},
+										// only the 3 links of the 2 tags are fetched, found through the index
+										"linkScanNode": dataMap{
+											"iterations":   uint64(5),
+											"docFetches":   uint64(3),
+											"fieldFetches": uint64(9),
+											"indexFetches": uint64(3),
+										},
+										"subTypeScanNode": dataMap{
+											"iterations":   uint64(5),
+											"docFetches":   uint64(3),
+											"fieldFetches": uint64(3),
+											"indexFetches": uint64(0),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
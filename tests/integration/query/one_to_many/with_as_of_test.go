@@ -0,0 +1,177 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package one_to_many
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryOneToManyWithAsOf_ReturnsDocumentsAsTheyWereAtTheGivenTime(t *testing.T) {
+	request := `query($asOf: DateTime) {
+		Author(asOf: $asOf, order: {name: ASC}) {
+			name
+			published(filter: {rating: {_gt: 2}}) {
+				name
+				rating
+			}
+		}
+	}`
+
+	test := testUtils.TestCase{
+		Description: "One-to-many query with asOf, returning the documents as they were at the given time",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Book {
+						name: String
+						rating: Int
+						author: Author
+					}
+
+					type Author {
+						name: String
+						published: [Book]
+					}
+				`,
+			},
+			testUtils.RecordTime{
+				Name: "beforeCreate",
+			},
+			testUtils.Request{
+				Request: `mutation {
+					create_Author(input: {name: "John Grisham", published: {create: [{name: "Painted House", rating: 1}]}}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John Grisham",
+					},
+				},
+			},
+			testUtils.RecordTime{
+				Name: "beforeUpdate",
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Book(input: {rating: 4}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Painted House",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `mutation {
+					create_Author(input: {name: "Cornelia Funke", published: {create: [{name: "Theif Lord", rating: 5}]}}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Cornelia Funke",
+					},
+				},
+			},
+			testUtils.RecordTime{
+				Name: "beforeDelete",
+			},
+			testUtils.Request{
+				Request: `mutation {
+					delete_Book(filter: {name: {_eq: "Painted House"}}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Painted House",
+					},
+				},
+			},
+			testUtils.RecordTime{
+				Name: "afterDelete",
+			},
+			testUtils.Request{
+				Request: request,
+				Variables: map[string]any{
+					"asOf": testUtils.RecordedTime("beforeCreate"),
+				},
+				Results: []map[string]any{},
+			},
+			testUtils.Request{
+				Request: request,
+				Variables: map[string]any{
+					"asOf": testUtils.RecordedTime("beforeUpdate"),
+				},
+				Results: []map[string]any{
+					{
+						"name":      "John Grisham",
+						"published": []map[string]any{},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: request,
+				Variables: map[string]any{
+					"asOf": testUtils.RecordedTime("beforeDelete"),
+				},
+				Results: []map[string]any{
+					{
+						"name": "Cornelia Funke",
+						"published": []map[string]any{
+							{
+								"name":   "Theif Lord",
+								"rating": int64(5),
+							},
+						},
+					},
+					{
+						"name": "John Grisham",
+						"published": []map[string]any{
+							{
+								"name":   "Painted House",
+								"rating": int64(4),
+							},
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: request,
+				Variables: map[string]any{
+					"asOf": testUtils.RecordedTime("afterDelete"),
+				},
+				Results: []map[string]any{
+					{
+						"name": "Cornelia Funke",
+						"published": []map[string]any{
+							{
+								"name":   "Theif Lord",
+								"rating": int64(5),
+							},
+						},
+					},
+					{
+						"name":      "John Grisham",
+						"published": []map[string]any{},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,405 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package one_to_many
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryOneToManyWithComputedFieldReferencingRelation(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-many query with computed field referencing a related field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Book {
+						name: String
+						author: Author
+						label: String @computed(expr: "name + ' by ' + author.name")
+					}
+
+					type Author {
+						name: String
+						published: [Book]
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name": "John Grisham"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Painted House",
+					"author_id": "bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Theif Lord"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Book(filter: {label: {_like: "%Grisham"}}) {
+						label
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"label": "Painted House by John Grisham",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Book(filter: {name: {_eq: "Theif Lord"}}) {
+						label
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"label": nil,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryOneToManyWithComputedFieldReferencingRelation_WithDeletedRelatedDoc(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-many query with computed field referencing a field of a deleted related document",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Book {
+						name: String
+						author: Author
+						label: String @computed(expr: "name + ' by ' + author.name")
+					}
+
+					type Author {
+						name: String
+						published: [Book]
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name": "John Grisham"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Painted House",
+					"author_id": "bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed"
+				}`,
+			},
+			testUtils.DeleteDoc{
+				CollectionID: 1,
+				DocID:        0,
+			},
+			testUtils.Request{
+				Request: `query {
+					Book {
+						label
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"label": nil,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryOneToManyWithComputedFieldReferencingRelation_WithUnsetRelatedField(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-many query with computed field referencing an unset field of a related document",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Book {
+						name: String
+						author: Author
+						label: String @computed(expr: "name + ' by ' + author.name")
+					}
+
+					type Author {
+						name: String
+						age: Int
+						published: [Book]
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"age": 65
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Painted House",
+					"author_id": "bae-c5ed7759-9881-503b-b541-0e1aedc1cfad"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Book {
+						label
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"label": nil,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryOneToManyWithComputedFieldReferencingRelation_WithManyRelatedDocs(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-many query with computed field referencing the fields of many related documents",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Book {
+						name: String
+						author: Author
+						label: String @computed(expr: "name + ' by ' + author.name")
+					}
+
+					type Author {
+						name: String
+						published: [Book]
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name": "John Grisham"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name": "Cornelia Funke"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Painted House",
+					"author_id": "bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "A Time for Mercy",
+					"author_id": "bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Theif Lord",
+					"author_id": "bae-b6ea52b8-a5a5-5127-b9c0-5df4243457a3"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Book(order: {name: ASC}) {
+						label
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"label": "A Time for Mercy by John Grisham",
+					},
+					{
+						"label": "Painted House by John Grisham",
+					},
+					{
+						"label": "Theif Lord by Cornelia Funke",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryOneToManyWithComputedFieldReferencingRelation_WithAsOf(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-many query as of a time with computed field referencing a related field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Book {
+						name: String
+						author: Author
+						label: String @computed(expr: "name + ' by ' + author.name")
+					}
+
+					type Author {
+						name: String
+						published: [Book]
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name": "John Grisham"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Painted House",
+					"author_id": "bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				CollectionID: 1,
+				DocID:        0,
+				Doc: `{
+					"name": "J. Grisham"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Book(asOf: "2200-01-01T00:00:00Z", filter: {label: {_like: "%Grisham"}}) {
+						label
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"label": "Painted House by J. Grisham",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Book(asOf: "2000-01-01T00:00:00Z") {
+						label
+					}
+				}`,
+				Results: []map[string]any{},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryOneToManyWithComputedFieldReferencingRelation_WithCid(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-many query of a document version with computed field referencing a related field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Book {
+						name: String
+						author: Author
+						label: String @computed(expr: "name + ' by ' + author.name")
+					}
+
+					type Author {
+						name: String
+						published: [Book]
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name": "John Grisham"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Painted House",
+					"author_id": "bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				CollectionID: 1,
+				DocID:        0,
+				Doc: `{
+					"name": "J. Grisham"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				CollectionID: 0,
+				DocID:        0,
+				Doc: `{
+					"name": "A Painted House"
+				}`,
+			},
+			testUtils.Request{
+				// The related document is resolved as it was when the version was applied.
+				Request: `query {
+					Book(
+						cid: "bafybeienms3wahwjn5ukql6y43gq3nre3tddw5olwgrzak6xlyferhwngq",
+						docID: "bae-22e0a1c2-d12b-5bfd-b039-0cf72f963991"
+					) {
+						label
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"label": "Painted House by John Grisham",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Book {
+						label
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"label": "A Painted House by J. Grisham",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
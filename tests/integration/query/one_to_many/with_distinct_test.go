@@ -0,0 +1,153 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package one_to_many
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryOneToMany_WithDistinctOnRelatedObject_ReturnsFirstOfEachRelation(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-many relation query from the many side, distinct on the related object",
+		Actions: append(
+			createBookAuthorDocs(),
+			testUtils.Request{
+				Request: `query {
+					Book(distinct: [author], order: {name: ASC}) {
+						name
+						author {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "A Time for Mercy",
+						"author": map[string]any{
+							"name": "John Grisham",
+						},
+					},
+					{
+						"name": "Theif Lord",
+						"author": map[string]any{
+							"name": "Cornelia Funke",
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryOneToMany_WithDistinctOnChildren_ReturnsDistinctChildren(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-many relation query from the one side, distinct on the children",
+		Actions: append(
+			createBookAuthorDocs(),
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "The Client",
+					"rating": 4.5,
+					"author_id": "bae-41598f0c-19bc-5da6-813b-e80f14a10df3"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Author(filter: {name: {_eq: "John Grisham"}}) {
+						name
+						published(distinct: [rating], order: {name: DESC}) {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John Grisham",
+						"published": []map[string]any{
+							{
+								"name": "The Client",
+							},
+							{
+								"name": "Painted House",
+							},
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryOneToMany_WithCountDistinctOnChildren_ReturnsDistinctCount(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-many relation query from the one side, count distinct on the children",
+		Actions: append(
+			createBookAuthorDocs(),
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "The Client",
+					"rating": 4.5,
+					"author_id": "bae-41598f0c-19bc-5da6-813b-e80f14a10df3"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Author(order: {name: ASC}) {
+						name
+						_count(published: {distinct: rating})
+						total: _count(published: {})
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":   "Cornelia Funke",
+						"_count": 1,
+						"total":  1,
+					},
+					{
+						"name":   "John Grisham",
+						"_count": 2,
+						"total":  3,
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryOneToMany_WithDistinctOnRelatedObjects_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-many relation query from the one side, distinct on the related objects",
+		Actions: append(
+			createBookAuthorDocs(),
+			testUtils.Request{
+				Request: `query {
+					Author(distinct: [published]) {
+						name
+					}
+				}`,
+				ExpectedError: "invalid field value to distinct",
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,94 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package one_to_many
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryOneToMany_WithCountFilterOnChildren_ReturnsParentsWithMatchingCount(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-many relation query from the one side, filtered by child count",
+		Actions: append(
+			createBookAuthorDocs(),
+			testUtils.Request{
+				Request: `query {
+					Author(filter: {published: {_count: {_gt: 1}}}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John Grisham",
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryOneToMany_WithCountFilterOnSelectedChildren_ReturnsParentsWithMatchingCount(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-many relation query from the one side, filtered by selected child count",
+		Actions: append(
+			createBookAuthorDocs(),
+			testUtils.Request{
+				Request: `query {
+					Author(filter: {published: {_count: {_lt: 2}}}) {
+						name
+						published {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Cornelia Funke",
+						"published": []map[string]any{
+							{
+								"name": "Theif Lord",
+							},
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryOneToMany_WithCountAndFieldFilterOnChildren_ReturnsParentsWithMatchingCount(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-many relation query from the one side, filtered by child count and child field",
+		Actions: append(
+			createBookAuthorDocs(),
+			testUtils.Request{
+				Request: `query {
+					Author(filter: {published: {_count: {_gt: 0}, rating: {_gt: 4.8}}}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John Grisham",
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,170 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package one_to_many
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func createBookAuthorDocs() []any {
+	return []any{
+		testUtils.SchemaUpdate{
+			Schema: bookAuthorGQLSchema,
+		},
+		testUtils.CreateDoc{
+			CollectionID: 0,
+			Doc: `{
+				"name": "Painted House",
+				"rating": 4.9,
+				"author_id": "bae-41598f0c-19bc-5da6-813b-e80f14a10df3"
+			}`,
+		},
+		testUtils.CreateDoc{
+			CollectionID: 0,
+			Doc: `{
+				"name": "A Time for Mercy",
+				"rating": 4.5,
+				"author_id": "bae-41598f0c-19bc-5da6-813b-e80f14a10df3"
+			}`,
+		},
+		testUtils.CreateDoc{
+			CollectionID: 0,
+			Doc: `{
+				"name": "Theif Lord",
+				"rating": 4.8,
+				"author_id": "bae-b769708d-f552-5c3d-a402-ccfd7ac7fb04"
+			}`,
+		},
+		testUtils.CreateDoc{
+			CollectionID: 1,
+			// bae-41598f0c-19bc-5da6-813b-e80f14a10df3
+			Doc: `{
+				"name": "John Grisham",
+				"age": 65,
+				"verified": true
+			}`,
+		},
+		testUtils.CreateDoc{
+			CollectionID: 1,
+			// bae-b769708d-f552-5c3d-a402-ccfd7ac7fb04
+			Doc: `{
+				"name": "Cornelia Funke",
+				"age": 62,
+				"verified": false
+			}`,
+		},
+	}
+}
+
+func TestQueryOneToMany_WithSomeFilterOnChild_ReturnsParentsWithMatchingChild(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-many relation query from the one side, filtered by some child",
+		Actions: append(
+			createBookAuthorDocs(),
+			testUtils.Request{
+				Request: `query {
+					Author(filter: {published: {_some: {rating: {_lt: 4.6}}}}) {
+						name
+						published {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John Grisham",
+						"published": []map[string]any{
+							{
+								"name": "Painted House",
+							},
+							{
+								"name": "A Time for Mercy",
+							},
+						},
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryOneToMany_WithSomeFilterOnChildNotSelected_ReturnsParentsWithMatchingChild(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-many relation query from the one side, filtered by some child not selected",
+		Actions: append(
+			createBookAuthorDocs(),
+			testUtils.Request{
+				Request: `query {
+					Author(filter: {published: {_some: {name: {_eq: "Theif Lord"}}}}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Cornelia Funke",
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryOneToMany_WithNotSomeFilterOnChild_ReturnsParentsWithoutMatchingChild(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-many relation query from the one side, filtered by negated some child",
+		Actions: append(
+			createBookAuthorDocs(),
+			testUtils.Request{
+				Request: `query {
+					Author(filter: {_not: {published: {_some: {rating: {_gt: 4.8}}}}}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Cornelia Funke",
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryOneToMany_WithSomeFilterAndParentFilter_ReturnsMatchingParents(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-many relation query from the one side, filtered by some child and parent",
+		Actions: append(
+			createBookAuthorDocs(),
+			testUtils.Request{
+				Request: `query {
+					Author(filter: {published: {_some: {rating: {_gt: 4.7}}}, age: {_lt: 63}}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Cornelia Funke",
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -767,7 +767,7 @@ func TestQueryOneToManyWithParentGroupByOnRelatedTypeWithIDSelectionFromSingleSi
 			},
 		},
 
-		ExpectedError: "Cannot query field \"published_id\" on type \"Author\". ",
+		ExpectedError: "Cannot query field \"published_id\" on type \"AuthorPageItem\". ",
 	}
 
 	executeTestCase(t, test)
@@ -187,7 +187,7 @@ func TestQueryOneToManyWithRelatedTypeIDFromSingleSide(t *testing.T) {
 			},
 		},
 
-		ExpectedError: "Cannot query field \"author_id\" on type \"Author\".",
+		ExpectedError: "Cannot query field \"author_id\" on type \"AuthorPageItem\".",
 	}
 
 	executeTestCase(t, test)
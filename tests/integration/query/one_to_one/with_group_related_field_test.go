@@ -0,0 +1,566 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package one_to_one
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryOneToOneWithGroupRelatedField(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-one relation query with group by related field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Book {
+						name: String
+						author: Author @primary
+					}
+
+					type Author {
+						name: String
+						country: String
+						published: Book
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				// bae-385c1ece-0e0a-5556-a63f-dee5bf722653
+				Doc: `{
+					"name": "John Grisham",
+					"country": "USA"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				// bae-9b34f8d0-7ecc-5cc5-bcdd-f96194e165fd
+				Doc: `{
+					"name": "Cornelia Funke",
+					"country": "Germany"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				// bae-52066005-5699-5c11-b906-27bfd789817d
+				Doc: `{
+					"name": "Stephen King",
+					"country": "USA"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Painted House",
+					"author_id": "bae-385c1ece-0e0a-5556-a63f-dee5bf722653"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Inkheart",
+					"author_id": "bae-9b34f8d0-7ecc-5cc5-bcdd-f96194e165fd"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "It",
+					"author_id": "bae-52066005-5699-5c11-b906-27bfd789817d"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Book(groupByKeys: [{field: "country", relation: ["author"]}]) {
+						author {
+							country
+						}
+						_group {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"author": map[string]any{
+							"country": "Germany",
+						},
+						"_group": []map[string]any{
+							{
+								"name": "Inkheart",
+							},
+						},
+					},
+					{
+						"author": map[string]any{
+							"country": "USA",
+						},
+						"_group": []map[string]any{
+							{
+								"name": "Painted House",
+							},
+							{
+								"name": "It",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryOneToOneWithGroupRelatedFieldWithoutRenderedRelation(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-one relation query with group by related field, related object not rendered",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Book {
+						name: String
+						author: Author @primary
+					}
+
+					type Author {
+						name: String
+						country: String
+						published: Book
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				// bae-385c1ece-0e0a-5556-a63f-dee5bf722653
+				Doc: `{
+					"name": "John Grisham",
+					"country": "USA"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				// bae-9b34f8d0-7ecc-5cc5-bcdd-f96194e165fd
+				Doc: `{
+					"name": "Cornelia Funke",
+					"country": "Germany"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				// bae-52066005-5699-5c11-b906-27bfd789817d
+				Doc: `{
+					"name": "Stephen King",
+					"country": "USA"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Painted House",
+					"author_id": "bae-385c1ece-0e0a-5556-a63f-dee5bf722653"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Inkheart",
+					"author_id": "bae-9b34f8d0-7ecc-5cc5-bcdd-f96194e165fd"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "It",
+					"author_id": "bae-52066005-5699-5c11-b906-27bfd789817d"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Book(groupByKeys: [{field: "country", relation: ["author"]}]) {
+						_count(_group: {})
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"_count": 1,
+					},
+					{
+						"_count": 2,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryOneToOneWithGroupRelatedFieldWithAliasAndRenderedGroupRelation(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-one relation query with group by related field, relation rendered within group",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Book {
+						name: String
+						author: Author @primary
+					}
+
+					type Author {
+						name: String
+						country: String
+						published: Book
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				// bae-385c1ece-0e0a-5556-a63f-dee5bf722653
+				Doc: `{
+					"name": "John Grisham",
+					"country": "USA"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				// bae-9b34f8d0-7ecc-5cc5-bcdd-f96194e165fd
+				Doc: `{
+					"name": "Cornelia Funke",
+					"country": "Germany"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				// bae-52066005-5699-5c11-b906-27bfd789817d
+				Doc: `{
+					"name": "Stephen King",
+					"country": "USA"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Painted House",
+					"author_id": "bae-385c1ece-0e0a-5556-a63f-dee5bf722653"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Inkheart",
+					"author_id": "bae-9b34f8d0-7ecc-5cc5-bcdd-f96194e165fd"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "It",
+					"author_id": "bae-52066005-5699-5c11-b906-27bfd789817d"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Book(groupByKeys: [{field: "country", relation: ["author"]}]) {
+						writer: author {
+							country
+						}
+						_count(_group: {})
+						_group {
+							name
+							author {
+								name
+							}
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"writer": map[string]any{
+							"country": "Germany",
+						},
+						"_count": 1,
+						"_group": []map[string]any{
+							{
+								"name": "Inkheart",
+								"author": map[string]any{
+									"name": "Cornelia Funke",
+								},
+							},
+						},
+					},
+					{
+						"writer": map[string]any{
+							"country": "USA",
+						},
+						"_count": 2,
+						"_group": []map[string]any{
+							{
+								"name": "Painted House",
+								"author": map[string]any{
+									"name": "John Grisham",
+								},
+							},
+							{
+								"name": "It",
+								"author": map[string]any{
+									"name": "Stephen King",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryOneToOneWithGroupTruncatedRelatedDateTime(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-one relation query with group by truncated related DateTime",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Book {
+						name: String
+						author__country: String
+						author: Author @primary
+					}
+
+					type Author {
+						name: String
+						country: String
+						born: DateTime
+						published: Book
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				// bae-078af329-e812-5c49-a1e4-6f0fb2f8de4d
+				Doc: `{
+					"name": "John Grisham",
+					"country": "USA",
+					"born": "1955-02-08T10:00:00Z"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				// bae-c7d2033d-cfe1-5347-b96f-db44b43f9f85
+				Doc: `{
+					"name": "Cornelia Funke",
+					"country": "Germany",
+					"born": "1958-12-10T10:00:00Z"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				// bae-3b8a3682-1a83-5b26-995c-6088df74224f
+				Doc: `{
+					"name": "Stephen King",
+					"country": "USA",
+					"born": "1955-02-21T10:00:00Z"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Painted House",
+					"author_id": "bae-078af329-e812-5c49-a1e4-6f0fb2f8de4d"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Inkheart",
+					"author_id": "bae-c7d2033d-cfe1-5347-b96f-db44b43f9f85"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "It",
+					"author_id": "bae-3b8a3682-1a83-5b26-995c-6088df74224f"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Book(groupByKeys: [{field: "born", relation: ["author"], truncate: MONTH}]) {
+						author {
+							born
+						}
+						_group {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"author": map[string]any{
+							"born": testUtils.MustParseTime("1955-02-01T00:00:00Z"),
+						},
+						"_group": []map[string]any{
+							{
+								"name": "It",
+							},
+							{
+								"name": "Painted House",
+							},
+						},
+					},
+					{
+						"author": map[string]any{
+							"born": testUtils.MustParseTime("1958-12-01T00:00:00Z"),
+						},
+						"_group": []map[string]any{
+							{
+								"name": "Inkheart",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryOneToOneWithGroupRelatedFieldAndFieldNamedAsIt(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-one relation query with group by related field and a field named as it",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Book {
+						name: String
+						author__country: String
+						author: Author @primary
+					}
+
+					type Author {
+						name: String
+						country: String
+						published: Book
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				// bae-385c1ece-0e0a-5556-a63f-dee5bf722653
+				Doc: `{
+					"name": "John Grisham",
+					"country": "USA"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				// bae-9b34f8d0-7ecc-5cc5-bcdd-f96194e165fd
+				Doc: `{
+					"name": "Cornelia Funke",
+					"country": "Germany"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Painted House",
+					"author__country": "USA",
+					"author_id": "bae-385c1ece-0e0a-5556-a63f-dee5bf722653"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Inkheart",
+					"author__country": "USA",
+					"author_id": "bae-9b34f8d0-7ecc-5cc5-bcdd-f96194e165fd"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Book(groupBy: [author__country], groupByKeys: [{field: "country", relation: ["author"]}]) {
+						author__country
+						author {
+							country
+						}
+						_group {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"author__country": "USA",
+						"author": map[string]any{
+							"country": "Germany",
+						},
+						"_group": []map[string]any{
+							{
+								"name": "Inkheart",
+							},
+						},
+					},
+					{
+						"author__country": "USA",
+						"author": map[string]any{
+							"country": "USA",
+						},
+						"_group": []map[string]any{
+							{
+								"name": "Painted House",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryOneToOneWithGroupFieldOfIndirectlyRelatedObject_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One-to-one relation query with group by field of an indirectly related object",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Book {
+						name: String
+						author: Author @primary
+					}
+
+					type Author {
+						name: String
+						published: Book
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Book(groupByKeys: [{field: "name", relation: ["author", "published"]}]) {
+						_group {
+							name
+						}
+					}
+				}`,
+				ExpectedError: "invalid field value to groupBy. Field: author.published.name",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
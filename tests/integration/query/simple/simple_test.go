@@ -119,7 +119,7 @@ func TestQuerySimpleWithUndefinedField(t *testing.T) {
 						ThisFieldDoesNotExists
 					}
 				}`,
-		ExpectedError: "Cannot query field \"ThisFieldDoesNotExists\" on type \"Users\".",
+		ExpectedError: "Cannot query field \"ThisFieldDoesNotExists\" on type \"UsersPageItem\".",
 	}
 
 	executeTestCase(t, test)
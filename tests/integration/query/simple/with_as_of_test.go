@@ -0,0 +1,256 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/db"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQuerySimple_WithAsOfBeforeAnyCommit_ReturnsNothing(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(asOf: "2000-01-01T00:00:00Z") {
+						name
+					}
+				}`,
+				Results: []map[string]any{},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithAsOfAfterAllCommits_ReturnsCurrentState(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Fred",
+					"age": 40
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"age": 22
+				}`,
+			},
+			testUtils.DeleteDoc{
+				DocID: 1,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(asOf: "2200-01-01T00:00:00Z", filter: {age: {_gt: 21}}) {
+						name
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"age":  int64(22),
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(asOf: "2200-01-01T00:00:00Z", showDeleted: true) {
+						name
+						_deleted
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":     "Fred",
+						"_deleted": true,
+					},
+					{
+						"name":     "John",
+						"_deleted": false,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithInvalidAsOf_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(asOf: "yesterday") {
+						name
+					}
+				}`,
+				ExpectedError: "Argument \"asOf\" has invalid value \"yesterday\".",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithAsOfCommittedClock_ReturnsStateAtCommitTimestamps(t *testing.T) {
+	test := testUtils.TestCase{
+		DatabaseOptions: []db.Option{
+			db.WithCommitTimestamps(),
+		},
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"age": 22
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(asOf: "2000-01-01T00:00:00Z", asOfClock: COMMITTED) {
+						name
+					}
+				}`,
+				Results: []map[string]any{},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(asOf: "2200-01-01T00:00:00Z", asOfClock: COMMITTED) {
+						name
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"age":  int64(22),
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(
+						asOf: "2200-01-01T00:00:00Z",
+						asOfClock: COMMITTED,
+						docID: "bae-f54b9689-e06e-5e3a-89b3-f3aee8e64ca7"
+					) {
+						name
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"age":  int64(22),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithAsOfCommittedClockWithoutTimestamps_ReturnsNothing(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(asOf: "2200-01-01T00:00:00Z", asOfClock: COMMITTED) {
+						name
+					}
+				}`,
+				Results: []map[string]any{},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(asOf: "2200-01-01T00:00:00Z", asOfClock: APPLIED) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,217 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+const computedSchema = `
+	type Orders {
+		firstName: String
+		lastName: String
+		price: Float
+		quantity: Int
+		fullName: String @computed(expr: "firstName + ' ' + lastName")
+		totalPrice: Float @computed(expr: "price * quantity")
+	}
+`
+
+func computedActions(request testUtils.Request) []any {
+	return []any{
+		testUtils.SchemaUpdate{
+			Schema: computedSchema,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"firstName": "John",
+				"lastName": "Grisham",
+				"price": 2.5,
+				"quantity": 4
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"firstName": "Islam",
+				"lastName": "Rahman",
+				"price": 12,
+				"quantity": 1
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"firstName": "Fred",
+				"price": 1.5
+			}`,
+		},
+		request,
+	}
+}
+
+func TestQuerySimpleWithComputedFields(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with computed fields",
+		Actions: computedActions(testUtils.Request{
+			Request: `query {
+				Orders {
+					fullName
+					totalPrice
+				}
+			}`,
+			Results: []map[string]any{
+				{
+					"fullName":   "Islam Rahman",
+					"totalPrice": float64(12),
+				},
+				{
+					"fullName":   "John Grisham",
+					"totalPrice": float64(10),
+				},
+				{
+					"fullName":   nil,
+					"totalPrice": nil,
+				},
+			},
+		}),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithComputedFieldFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with filter on computed field",
+		Actions: computedActions(testUtils.Request{
+			Request: `query {
+				Orders(filter: {totalPrice: {_lt: 11}, firstName: {_ne: "Fred"}}) {
+					firstName
+					totalPrice
+				}
+			}`,
+			Results: []map[string]any{
+				{
+					"firstName":  "John",
+					"totalPrice": float64(10),
+				},
+			},
+		}),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithComputedFieldFilterNotSelected(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with filter on computed field not in the selection",
+		Actions: computedActions(testUtils.Request{
+			Request: `query {
+				Orders(filter: {fullName: {_eq: "Islam Rahman"}}) {
+					quantity
+				}
+			}`,
+			Results: []map[string]any{
+				{
+					"quantity": int64(1),
+				},
+			},
+		}),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithComputedFieldOrder(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query ordered by computed field",
+		Actions: computedActions(testUtils.Request{
+			Request: `query {
+				Orders(order: {totalPrice: DESC}) {
+					firstName
+				}
+			}`,
+			Results: []map[string]any{
+				{
+					"firstName": "Islam",
+				},
+				{
+					"firstName": "John",
+				},
+				{
+					"firstName": "Fred",
+				},
+			},
+		}),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithComputedFieldCreate_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create with value for computed field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: computedSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"firstName": "John",
+					"fullName": "Johnny"
+				}`,
+				ExpectedError: "computed fields are read-only",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithComputedBigNumberFields(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with computed BigInt and Decimal fields",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Accounts {
+						balance: BigInt
+						rate: Decimal
+						doubled: BigInt @computed(expr: "balance * 2")
+						interest: Decimal @computed(expr: "balance * rate")
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"balance": 18446744073709551616,
+					"rate": "0.1"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Accounts {
+						doubled
+						interest
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"doubled":  "36893488147419103232",
+						"interest": "1844674407370955161.6",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
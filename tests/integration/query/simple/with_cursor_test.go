@@ -0,0 +1,501 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/sourcenetwork/defradb/planner/mapper"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+const (
+	bobDocID   = "bae-14997c9b-3537-540a-8ccb-0f025b80f1b1"
+	fredDocID  = "bae-2a171c5d-747e-54fb-ac94-34c0ec56cb34"
+	aliceDocID = "bae-32b125a8-f9dd-5eef-8c0b-cd57e66d83b4"
+	johnDocID  = "bae-52b9170d-b77a-5887-b877-cbdbb99b009f"
+)
+
+func newCursor(docID string, values ...any) string {
+	if values == nil {
+		values = []any{}
+	}
+	cursor, err := mapper.Cursor{Values: values, DocID: docID}.String()
+	if err != nil {
+		panic(err)
+	}
+	return cursor
+}
+
+func cursorTestActions(request testUtils.Request) []any {
+	return []any{
+		testUtils.SchemaUpdate{
+			Schema: userCollectionGQLSchema,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"Name": "John",
+				"Age": 21
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"Name": "Bob",
+				"Age": 32
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"Name": "Alice",
+				"Age": 19
+			}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{
+				"Name": "Fred",
+				"Age": 32
+			}`,
+		},
+		request,
+	}
+}
+
+func TestQuerySimpleWithCursor_FirstPage(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with cursor and page info, first page",
+		Actions: cursorTestActions(testUtils.Request{
+			Request: `query {
+				Users(order: {Age: ASC}, limit: 2) {
+					Name
+					_cursor
+					_pageInfo {
+						hasNextPage
+						hasPreviousPage
+						endCursor
+					}
+				}
+			}`,
+			Results: []map[string]any{
+				{
+					"Name":    "Alice",
+					"_cursor": newCursor(aliceDocID, 19),
+				},
+				{
+					"Name":    "John",
+					"_cursor": newCursor(johnDocID, 21),
+				},
+			},
+			Extensions: map[string]any{
+				"_pageInfo": map[string]any{
+					"hasNextPage":     true,
+					"hasPreviousPage": false,
+					"endCursor":       newCursor(johnDocID, 21),
+				},
+			},
+		}),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithCursor_After(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with after cursor",
+		Actions: cursorTestActions(testUtils.Request{
+			Request: `query($after: String) {
+				Users(order: {Age: ASC}, limit: 2, after: $after) {
+					Name
+					_pageInfo {
+						hasNextPage
+						hasPreviousPage
+						startCursor
+					}
+				}
+			}`,
+			Variables: map[string]any{
+				"after": newCursor(johnDocID, 21),
+			},
+			Results: []map[string]any{
+				{
+					"Name": "Bob",
+				},
+				{
+					"Name": "Fred",
+				},
+			},
+			Extensions: map[string]any{
+				"_pageInfo": map[string]any{
+					"hasNextPage":     false,
+					"hasPreviousPage": true,
+					"startCursor":     newCursor(bobDocID, 32),
+				},
+			},
+		}),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithCursor_AfterCursorWithEqualValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with after cursor of a document with an equal value to the next",
+		Actions: cursorTestActions(testUtils.Request{
+			Request: `query($after: String) {
+				Users(order: {Age: ASC}, after: $after) {
+					Name
+				}
+			}`,
+			Variables: map[string]any{
+				"after": newCursor(bobDocID, 32),
+			},
+			Results: []map[string]any{
+				{
+					"Name": "Fred",
+				},
+			},
+		}),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithCursor_Before(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with before cursor yields the documents immediately preceding it",
+		Actions: cursorTestActions(testUtils.Request{
+			Request: `query($before: String) {
+				Users(order: {Age: ASC}, limit: 1, before: $before) {
+					Name
+					_pageInfo {
+						hasNextPage
+						hasPreviousPage
+					}
+				}
+			}`,
+			Variables: map[string]any{
+				"before": newCursor(bobDocID, 32),
+			},
+			Results: []map[string]any{
+				{
+					"Name": "John",
+				},
+			},
+			Extensions: map[string]any{
+				"_pageInfo": map[string]any{
+					"hasNextPage":     true,
+					"hasPreviousPage": true,
+				},
+			},
+		}),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithCursor_BeforeWithOffset(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with before cursor and offset skips the documents immediately preceding it",
+		Actions: cursorTestActions(testUtils.Request{
+			Request: `query($before: String) {
+				Users(order: {Age: ASC}, limit: 2, offset: 1, before: $before) {
+					Name
+					_pageInfo {
+						hasNextPage
+						hasPreviousPage
+					}
+				}
+			}`,
+			Variables: map[string]any{
+				"before": newCursor(fredDocID, 32),
+			},
+			Results: []map[string]any{
+				{
+					"Name": "Alice",
+				},
+				{
+					"Name": "John",
+				},
+			},
+			Extensions: map[string]any{
+				"_pageInfo": map[string]any{
+					"hasNextPage":     true,
+					"hasPreviousPage": false,
+				},
+			},
+		}),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithCursor_AfterLastDocument_ReturnsPageInfo(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with after cursor of the last document returns the page info of the empty page",
+		Actions: cursorTestActions(testUtils.Request{
+			Request: `query($after: String) {
+				Users(order: {Age: ASC}, limit: 2, after: $after) {
+					Name
+					info: _pageInfo {
+						hasNextPage
+						hasPreviousPage
+						startCursor
+					}
+				}
+			}`,
+			Variables: map[string]any{
+				"after": newCursor(fredDocID, 32),
+			},
+			Results: []map[string]any{},
+			Extensions: map[string]any{
+				"info": map[string]any{
+					"hasNextPage":     false,
+					"hasPreviousPage": true,
+					"startCursor":     nil,
+				},
+			},
+		}),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithCursor_AfterAndBefore(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with after and before cursors",
+		Actions: cursorTestActions(testUtils.Request{
+			Request: `query($after: String, $before: String) {
+				Users(order: {Age: ASC}, after: $after, before: $before) {
+					Name
+				}
+			}`,
+			Variables: map[string]any{
+				"after":  newCursor(aliceDocID, 19),
+				"before": newCursor(fredDocID, 32),
+			},
+			Results: []map[string]any{
+				{
+					"Name": "John",
+				},
+				{
+					"Name": "Bob",
+				},
+			},
+		}),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithCursor_AfterWithOrderDesc(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with after cursor and descending order",
+		Actions: cursorTestActions(testUtils.Request{
+			Request: `query($after: String) {
+				Users(order: {Age: DESC}, after: $after) {
+					Name
+				}
+			}`,
+			Variables: map[string]any{
+				"after": newCursor(fredDocID, 32),
+			},
+			Results: []map[string]any{
+				{
+					"Name": "John",
+				},
+				{
+					"Name": "Alice",
+				},
+			},
+		}),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithCursor_AfterWithoutOrder(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with after cursor and no order, ordered by docID",
+		Actions: cursorTestActions(testUtils.Request{
+			Request: `query($after: String) {
+				Users(after: $after, limit: 1) {
+					Name
+					_cursor
+				}
+			}`,
+			Variables: map[string]any{
+				"after": newCursor(fredDocID),
+			},
+			Results: []map[string]any{
+				{
+					"Name":    "Alice",
+					"_cursor": newCursor(aliceDocID),
+				},
+			},
+		}),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithCursor_InvalidCursor_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with invalid cursor",
+		Actions: cursorTestActions(testUtils.Request{
+			Request: `query {
+				Users(after: "invalid") {
+					Name
+				}
+			}`,
+			ExpectedError: "invalid cursor. Cursor: invalid",
+		}),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithCursor_CursorOfDifferentOrder_Errors(t *testing.T) {
+	cursor := newCursor(fredDocID)
+	test := testUtils.TestCase{
+		Description: "Simple query with cursor yielded by a request of a different order",
+		Actions: cursorTestActions(testUtils.Request{
+			Request: `query($after: String) {
+				Users(order: {Age: ASC}, after: $after) {
+					Name
+				}
+			}`,
+			Variables: map[string]any{
+				"after": cursor,
+			},
+			ExpectedError: "invalid cursor. Cursor: " + cursor,
+		}),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithCursor_WithinGroup_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with cursor within _group",
+		Actions: cursorTestActions(testUtils.Request{
+			Request: `query {
+				Users(groupBy: [Age]) {
+					Age
+					_group {
+						Name
+						_cursor
+					}
+				}
+			}`,
+			// the cursor is only exposed by the documents of top-level selects
+			ExpectedError: "Cannot query field \"_cursor\" on type \"Users\".",
+		}),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithCursor_PageInfoWithinGroup_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with page info within _group",
+		Actions: cursorTestActions(testUtils.Request{
+			Request: `query {
+				Users(groupBy: [Age]) {
+					Age
+					_group {
+						Name
+						_pageInfo {
+							hasNextPage
+						}
+					}
+				}
+			}`,
+			// the page info is only exposed by the documents of top-level selects
+			ExpectedError: "Cannot query field \"_pageInfo\" on type \"Users\".",
+		}),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithCursor_WithGroupBy_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with cursor and groupBy",
+		Actions: cursorTestActions(testUtils.Request{
+			Request: `query($after: String) {
+				Users(groupBy: [Age], after: $after) {
+					Age
+				}
+			}`,
+			Variables: map[string]any{
+				"after": newCursor(fredDocID),
+			},
+			ExpectedError: "cursors cannot be used with groupBy",
+		}),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithCursor_AfterWithOrderOnBigInt(t *testing.T) {
+	bobBalance, _ := new(big.Int).SetString("-18446744073709551616", 10)
+
+	test := testUtils.TestCase{
+		Description: "Simple query with after cursor, ordered by a BigInt field beyond the range of Int",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						balance: BigInt
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"balance": 18446744073709551616
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Bob",
+					"balance": -18446744073709551616
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Alice",
+					"balance": 1
+				}`,
+			},
+			testUtils.Request{
+				Request: `query($after: String) {
+					Users(order: {balance: ASC}, limit: 1, after: $after) {
+						name
+						_cursor
+					}
+				}`,
+				Variables: map[string]any{
+					"after": newCursor("bae-61b5a154-163b-5e90-a47e-8bc5218d4237", bobBalance),
+				},
+				Results: []map[string]any{
+					{
+						"name":    "Alice",
+						"_cursor": newCursor("bae-e595556a-e1e6-531d-a82f-bdc1c63277d3", 1),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,277 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+var distinctDocs = map[int][]string{
+	0: {
+		`{
+			"Name": "John",
+			"Age": 32,
+			"Verified": true
+		}`,
+		`{
+			"Name": "Bob",
+			"Age": 32,
+			"Verified": false
+		}`,
+		`{
+			"Name": "Shahzad",
+			"Age": 19,
+			"Verified": true
+		}`,
+		`{
+			"Name": "Alice",
+			"Age": 32,
+			"Verified": true
+		}`,
+	},
+}
+
+func TestQuerySimpleWithDistinctOnField(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with distinct on a field",
+		Request: `query {
+					Users(distinct: [Age], order: {Name: ASC}) {
+						Name
+						Age
+					}
+				}`,
+		Docs: distinctDocs,
+		Results: []map[string]any{
+			{
+				"Name": "Alice",
+				"Age":  int64(32),
+			},
+			{
+				"Name": "Shahzad",
+				"Age":  int64(19),
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithDistinctOnMultipleFields(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with distinct on multiple fields",
+		Request: `query {
+					Users(distinct: [Age, Verified], order: {Name: DESC}) {
+						Name
+					}
+				}`,
+		Docs: distinctDocs,
+		Results: []map[string]any{
+			{
+				"Name": "Shahzad",
+			},
+			{
+				"Name": "John",
+			},
+			{
+				"Name": "Bob",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithDistinctOnUnrenderedFieldWithLimit(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with distinct on an unrendered field, and limit",
+		Request: `query {
+					Users(distinct: [Verified], order: {Name: ASC}, limit: 1, offset: 1) {
+						Name
+					}
+				}`,
+		Docs: distinctDocs,
+		Results: []map[string]any{
+			{
+				"Name": "Bob",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithGroupByAndCountDistinct(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with group by, and count distinct within the group",
+		Request: `query {
+					Users(groupBy: [Age], order: {Age: ASC}) {
+						Age
+						_count(_group: {distinct: Verified})
+						total: _count(_group: {})
+					}
+				}`,
+		Docs: distinctDocs,
+		Results: []map[string]any{
+			{
+				"Age":    int64(19),
+				"_count": 1,
+				"total":  1,
+			},
+			{
+				"Age":    int64(32),
+				"_count": 2,
+				"total":  3,
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithGroupByAndDistinctGroup(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with group by, and distinct within the group",
+		Request: `query {
+					Users(groupBy: [Age], filter: {Age: {_gt: 20}}) {
+						Age
+						_group(distinct: [Verified], order: {Name: ASC}) {
+							Name
+						}
+					}
+				}`,
+		Docs: distinctDocs,
+		Results: []map[string]any{
+			{
+				"Age": int64(32),
+				"_group": []map[string]any{
+					{
+						"Name": "Alice",
+					},
+					{
+						"Name": "Bob",
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithDistinctOnDecimalField(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with distinct on a Decimal field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						balance: Decimal
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"balance": "0.100000000000000000000000000001"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Bob",
+					"balance": "0.100000000000000000000000000001"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Alice",
+					"balance": "0.1"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(distinct: [balance], order: {name: ASC}) {
+						name
+						balance
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":    "Alice",
+						"balance": "0.1",
+					},
+					{
+						"name":    "Bob",
+						"balance": "0.100000000000000000000000000001",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithGroupByAndCountDistinctDecimal(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with group by and count distinct of a Decimal field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						verified: Boolean
+						balance: Decimal
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"verified": true,
+					"balance": "0.100000000000000000000000000001"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Bob",
+					"verified": true,
+					"balance": "0.100000000000000000000000000001"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Alice",
+					"verified": true,
+					"balance": "0.1"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(groupBy: [verified]) {
+						verified
+						_count(_group: {distinct: balance})
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"verified": true,
+						"_count":   2,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,129 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQuerySimple_WithBigIntEqualsFilterBeyondInt64_ReturnsExactMatch(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with basic filter (BigInt) beyond the range of Int",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						balance: BigInt
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"balance": 123456789012345678901234567890
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Bob",
+					"balance": "123456789012345678901234567891"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {balance: {_eq: 123456789012345678901234567890}}) {
+						name
+						balance
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":    "John",
+						"balance": "123456789012345678901234567890",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithBigIntGreaterThanFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with basic _gt filter (BigInt)",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						balance: BigInt
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"balance": 9223372036854775808
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Bob",
+					"balance": -9223372036854775809
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {balance: {_gt: 9223372036854775807}}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithBigIntFilterGivenFraction_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with BigInt filter given a fractional value",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						balance: BigInt
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {balance: {_eq: 1.5}}) {
+						name
+					}
+				}`,
+				ExpectedError: "Argument \"filter\" has invalid value {balance: {_eq: 1.5}}.",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
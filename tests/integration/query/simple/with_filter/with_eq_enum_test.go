@@ -0,0 +1,152 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQuerySimple_WithEnumEqualsFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with basic filter (enum)",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Status {
+						ACTIVE
+						INACTIVE
+					}
+
+					type Users {
+						name: String
+						status: Status
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"status": "ACTIVE"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Bob",
+					"status": "INACTIVE"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {status: {_eq: INACTIVE}}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Bob",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithEnumInFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with basic _in filter (enum)",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Status {
+						ACTIVE
+						INACTIVE
+						PENDING
+					}
+
+					type Users {
+						name: String
+						status: Status
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"status": "ACTIVE"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Bob",
+					"status": "INACTIVE"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Fred",
+					"status": "PENDING"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {status: {_in: [ACTIVE, PENDING]}}, order: {name: ASC}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Fred",
+					},
+					{
+						"name": "John",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithInvalidEnumFilterValue_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with filter value outside of enum",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Status {
+						ACTIVE
+						INACTIVE
+					}
+
+					type Users {
+						name: String
+						status: Status
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {status: {_eq: ARCHIVED}}) {
+						name
+					}
+				}`,
+				ExpectedError: "Argument \"filter\" has invalid value {status: {_eq: ARCHIVED}}",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
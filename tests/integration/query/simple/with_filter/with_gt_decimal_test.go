@@ -0,0 +1,105 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQuerySimple_WithDecimalGreaterThanFilter_ComparesExactly(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with basic _gt filter (Decimal) without loss of precision",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						balance: Decimal
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"balance": 0.30000000000000000001
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Bob",
+					"balance": "0.3"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {balance: {_gt: "0.3"}}) {
+						name
+						balance
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":    "John",
+						"balance": "0.30000000000000000001",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithDecimalEqualsFilterGivenInt(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with basic _eq filter (Decimal) given an Int value",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						balance: Decimal
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"balance": 10.00
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Bob",
+					"balance": 10.01
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {balance: {_eq: 10}}) {
+						name
+						balance
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":    "John",
+						"balance": "10",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,181 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQuerySimple_WithRegexStringFilter_MatchesExpression(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with basic regex-string filter",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						HeightM: Float
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Daenerys Stormborn of House Targaryen, the First of Her Name",
+					"HeightM": 1.65
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Viserys I Targaryen, King of the Andals",
+					"HeightM": 1.82
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Name: {_regex: "^Viserys I+ "}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Viserys I Targaryen, King of the Andals",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithRegexStringFilterCaseInsensitiveFlag_MatchesIgnoringCase(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with regex-string filter using the case-insensitive flag",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						HeightM: Float
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Daenerys Stormborn of House Targaryen, the First of Her Name",
+					"HeightM": 1.65
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Viserys I Targaryen, King of the Andals",
+					"HeightM": 1.82
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Name: {_regex: "(?i)house targaryen"}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Daenerys Stormborn of House Targaryen, the First of Her Name",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithNotRegexStringFilter_ExcludesMatches(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with basic nregex-string filter",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						HeightM: Float
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Daenerys Stormborn of House Targaryen, the First of Her Name",
+					"HeightM": 1.65
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Viserys I Targaryen, King of the Andals",
+					"HeightM": 1.82
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Name: {_nregex: "Name$"}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Viserys I Targaryen, King of the Andals",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithInvalidRegexStringFilter_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with an invalid regular expression",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						HeightM: Float
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Daenerys Stormborn of House Targaryen, the First of Her Name",
+					"HeightM": 1.65
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Viserys I Targaryen, King of the Andals",
+					"HeightM": 1.82
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Name: {_regex: "Viserys("}}) {
+						Name
+					}
+				}`,
+				ExpectedError: "invalid regular expression",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
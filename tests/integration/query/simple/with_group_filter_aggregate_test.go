@@ -0,0 +1,163 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+var groupFilterAggregateDocs = map[int][]string{
+	0: {
+		`{
+			"Name": "John",
+			"Age": 32
+		}`,
+		`{
+			"Name": "Bob",
+			"Age": 32
+		}`,
+		`{
+			"Name": "Shahzad",
+			"Age": 19
+		}`,
+		`{
+			"Name": "Alice",
+			"Age": 19
+		}`,
+		`{
+			"Name": "Carlo",
+			"Age": 55
+		}`,
+	},
+}
+
+func TestQuerySimpleWithGroupByNumberWithFilterOnCount(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with group by number, filtered by requested count",
+		Request: `query {
+					Users(groupBy: [Age], filter: {_count: {_gt: 1}}) {
+						Age
+						_count(_group: {})
+					}
+				}`,
+		Docs: groupFilterAggregateDocs,
+		Results: []map[string]any{
+			{
+				"Age":    int64(32),
+				"_count": 2,
+			},
+			{
+				"Age":    int64(19),
+				"_count": 2,
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithGroupByNumberWithFilterOnUnrequestedCount(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with group by number, filtered by unrequested count",
+		Request: `query {
+					Users(groupBy: [Age], filter: {_count: {_eq: 1}}) {
+						Age
+					}
+				}`,
+		Docs: groupFilterAggregateDocs,
+		Results: []map[string]any{
+			{
+				"Age": int64(55),
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithGroupByNumberWithFilterOnFieldAndCount(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with group by number, filtered by field and count",
+		Request: `query {
+					Users(groupBy: [Age], filter: {_and: [{Age: {_lt: 50}}, {_count: {_gt: 1}}]}) {
+						Age
+					}
+				}`,
+		Docs: groupFilterAggregateDocs,
+		Results: []map[string]any{
+			{
+				"Age": int64(32),
+			},
+			{
+				"Age": int64(19),
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithGroupByNumberWithFilterOnCountOrField(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with group by number, filtered by count or field",
+		Request: `query {
+					Users(groupBy: [Age], filter: {_or: [{Age: {_gt: 50}}, {_count: {_lt: 1}}]}) {
+						Age
+					}
+				}`,
+		Docs: groupFilterAggregateDocs,
+		Results: []map[string]any{
+			{
+				"Age": int64(55),
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithGroupByNumberWithFilterOnSum(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with group by string, filtered by requested sum",
+		Request: `query {
+					Users(groupBy: [Name], filter: {_sum: {_ge: 50}}) {
+						Name
+						_sum(_group: {field: Age})
+					}
+				}`,
+		Docs: groupFilterAggregateDocs,
+		Results: []map[string]any{
+			{
+				"Name": "Carlo",
+				"_sum": int64(55),
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithGroupByNumberWithFilterOnUnrequestedSum_Errors(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with group by number, filtered by unrequested sum",
+		Request: `query {
+					Users(groupBy: [Age], filter: {_sum: {_ge: 50}}) {
+						Age
+					}
+				}`,
+		Docs:          groupFilterAggregateDocs,
+		ExpectedError: "aggregates must be requested in order to be filtered upon",
+	}
+
+	executeTestCase(t, test)
+}
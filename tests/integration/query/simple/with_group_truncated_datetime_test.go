@@ -0,0 +1,178 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+var truncatedDateTimeDocs = map[int][]string{
+	0: {
+		`{
+			"Name": "John",
+			"CreatedAt": "2024-03-04T10:00:00Z"
+		}`,
+		`{
+			"Name": "Bob",
+			"CreatedAt": "2024-03-04T23:30:00-05:00"
+		}`,
+		`{
+			"Name": "Carlo",
+			"CreatedAt": "2024-03-10T12:00:00Z"
+		}`,
+		`{
+			"Name": "Alice",
+			"CreatedAt": "2024-04-01T00:00:00Z"
+		}`,
+	},
+}
+
+func TestQuerySimpleWithGroupByDateTimeTruncatedToDay(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with group by DateTime truncated to day",
+		Request: `query {
+					Users(groupByKeys: [{field: "CreatedAt", truncate: DAY}]) {
+						CreatedAt
+						_group {
+							Name
+						}
+					}
+				}`,
+		Docs: truncatedDateTimeDocs,
+		Results: []map[string]any{
+			{
+				"CreatedAt": testUtils.MustParseTime("2024-03-05T00:00:00Z"),
+				"_group": []map[string]any{
+					{
+						"Name": "Bob",
+					},
+				},
+			},
+			{
+				"CreatedAt": testUtils.MustParseTime("2024-04-01T00:00:00Z"),
+				"_group": []map[string]any{
+					{
+						"Name": "Alice",
+					},
+				},
+			},
+			{
+				"CreatedAt": testUtils.MustParseTime("2024-03-04T00:00:00Z"),
+				"_group": []map[string]any{
+					{
+						"Name": "John",
+					},
+				},
+			},
+			{
+				"CreatedAt": testUtils.MustParseTime("2024-03-10T00:00:00Z"),
+				"_group": []map[string]any{
+					{
+						"Name": "Carlo",
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithGroupByDateTimeTruncatedToWeek(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with group by DateTime truncated to week",
+		Request: `query {
+					Users(groupByKeys: [{field: "CreatedAt", truncate: WEEK}]) {
+						CreatedAt
+						_count(_group: {})
+						_group {
+							Name
+							CreatedAt
+						}
+					}
+				}`,
+		Docs: truncatedDateTimeDocs,
+		Results: []map[string]any{
+			{
+				"CreatedAt": testUtils.MustParseTime("2024-03-04T00:00:00Z"),
+				"_count":    3,
+				"_group": []map[string]any{
+					{
+						"Name":      "Bob",
+						"CreatedAt": testUtils.MustParseTime("2024-03-04T23:30:00-05:00"),
+					},
+					{
+						"Name":      "John",
+						"CreatedAt": testUtils.MustParseTime("2024-03-04T10:00:00Z"),
+					},
+					{
+						"Name":      "Carlo",
+						"CreatedAt": testUtils.MustParseTime("2024-03-10T12:00:00Z"),
+					},
+				},
+			},
+			{
+				"CreatedAt": testUtils.MustParseTime("2024-04-01T00:00:00Z"),
+				"_count":    1,
+				"_group": []map[string]any{
+					{
+						"Name":      "Alice",
+						"CreatedAt": testUtils.MustParseTime("2024-04-01T00:00:00Z"),
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithGroupByDateTimeTruncatedToMonth(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with group by DateTime truncated to month",
+		Request: `query {
+					Users(groupByKeys: [{field: "CreatedAt", truncate: MONTH}]) {
+						CreatedAt
+						_count(_group: {})
+					}
+				}`,
+		Docs: truncatedDateTimeDocs,
+		Results: []map[string]any{
+			{
+				"CreatedAt": testUtils.MustParseTime("2024-03-01T00:00:00Z"),
+				"_count":    3,
+			},
+			{
+				"CreatedAt": testUtils.MustParseTime("2024-04-01T00:00:00Z"),
+				"_count":    1,
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithGroupByTruncatedNonDateTime(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with group by truncated non-DateTime field",
+		Request: `query {
+					Users(groupByKeys: [{field: "Name", truncate: DAY}]) {
+						Name
+					}
+				}`,
+		Docs:          truncatedDateTimeDocs,
+		ExpectedError: "invalid field value to groupBy. Field: Name",
+	}
+
+	executeTestCase(t, test)
+}
@@ -0,0 +1,209 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQuerySimple_WithOrderAscOnBigInt_OrdersByValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query, order ascending by a BigInt field beyond the range of Int",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						balance: BigInt
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"balance": 18446744073709551616
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Bob",
+					"balance": -18446744073709551616
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Alice",
+					"balance": 1
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(order: {balance: ASC}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{"name": "Bob"},
+					{"name": "Alice"},
+					{"name": "John"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithOrderDescOnBigInt_OrdersByValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query, order descending by a BigInt field beyond the range of Int",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						balance: BigInt
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"balance": 18446744073709551616
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Bob",
+					"balance": -18446744073709551616
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Alice",
+					"balance": 1
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(order: {balance: DESC}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{"name": "John"},
+					{"name": "Alice"},
+					{"name": "Bob"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithOrderAscOnDecimal_OrdersByValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query, order ascending by a Decimal field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						balance: Decimal
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"balance": 0.3
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Bob",
+					"balance": 0.1
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Alice",
+					"balance": 0.2
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(order: {balance: ASC}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{"name": "Bob"},
+					{"name": "Alice"},
+					{"name": "John"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithOrderDescOnDecimal_OrdersByValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query, order descending by a Decimal field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						balance: Decimal
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"balance": 0.3
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Bob",
+					"balance": 0.1
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Alice",
+					"balance": 0.2
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(order: {balance: DESC}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{"name": "John"},
+					{"name": "Alice"},
+					{"name": "Bob"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,113 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQuerySimple_WithSumOfBigInt_SumsExactly(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query, sum of BigInt beyond the range of Int",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						balance: BigInt
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"balance": 9223372036854775807
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Bob",
+					"balance": 9223372036854775807
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Alice",
+					"balance": 1
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					_sum(Users: {field: balance})
+					_avg(Users: {field: balance})
+				}`,
+				Results: []map[string]any{
+					{
+						"_sum": "18446744073709551615",
+						"_avg": "6148914691236517205",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithSumOfDecimal_SumsExactly(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query, sum and average of Decimal without loss of precision",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						balance: Decimal
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"balance": 0.1
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Bob",
+					"balance": 0.2
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Alice",
+					"balance": 0.3
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					_sum(Users: {field: balance})
+					_avg(Users: {field: balance})
+				}`,
+				Results: []map[string]any{
+					{
+						"_sum": "0.6",
+						"_avg": "0.2",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,477 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"encoding/json"
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQuerySimpleWithVariables_InFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with variable in filter",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: userCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 21
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Bob",
+					"Age": 32
+				}`,
+			},
+			testUtils.Request{
+				Request: `query($age: Int) {
+					Users(filter: {Age: {_gt: $age}}) {
+						Name
+					}
+				}`,
+				Variables: map[string]any{
+					"age": 30,
+				},
+				Results: []map[string]any{
+					{
+						"Name": "Bob",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithVariables_FilterObject(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with variable as the whole filter",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: userCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 21
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Bob",
+					"Age": 32
+				}`,
+			},
+			testUtils.Request{
+				Request: `query($filter: UsersFilterArg) {
+					Users(filter: $filter) {
+						Name
+					}
+				}`,
+				Variables: map[string]any{
+					"filter": map[string]any{
+						"Name": map[string]any{
+							"_eq": "John",
+						},
+					},
+				},
+				Results: []map[string]any{
+					{
+						"Name": "John",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithVariables_InArguments(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with variables in limit and order arguments",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: userCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 21
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Bob",
+					"Age": 32
+				}`,
+			},
+			testUtils.Request{
+				Request: `query($limit: Int!, $direction: Ordering) {
+					Users(limit: $limit, order: {Age: $direction}) {
+						Name
+					}
+				}`,
+				Variables: map[string]any{
+					"limit":     1,
+					"direction": "DESC",
+				},
+				Results: []map[string]any{
+					{
+						"Name": "Bob",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithVariables_DefaultValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with variable default value",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: userCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 21
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Bob",
+					"Age": 32
+				}`,
+			},
+			testUtils.Request{
+				Request: `query($name: String = "John") {
+					Users(filter: {Name: {_eq: $name}}) {
+						Age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Age": int64(21),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithVariables_NullableNotProvided(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with nullable variable not provided",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: userCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 21
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Bob",
+					"Age": 32
+				}`,
+			},
+			testUtils.Request{
+				Request: `query($limit: Int) {
+					Users(limit: $limit) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Bob",
+					},
+					{
+						"Name": "John",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithVariables_RequiredNotProvided_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with required variable not provided",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: userCollectionGQLSchema,
+			},
+			testUtils.Request{
+				Request: `query($limit: Int!) {
+					Users(limit: $limit) {
+						Name
+					}
+				}`,
+				ExpectedError: "required variable was not provided. Name: limit",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithVariables_InvalidValue_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with variable value of the wrong type",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: userCollectionGQLSchema,
+			},
+			testUtils.Request{
+				Request: `query($limit: Int) {
+					Users(limit: $limit) {
+						Name
+					}
+				}`,
+				Variables: map[string]any{
+					"limit": "one",
+				},
+				ExpectedError: "Argument \"limit\" has invalid value \"one\"",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithOperationName(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with operation name selecting one of many operations",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: userCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 21
+				}`,
+			},
+			testUtils.Request{
+				Request: `
+					query GetNames {
+						Users {
+							Name
+						}
+					}
+					query GetAges {
+						Users {
+							Age
+						}
+					}
+				`,
+				OperationName: "GetAges",
+				Results: []map[string]any{
+					{
+						"Age": int64(21),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithOperationName_Unknown_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with unknown operation name",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: userCollectionGQLSchema,
+			},
+			testUtils.Request{
+				Request: `
+					query GetNames {
+						Users {
+							Name
+						}
+					}
+				`,
+				OperationName: "GetAges",
+				ExpectedError: "unknown operation name. Name: GetAges",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithoutOperationName_WithManyOperations_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query without operation name given many operations",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: userCollectionGQLSchema,
+			},
+			testUtils.Request{
+				Request: `
+					query GetNames {
+						Users {
+							Name
+						}
+					}
+					query GetAges {
+						Users {
+							Age
+						}
+					}
+				`,
+				ExpectedError: "an operation name must be given for requests with several operations",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithoutOperationName_WithSingleNamedOperation(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query without operation name given a single named operation",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: userCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 21
+				}`,
+			},
+			testUtils.Request{
+				Request: `
+					query GetNames {
+						Users {
+							Name
+						}
+					}
+				`,
+				Results: []map[string]any{
+					{
+						"Name": "John",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithVariables_InFragment(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with variable in fragment",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: userCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 21
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Bob",
+					"Age": 32
+				}`,
+			},
+			testUtils.Request{
+				Request: `query($age: Int) {
+					...UserNames
+				}
+				fragment UserNames on Query {
+					Users(filter: {Age: {_gt: $age}}) {
+						Name
+					}
+				}`,
+				Variables: map[string]any{
+					"age": 30,
+				},
+				Results: []map[string]any{
+					{
+						"Name": "Bob",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimpleWithVariables_WithBigIntValue_KeepsPrecision(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with big integer variable",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						balance: BigInt
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"balance": 123456789012345678901234567890
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Bob",
+					"balance": 123456789012345678901234567891
+				}`,
+			},
+			testUtils.Request{
+				Request: `query($balance: BigInt) {
+					Users(filter: {balance: {_eq: $balance}}) {
+						name
+					}
+				}`,
+				Variables: map[string]any{
+					"balance": json.Number("123456789012345678901234567891"),
+				},
+				Results: []map[string]any{
+					{
+						"name": "Bob",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
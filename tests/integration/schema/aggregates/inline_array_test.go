@@ -88,6 +88,12 @@ func TestSchemaAggregateInlineArrayCreatesUsersCount(t *testing.T) {
 										"type": map[string]any{
 											"name": "Users__CountSelector",
 											"inputFields": []any{
+												map[string]any{
+													"name": "distinct",
+													"type": map[string]any{
+														"name": "UsersFields",
+													},
+												},
 												map[string]any{
 													"name": "filter",
 													"type": map[string]any{
@@ -388,59 +394,94 @@ func TestSchemaAggregateInlineArrayCreatesUsersAverage(t *testing.T) {
 }
 */
 
-var aggregateGroupArg = map[string]any{
-	"name": "_group",
-	"type": map[string]any{
-		"name": "Users__CountSelector",
-		"inputFields": []any{
-			map[string]any{
-				"name": "filter",
-				"type": map[string]any{
-					"name": "UsersFilterArg",
-					"inputFields": []any{
-						map[string]any{
-							"name": "_and",
-							"type": map[string]any{
-								"name": nil,
+// aggregateGroupArg returns the expected `_group` aggregate argument of the Users collection,
+// for a schema with a single inline array field filtered by the given operator block.
+func aggregateGroupArg(favouritesOperatorBlock string) map[string]any {
+	return map[string]any{
+		"name": "_group",
+		"type": map[string]any{
+			"name": "Users__CountSelector",
+			"inputFields": []any{
+				map[string]any{
+					"name": "distinct",
+					"type": map[string]any{
+						"name":        "UsersFields",
+						"inputFields": nil,
+					},
+				},
+				map[string]any{
+					"name": "filter",
+					"type": map[string]any{
+						"name": "UsersFilterArg",
+						"inputFields": []any{
+							map[string]any{
+								"name": "Favourites",
+								"type": map[string]any{
+									"name": favouritesOperatorBlock,
+								},
 							},
-						},
-						map[string]any{
-							"name": "_docID",
-							"type": map[string]any{
-								"name": "IDOperatorBlock",
+							map[string]any{
+								"name": "_and",
+								"type": map[string]any{
+									"name": nil,
+								},
 							},
-						},
-						map[string]any{
-							"name": "_not",
-							"type": map[string]any{
-								"name": "UsersFilterArg",
+							map[string]any{
+								"name": "_avg",
+								"type": map[string]any{
+									"name": "FloatOperatorBlock",
+								},
 							},
-						},
-						map[string]any{
-							"name": "_or",
-							"type": map[string]any{
-								"name": nil,
+							map[string]any{
+								"name": "_count",
+								"type": map[string]any{
+									"name": "IntOperatorBlock",
+								},
+							},
+							map[string]any{
+								"name": "_docID",
+								"type": map[string]any{
+									"name": "IDOperatorBlock",
+								},
+							},
+							map[string]any{
+								"name": "_not",
+								"type": map[string]any{
+									"name": "UsersFilterArg",
+								},
+							},
+							map[string]any{
+								"name": "_or",
+								"type": map[string]any{
+									"name": nil,
+								},
+							},
+							map[string]any{
+								"name": "_sum",
+								"type": map[string]any{
+									"name": "FloatOperatorBlock",
+								},
 							},
 						},
 					},
 				},
-			},
-			map[string]any{
-				"name": "limit",
-				"type": map[string]any{
-					"name":        "Int",
-					"inputFields": nil,
+				map[string]any{
+					"name": "limit",
+					"type": map[string]any{
+						"name":        "Int",
+						"inputFields": nil,
+					},
 				},
-			},
-			map[string]any{
-				"name": "offset",
-				"type": map[string]any{
-					"name":        "Int",
-					"inputFields": nil,
+				map[string]any{
+					"name": "offset",
+					"type": map[string]any{
+						"name":        "Int",
+						"inputFields": nil,
+					},
 				},
 			},
 		},
-	},
+	}
 }
 
 var aggregateVersionArg = map[string]any{
@@ -578,7 +619,7 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableBooleanCountFilter(t *tes
 											},
 										},
 									},
-									aggregateGroupArg,
+									aggregateGroupArg("BooleanListOperatorBlock"),
 									aggregateVersionArg,
 								},
 							},
@@ -704,7 +745,7 @@ func TestSchemaAggregateInlineArrayCreatesUsersBooleanCountFilter(t *testing.T)
 											},
 										},
 									},
-									aggregateGroupArg,
+									aggregateGroupArg("NotNullBooleanListOperatorBlock"),
 									aggregateVersionArg,
 								},
 							},
@@ -854,7 +895,7 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableIntegerCountFilter(t *tes
 											},
 										},
 									},
-									aggregateGroupArg,
+									aggregateGroupArg("IntListOperatorBlock"),
 									aggregateVersionArg,
 								},
 							},
@@ -1004,7 +1045,7 @@ func TestSchemaAggregateInlineArrayCreatesUsersIntegerCountFilter(t *testing.T)
 											},
 										},
 									},
-									aggregateGroupArg,
+									aggregateGroupArg("NotNullIntListOperatorBlock"),
 									aggregateVersionArg,
 								},
 							},
@@ -1154,7 +1195,7 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableFloatCountFilter(t *testi
 											},
 										},
 									},
-									aggregateGroupArg,
+									aggregateGroupArg("FloatListOperatorBlock"),
 									aggregateVersionArg,
 								},
 							},
@@ -1304,7 +1345,7 @@ func TestSchemaAggregateInlineArrayCreatesUsersFloatCountFilter(t *testing.T) {
 											},
 										},
 									},
-									aggregateGroupArg,
+									aggregateGroupArg("NotNullFloatListOperatorBlock"),
 									aggregateVersionArg,
 								},
 							},
@@ -1386,6 +1427,12 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableStringCountFilter(t *test
 																	"name": "String",
 																},
 															},
+															map[string]any{
+																"name": "_ilike",
+																"type": map[string]any{
+																	"name": "String",
+																},
+															},
 															map[string]any{
 																"name": "_in",
 																"type": map[string]any{
@@ -1404,6 +1451,12 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableStringCountFilter(t *test
 																	"name": "String",
 																},
 															},
+															map[string]any{
+																"name": "_nilike",
+																"type": map[string]any{
+																	"name": "String",
+																},
+															},
 															map[string]any{
 																"name": "_nin",
 																"type": map[string]any{
@@ -1416,12 +1469,24 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableStringCountFilter(t *test
 																	"name": "String",
 																},
 															},
+															map[string]any{
+																"name": "_nregex",
+																"type": map[string]any{
+																	"name": "String",
+																},
+															},
 															map[string]any{
 																"name": "_or",
 																"type": map[string]any{
 																	"name": nil,
 																},
 															},
+															map[string]any{
+																"name": "_regex",
+																"type": map[string]any{
+																	"name": "String",
+																},
+															},
 														},
 													},
 												},
@@ -1442,7 +1507,7 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableStringCountFilter(t *test
 											},
 										},
 									},
-									aggregateGroupArg,
+									aggregateGroupArg("StringListOperatorBlock"),
 									aggregateVersionArg,
 								},
 							},
@@ -1524,6 +1589,12 @@ func TestSchemaAggregateInlineArrayCreatesUsersStringCountFilter(t *testing.T) {
 																	"name": "String",
 																},
 															},
+															map[string]any{
+																"name": "_ilike",
+																"type": map[string]any{
+																	"name": "String",
+																},
+															},
 															map[string]any{
 																"name": "_in",
 																"type": map[string]any{
@@ -1542,6 +1613,12 @@ func TestSchemaAggregateInlineArrayCreatesUsersStringCountFilter(t *testing.T) {
 																	"name": "String",
 																},
 															},
+															map[string]any{
+																"name": "_nilike",
+																"type": map[string]any{
+																	"name": "String",
+																},
+															},
 															map[string]any{
 																"name": "_nin",
 																"type": map[string]any{
@@ -1554,12 +1631,24 @@ func TestSchemaAggregateInlineArrayCreatesUsersStringCountFilter(t *testing.T) {
 																	"name": "String",
 																},
 															},
+															map[string]any{
+																"name": "_nregex",
+																"type": map[string]any{
+																	"name": "String",
+																},
+															},
 															map[string]any{
 																"name": "_or",
 																"type": map[string]any{
 																	"name": nil,
 																},
 															},
+															map[string]any{
+																"name": "_regex",
+																"type": map[string]any{
+																	"name": "String",
+																},
+															},
 														},
 													},
 												},
@@ -1580,7 +1669,7 @@ func TestSchemaAggregateInlineArrayCreatesUsersStringCountFilter(t *testing.T) {
 											},
 										},
 									},
-									aggregateGroupArg,
+									aggregateGroupArg("NotNullStringListOperatorBlock"),
 									aggregateVersionArg,
 								},
 							},
@@ -59,6 +59,12 @@ func TestSchemaAggregateSimpleCreatesUsersCount(t *testing.T) {
 										"type": map[string]any{
 											"name": "Users__CountSelector",
 											"inputFields": []any{
+												map[string]any{
+													"name": "distinct",
+													"type": map[string]any{
+														"name": "UsersFields",
+													},
+												},
 												map[string]any{
 													"name": "filter",
 													"type": map[string]any{
@@ -0,0 +1,89 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package schema
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestSchemaCreate_WithComputedFieldInvalidExpression_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						age: Int
+						nextAge: Int @computed(expr: "age +")
+					}
+				`,
+				ExpectedError: "invalid computed expression",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaCreate_WithComputedFieldUnknownReference_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						age: Int
+						nextAge: Int @computed(expr: "years + 1")
+					}
+				`,
+				ExpectedError: "invalid field reference in computed expression",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaCreate_WithComputedFieldUnsupportedKind_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						age: Int
+						isAdult: Boolean @computed(expr: "age")
+					}
+				`,
+				ExpectedError: "computed fields must be of kind Int, Float, BigInt, Decimal or String",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaCreate_WithComputedFieldMissingExpression_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						age: Int
+						nextAge: Int @computed
+					}
+				`,
+				ExpectedError: "missing expression",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
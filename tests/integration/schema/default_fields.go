@@ -70,6 +70,26 @@ var DefaultFields = concat(
 	aggregateFields,
 )
 
+// DefaultPageItemFields contains the list of fields every
+// document of a top-level select should have.
+var DefaultPageItemFields = concat(
+	DefaultFields,
+	fields{
+		cursorField,
+		pageInfoField,
+	},
+)
+
+// DefaultSubscriptionPayloadFields contains the list of fields every
+// document of a subscription should have.
+var DefaultSubscriptionPayloadFields = concat(
+	DefaultFields,
+	fields{
+		operationField,
+		changedFieldsField,
+	},
+)
+
 // DefaultViewObjFields contains the list of fields every
 // defra view-object should have.
 var DefaultViewObjFields = concat(
@@ -95,6 +115,38 @@ var deletedField = Field{
 	},
 }
 
+var cursorField = Field{
+	"name": "_cursor",
+	"type": map[string]any{
+		"kind": "SCALAR",
+		"name": "String",
+	},
+}
+
+var pageInfoField = Field{
+	"name": "_pageInfo",
+	"type": map[string]any{
+		"kind": "OBJECT",
+		"name": "PageInfo",
+	},
+}
+
+var operationField = Field{
+	"name": "_operation",
+	"type": map[string]any{
+		"kind": "ENUM",
+		"name": "SubscriptionOperation",
+	},
+}
+
+var changedFieldsField = Field{
+	"name": "_changedFields",
+	"type": map[string]any{
+		"kind": "LIST",
+		"name": nil,
+	},
+}
+
 var versionField = Field{
 	"name": "_version",
 	"type": map[string]any{
@@ -142,6 +194,21 @@ var cidArg = Field{
 		"inputFields": nil,
 	},
 }
+
+var asOfArg = Field{
+	"name": "asOf",
+	"type": map[string]any{
+		"name":        "DateTime",
+		"inputFields": nil,
+	},
+}
+var asOfClockArg = Field{
+	"name": request.AsOfClockArgName,
+	"type": map[string]any{
+		"name":        request.AsOfClockTypeName,
+		"inputFields": nil,
+	},
+}
 var docIDArg = Field{
 	"name": request.DocIDArgName,
 	"type": map[string]any{
@@ -180,6 +247,30 @@ var groupByArg = Field{
 	},
 }
 
+var groupByKeysArg = Field{
+	"name": "groupByKeys",
+	"type": map[string]any{
+		"name":        nil,
+		"inputFields": nil,
+		"ofType": map[string]any{
+			"kind": "NON_NULL",
+			"name": nil,
+		},
+	},
+}
+
+var distinctArg = Field{
+	"name": "distinct",
+	"type": map[string]any{
+		"name":        nil,
+		"inputFields": nil,
+		"ofType": map[string]any{
+			"kind": "NON_NULL",
+			"name": nil,
+		},
+	},
+}
+
 var limitArg = Field{
 	"name": "limit",
 	"type": map[string]any{
@@ -230,12 +321,15 @@ func buildFilterArg(objectName string, fields []argDef) Field {
 			"kind": "INPUT_OBJECT",
 			"name": filterArgName,
 		}),
+		makeInputObject("_avg", "FloatOperatorBlock", nil),
+		makeInputObject("_count", "IntOperatorBlock", nil),
 		makeInputObject("_docID", "IDOperatorBlock", nil),
 		makeInputObject("_not", filterArgName, nil),
 		makeInputObject("_or", nil, map[string]any{
 			"kind": "INPUT_OBJECT",
 			"name": filterArgName,
 		}),
+		makeInputObject("_sum", "FloatOperatorBlock", nil),
 	}
 
 	for _, field := range fields {
@@ -302,3 +396,21 @@ func makeInputObject(
 		},
 	}
 }
+
+var afterArg = Field{
+	"name": "after",
+	"type": map[string]any{
+		"name":        "String",
+		"inputFields": nil,
+		"ofType":      nil,
+	},
+}
+
+var beforeArg = Field{
+	"name": "before",
+	"type": map[string]any{
+		"name":        "String",
+		"inputFields": nil,
+		"ofType":      nil,
+	},
+}
@@ -75,6 +75,20 @@ func TestFilterForSimpleSchema(t *testing.T) {
 															},
 														},
 													},
+													map[string]any{
+														"name": "_avg",
+														"type": map[string]any{
+															"name":   "FloatOperatorBlock",
+															"ofType": nil,
+														},
+													},
+													map[string]any{
+														"name": "_count",
+														"type": map[string]any{
+															"name":   "IntOperatorBlock",
+															"ofType": nil,
+														},
+													},
 													map[string]any{
 														"name": "_docID",
 														"type": map[string]any{
@@ -98,6 +112,13 @@ func TestFilterForSimpleSchema(t *testing.T) {
 															},
 														},
 													},
+													map[string]any{
+														"name": "_sum",
+														"type": map[string]any{
+															"name":   "FloatOperatorBlock",
+															"ofType": nil,
+														},
+													},
 													map[string]any{
 														"name": "name",
 														"type": map[string]any{
@@ -132,12 +153,18 @@ var testFilterForSimpleSchemaArgProps = map[string]any{
 var defaultUserArgsWithoutFilter = trimFields(
 	fields{
 		cidArg,
+		asOfArg,
+		asOfClockArg,
 		docIDArg,
 		docIDsArg,
 		showDeletedArg,
 		groupByArg,
+		groupByKeysArg,
+		distinctArg,
 		limitArg,
 		offsetArg,
+		afterArg,
+		beforeArg,
 		buildOrderArg("Users", []argDef{
 			{
 				fieldName: "name",
@@ -213,6 +240,20 @@ func TestFilterForOneToOneSchema(t *testing.T) {
 															},
 														},
 													},
+													map[string]any{
+														"name": "_avg",
+														"type": map[string]any{
+															"name":   "FloatOperatorBlock",
+															"ofType": nil,
+														},
+													},
+													map[string]any{
+														"name": "_count",
+														"type": map[string]any{
+															"name":   "IntOperatorBlock",
+															"ofType": nil,
+														},
+													},
 													map[string]any{
 														"name": "_docID",
 														"type": map[string]any{
@@ -236,6 +277,13 @@ func TestFilterForOneToOneSchema(t *testing.T) {
 															},
 														},
 													},
+													map[string]any{
+														"name": "_sum",
+														"type": map[string]any{
+															"name":   "FloatOperatorBlock",
+															"ofType": nil,
+														},
+													},
 													map[string]any{
 														"name": "author",
 														"type": map[string]any{
@@ -284,12 +332,18 @@ var testFilterForOneToOneSchemaArgProps = map[string]any{
 var defaultBookArgsWithoutFilter = trimFields(
 	fields{
 		cidArg,
+		asOfArg,
+		asOfClockArg,
 		docIDArg,
 		docIDsArg,
 		showDeletedArg,
 		groupByArg,
+		groupByKeysArg,
+		distinctArg,
 		limitArg,
 		offsetArg,
+		afterArg,
+		beforeArg,
 		buildOrderArg("Book", []argDef{
 			{
 				fieldName: "author",
@@ -307,3 +361,101 @@ var defaultBookArgsWithoutFilter = trimFields(
 	},
 	testFilterForOneToOneSchemaArgProps,
 )
+
+func TestFilterForOneToManySchema_ListFilterHasSomeOperator(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Book {
+						name: String
+						author: Author
+					}
+
+					type Author {
+						name: String
+						published: [Book]
+					}
+				`,
+			},
+			testUtils.IntrospectionRequest{
+				Request: `
+					query {
+						__type (name: "BookListFilterArg") {
+							name
+							inputFields {
+								name
+								type {
+									name
+								}
+							}
+						}
+					}
+				`,
+				ExpectedData: map[string]any{
+					"__type": map[string]any{
+						"name": "BookListFilterArg",
+						"inputFields": []any{
+							map[string]any{
+								"name": "_and",
+								"type": map[string]any{
+									"name": nil,
+								},
+							},
+							map[string]any{
+								"name": "_count",
+								"type": map[string]any{
+									"name": "IntOperatorBlock",
+								},
+							},
+							map[string]any{
+								"name": "_docID",
+								"type": map[string]any{
+									"name": "IDOperatorBlock",
+								},
+							},
+							map[string]any{
+								"name": "_not",
+								"type": map[string]any{
+									"name": "BookFilterArg",
+								},
+							},
+							map[string]any{
+								"name": "_or",
+								"type": map[string]any{
+									"name": nil,
+								},
+							},
+							map[string]any{
+								"name": "_some",
+								"type": map[string]any{
+									"name": "BookFilterArg",
+								},
+							},
+							map[string]any{
+								"name": "author",
+								"type": map[string]any{
+									"name": "AuthorFilterArg",
+								},
+							},
+							map[string]any{
+								"name": "author_id",
+								"type": map[string]any{
+									"name": "IDOperatorBlock",
+								},
+							},
+							map[string]any{
+								"name": "name",
+								"type": map[string]any{
+									"name": "StringOperatorBlock",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
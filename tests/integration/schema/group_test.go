@@ -140,3 +140,100 @@ func TestGroupByFieldForTheSingleSideInSchema(t *testing.T) {
 
 	testUtils.ExecuteTestCase(t, test)
 }
+
+func TestGroupByKeysInSchema(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test the groupByKeys argument input type is generated.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Book {
+						name: String
+						publishedAt: DateTime
+					}
+				`,
+			},
+			testUtils.IntrospectionRequest{
+				Request: `
+				{
+				  __type(name: "GroupByKey") {
+				    name
+				    kind
+				    inputFields {
+				      name
+				    }
+				  }
+				}
+				`,
+				ContainsData: map[string]any{
+					"__type": map[string]any{
+						"kind": "INPUT_OBJECT",
+						"name": "GroupByKey",
+						"inputFields": []any{
+							map[string]any{"name": "field"},
+							map[string]any{"name": "relation"},
+							map[string]any{"name": "truncate"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestGroupByKeysInSchema_WithFieldsNamedAsDerivedKeys_Succeeds(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test fields may be named as the keys derived from other fields.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Book {
+						name: String
+						publishedAt: DateTime
+						publishedAt_day: String
+						author__name: String
+						author: Author
+					}
+
+					type Author {
+						name: String
+						published: [Book]
+					}
+				`,
+			},
+			testUtils.IntrospectionRequest{
+				Request: `
+				{
+				  __type(name: "BookFields") {
+				    name
+				    enumValues {
+				      name
+				    }
+				  }
+				}
+				`,
+				ContainsData: map[string]any{
+					"__type": map[string]any{
+						"name": "BookFields",
+						"enumValues": []any{
+							map[string]any{"name": "author"},
+							map[string]any{"name": "author__name"},
+							map[string]any{"name": "author_id"},
+							map[string]any{"name": "_deleted"},
+							map[string]any{"name": "_group"},
+							map[string]any{"name": "_docID"},
+							map[string]any{"name": "_version"},
+							map[string]any{"name": "name"},
+							map[string]any{"name": "publishedAt"},
+							map[string]any{"name": "publishedAt_day"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -82,12 +82,16 @@ func TestInputTypeOfOrderFieldWhereSchemaHasManyRelationType(t *testing.T) {
 											buildFilterArg("group", []argDef{
 												{
 													fieldName: "members",
-													typeName:  "userFilterArg",
+													typeName:  "userListFilterArg",
 												},
 											}),
 											groupByArg,
+											groupByKeysArg,
+											distinctArg,
 											limitArg,
 											offsetArg,
+											afterArg,
+											beforeArg,
 										},
 										testInputTypeOfOrderFieldWhereSchemaHasRelationTypeArgProps,
 									),
@@ -283,8 +287,12 @@ var defaultGroupArgsWithoutOrder = trimFields(
 			},
 		}),
 		groupByArg,
+		groupByKeysArg,
+		distinctArg,
 		limitArg,
 		offsetArg,
+		afterArg,
+		beforeArg,
 	},
 	testInputTypeOfOrderFieldWhereSchemaHasRelationTypeArgProps,
 )
@@ -0,0 +1,305 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package documents
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestSchemaMigrationDocuments_NoDocuments(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test document migration, no documents",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.MigrateDocuments{
+				CollectionName: "Users",
+				ExpectedStatus: client.DocumentMigrationStatus{
+					CollectionName:  "Users",
+					SchemaVersionID: "bafkreig3zt63qt7bkji47etyu2sqtzroa3tcfdxgwqc3ka2ijy63refq3a",
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaMigrationDocuments_WithRenamedField(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test document migration, with renamed field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Islam"
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/name/Name", "value": "fullName" }
+					]
+				`,
+			},
+			testUtils.GetDocumentMigrationStatus{
+				CollectionName: "Users",
+				ExpectedStatus: client.DocumentMigrationStatus{
+					CollectionName:  "Users",
+					SchemaVersionID: "bafkreibyijrcbasdaqnfivggfmx4xehrq4p5hulnlr2hvlmxk6f7zwwwky",
+					Pending:         2,
+				},
+			},
+			testUtils.MigrateDocuments{
+				CollectionName: "Users",
+				ExpectedStatus: client.DocumentMigrationStatus{
+					CollectionName:  "Users",
+					SchemaVersionID: "bafkreibyijrcbasdaqnfivggfmx4xehrq4p5hulnlr2hvlmxk6f7zwwwky",
+					Migrated:        2,
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						fullName
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"fullName": "Islam",
+					},
+					{
+						"fullName": "John",
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaMigrationDocuments_WithBatchLimit(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test document migration, with batch limit",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Islam"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Fred"
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/name/Name", "value": "fullName" }
+					]
+				`,
+			},
+			testUtils.MigrateDocuments{
+				CollectionName: "Users",
+				MigrateDocumentsOptions: client.MigrateDocumentsOptions{
+					BatchSize:  2,
+					BatchLimit: 1,
+				},
+				ExpectedStatus: client.DocumentMigrationStatus{
+					CollectionName:  "Users",
+					SchemaVersionID: "bafkreibyijrcbasdaqnfivggfmx4xehrq4p5hulnlr2hvlmxk6f7zwwwky",
+					Migrated:        2,
+					Pending:         1,
+				},
+			},
+			testUtils.MigrateDocuments{
+				CollectionName: "Users",
+				MigrateDocumentsOptions: client.MigrateDocumentsOptions{
+					BatchSize:  2,
+					BatchLimit: 1,
+				},
+				ExpectedStatus: client.DocumentMigrationStatus{
+					CollectionName:  "Users",
+					SchemaVersionID: "bafkreibyijrcbasdaqnfivggfmx4xehrq4p5hulnlr2hvlmxk6f7zwwwky",
+					Migrated:        3,
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaMigrationDocuments_WithoutMigration(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test document migration, new version without a registered migration",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "email", "Kind": "String"} }
+					]
+				`,
+			},
+			testUtils.MigrateDocuments{
+				CollectionName: "Users",
+				ExpectedStatus: client.DocumentMigrationStatus{
+					CollectionName:  "Users",
+					SchemaVersionID: "bafkreiclwd4nrvczrzy7aj52olojyzvgm4ht6jpktwpxuqej5wk3ocxpqi",
+					Migrated:        1,
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						email
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":  "John",
+						"email": nil,
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaMigrationDocuments_WithDeletedDocument(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test document migration, deleted documents are not migrated",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Islam"
+				}`,
+			},
+			testUtils.DeleteDoc{
+				DocID: 0,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/name/Name", "value": "fullName" }
+					]
+				`,
+			},
+			testUtils.MigrateDocuments{
+				CollectionName: "Users",
+				ExpectedStatus: client.DocumentMigrationStatus{
+					CollectionName:  "Users",
+					SchemaVersionID: "bafkreibyijrcbasdaqnfivggfmx4xehrq4p5hulnlr2hvlmxk6f7zwwwky",
+					Migrated:        1,
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaMigrationDocuments_WithNegativeBatchSize_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test document migration, negative batch size",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.MigrateDocuments{
+				CollectionName: "Users",
+				MigrateDocumentsOptions: client.MigrateDocumentsOptions{
+					BatchSize: -1,
+				},
+				ExpectedError: "invalid document migration batch size",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaMigrationDocuments_UnknownCollection_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test document migration, unknown collection",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.MigrateDocuments{
+				CollectionName: "Books",
+				ExpectedError:  "datastore: key not found",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
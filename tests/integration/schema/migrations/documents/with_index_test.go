@@ -0,0 +1,148 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package documents
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestSchemaMigrationDocuments_WithIndexedRenamedField(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test document migration, with indexed renamed field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String @index
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Islam",
+					"age": 32
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/name/Name", "value": "fullName" }
+					]
+				`,
+			},
+			testUtils.MigrateDocuments{
+				CollectionName: "Users",
+				ExpectedStatus: client.DocumentMigrationStatus{
+					CollectionName:  "Users",
+					SchemaVersionID: "bafkreihdtjkzmddryranfm57mxefilh7lzbggl5tjz7ltyjascv3ey27ya",
+					Migrated:        2,
+				},
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"fullName": "Johnny"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {fullName: {_eq: "Johnny"}}) {
+						fullName
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"fullName": "Johnny",
+						"age":      int64(21),
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {fullName: {_eq: "Islam"}}) {
+						fullName
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"fullName": "Islam",
+						"age":      int64(32),
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaMigrationDocuments_WithIndexedCastField(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test document migration, with indexed field of changed kind",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int @index
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/age/Kind", "value": "String" }
+					]
+				`,
+			},
+			testUtils.MigrateDocuments{
+				CollectionName: "Users",
+				ExpectedStatus: client.DocumentMigrationStatus{
+					CollectionName:  "Users",
+					SchemaVersionID: "bafkreickisfyxtq4pmbbhcg7vf4xxbayhsdqrk7c6pmfrjvncj532llvkm",
+					Migrated:        1,
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {age: {_eq: "21"}}) {
+						name
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"age":  "21",
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
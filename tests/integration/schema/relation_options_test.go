@@ -0,0 +1,83 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package schema
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestSchemaCreate_WithRelationOptionsOnSecondarySide_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Book {
+						name: String
+						author: Author
+					}
+					type Author {
+						name: String
+						published: [Book] @relation(onDelete: CASCADE)
+					}
+				`,
+				ExpectedError: "relation options may only be set on the primary side of a relation",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaCreate_WithRelationCheckExistsOnSecondarySide_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						dog: Dog @relation(checkExists: true)
+					}
+					type Dog {
+						name: String
+						owner: User @primary
+					}
+				`,
+				ExpectedError: "relation options may only be set on the primary side of a relation",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaCreate_WithRelationInvalidOnDelete_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Book {
+						name: String
+						author: Author @relation(onDelete: DROP)
+					}
+					type Author {
+						name: String
+						published: [Book]
+					}
+				`,
+				ExpectedError: "relation with invalid argument",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -180,3 +180,93 @@ func TestSchemaRelation_GivenSelfReferemceRelationField_ReturnError(t *testing.T
 
 	testUtils.ExecuteTestCase(t, test)
 }
+
+func TestSchemaRelationManyToMany(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Dog {
+						name: String
+						owners: [User]
+					}
+					type User {
+						dogs: [Dog]
+					}
+				`,
+			},
+			testUtils.IntrospectionRequest{
+				Request: `
+					query {
+						__type (name: "Dog") {
+							name
+							fields {
+								name
+								type {
+									name
+									kind
+								}
+							}
+						}
+					}
+				`,
+				ExpectedData: map[string]any{
+					"__type": map[string]any{
+						"name": "Dog",
+						"fields": append(DefaultFields,
+							Field{
+								"name": "name",
+								"type": map[string]any{
+									"kind": "SCALAR",
+									"name": "String",
+								},
+							},
+							Field{
+								"name": "owners",
+								"type": map[string]any{
+									"kind": "LIST",
+									"name": nil,
+								},
+							},
+						).Tidy(),
+					},
+				},
+			},
+			testUtils.IntrospectionRequest{
+				Request: `
+					query {
+						__type (name: "_dog_user") {
+							name
+						}
+					}
+				`,
+				ExpectedData: map[string]any{
+					"__type": nil,
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaRelationManyToMany_GivenFieldsWithSameName_ReturnError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Dog {
+						name: String
+						friends: [User]
+					}
+					type User {
+						friends: [Dog]
+					}
+				`,
+				ExpectedError: "the fields of a many-to-many relation must have different names",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
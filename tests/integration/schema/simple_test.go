@@ -234,7 +234,7 @@ func TestSchemaSimpleCreatesSchemaGivenTypeWithStringField(t *testing.T) {
 	testUtils.ExecuteTestCase(t, test)
 }
 
-func TestSchemaSimpleErrorsGivenNonNullField(t *testing.T) {
+func TestSchemaSimpleCreatesSchemaGivenNonNullField(t *testing.T) {
 	test := testUtils.TestCase{
 		Actions: []any{
 			testUtils.SchemaUpdate{
@@ -243,6 +243,34 @@ func TestSchemaSimpleErrorsGivenNonNullField(t *testing.T) {
 						email: String!
 					}
 				`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						email
+					}
+				}`,
+				Results: []map[string]any{},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaSimpleErrorsGivenNonNullRelationField(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Dogs {
+						name: String
+						owner: Users!
+					}
+					type Users {
+						dogs: [Dogs]
+					}
+				`,
 				ExpectedError: "NonNull fields are not currently supported",
 			},
 		},
@@ -317,3 +345,85 @@ func TestSchemaSimpleCreatesSchemaGivenTypeWithBlobField(t *testing.T) {
 
 	testUtils.ExecuteTestCase(t, test)
 }
+
+func TestSchemaSimpleCreatesPageItemTypeWithPaginationFields(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.IntrospectionRequest{
+				Request: `
+					query {
+						__type (name: "UsersPageItem") {
+							name
+							fields {
+								name
+								type {
+								name
+								kind
+								}
+							}
+						}
+					}
+				`,
+				ExpectedData: map[string]any{
+					"__type": map[string]any{
+						"name": "UsersPageItem",
+						"fields": DefaultPageItemFields.Append(
+							Field{
+								"name": "name",
+								"type": map[string]any{
+									"kind": "SCALAR",
+									"name": "String",
+								},
+							},
+						).Tidy(),
+					},
+				},
+			},
+			testUtils.IntrospectionRequest{
+				Request: `
+					query {
+						__schema {
+							queryType {
+								fields {
+									name
+									type {
+										kind
+										ofType {
+											name
+										}
+									}
+								}
+							}
+						}
+					}
+				`,
+				ContainsData: map[string]any{
+					"__schema": map[string]any{
+						"queryType": map[string]any{
+							"fields": []any{
+								map[string]any{
+									"name": "Users",
+									"type": map[string]any{
+										"kind": "LIST",
+										"ofType": map[string]any{
+											"name": "UsersPageItem",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
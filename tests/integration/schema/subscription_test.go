@@ -0,0 +1,206 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package schema
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestSchemaSubscriptionCreatesFieldWithOperationsEnumArg(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.IntrospectionRequest{
+				Request: `
+					query {
+						__schema {
+							subscriptionType {
+								name
+								fields {
+									name
+									args {
+										name
+										type {
+											kind
+											ofType {
+												kind
+												ofType {
+													name
+													kind
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				`,
+				ContainsData: map[string]any{
+					"__schema": map[string]any{
+						"subscriptionType": map[string]any{
+							"name": "Subscription",
+							"fields": []any{
+								map[string]any{
+									"name": "Users",
+									"args": []any{
+										map[string]any{
+											"name": "filter",
+											"type": map[string]any{
+												"kind":   "INPUT_OBJECT",
+												"ofType": nil,
+											},
+										},
+										map[string]any{
+											"name": "operations",
+											"type": map[string]any{
+												"kind": "LIST",
+												"ofType": map[string]any{
+													"kind": "NON_NULL",
+													"ofType": map[string]any{
+														"name": "SubscriptionOperation",
+														"kind": "ENUM",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			testUtils.IntrospectionRequest{
+				Request: `
+					query {
+						__type (name: "SubscriptionOperation") {
+							name
+							kind
+							enumValues {
+								name
+							}
+						}
+					}
+				`,
+				ContainsData: map[string]any{
+					"__type": map[string]any{
+						"name": "SubscriptionOperation",
+						"kind": "ENUM",
+						"enumValues": []any{
+							map[string]any{
+								"name": "CREATE",
+							},
+							map[string]any{
+								"name": "DELETE",
+							},
+							map[string]any{
+								"name": "UPDATE",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaSubscriptionCreatesPayloadTypeWithEventFields(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.IntrospectionRequest{
+				Request: `
+					query {
+						__type (name: "UsersSubscriptionPayload") {
+							name
+							fields {
+								name
+								type {
+								name
+								kind
+								}
+							}
+						}
+					}
+				`,
+				ExpectedData: map[string]any{
+					"__type": map[string]any{
+						"name": "UsersSubscriptionPayload",
+						"fields": DefaultSubscriptionPayloadFields.Append(
+							Field{
+								"name": "name",
+								"type": map[string]any{
+									"kind": "SCALAR",
+									"name": "String",
+								},
+							},
+						).Tidy(),
+					},
+				},
+			},
+			testUtils.IntrospectionRequest{
+				Request: `
+					query {
+						__schema {
+							subscriptionType {
+								fields {
+									name
+									type {
+										kind
+										ofType {
+											name
+										}
+									}
+								}
+							}
+						}
+					}
+				`,
+				ContainsData: map[string]any{
+					"__schema": map[string]any{
+						"subscriptionType": map[string]any{
+							"fields": []any{
+								map[string]any{
+									"name": "Users",
+									"type": map[string]any{
+										"kind": "LIST",
+										"ofType": map[string]any{
+											"name": "UsersSubscriptionPayload",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
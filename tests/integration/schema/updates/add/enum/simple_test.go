@@ -0,0 +1,226 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package enum
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestSchemaUpdatesAddEnumValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, add enum value",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Status {
+						ACTIVE
+						INACTIVE
+					}
+
+					type Users {
+						name: String
+						status: Status
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Enums/0/Values/-", "value": "ARCHIVED" }
+					]
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"status": "ARCHIVED"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {status: {_eq: ARCHIVED}}) {
+						name
+						status
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":   "John",
+						"status": "ARCHIVED",
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesAddEnum(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, add enum and field using it",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Enums", "value": [{"Name": "Role", "Values": ["ADMIN", "USER"]}] },
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "role", "Kind": 22, "Schema": "Role"} }
+					]
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"role": "ADMIN"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						role
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"role": "ADMIN",
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesRemoveEnumValue_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, remove enum value",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Status {
+						ACTIVE
+						INACTIVE
+					}
+
+					type Users {
+						name: String
+						status: Status
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "remove", "path": "/Users/Enums/0/Values/1" }
+					]
+				`,
+				ExpectedError: "removing or reordering existing enum values is not supported. Name: Status",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesReplaceEnumValue_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, replace enum value",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Status {
+						ACTIVE
+						INACTIVE
+					}
+
+					type Users {
+						name: String
+						status: Status
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Enums/0/Values/0", "value": "ENABLED" }
+					]
+				`,
+				ExpectedError: "removing or reordering existing enum values is not supported. Name: Status",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesAddInvalidEnumValue_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, add invalid enum value",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Status {
+						ACTIVE
+					}
+
+					type Users {
+						status: Status
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Enums/0/Values/-", "value": "not valid" }
+					]
+				`,
+				ExpectedError: "invalid enum value name. Name: Status, Value: not valid",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesAddDuplicateEnumValue_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, add duplicate enum value",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Status {
+						ACTIVE
+					}
+
+					type Users {
+						status: Status
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Enums/0/Values/-", "value": "ACTIVE" }
+					]
+				`,
+				ExpectedError: "duplicate enum value. Name: Status, Value: ACTIVE",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
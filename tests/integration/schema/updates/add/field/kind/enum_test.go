@@ -0,0 +1,141 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package kind
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestSchemaUpdatesAddFieldKindEnum(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, add field with kind enum (22)",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Status {
+						ACTIVE
+						INACTIVE
+					}
+
+					type Users {
+						name: String
+						status: Status
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "foo", "Kind": 22, "Schema": "Status"} }
+					]
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "John",
+					"foo": "ACTIVE"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						foo
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"foo":  "ACTIVE",
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesAddFieldKindEnumSubstitution(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, add field with kind enum substitution",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Status {
+						ACTIVE
+						INACTIVE
+					}
+
+					type Users {
+						name: String
+						status: Status
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "foo", "Kind": "Status"} }
+					]
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "John",
+					"foo": "INACTIVE"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						foo
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"foo":  "INACTIVE",
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesAddFieldKindEnumWithoutEnum(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, add field with kind enum (22) referencing no enum",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "foo", "Kind": 22, "Schema": "Status"} }
+					]
+				`,
+				ExpectedError: "enum not found. Field: foo, Enum: Status",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
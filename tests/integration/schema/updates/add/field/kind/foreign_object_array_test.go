@@ -1037,7 +1037,7 @@ func TestSchemaUpdatesAddFieldKindForeignObjectArray_MissingPrimaryIDField_DoesN
 						foobar_id
 					}
 				}`,
-				ExpectedError: `Cannot query field "foobar_id" on type "Users"`,
+				ExpectedError: `Cannot query field "foobar_id" on type "UsersPageItem"`,
 			},
 		},
 	}
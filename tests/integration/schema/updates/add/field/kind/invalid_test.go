@@ -114,9 +114,9 @@ func TestSchemaUpdatesAddFieldKind15(t *testing.T) {
 
 // This test is currently the first unsupported value, if it becomes supported
 // please update this test to be the newly lowest unsupported value.
-func TestSchemaUpdatesAddFieldKind22(t *testing.T) {
+func TestSchemaUpdatesAddFieldKind25(t *testing.T) {
 	test := testUtils.TestCase{
-		Description: "Test schema update, add field with kind unsupported (22)",
+		Description: "Test schema update, add field with kind unsupported (25)",
 		Actions: []any{
 			testUtils.SchemaUpdate{
 				Schema: `
@@ -128,10 +128,10 @@ func TestSchemaUpdatesAddFieldKind22(t *testing.T) {
 			testUtils.SchemaPatch{
 				Patch: `
 					[
-						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "foo", "Kind": 22} }
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "foo", "Kind": 25} }
 					]
 				`,
-				ExpectedError: "no type found for given name. Type: 22",
+				ExpectedError: "no type found for given name. Type: 25",
 			},
 		},
 	}
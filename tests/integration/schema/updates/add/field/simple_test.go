@@ -109,7 +109,7 @@ func TestSchemaUpdates_AddFieldSimpleDoNotSetDefault_Errors(t *testing.T) {
 						email
 					}
 				}`,
-				ExpectedError: `Cannot query field "email" on type "Users".`,
+				ExpectedError: `Cannot query field "email" on type "UsersPageItem".`,
 			},
 		},
 	}
@@ -333,7 +333,7 @@ func TestSchemaUpdatesAddFieldMultipleInPatchPartialSuccess(t *testing.T) {
 						email
 					}
 				}`,
-				ExpectedError: "Cannot query field \"email\" on type \"Users\"",
+				ExpectedError: "Cannot query field \"email\" on type \"UsersPageItem\"",
 			},
 			testUtils.Request{
 				// Original schema is preserved
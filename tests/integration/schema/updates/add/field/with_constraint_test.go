@@ -0,0 +1,148 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package field
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestSchemaUpdatesAddFieldWithConstraintAndDefault(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, add field with constraint and default value",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{
+							"op": "add",
+							"path": "/Users/Fields/-",
+							"value": {
+								"Name": "age",
+								"Kind": "Int",
+								"IsNonNull": true,
+								"DefaultValue": "18",
+								"Constraint": {"Min": 0, "Max": 150}
+							}
+						}
+					]
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Shahzad",
+					"age": 151
+				}`,
+				ExpectedError: "value is greater than the maximum permitted by the field. Field: age",
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"age":  int64(18),
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesAddFieldWithInvalidDefault_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, add field with default value of the wrong kind",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "age", "Kind": "Int", "DefaultValue": "true"} }
+					]
+				`,
+				ExpectedError: "invalid default value for field",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesAddFieldWithPatternOnIntField_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, add int field with pattern constraint",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "age", "Kind": "Int", "Constraint": {"Pattern": "^1"}} }
+					]
+				`,
+				ExpectedError: "constraint is not supported for the field. Field: age, Constraint: pattern",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesChangeConstraintOfExistingField_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, change constraint of existing field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String @constraint(maxLength: 10)
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/name/Constraint/MaxLength", "value": 5 }
+					]
+				`,
+				ExpectedError: "mutating an existing field is not supported. ID: 1, ProposedName: name",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
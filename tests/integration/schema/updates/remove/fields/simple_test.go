@@ -16,7 +16,7 @@ import (
 	testUtils "github.com/sourcenetwork/defradb/tests/integration"
 )
 
-func TestSchemaUpdatesRemoveFieldErrors(t *testing.T) {
+func TestSchemaUpdatesRemoveField(t *testing.T) {
 	test := testUtils.TestCase{
 		Description: "Test schema update, remove field",
 		Actions: []any{
@@ -28,13 +28,116 @@ func TestSchemaUpdatesRemoveFieldErrors(t *testing.T) {
 					}
 				`,
 			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"email": "john@example.com"
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "remove", "path": "/Users/Fields/2" }
+					]
+				`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						email
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"email": "john@example.com",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+					}
+				}`,
+				ExpectedError: `Cannot query field "name" on type "UsersPageItem".`,
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesRemoveFieldThenAddFieldWithSameName(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, remove field then add field with same name",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						email: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"email": "john@example.com"
+				}`,
+			},
 			testUtils.SchemaPatch{
 				Patch: `
 					[
 						{ "op": "remove", "path": "/Users/Fields/2" }
 					]
 				`,
-				ExpectedError: "deleting an existing field is not supported. Name: name",
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "name", "Kind": "String"} }
+					]
+				`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						email
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						// The value of the removed field must not be read as the value
+						// of the new field.
+						"name":  nil,
+						"email": "john@example.com",
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesRemoveDocIDFieldErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, remove doc id field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						email: String
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "remove", "path": "/Users/Fields/0" }
+					]
+				`,
+				ExpectedError: "deleting an existing field is not supported. Name: _docID, ID: 0",
 			},
 		},
 	}
@@ -91,7 +194,7 @@ func TestSchemaUpdatesRemoveFieldNameErrors(t *testing.T) {
 	testUtils.ExecuteTestCase(t, test)
 }
 
-func TestSchemaUpdatesRemoveFieldIDErrors(t *testing.T) {
+func TestSchemaUpdatesRemoveFieldIDReplacesField(t *testing.T) {
 	test := testUtils.TestCase{
 		Description: "Test schema update, remove field id",
 		Actions: []any{
@@ -103,13 +206,30 @@ func TestSchemaUpdatesRemoveFieldIDErrors(t *testing.T) {
 					}
 				`,
 			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
 			testUtils.SchemaPatch{
+				// Without its ID the field is treated as a new field replacing the existing one.
 				Patch: `
 					[
 						{ "op": "remove", "path": "/Users/Fields/2/ID" }
 					]
 				`,
-				ExpectedError: "deleting an existing field is not supported. Name: name, ID: 2",
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": nil,
+					},
+				},
 			},
 		},
 	}
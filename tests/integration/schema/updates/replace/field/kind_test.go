@@ -0,0 +1,270 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package replace
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestSchemaUpdatesReplaceFieldKind_IntToString(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, replace field kind int to string",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/age/Kind", "value": "String" }
+					]
+				`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"age":  "21",
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesReplaceFieldKind_StringToInt(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, replace field kind string to int",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": "21"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Shahzad",
+					"age": "unknown"
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/age/Kind", "value": "Int" }
+					]
+				`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"age":  int64(21),
+					},
+					{
+						"name": "Shahzad",
+						// Values that cannot be cast to the new kind are set to nil.
+						"age": nil,
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {age: {_gt: 20}}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesReplaceFieldKind_IntToDecimal(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, replace field kind int to decimal",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						points: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"points": 21
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/points/Kind", "value": "Decimal" }
+					]
+				`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"name": "John Doe"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						points
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":   "John Doe",
+						"points": "21",
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesReplaceFieldKindAndName(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, replace field kind and name",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": "21.5"
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/age/Kind", "value": "Float" },
+						{ "op": "replace", "path": "/Users/Fields/age/Name", "value": "years" }
+					]
+				`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						years
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":  "John",
+						"years": float64(21.5),
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesReplaceFieldKind_ToArray_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, replace field kind with array kind",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/name/Kind", "value": "[String]" }
+					]
+				`,
+				ExpectedError: "mutating an existing field is not supported. ID: 1, ProposedName: name",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesReplaceFieldKind_WithPNCounter_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, replace field kind of pn counter field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						points: Int @crdt(type: "pncounter")
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/points/Kind", "value": "Float" }
+					]
+				`,
+				ExpectedError: "mutating an existing field is not supported. ID: 1, ProposedName: points",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,205 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package replace
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestSchemaUpdatesReplaceFieldName(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, replace field name",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						email: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"email": "john@example.com"
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/name/Name", "value": "fullName" }
+					]
+				`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						fullName
+						email
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"fullName": "John",
+						"email":    "john@example.com",
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesReplaceFieldName_Swap(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, swap field names",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						email: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"email": "john@example.com"
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/1/Name", "value": "name" },
+						{ "op": "replace", "path": "/Users/Fields/2/Name", "value": "email" }
+					]
+				`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						email
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":  "john@example.com",
+						"email": "John",
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesReplaceFieldName_WithUpdateAfterRename(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, replace field name with update after rename",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/name/Name", "value": "fullName" }
+					]
+				`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"fullName": "John Doe"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						fullName
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"fullName": "John Doe",
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesReplaceFieldName_DuplicateName_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, replace field name with name of existing field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						email: String
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/2/Name", "value": "email" }
+					]
+				`,
+				ExpectedError: "duplicate field. Name: email",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesReplaceRelationFieldName_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, replace relation field name",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						book: Book
+					}
+					type Book {
+						name: String
+						author: Users @primary
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Book/Fields/author/Name", "value": "writer" }
+					]
+				`,
+				ExpectedError: "mutating an existing field is not supported. ID: 1, ProposedName: writer",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -16,7 +16,7 @@ import (
 	testUtils "github.com/sourcenetwork/defradb/tests/integration"
 )
 
-func TestSchemaUpdatesReplaceFieldErrors(t *testing.T) {
+func TestSchemaUpdatesReplaceField(t *testing.T) {
 	test := testUtils.TestCase{
 		Description: "Test schema update, replace field",
 		Actions: []any{
@@ -28,13 +28,29 @@ func TestSchemaUpdatesReplaceFieldErrors(t *testing.T) {
 					}
 				`,
 			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
 			testUtils.SchemaPatch{
 				Patch: `
 					[
 						{ "op": "replace", "path": "/Users/Fields/2", "value": {"Name": "Fax", "Kind": 11} }
 					]
 				`,
-				ExpectedError: "deleting an existing field is not supported. Name: name, ID: 2",
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						Fax
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Fax": nil,
+					},
+				},
 			},
 		},
 	}
@@ -76,7 +76,7 @@ func TestSchemaUpdatesTestAddFieldBlockedByTest(t *testing.T) {
 						email
 					}
 				}`,
-				ExpectedError: "Cannot query field \"email\" on type \"Users\"",
+				ExpectedError: "Cannot query field \"email\" on type \"UsersPageItem\"",
 			},
 		},
 	}
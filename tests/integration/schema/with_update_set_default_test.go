@@ -102,7 +102,7 @@ func TestSchema_WithUpdateAndSetDefaultVersionToOriginal_NewFieldIsNotQueriable(
 					}
 				}`,
 				// As the email field did not exist at this schema version, it will return a gql error
-				ExpectedError: `Cannot query field "email" on type "Users".`,
+				ExpectedError: `Cannot query field "email" on type "UsersPageItem".`,
 			},
 		},
 	}
@@ -13,6 +13,7 @@ package tests
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -85,6 +86,9 @@ type state struct {
 	// Indexes, by index, by collection index, by node index.
 	indexes [][][]client.IndexDescription
 
+	// The times recorded by the RecordTime actions, by name.
+	times map[string]time.Time
+
 	// isBench indicates wether the test is currently being benchmarked.
 	isBench bool
 }
@@ -117,5 +121,6 @@ func newState(
 		collectionNames:          collectionNames,
 		documents:                [][]*client.Document{},
 		indexes:                  [][][]client.IndexDescription{},
+		times:                    map[string]time.Time{},
 	}
 }
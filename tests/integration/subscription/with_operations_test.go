@@ -0,0 +1,274 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package subscription
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestSubscriptionWithOperationAndChangedFields_OnCreate(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Subscription with operation and changed fields, and one user creation",
+		Actions: []any{
+			testUtils.SubscriptionRequest{
+				Request: `subscription {
+					User {
+						_docID
+						name
+						_operation
+						_changedFields
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"_docID":         "bae-0a24cf29-b2c2-5861-9d00-abd6250c475d",
+						"name":           "John",
+						"_operation":     "CREATE",
+						"_changedFields": []string{"age", "name", "points", "verified"},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `mutation {
+					create_User(input: {name: "John", age: 27, points: 42.1, verified: true}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+					},
+				},
+			},
+		},
+	}
+
+	execute(t, test)
+}
+
+func TestSubscriptionWithUpdateOperation_OnlyNotifiesOfUpdates(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Subscription to updates, with a user creation and update",
+		Actions: []any{
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "John",
+					"age": 27,
+					"verified": true,
+					"points": 42.1
+				}`,
+			},
+			testUtils.SubscriptionRequest{
+				Request: `subscription {
+					User(operations: [UPDATE]) {
+						_docID
+						name
+						points
+						_operation
+						_changedFields
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"_docID":         "bae-0a24cf29-b2c2-5861-9d00-abd6250c475d",
+						"name":           "John",
+						"points":         float64(45),
+						"_operation":     "UPDATE",
+						"_changedFields": []string{"points"},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `mutation {
+					create_User(input: {name: "Addo", age: 31, points: 50, verified: true}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Addo",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_User(filter: {name: {_eq: "John"}}, input: {points: 45}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+					},
+				},
+			},
+		},
+	}
+
+	execute(t, test)
+}
+
+func TestSubscriptionWithDeleteOperation_NotifiesOfDeletes(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Subscription to deletes, with a user update and deletion",
+		Actions: []any{
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "John",
+					"age": 27,
+					"verified": true,
+					"points": 42.1
+				}`,
+			},
+			testUtils.SubscriptionRequest{
+				Request: `subscription {
+					User(operations: DELETE) {
+						_docID
+						name
+						_deleted
+						_operation
+						_changedFields
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"_docID":         "bae-0a24cf29-b2c2-5861-9d00-abd6250c475d",
+						"name":           "John",
+						"_deleted":       true,
+						"_operation":     "DELETE",
+						"_changedFields": []string{},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_User(filter: {name: {_eq: "John"}}, input: {points: 45}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `mutation {
+					delete_User(docID: "bae-0a24cf29-b2c2-5861-9d00-abd6250c475d") {
+						_docID
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"_docID": "bae-0a24cf29-b2c2-5861-9d00-abd6250c475d",
+					},
+				},
+			},
+		},
+	}
+
+	execute(t, test)
+}
+
+func TestSubscriptionWithFilterAndDeleteOperation_OnlyNotifiesOfMatchingDeletes(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Subscription to deletes with filter, with user deletions in and outside of the filter",
+		Actions: []any{
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "John",
+					"age": 27
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Addo",
+					"age": 31
+				}`,
+			},
+			testUtils.SubscriptionRequest{
+				Request: `subscription {
+					User(operations: [DELETE], filter: {age: {_gt: 30}}) {
+						name
+						_operation
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":       "Addo",
+						"_operation": "DELETE",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `mutation {
+					delete_User(filter: {}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Addo",
+					},
+					{
+						"name": "John",
+					},
+				},
+			},
+		},
+	}
+
+	execute(t, test)
+}
+
+func TestSubscriptionWithInvalidOperation_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Subscription with an invalid operation",
+		Actions: []any{
+			testUtils.SubscriptionRequest{
+				Request: `subscription {
+					User(operations: [UPSERT]) {
+						name
+					}
+				}`,
+				ExpectedError: "Argument \"operations\" has invalid value [UPSERT].\nIn element #1: Expected type \"SubscriptionOperation\", found UPSERT.",
+			},
+		},
+	}
+
+	execute(t, test)
+}
+
+func TestQueryWithEventFields_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Query selecting the subscription event fields",
+		Actions: []any{
+			testUtils.Request{
+				Request: `query {
+					User {
+						name
+						_operation
+					}
+				}`,
+				// the event fields are only exposed by the documents of subscriptions
+				ExpectedError: "Cannot query field \"_operation\" on type \"UserPageItem\".",
+			},
+		},
+	}
+
+	execute(t, test)
+}
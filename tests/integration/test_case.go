@@ -17,6 +17,7 @@ import (
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/config"
+	"github.com/sourcenetwork/defradb/db"
 	"github.com/sourcenetwork/defradb/tests/gen"
 	"github.com/sourcenetwork/defradb/tests/predefined"
 )
@@ -37,6 +38,10 @@ type TestCase struct {
 	// This is to only be used in the very rare cases where we really do want behavioural
 	// differences between mutation types, or we need to temporarily document a bug.
 	SupportedMutationTypes immutable.Option[[]MutationType]
+
+	// DatabaseOptions are the options, in addition to the default ones, with which the
+	// databases of this test are created.
+	DatabaseOptions []db.Option
 }
 
 // SetupComplete is a flag to explicitly notify the change detector at which point
@@ -306,6 +311,89 @@ type GetIndexes struct {
 	ExpectedError string
 }
 
+// GetChanges will attempt to get the changes made to the documents of the given collection
+// using the collection api.
+type GetChanges struct {
+	// NodeID may hold the ID (index) of a node to get the changes from.
+	//
+	// If a value is not provided the changes will be retrieved from all nodes.
+	NodeID immutable.Option[int]
+
+	// The collection for which the changes should be retrieved.
+	CollectionID int
+
+	// The cursor of the change after which the changes should be returned.
+	//
+	// If empty, the changes are returned from the start of the change log.
+	Since string
+
+	// The maximum number of changes to return. Zero returns all of them.
+	Limit int
+
+	// The expected changes to be returned.
+	//
+	// The time of the changes is not compared as it depends on when they were applied.
+	ExpectedChanges []client.Change
+
+	// Any error expected from the action. Optional.
+	//
+	// String can be a partial, and the test will pass if an error is returned that
+	// contains this string.
+	ExpectedError string
+}
+
+// CompactHistory will attempt to apply the retention policy of the given collection to the
+// history of its documents using the collection api.
+type CompactHistory struct {
+	// NodeID may hold the ID (index) of a node to compact the history of.
+	//
+	// If a value is not provided the history will be compacted on all nodes.
+	NodeID immutable.Option[int]
+
+	// The collection whose history should be compacted.
+	CollectionID int
+
+	// Any error expected from the action. Optional.
+	//
+	// String can be a partial, and the test will pass if an error is returned that
+	// contains this string.
+	ExpectedError string
+}
+
+// PurgeDoc will attempt to purge the given deleted document and its history from the given
+// collection using the collection api.
+type PurgeDoc struct {
+	// NodeID may hold the ID (index) of a node to purge the document from.
+	//
+	// If a value is not provided the document will be purged from all nodes.
+	NodeID immutable.Option[int]
+
+	// The collection from which the document should be purged.
+	CollectionID int
+
+	// The index-identifier of the document within the collection.  This is based on
+	// the order in which it was created, not the ordering of the document within the
+	// database.
+	DocID int
+
+	// Any error expected from the action. Optional.
+	//
+	// String can be a partial, and the test will pass if an error is returned that
+	// contains this string.
+	ExpectedError string
+}
+
+// RecordTime records the current time under the given name, so that the following requests
+// can refer to it with a [RecordedTime] variable.
+type RecordTime struct {
+	// The name under which the time is recorded.
+	Name string
+}
+
+// RecordedTime is the value of a request variable holding the time recorded under the given
+// name by a previous [RecordTime] action, formatted as a DateTime.
+type RecordedTime string
+
 // ResultAsserter is an interface that can be implemented to provide custom result
 // assertions.
 type ResultAsserter interface {
@@ -350,9 +438,19 @@ type Request struct {
 	// The request to execute.
 	Request string
 
+	// OperationName is the name of the operation within the request to execute. Optional.
+	OperationName string
+
+	// Variables holds the values of the variables referenced by the request. Optional.
+	Variables map[string]any
+
 	// The expected (data) results of the issued request.
 	Results []map[string]any
 
+	// The expected extensions of the result of the issued request, such as the page info of
+	// a paginated request. Optional.
+	Extensions map[string]any
+
 	// Asserter is an optional custom result asserter.
 	Asserter ResultAsserter
 
@@ -0,0 +1,69 @@
+// Copyright 2024 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package ttl
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestTTL_CreatesIndexOnExpiryField(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "A collection with a ttl has an index on its expiry field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Sessions @ttl(field: "expiresAt") {
+						name: String
+						expiresAt: DateTime
+					}
+				`,
+			},
+			testUtils.GetIndexes{
+				CollectionID: 0,
+				ExpectedIndexes: []client.IndexDescription{
+					{
+						Name: "Sessions_expiresAt_ASC",
+						ID:   1,
+						Fields: []client.IndexedFieldDescription{
+							{
+								Name:      "expiresAt",
+								Direction: client.Ascending,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestTTL_WithNonDateTimeField_Error(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "A collection with a ttl on a field that is not a DateTime",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Sessions @ttl(field: "name") {
+						name: String
+					}
+				`,
+				ExpectedError: "ttl field must be a DateTime field of the type",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -272,6 +272,12 @@ func performAction(
 	case GetMigrations:
 		getMigrations(s, action)
 
+	case MigrateDocuments:
+		migrateDocuments(s, action)
+
+	case GetDocumentMigrationStatus:
+		getDocumentMigrationStatus(s, action)
+
 	case CreateDoc:
 		createDoc(s, action)
 
@@ -290,6 +296,18 @@ func performAction(
 	case GetIndexes:
 		getIndexes(s, action)
 
+	case GetChanges:
+		getChanges(s, action)
+
+	case CompactHistory:
+		compactHistory(s, action)
+
+	case PurgeDoc:
+		purgeDoc(s, action)
+
+	case RecordTime:
+		s.times[action.Name] = time.Now()
+
 	case BackupExport:
 		backupExport(s, action)
 
@@ -905,6 +923,90 @@ func getIndexes(
 	assertExpectedErrorRaised(s.t, s.testCase.Description, action.ExpectedError, expectedErrorRaised)
 }
 
+// getChanges gets the changes of the given collection and asserts that they match the
+// expected changes.
+func getChanges(
+	s *state,
+	action GetChanges,
+) {
+	if len(s.collections) == 0 {
+		return
+	}
+
+	var expectedErrorRaised bool
+	actionNodes := getNodes(action.NodeID, s.nodes)
+	for nodeID, collections := range getNodeCollections(action.NodeID, s.collections) {
+		err := withRetry(
+			actionNodes,
+			nodeID,
+			func() error {
+				actualChanges, err := collections[action.CollectionID].Changes(s.ctx, action.Since, action.Limit)
+				if err != nil {
+					return err
+				}
+
+				require.Len(s.t, actualChanges, len(action.ExpectedChanges), s.testCase.Description)
+				for i, change := range actualChanges {
+					assert.False(s.t, change.Time.IsZero(), s.testCase.Description)
+					change.Time = time.Time{}
+					assert.Equal(s.t, action.ExpectedChanges[i], change, s.testCase.Description)
+				}
+
+				return nil
+			},
+		)
+		expectedErrorRaised = expectedErrorRaised ||
+			AssertError(s.t, s.testCase.Description, err, action.ExpectedError)
+	}
+
+	assertExpectedErrorRaised(s.t, s.testCase.Description, action.ExpectedError, expectedErrorRaised)
+}
+
+// compactHistory applies the retention policy of the given collection to the history of its
+// documents using the collection api.
+func compactHistory(
+	s *state,
+	action CompactHistory,
+) {
+	var expectedErrorRaised bool
+	actionNodes := getNodes(action.NodeID, s.nodes)
+	for nodeID, collections := range getNodeCollections(action.NodeID, s.collections) {
+		err := withRetry(
+			actionNodes,
+			nodeID,
+			func() error {
+				return collections[action.CollectionID].CompactHistory(s.ctx)
+			},
+		)
+		expectedErrorRaised = AssertError(s.t, s.testCase.Description, err, action.ExpectedError)
+	}
+
+	assertExpectedErrorRaised(s.t, s.testCase.Description, action.ExpectedError, expectedErrorRaised)
+}
+
+// purgeDoc purges a deleted document and its history using the collection api.
+func purgeDoc(
+	s *state,
+	action PurgeDoc,
+) {
+	doc := s.documents[action.CollectionID][action.DocID]
+
+	var expectedErrorRaised bool
+	actionNodes := getNodes(action.NodeID, s.nodes)
+	for nodeID, collections := range getNodeCollections(action.NodeID, s.collections) {
+		err := withRetry(
+			actionNodes,
+			nodeID,
+			func() error {
+				return collections[action.CollectionID].Purge(s.ctx, doc.ID())
+			},
+		)
+		expectedErrorRaised = AssertError(s.t, s.testCase.Description, err, action.ExpectedError)
+	}
+
+	assertExpectedErrorRaised(s.t, s.testCase.Description, action.ExpectedError, expectedErrorRaised)
+}
+
 func assertIndexesListsEqual(
 	expectedIndexes []client.IndexDescription,
 	actualIndexes []client.IndexDescription,
@@ -1525,7 +1627,12 @@ func executeRequest(
 	var expectedErrorRaised bool
 	for nodeID, node := range getNodes(action.NodeID, s.nodes) {
 		db := getStore(s, node, action.TransactionID, action.ExpectedError)
-		result := db.ExecRequest(s.ctx, action.Request)
+		result := db.ExecRequest(
+			s.ctx,
+			action.Request,
+			client.WithOperationName(action.OperationName),
+			client.WithVariables(getRequestVariables(s, action.Variables)),
+		)
 
 		anyOfByFieldKey := map[docFieldKey][]any{}
 		expectedErrorRaised = assertRequestResults(
@@ -1537,11 +1644,39 @@ func executeRequest(
 			nodeID,
 			anyOfByFieldKey,
 		)
+
+		if action.Extensions != nil && !expectedErrorRaised {
+			assertResultsEqual(
+				s.t,
+				s.clientType,
+				action.Extensions,
+				result.GQL.Extensions,
+				fmt.Sprintf("node: %v, extensions", nodeID),
+			)
+		}
 	}
 
 	assertExpectedErrorRaised(s.t, s.testCase.Description, action.ExpectedError, expectedErrorRaised)
 }
 
+// getRequestVariables returns the given request variables, with the value of the [RecordedTime]
+// variables replaced by the time they refer to.
+func getRequestVariables(s *state, variables map[string]any) map[string]any {
+	if variables == nil {
+		return nil
+	}
+	result := make(map[string]any, len(variables))
+	for name, value := range variables {
+		if recordedTime, ok := value.(RecordedTime); ok {
+			recorded, ok := s.times[string(recordedTime)]
+			require.True(s.t, ok, "time %q has not been recorded", recordedTime)
+			value = recorded.Format(time.RFC3339Nano)
+		}
+		result[name] = value
+	}
+	return result
+}
+
 // executeSubscriptionRequest executes the given subscription request, returning
 // a channel that will receive a single event once the subscription has been completed.
 //